@@ -0,0 +1,214 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// BuildLog records every tool run by the builder in a file, one
+// BuildLogEntry per line in the JSON Lines format. The entries are written as
+// soon as the tools exit, so that the log of a failed or interrupted build is
+// complete up to that point. The environment of the CLI is not recorded, it
+// may contain credentials and the secrets of the sketch.
+type BuildLog struct {
+	File    *paths.Path
+	out     *os.File
+	lock    sync.Mutex
+	lastID  int
+	recipes map[*exec.Cmd]buildLogRecipe
+}
+
+type buildLogRecipe struct {
+	name    string
+	pattern string
+}
+
+// BuildLogRedacted replaces the value of the environment variables that may
+// contain credentials in a BuildLogEntry
+const BuildLogRedacted = "<redacted>"
+
+var credentialEnvRegexp = regexp.MustCompile(`(?i)(SECRET|TOKEN|PASSWORD|PASSWD|CREDENTIAL|AUTH|KEY)`)
+
+// BuildLogEntry is the record of a single run of a tool. Env contains only
+// the variables set by the builder in addition to the environment of the CLI,
+// e.g. the ones of the compiler launcher.
+type BuildLogEntry struct {
+	ID         int       `json:"id"`
+	Recipe     string    `json:"recipe,omitempty"`
+	Pattern    string    `json:"pattern,omitempty"`
+	Directory  string    `json:"directory"`
+	Arguments  []string  `json:"arguments"`
+	Env        []string  `json:"env"`
+	Start      time.Time `json:"start"`
+	DurationMs int64     `json:"duration_ms"`
+	ExitCode   int       `json:"exit_code"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// NewBuildLog creates the file of a BuildLog, replacing it if it already
+// exists
+func NewBuildLog(file *paths.Path) (*BuildLog, error) {
+	out, err := os.Create(file.String())
+	if err != nil {
+		return nil, err
+	}
+	return &BuildLog{
+		File:    file,
+		out:     out,
+		recipes: map[*exec.Cmd]buildLogRecipe{},
+	}, nil
+}
+
+// LoadBuildLog reads the entries recorded in a BuildLog file
+func LoadBuildLog(file *paths.Path) ([]*BuildLogEntry, error) {
+	in, err := os.Open(file.String())
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+	entries := []*BuildLogEntry{}
+	scanner := bufio.NewScanner(in)
+	// The environment and the command lines of the linker may be long
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		entry := &BuildLogEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, errors.Errorf("invalid entry at line %d: %s", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// Close closes the file of the log. It's a no-op on a nil BuildLog.
+func (l *BuildLog) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.out.Close()
+}
+
+// SetRecipe records that command has been prepared from the recipe with the
+// given name and pattern, they will be logged when command is run. It must be
+// called only for the commands that are about to be run. It's a no-op on a
+// nil BuildLog.
+func (l *BuildLog) SetRecipe(command *exec.Cmd, name, pattern string) {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.recipes[command] = buildLogRecipe{name: name, pattern: pattern}
+}
+
+// Begin returns the entry of a run of command that is about to start, it must
+// be completed with End once command exited. It returns nil on a nil
+// BuildLog.
+func (l *BuildLog) Begin(command *exec.Cmd) *BuildLogEntry {
+	if l == nil {
+		return nil
+	}
+	dir := command.Dir
+	if dir == "" {
+		// This mimics the Cmd.Dir behavior
+		if wd, err := os.Getwd(); err == nil {
+			dir = wd
+		}
+	}
+	env := builderEnv(command.Env, os.Environ())
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	recipe := l.recipes[command]
+	delete(l.recipes, command)
+	l.lastID++
+	return &BuildLogEntry{
+		ID:        l.lastID,
+		Recipe:    recipe.name,
+		Pattern:   recipe.pattern,
+		Directory: dir,
+		// Use the resolved path of the executable as first argument
+		Arguments: append([]string{command.Path}, command.Args[1:]...),
+		Env:       env,
+		Start:     time.Now(),
+	}
+}
+
+// builderEnv returns the variables of env that are not part of the
+// environment of the CLI, with the values that may be credentials redacted
+func builderEnv(env, cliEnv []string) []string {
+	inherited := map[string]bool{}
+	for _, variable := range cliEnv {
+		inherited[variable] = true
+	}
+	res := []string{}
+	for _, variable := range env {
+		if inherited[variable] {
+			continue
+		}
+		if name := strings.SplitN(variable, "=", 2)[0]; credentialEnvRegexp.MatchString(name) {
+			variable = name + "=" + BuildLogRedacted
+		}
+		res = append(res, variable)
+	}
+	return res
+}
+
+// End completes entry with the outcome of the run, err is the error returned
+// by the start or the wait of the command, and writes it in the log. Errors
+// writing the log are reported as warnings, they don't stop the build. It's a
+// no-op on a nil BuildLog.
+func (l *BuildLog) End(entry *BuildLogEntry, err error) {
+	if l == nil || entry == nil {
+		return
+	}
+	entry.DurationMs = time.Since(entry.Start).Milliseconds()
+	if err != nil {
+		entry.ExitCode = -1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			entry.ExitCode = exitErr.ExitCode()
+		}
+		entry.Error = err.Error()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		logrus.WithError(err).Warn("Error encoding build log entry")
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if _, err := l.out.Write(append(data, '\n')); err != nil {
+		logrus.WithError(err).WithField("file", l.File).Warn("Error writing build log")
+	}
+}
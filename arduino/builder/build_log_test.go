@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperBuildLogProcess is not a real test, it's the failing tool run by
+// TestBuildLog
+func TestHelperBuildLogProcess(t *testing.T) {
+	if os.Getenv("BUILD_LOG_HELPER_PROCESS") != "1" {
+		return
+	}
+	os.Exit(3)
+}
+
+func TestBuildLog(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	logFile := tmp.Join("build.log")
+
+	log, err := NewBuildLog(logFile)
+	require.NoError(t, err)
+
+	compile := exec.Command("gcc", "-c", "sketch.cpp")
+	compile.Dir = tmp.String()
+	log.SetRecipe(compile, "recipe.cpp.o.pattern", "{compiler.path}gcc -c {source_file}")
+	entry := log.Begin(compile)
+	log.End(entry, nil)
+
+	// Only the variables added by the builder are recorded, without credentials
+	launcher := exec.Command(os.Args[0], "-test.run=TestHelperBuildLogProcess")
+	launcher.Env = append(os.Environ(), "BUILD_LOG_HELPER_PROCESS=1", "ARDUINO_SECRETS_KEY=s3cr3t")
+	log.SetRecipe(launcher, "recipe.cpp.o.pattern", "{compiler.path}gcc -c {source_file}")
+	entry = log.Begin(launcher)
+	log.End(entry, launcher.Run())
+
+	killed := exec.Command("ctags")
+	log.End(log.Begin(killed), errors.New("signal: killed"))
+	require.NoError(t, log.Close())
+
+	entries, err := LoadBuildLog(logFile)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, 1, entries[0].ID)
+	require.Equal(t, "recipe.cpp.o.pattern", entries[0].Recipe)
+	require.Equal(t, "{compiler.path}gcc -c {source_file}", entries[0].Pattern)
+	require.Equal(t, []string{compile.Path, "-c", "sketch.cpp"}, entries[0].Arguments)
+	require.Equal(t, tmp.String(), entries[0].Directory)
+	require.Empty(t, entries[0].Env)
+	require.Equal(t, 0, entries[0].ExitCode)
+	require.Empty(t, entries[0].Error)
+
+	require.Equal(t, 2, entries[1].ID)
+	require.Equal(t, "recipe.cpp.o.pattern", entries[1].Recipe)
+	require.Equal(t, []string{"BUILD_LOG_HELPER_PROCESS=1", "ARDUINO_SECRETS_KEY=" + BuildLogRedacted}, entries[1].Env)
+	require.Equal(t, 3, entries[1].ExitCode)
+	require.Equal(t, "exit status 3", entries[1].Error)
+
+	require.Equal(t, 3, entries[2].ID)
+	require.Empty(t, entries[2].Recipe)
+	require.NotEmpty(t, entries[2].Directory)
+	require.Equal(t, -1, entries[2].ExitCode)
+	require.Equal(t, "signal: killed", entries[2].Error)
+
+	// A nil BuildLog records nothing
+	var noLog *BuildLog
+	noLog.SetRecipe(compile, "recipe.cpp.o.pattern", "")
+	noLog.End(noLog.Begin(compile), nil)
+	require.NoError(t, noLog.Close())
+}
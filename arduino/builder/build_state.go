@@ -0,0 +1,209 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// BuildState keeps track of the content of the files used to compile each
+// object file. It's saved in the build path so that the next builds can tell
+// if an object file is up to date without relying on the files timestamps,
+// that are not preserved when the sources are checked out again.
+type BuildState struct {
+	File    *paths.Path
+	Objects map[string]*ObjectState
+	lock    sync.Mutex
+	// hashes caches the hashes of the sources computed during this build,
+	// the same headers are included by many source files
+	hashes map[string]sourceHash
+}
+
+// sourceHash is the hash of a source file, valid as long as the modification
+// time and the size of the file don't change
+type sourceHash struct {
+	modTime time.Time
+	size    int64
+	hash    string
+}
+
+// ObjectState is the content of an object file and of the files used to
+// compile it, identified by their SHA-256 hash
+type ObjectState struct {
+	Hash         string            `json:"hash"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// NewBuildState creates an empty BuildState that will be saved in the
+// specified file
+func NewBuildState(file *paths.Path) *BuildState {
+	return &BuildState{
+		File:    file,
+		Objects: map[string]*ObjectState{},
+		hashes:  map[string]sourceHash{},
+	}
+}
+
+// LoadBuildState reads a BuildState from a file. A missing or unreadable
+// file results in an empty BuildState, so that everything is rebuilt.
+func LoadBuildState(file *paths.Path) *BuildState {
+	res := NewBuildState(file)
+	data, err := file.ReadFile()
+	if err != nil {
+		return res
+	}
+	if err := json.Unmarshal(data, &res.Objects); err != nil || res.Objects == nil {
+		res.Objects = map[string]*ObjectState{}
+	}
+	return res
+}
+
+// SaveToFile saves the BuildState in its file, the state of the object files
+// that have been removed from the build path is discarded.
+func (s *BuildState) SaveToFile() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for object := range s.Objects {
+		if !paths.New(object).Exist() {
+			delete(s.Objects, object)
+		}
+	}
+	data, err := json.MarshalIndent(s.Objects, "", " ")
+	if err != nil {
+		return errors.Errorf("encoding build state: %s", err)
+	}
+	if err := s.File.WriteFile(data); err != nil {
+		return errors.Errorf("writing build state: %s", err)
+	}
+	return nil
+}
+
+// Has returns true if the state of the object file has been recorded
+func (s *BuildState) Has(object *paths.Path) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.Objects[object.String()]
+	return ok
+}
+
+// IsUpToDate returns true if neither the object file nor the files used to
+// compile it changed since its state has been recorded. The dependencies
+// must be the files listed in the dependency file produced by the compiler.
+func (s *BuildState) IsUpToDate(object *paths.Path, dependencies paths.PathList) bool {
+	s.lock.Lock()
+	state, ok := s.Objects[object.String()]
+	s.lock.Unlock()
+	if !ok {
+		return false
+	}
+
+	if hash, err := hashFile(object); err != nil || hash != state.Hash {
+		return false
+	}
+	// the source file is usually listed among the dependencies too
+	checked := map[string]bool{}
+	for _, dep := range dependencies {
+		if checked[dep.String()] {
+			continue
+		}
+		checked[dep.String()] = true
+		recorded, ok := state.Dependencies[dep.String()]
+		if !ok {
+			return false
+		}
+		if hash, err := s.hashSource(dep); err != nil || hash != recorded {
+			return false
+		}
+	}
+	return len(checked) == len(state.Dependencies)
+}
+
+// Update records the current state of the object file and of its
+// dependencies. It's safe to call Update from concurrent goroutines.
+func (s *BuildState) Update(object *paths.Path, dependencies paths.PathList) error {
+	state := &ObjectState{Dependencies: map[string]string{}}
+	hash, err := hashFile(object)
+	if err != nil {
+		return errors.Errorf("computing hash of %s: %s", object, err)
+	}
+	state.Hash = hash
+	for _, dep := range dependencies {
+		hash, err := s.hashSource(dep)
+		if err != nil {
+			return errors.Errorf("computing hash of %s: %s", dep, err)
+		}
+		state.Dependencies[dep.String()] = hash
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.Objects[object.String()] = state
+	return nil
+}
+
+// Remove forgets the state of the object file, it must be called before the
+// object file is compiled again.
+func (s *BuildState) Remove(object *paths.Path) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.Objects, object.String())
+}
+
+// hashSource returns the hash of a source file, computing it again only if
+// the file has been modified since the last time
+func (s *BuildState) hashSource(source *paths.Path) (string, error) {
+	info, err := source.Stat()
+	if err != nil {
+		return "", err
+	}
+	s.lock.Lock()
+	cached, ok := s.hashes[source.String()]
+	s.lock.Unlock()
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.hash, nil
+	}
+
+	hash, err := hashFile(source)
+	if err != nil {
+		return "", err
+	}
+	s.lock.Lock()
+	s.hashes[source.String()] = sourceHash{modTime: info.ModTime(), size: info.Size(), hash: hash}
+	s.lock.Unlock()
+	return hash, nil
+}
+
+func hashFile(file *paths.Path) (string, error) {
+	f, err := os.Open(file.String())
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
@@ -0,0 +1,84 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildState(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	source := tmp.Join("sketch.cpp")
+	header := tmp.Join("sketch.h")
+	object := tmp.Join("sketch.cpp.o")
+	require.NoError(t, source.WriteFile([]byte("#include \"sketch.h\"")))
+	require.NoError(t, header.WriteFile([]byte("int a;")))
+	require.NoError(t, object.WriteFile([]byte("object")))
+	deps := paths.PathList{source, source, header}
+
+	state := NewBuildState(tmp.Join("build_state.json"))
+	require.False(t, state.Has(object))
+	require.False(t, state.IsUpToDate(object, deps))
+	require.NoError(t, state.Update(object, deps))
+	require.True(t, state.IsUpToDate(object, deps))
+	require.NoError(t, state.SaveToFile())
+
+	// The timestamps are ignored
+	future := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(source.String(), future, future))
+	state = LoadBuildState(tmp.Join("build_state.json"))
+	require.True(t, state.Has(object))
+	require.True(t, state.IsUpToDate(object, deps))
+
+	// A different set of dependencies
+	require.False(t, state.IsUpToDate(object, paths.PathList{source}))
+
+	// A change in the content of a dependency
+	require.NoError(t, header.WriteFile([]byte("int b;")))
+	state = LoadBuildState(tmp.Join("build_state.json"))
+	require.False(t, state.IsUpToDate(object, deps))
+
+	// A dependency rewritten after the state has been recorded in this build
+	require.NoError(t, state.Update(object, deps))
+	require.NoError(t, header.WriteFile([]byte("int c;")))
+	require.False(t, state.IsUpToDate(object, deps))
+
+	// A change in the content of the object file
+	require.NoError(t, state.Update(object, deps))
+	require.True(t, state.IsUpToDate(object, deps))
+	require.NoError(t, object.WriteFile([]byte("other object")))
+	require.False(t, state.IsUpToDate(object, deps))
+
+	// The state of the removed object files is not saved
+	state.Remove(object)
+	require.False(t, state.Has(object))
+	require.NoError(t, state.Update(object, deps))
+	require.NoError(t, object.Remove())
+	require.NoError(t, state.SaveToFile())
+	require.False(t, LoadBuildState(tmp.Join("build_state.json")).Has(object))
+
+	// A corrupted file results in an empty state
+	require.NoError(t, tmp.Join("build_state.json").WriteFile([]byte("{")))
+	require.Empty(t, LoadBuildState(tmp.Join("build_state.json")).Objects)
+}
@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// CompilationDatabase keeps track of all the compile commands run by the builder
+// and stores them in the JSON compilation database format used by clang tools:
+// https://clang.llvm.org/docs/JSONCompilationDatabase.html
+type CompilationDatabase struct {
+	Contents []CompilationCommand
+	File     *paths.Path
+	lock     sync.Mutex
+}
+
+// CompilationCommand keeps track of a single run of a compile command
+type CompilationCommand struct {
+	Directory string   `json:"directory"`
+	Arguments []string `json:"arguments"`
+	File      string   `json:"file"`
+}
+
+// NewCompilationDatabase creates an empty CompilationDatabase that will be
+// saved in the specified file
+func NewCompilationDatabase(filename *paths.Path) *CompilationDatabase {
+	return &CompilationDatabase{
+		File:     filename,
+		Contents: []CompilationCommand{},
+	}
+}
+
+// LoadCompilationDatabase reads a compilation database from a file
+func LoadCompilationDatabase(file *paths.Path) (*CompilationDatabase, error) {
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	res := NewCompilationDatabase(file)
+	return res, json.Unmarshal(data, &res.Contents)
+}
+
+// SaveToFile saves the CompilationDatabase to file as a clangd-compatible
+// compile_commands.json, see https://clang.llvm.org/docs/JSONCompilationDatabase.html
+func (db *CompilationDatabase) SaveToFile() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	data, err := json.MarshalIndent(db.Contents, "", " ")
+	if err != nil {
+		return errors.Errorf("encoding compilation database: %s", err)
+	}
+	if err := db.File.WriteFile(data); err != nil {
+		return errors.Errorf("writing compilation database: %s", err)
+	}
+	return nil
+}
+
+// Add adds a new CompilationDatabase entry for the command that compiles the
+// target file. It's safe to call Add from concurrent goroutines.
+func (db *CompilationDatabase) Add(target *paths.Path, command *exec.Cmd) {
+	commandDir := command.Dir
+	if commandDir == "" {
+		// This mimics the Cmd.Dir behavior
+		if dir, err := os.Getwd(); err == nil {
+			commandDir = dir
+		}
+	}
+
+	// Use the resolved path of the executable as first argument
+	args := append([]string{command.Path}, command.Args[1:]...)
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.Contents = append(db.Contents, CompilationCommand{
+		Directory: commandDir,
+		Arguments: args,
+		File:      target.String(),
+	})
+}
@@ -0,0 +1,98 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CompilerDiagnostic is an error, warning or note reported by the compiler
+type CompilerDiagnostic struct {
+	File     string                `json:"file"`
+	Line     int                   `json:"line"`
+	Column   int                   `json:"column,omitempty"`
+	Severity string                `json:"severity"`
+	Message  string                `json:"message"`
+	Notes    []*CompilerDiagnostic `json:"notes,omitempty"`
+	// Option is the compiler option that enabled the warning, e.g.
+	// "-Wunused-variable", and Promoted is true if the warning has been
+	// treated as an error, e.g. because of -Werror
+	Option   string `json:"option,omitempty"`
+	Promoted bool   `json:"promoted,omitempty"`
+}
+
+// Matches lines in the form "/path/to/file.cpp:12:5: error: 'foo' was not declared",
+// the column is optional and the severity may be localized.
+var diagnosticRegexp = regexp.MustCompile(`^(.+?):(\d+)(?::(\d+))?: ([^:\d][^:]*): (.*)$`)
+
+// Matches the option reported by GCC at the end of the warnings, in the form
+// "[-Wunused-variable]", or "[-Werror=unused-variable]" if promoted to error.
+var diagnosticOptionRegexp = regexp.MustCompile(`\[-W(error=)?([^\]\s]+)\]$`)
+
+// canonicalSeverities maps the severities emitted by GCC to the ones exposed
+// to the clients. Unknown (eg. localized) severities are reported verbatim.
+var canonicalSeverities = map[string]string{
+	"error":       "error",
+	"fatal error": "error",
+	"warning":     "warning",
+	"note":        "note",
+}
+
+// ParseCompilerDiagnostics extracts the diagnostics from the output of a
+// GCC-compatible compiler. Notes are attached to the diagnostic that
+// precedes them, source excerpts and context lines are discarded.
+func ParseCompilerDiagnostics(output []byte) []*CompilerDiagnostic {
+	res := []*CompilerDiagnostic{}
+	var last *CompilerDiagnostic
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		match := diagnosticRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		diag := &CompilerDiagnostic{
+			File:     match[1],
+			Severity: strings.ToLower(strings.TrimSpace(match[4])),
+			Message:  strings.TrimSpace(match[5]),
+		}
+		diag.Line, _ = strconv.Atoi(match[2])
+		if match[3] != "" {
+			diag.Column, _ = strconv.Atoi(match[3])
+		}
+		if severity, ok := canonicalSeverities[diag.Severity]; ok {
+			diag.Severity = severity
+		}
+		if option := diagnosticOptionRegexp.FindStringSubmatch(diag.Message); option != nil {
+			diag.Option = "-W" + option[2]
+			diag.Promoted = option[1] != ""
+		}
+
+		if diag.Severity == "note" && last != nil {
+			last.Notes = append(last.Notes, diag)
+			continue
+		}
+		res = append(res, diag)
+		last = diag
+	}
+	return res
+}
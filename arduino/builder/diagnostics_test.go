@@ -0,0 +1,96 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompilerDiagnostics(t *testing.T) {
+	output := []byte(`In file included from /home/user/Arduino/Blink/Blink.ino:1:0:
+/home/user/Arduino/Blink/foo.h:3:5: warning: unused variable 'x' [-Wunused-variable]
+     int x;
+         ^
+/home/user/Arduino/Blink/Blink.ino: In function 'void loop()':
+/home/user/Arduino/Blink/Blink.ino:9:3: error: 'bar' was not declared in this scope
+   bar();
+   ^~~
+/home/user/Arduino/Blink/Blink.ino:9:3: note: suggested alternative: 'bar2'
+   bar();
+   ^~~
+   bar2
+C:\Users\user\Arduino\Blink\Blink.ino:12:1: fatal error: extra.h: No such file or directory
+compilation terminated.
+/home/user/Arduino/Blink/Blink.ino:14: Fehler: nicht deklariert
+exit status 1
+`)
+	diags := ParseCompilerDiagnostics(output)
+	require.Len(t, diags, 4)
+
+	require.Equal(t, "/home/user/Arduino/Blink/foo.h", diags[0].File)
+	require.Equal(t, 3, diags[0].Line)
+	require.Equal(t, 5, diags[0].Column)
+	require.Equal(t, "warning", diags[0].Severity)
+	require.Equal(t, "unused variable 'x' [-Wunused-variable]", diags[0].Message)
+	require.Equal(t, "-Wunused-variable", diags[0].Option)
+	require.False(t, diags[0].Promoted)
+	require.Empty(t, diags[0].Notes)
+
+	require.Equal(t, "/home/user/Arduino/Blink/Blink.ino", diags[1].File)
+	require.Equal(t, "error", diags[1].Severity)
+	require.Equal(t, "'bar' was not declared in this scope", diags[1].Message)
+	require.Len(t, diags[1].Notes, 1)
+	require.Equal(t, "note", diags[1].Notes[0].Severity)
+	require.Equal(t, "suggested alternative: 'bar2'", diags[1].Notes[0].Message)
+
+	require.Equal(t, `C:\Users\user\Arduino\Blink\Blink.ino`, diags[2].File)
+	require.Equal(t, 12, diags[2].Line)
+	require.Equal(t, "error", diags[2].Severity)
+	require.Equal(t, "extra.h: No such file or directory", diags[2].Message)
+
+	require.Equal(t, 14, diags[3].Line)
+	require.Equal(t, 0, diags[3].Column)
+	require.Equal(t, "fehler", diags[3].Severity)
+	require.Equal(t, "nicht deklariert", diags[3].Message)
+
+	require.Empty(t, ParseCompilerDiagnostics(nil))
+}
+
+func TestParseCompilerDiagnosticsPromotedWarnings(t *testing.T) {
+	output := []byte(`/home/user/Arduino/Blink/Blink.ino: In function 'void setup()':
+/home/user/Arduino/Blink/Blink.ino:4:7: error: unused variable 'x' [-Werror=unused-variable]
+   int x;
+       ^
+/home/user/Arduino/Blink/Blink.ino:5:2: error: #warning "check this" [-Werror=cpp]
+/home/user/Arduino/Blink/Blink.ino:6:3: error: 'bar' was not declared in this scope
+cc1plus: all warnings being treated as errors
+`)
+	diags := ParseCompilerDiagnostics(output)
+	require.Len(t, diags, 3)
+
+	require.Equal(t, "error", diags[0].Severity)
+	require.Equal(t, "unused variable 'x' [-Werror=unused-variable]", diags[0].Message)
+	require.Equal(t, "-Wunused-variable", diags[0].Option)
+	require.True(t, diags[0].Promoted)
+
+	require.Equal(t, "-Wcpp", diags[1].Option)
+	require.True(t, diags[1].Promoted)
+
+	require.Empty(t, diags[2].Option)
+	require.False(t, diags[2].Promoted)
+}
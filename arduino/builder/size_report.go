@@ -0,0 +1,122 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"debug/elf"
+	"sort"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
+)
+
+// SizeReport describes the memory used by the compiled sketch
+type SizeReport struct {
+	// Memory is the usage of each memory of the board, as computed by the
+	// size recipe of the platform
+	Memory []*MemoryUsage
+	// Sections are the allocated sections of the executable
+	Sections []*SectionSize
+	// Symbols are the functions and the variables of the executable, sorted
+	// from the largest
+	Symbols []*SymbolSize
+}
+
+// MemoryUsage is the amount of a memory of the board used by the sketch.
+// MaxSize is 0 when the board doesn't define the size of the memory.
+type MemoryUsage struct {
+	Name    string
+	Size    int
+	MaxSize int
+}
+
+// SectionSize is the size of a section of the executable
+type SectionSize struct {
+	Name    string
+	Address uint64
+	Size    uint64
+}
+
+// SymbolSize is the size of a function or of a variable of the executable
+type SymbolSize struct {
+	Name    string
+	Section string
+	Type    string
+	Size    uint64
+}
+
+// ReadELFSizes returns the allocated sections of the ELF executable and its
+// largest maxSymbols symbols, all the symbols are returned if maxSymbols
+// is negative.
+func ReadELFSizes(elfFile *paths.Path, maxSymbols int) ([]*SectionSize, []*SymbolSize, error) {
+	f, err := elf.Open(elfFile.String())
+	if err != nil {
+		return nil, nil, errors.Errorf("reading executable %s: %s", elfFile, err)
+	}
+	defer f.Close()
+
+	sections := []*SectionSize{}
+	for _, section := range f.Sections {
+		if section.Flags&elf.SHF_ALLOC == 0 || section.Size == 0 {
+			continue
+		}
+		sections = append(sections, &SectionSize{
+			Name:    section.Name,
+			Address: section.Addr,
+			Size:    section.Size,
+		})
+	}
+
+	elfSymbols, err := f.Symbols()
+	if err != nil && err != elf.ErrNoSymbols {
+		return nil, nil, errors.Errorf("reading symbols of %s: %s", elfFile, err)
+	}
+	symbols := []*SymbolSize{}
+	for _, symbol := range elfSymbols {
+		if symbol.Size == 0 {
+			continue
+		}
+		symbolType := ""
+		switch elf.ST_TYPE(symbol.Info) {
+		case elf.STT_FUNC:
+			symbolType = "function"
+		case elf.STT_OBJECT:
+			symbolType = "variable"
+		default:
+			continue
+		}
+		section := ""
+		if int(symbol.Section) < len(f.Sections) {
+			section = f.Sections[symbol.Section].Name
+		}
+		symbols = append(symbols, &SymbolSize{
+			Name:    symbol.Name,
+			Section: section,
+			Type:    symbolType,
+			Size:    symbol.Size,
+		})
+	}
+	sort.SliceStable(symbols, func(i, j int) bool {
+		if symbols[i].Size != symbols[j].Size {
+			return symbols[i].Size > symbols[j].Size
+		}
+		return symbols[i].Name < symbols[j].Name
+	})
+	if maxSymbols >= 0 && len(symbols) > maxSymbols {
+		symbols = symbols[:maxSymbols]
+	}
+	return sections, symbols, nil
+}
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadELFSizes(t *testing.T) {
+	// The executable has been built from:
+	//   int counter;
+	//   char buffer[64];
+	//   const char message[] = "hello";
+	//   void setup(void) { buffer[0] = message[0]; }
+	//   void loop(void) { counter++; }
+	//   void _start(void) { setup(); for (;;) loop(); }
+	elfFile := paths.New("testdata", "TestReadELFSizes", "sketch.ino.elf")
+
+	sections, symbols, err := ReadELFSizes(elfFile, 3)
+	require.NoError(t, err)
+	require.Equal(t, []*SectionSize{
+		{Name: ".text", Address: 0x401000, Size: 29},
+		{Name: ".rodata", Address: 0x402000, Size: 6},
+		{Name: ".bss", Address: 0x403020, Size: 72},
+	}, sections)
+	require.Equal(t, []*SymbolSize{
+		{Name: "buffer", Section: ".bss", Type: "variable", Size: 64},
+		{Name: "_start", Section: ".text", Type: "function", Size: 14},
+		{Name: "setup", Section: ".text", Type: "function", Size: 8},
+	}, symbols)
+
+	_, symbols, err = ReadELFSizes(elfFile, -1)
+	require.NoError(t, err)
+	require.Len(t, symbols, 6)
+	require.Equal(t, "counter", symbols[5].Name)
+
+	_, _, err = ReadELFSizes(paths.New("testdata", "not-existent.elf"), -1)
+	require.Error(t, err)
+}
@@ -88,32 +88,33 @@ func (b *Board) GetConfigOptionValues(option string) *properties.Map {
 	return res
 }
 
-// GetBuildProperties returns the build properties and the build
-// platform for the Board with the configuration passed as parameter.
-func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map, error) {
+// NormalizeConfigs checks that the user configs are options of the board
+// menus with a valid value and returns them in the order of the menus, with
+// the options missing in userConfigs set to their default value (the first
+// value of the menu).
+func (b *Board) NormalizeConfigs(userConfigs *properties.Map) (*properties.Map, error) {
 	// Clone user configs because they are destroyed during iteration
 	userConfigs = userConfigs.Clone()
 
-	// Start with board's base properties
-	buildProperties := b.Properties.Clone()
-
-	// Add all sub-configurations one by one (a config is: option=value)
+	res := properties.NewMap()
 	menu := b.Properties.SubTree("menu")
 	for _, option := range menu.FirstLevelKeys() {
-		optionMenu := menu.SubTree(option)
+		values := menu.SubTree(option).FirstLevelKeys()
+		if len(values) == 0 {
+			continue
+		}
 		userValue, haveUserValue := userConfigs.GetOk(option)
 		if haveUserValue {
 			userConfigs.Remove(option)
-			if !optionMenu.ContainsKey(userValue) {
-				return nil, fmt.Errorf("invalid value '%s' for option '%s'", userValue, option)
+			if !menu.SubTree(option).ContainsKey(userValue) {
+				return nil, fmt.Errorf("invalid value '%s' for option '%s', valid values are: %s",
+					userValue, option, strings.Join(values, ", "))
 			}
 		} else {
 			// apply default
-			userValue = optionMenu.FirstLevelKeys()[0]
+			userValue = values[0]
 		}
-
-		optionsConf := optionMenu.SubTree(userValue)
-		buildProperties.Merge(optionsConf)
+		res.Set(option, userValue)
 	}
 
 	// Check for residual invalid options...
@@ -121,7 +122,32 @@ func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map
 		if invalidOption == "" {
 			return nil, fmt.Errorf("invalid empty option found")
 		}
-		return nil, fmt.Errorf("invalid option '%s'", invalidOption)
+		if res.Size() == 0 {
+			return nil, fmt.Errorf("invalid option '%s', the board has no options", invalidOption)
+		}
+		return nil, fmt.Errorf("invalid option '%s', valid options are: %s",
+			invalidOption, strings.Join(res.Keys(), ", "))
+	}
+
+	return res, nil
+}
+
+// GetBuildProperties returns the build properties and the build
+// platform for the Board with the configuration passed as parameter.
+func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map, error) {
+	configs, err := b.NormalizeConfigs(userConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start with board's base properties
+	buildProperties := b.Properties.Clone()
+
+	// Add all sub-configurations one by one (a config is: option=value)
+	menu := b.Properties.SubTree("menu")
+	for _, option := range configs.Keys() {
+		optionsConf := menu.SubTree(option).SubTree(configs.Get(option))
+		buildProperties.Merge(optionsConf)
 	}
 
 	return buildProperties, nil
@@ -16,6 +16,7 @@
 package cores
 
 import (
+	"strings"
 	"testing"
 
 	properties "github.com/arduino/go-properties-orderedmap"
@@ -339,3 +340,30 @@ func TestBoardOptions(t *testing.T) {
 	// require.NoError(t, err, "marshaling result")
 	// fmt.Print(string(data))
 }
+
+func TestBoardNormalizeConfigs(t *testing.T) {
+	configs, err := boardMega.NormalizeConfigs(properties.NewFromHashmap(map[string]string{"cpu": "atmega1280"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"cpu"}, configs.Keys())
+	require.Equal(t, "atmega1280", configs.Get("cpu"))
+
+	// Missing options are set to their default value
+	configs, err = boardWatterottTiny841.NormalizeConfigs(properties.NewFromHashmap(map[string]string{"core": "spencekonde"}))
+	require.NoError(t, err)
+	require.Equal(t, "spencekonde", configs.Get("core"))
+	require.Equal(t, "info", configs.Get("info"))
+
+	configs, err = boardUno.NormalizeConfigs(properties.NewMap())
+	require.NoError(t, err)
+	require.Equal(t, 0, configs.Size())
+
+	_, err = boardMega.NormalizeConfigs(properties.NewFromHashmap(map[string]string{"cpu": "atmegassss"}))
+	require.EqualError(t, err, "invalid value 'atmegassss' for option 'cpu', valid values are: "+
+		strings.Join(boardMega.GetConfigOptionValues("cpu").Keys(), ", "))
+
+	_, err = boardMega.NormalizeConfigs(properties.NewFromHashmap(map[string]string{"speed": "fast"}))
+	require.EqualError(t, err, "invalid option 'speed', valid options are: cpu")
+
+	_, err = boardUno.NormalizeConfigs(properties.NewFromHashmap(map[string]string{"cpu": "atmega1280"}))
+	require.EqualError(t, err, "invalid option 'cpu', the board has no options")
+}
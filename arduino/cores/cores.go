@@ -31,6 +31,7 @@ type Platform struct {
 	Architecture string // The name of the architecture of this package.
 	Name         string
 	Category     string
+	Deprecated   bool                        // The platform has been marked as deprecated in the package index.
 	Releases     map[string]*PlatformRelease // The Releases of this platform, labeled by version.
 	Package      *Package                    `json:"-"`
 }
@@ -261,24 +262,59 @@ func (release *PlatformRelease) String() string {
 	return release.Platform.String() + "@" + version
 }
 
+// IncompatibleTools returns the tools required by the PlatformRelease that are
+// not installed and have no flavour available for the running O.S.
+func (release *PlatformRelease) IncompatibleTools() ([]*ToolRelease, error) {
+	if release.Platform.Package == nil {
+		return nil, nil
+	}
+	deps, err := release.Platform.Package.Packages.GetDepsOfPlatformRelease(release)
+	if err != nil {
+		return nil, err
+	}
+	res := []*ToolRelease{}
+	for _, tool := range deps {
+		if !tool.IsCompatibleWithCurrentMachine() {
+			res = append(res, tool)
+		}
+	}
+	return res, nil
+}
+
+// IsCompatibleWithCurrentMachine returns true if the PlatformRelease is installed
+// or if all the tools it requires can be used on the running O.S.
+func (release *PlatformRelease) IsCompatibleWithCurrentMachine() bool {
+	if release.IsInstalled() {
+		return true
+	}
+	incompatible, err := release.IncompatibleTools()
+	return err == nil && len(incompatible) == 0
+}
+
 // MarshalJSON provides a more user friendly serialization for
 // PlatformRelease objects.
 func (release *PlatformRelease) MarshalJSON() ([]byte, error) {
 	latestStr := ""
+	latestIncompatible := false
 	latest := release.Platform.GetLatestRelease()
 	if latest != nil {
 		latestStr = latest.Version.String()
+		latestIncompatible = !latest.IsCompatibleWithCurrentMachine()
 	}
 
 	return json.Marshal(&struct {
-		ID        string `json:"ID,omitempty"`
-		Installed string `json:"Installed,omitempty"`
-		Latest    string `json:"Latest,omitempty"`
-		Name      string `json:"Name,omitempty"`
+		ID                 string `json:"ID,omitempty"`
+		Installed          string `json:"Installed,omitempty"`
+		Latest             string `json:"Latest,omitempty"`
+		Name               string `json:"Name,omitempty"`
+		Deprecated         bool   `json:"Deprecated,omitempty"`
+		LatestIncompatible bool   `json:"LatestIncompatible,omitempty"`
 	}{
-		ID:        release.Platform.String(),
-		Installed: release.Version.String(),
-		Latest:    latestStr,
-		Name:      release.Platform.Name,
+		ID:                 release.Platform.String(),
+		Installed:          release.Version.String(),
+		Latest:             latestStr,
+		Name:               release.Platform.Name,
+		Deprecated:         release.Platform.Deprecated,
+		LatestIncompatible: latestIncompatible,
 	})
 }
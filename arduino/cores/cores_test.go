@@ -18,6 +18,7 @@ package cores
 import (
 	"testing"
 
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 	semver "go.bug.st/relaxed-semver"
 )
@@ -55,3 +56,33 @@ func TestRequiresToolRelease(t *testing.T) {
 	toolRelease.Version = semver.ParseRelaxed(toolDependencyVersion)
 	require.True(t, release.RequiresToolRelease(toolRelease))
 }
+
+func TestIncompatibleTools(t *testing.T) {
+	packages := NewPackages()
+	pkg := packages.GetOrCreatePackage("test")
+	compatible := pkg.GetOrCreateTool("compatible").GetOrCreateRelease(semver.ParseRelaxed("1.0.0"))
+	compatible.Flavors = []*Flavor{{OS: "all"}}
+	incompatible := pkg.GetOrCreateTool("incompatible").GetOrCreateRelease(semver.ParseRelaxed("1.0.0"))
+	incompatible.Flavors = []*Flavor{{OS: "unsupported-host"}}
+
+	release, err := pkg.GetOrCreatePlatform("x86").GetOrCreateRelease(semver.MustParse("1.0.0"))
+	require.NoError(t, err)
+	release.Dependencies = ToolDependencies{
+		{ToolName: "compatible", ToolVersion: semver.ParseRelaxed("1.0.0"), ToolPackager: "test"},
+	}
+	tools, err := release.IncompatibleTools()
+	require.NoError(t, err)
+	require.Empty(t, tools)
+	require.True(t, release.IsCompatibleWithCurrentMachine())
+
+	release.Dependencies = append(release.Dependencies,
+		&ToolDependency{ToolName: "incompatible", ToolVersion: semver.ParseRelaxed("1.0.0"), ToolPackager: "test"})
+	tools, err = release.IncompatibleTools()
+	require.NoError(t, err)
+	require.Equal(t, []*ToolRelease{incompatible}, tools)
+	require.False(t, release.IsCompatibleWithCurrentMachine())
+
+	// A tool already installed is always usable
+	incompatible.InstallDir = paths.New("tools", "incompatible")
+	require.True(t, release.IsCompatibleWithCurrentMachine())
+}
@@ -51,6 +51,7 @@ type indexPlatformRelease struct {
 	Architecture     string                `json:"architecture"`
 	Version          *semver.Version       `json:"version,required"`
 	Category         string                `json:"category"`
+	Deprecated       bool                  `json:"deprecated,omitempty"`
 	URL              string                `json:"url"`
 	ArchiveFileName  string                `json:"archiveFileName,required"`
 	Checksum         string                `json:"checksum,required"`
@@ -127,6 +128,7 @@ func (inPlatformRelease indexPlatformRelease) extractPlatformIn(outPackage *core
 	// FIXME: shall we use the Name and Category of the latest release? or maybe move Name and Category in PlatformRelease?
 	outPlatform.Name = inPlatformRelease.Name
 	outPlatform.Category = inPlatformRelease.Category
+	outPlatform.Deprecated = inPlatformRelease.Deprecated
 
 	size, err := inPlatformRelease.Size.Int64()
 	if err != nil {
@@ -0,0 +1,186 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// archiveMetadataFileName is the name of the file, placed in the install dir of
+// a platform installed from an archive, that keeps track of where the platform
+// comes from.
+const archiveMetadataFileName = "installed.json"
+
+// archiveMetadata contains the informations recorded when a platform is installed
+// from an archive that is not listed in any package index.
+type archiveMetadata struct {
+	Name            string `json:"name"`
+	Architecture    string `json:"architecture"`
+	Version         string `json:"version"`
+	ArchiveFileName string `json:"archiveFileName"`
+	URL             string `json:"url,omitempty"`
+	Checksum        string `json:"checksum"`
+	Size            int64  `json:"size"`
+}
+
+// InstallPlatformFromArchive installs the platform contained in the specified archive
+// as packageName:architecture. If version is nil the version is taken from the
+// platform.txt found in the archive. url is the location the archive has been
+// downloaded from (if any) and it is recorded in the platform metadata together
+// with the archive checksum.
+func (pm *PackageManager) InstallPlatformFromArchive(archivePath *paths.Path, url, packageName, architecture string, version *semver.Version) (*cores.PlatformRelease, error) {
+	if packageName == "" || architecture == "" {
+		return nil, fmt.Errorf("package and architecture of the platform must be specified")
+	}
+	checksum, size, err := archiveChecksum(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("computing archive checksum: %s", err)
+	}
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+
+	// Extract the archive in a temporary dir
+	if err := pm.TempDir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating temp dir for extraction: %s", err)
+	}
+	tempDir, err := pm.TempDir.MkTempDir("platform-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir for extraction: %s", err)
+	}
+	defer tempDir.RemoveAll()
+	root, err := resources.UnpackArchive(archivePath, tempDir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the archive actually contains a platform
+	if exist, err := root.Join("boards.txt").ExistCheck(); err != nil {
+		return nil, fmt.Errorf("opening boards.txt: %s", err)
+	} else if !exist {
+		return nil, fmt.Errorf("%s does not contain a platform: boards.txt not found", archivePath.Base())
+	}
+	platformTxt, err := properties.SafeLoad(root.Join("platform.txt").String())
+	if err != nil {
+		return nil, fmt.Errorf("loading platform.txt: %s", err)
+	}
+	if version == nil {
+		v, ok := platformTxt.GetOk("version")
+		if !ok {
+			return nil, fmt.Errorf("platform version not specified and not found in platform.txt")
+		}
+		if version, err = semver.Parse(v); err != nil {
+			return nil, fmt.Errorf("invalid version in platform.txt: %s", err)
+		}
+	}
+
+	destDir := pm.PackagesDir.Join(packageName, "hardware", architecture, version.String())
+	if destDir.Exist() {
+		return nil, fmt.Errorf("platform %s:%s@%s already installed", packageName, architecture, version)
+	}
+
+	// The metadata is written before moving the platform in place, so that
+	// a platform is never found without it
+	metadata := &archiveMetadata{
+		Name:            platformTxt.Get("name"),
+		Architecture:    architecture,
+		Version:         version.String(),
+		ArchiveFileName: archivePath.Base(),
+		URL:             url,
+		Checksum:        checksum,
+		Size:            size,
+	}
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding platform metadata: %s", err)
+	}
+	if err := root.Join(archiveMetadataFileName).WriteFile(data); err != nil {
+		return nil, fmt.Errorf("writing platform metadata: %s", err)
+	}
+	if err := destDir.Parent().MkdirAll(); err != nil {
+		return nil, err
+	}
+	if err := root.Rename(destDir); err != nil {
+		return nil, fmt.Errorf("moving extracted archive to destination dir: %s", err)
+	}
+
+	platform := pm.Packages.GetOrCreatePackage(packageName).GetOrCreatePlatform(architecture)
+	release, err := platform.GetOrCreateRelease(version)
+	if err != nil {
+		return nil, err
+	}
+	if err := pm.loadPlatformRelease(release, destDir); err != nil {
+		destDir.RemoveAll()
+		return nil, fmt.Errorf("loading platform release: %s", err)
+	}
+	return release, nil
+}
+
+// loadArchiveMetadata fills the platform release with the informations recorded
+// by InstallPlatformFromArchive, if any.
+func loadArchiveMetadata(release *cores.PlatformRelease, installDir *paths.Path) error {
+	metadataPath := installDir.Join(archiveMetadataFileName)
+	if !metadataPath.Exist() {
+		return nil
+	}
+	data, err := metadataPath.ReadFile()
+	if err != nil {
+		return err
+	}
+	var metadata archiveMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return fmt.Errorf("decoding %s: %s", metadataPath, err)
+	}
+	if release.Platform.Name == "" {
+		release.Platform.Name = metadata.Name
+	}
+	if release.Resource == nil {
+		release.Resource = &resources.DownloadResource{
+			URL:             metadata.URL,
+			ArchiveFileName: metadata.ArchiveFileName,
+			Checksum:        metadata.Checksum,
+			Size:            metadata.Size,
+		}
+	}
+	return nil
+}
+
+func archiveChecksum(archivePath *paths.Path) (string, int64, error) {
+	file, err := os.Open(archivePath.String())
+	if err != nil {
+		return "", 0, err
+	}
+	defer file.Close()
+	algo := sha256.New()
+	size, err := io.Copy(algo, file)
+	if err != nil {
+		return "", 0, err
+	}
+	return "SHA-256:" + hex.EncodeToString(algo.Sum(nil)), size, nil
+}
@@ -0,0 +1,112 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// InstallPlatformFromDir installs the platform contained in the specified
+// directory as packageName:architecture, creating a link to the directory in
+// the PackagesDir instead of copying its content, so that the changes made to
+// the directory are picked up without reinstalling. If version is nil the
+// version is taken from the platform.txt found in the directory.
+func (pm *PackageManager) InstallPlatformFromDir(dir *paths.Path, packageName, architecture string, version *semver.Version) (*cores.PlatformRelease, error) {
+	if packageName == "" || architecture == "" {
+		return nil, fmt.Errorf("package and architecture of the platform must be specified")
+	}
+	absDir, err := dir.Abs()
+	if err != nil {
+		return nil, fmt.Errorf("finding absolute path of %s: %s", dir, err)
+	}
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	dir = absDir
+
+	// Check that the directory actually contains a platform
+	if exist, err := dir.Join("boards.txt").ExistCheck(); err != nil {
+		return nil, fmt.Errorf("opening boards.txt: %s", err)
+	} else if !exist {
+		return nil, fmt.Errorf("%s does not contain a platform: boards.txt not found", dir)
+	}
+	if version == nil {
+		platformTxt, err := properties.SafeLoad(dir.Join("platform.txt").String())
+		if err != nil {
+			return nil, fmt.Errorf("loading platform.txt: %s", err)
+		}
+		v, ok := platformTxt.GetOk("version")
+		if !ok {
+			return nil, fmt.Errorf("platform version not specified and not found in platform.txt")
+		}
+		if version, err = semver.Parse(v); err != nil {
+			return nil, fmt.Errorf("invalid version in platform.txt: %s", err)
+		}
+	}
+
+	destDir := pm.PackagesDir.Join(packageName, "hardware", architecture, version.String())
+	if destDir.Exist() {
+		return nil, fmt.Errorf("platform %s:%s@%s already installed", packageName, architecture, version)
+	}
+	if err := destDir.Parent().MkdirAll(); err != nil {
+		return nil, err
+	}
+	if err := os.Symlink(dir.String(), destDir.String()); err != nil {
+		return nil, fmt.Errorf("linking %s: %s", dir, err)
+	}
+
+	platform := pm.Packages.GetOrCreatePackage(packageName).GetOrCreatePlatform(architecture)
+	release, err := platform.GetOrCreateRelease(version)
+	if err != nil {
+		return nil, err
+	}
+	if err := pm.loadPlatformRelease(release, destDir); err != nil {
+		return nil, fmt.Errorf("loading platform release: %s", err)
+	}
+	return release, nil
+}
+
+// IsLinkedPlatformRelease returns true if the PlatformRelease has been installed
+// with InstallPlatformFromDir, as a link to a directory outside the PackagesDir.
+func (pm *PackageManager) IsLinkedPlatformRelease(platformRelease *cores.PlatformRelease) bool {
+	if pm.PackagesDir == nil || platformRelease.InstallDir == nil {
+		return false
+	}
+	if info, err := os.Lstat(platformRelease.InstallDir.String()); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		return false
+	}
+	linkDir := platformRelease.InstallDir.Parent()
+	if linkDir.FollowSymLink() != nil {
+		return false
+	}
+	packagesDir := pm.PackagesDir.Clone()
+	if packagesDir.FollowSymLink() != nil {
+		return false
+	}
+	linked, err := linkDir.IsInsideDir(packagesDir)
+	if err != nil {
+		return false
+	}
+	return linked
+}
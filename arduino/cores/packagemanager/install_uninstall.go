@@ -16,22 +16,47 @@
 package packagemanager
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"runtime"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/filelock"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/executils"
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
 )
 
-// InstallPlatform installs a specific release of a platform.
-func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease) error {
+// lockDataDir locks the data directory for an installation or removal, waiting
+// at most LockTimeout for the other processes to release it. The returned
+// lock is nil if the data directory is not set.
+func (pm *PackageManager) lockDataDir() (*filelock.Lock, error) {
+	if pm.IndexDir == nil {
+		return nil, nil
+	}
+	return filelock.Acquire(pm.IndexDir, pm.LockTimeout)
+}
+
+// InstallPlatform installs a specific release of a platform, reporting the
+// progress of the extraction to progressCB, if not nil.
+func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease, progressCB resources.ExtractProgressCB) error {
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
 	destDir := pm.PackagesDir.Join(
 		platformRelease.Platform.Package.Name,
 		"hardware",
 		platformRelease.Platform.Architecture,
 		platformRelease.Version.String())
-	if err := platformRelease.Resource.Install(pm.DownloadDir, pm.TempDir, destDir); err != nil {
+	if err := platformRelease.Resource.Install(pm.DownloadDir, destDir, verifyPlatformDir, progressCB); err != nil {
 		return errors.Errorf("installing platform %s: %s", platformRelease, err)
 	}
 	if d, err := destDir.Abs(); err == nil {
@@ -42,11 +67,32 @@ func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease
 	return nil
 }
 
-// RunPostInstallScript runs the post_install.sh (or post_install.bat) script for the
-// specified platformRelease.
-func (pm *PackageManager) RunPostInstallScript(platformRelease *cores.PlatformRelease) error {
+// verifyPlatformDir checks that the content of a platform archive, unpacked in
+// dir, actually is a platform.
+func verifyPlatformDir(dir *paths.Path) error {
+	if exist, err := dir.Join("boards.txt").ExistCheck(); err != nil {
+		return fmt.Errorf("opening boards.txt: %s", err)
+	} else if !exist {
+		return fmt.Errorf("boards.txt not found")
+	}
+	return nil
+}
+
+// PostInstallScriptTimeout is the maximum time a post-install script can run
+var PostInstallScriptTimeout = 10 * time.Minute
+
+// postInstallEnvironment are the environment variables passed to the
+// post-install scripts, all the others are removed
+var postInstallEnvironment = []string{
+	"PATH", "HOME", "USER", "LOGNAME", "LANG", "LC_ALL", "TMPDIR", "DISPLAY",
+	"SYSTEMROOT", "SYSTEMDRIVE", "WINDIR", "COMSPEC", "PATHEXT", "TEMP", "TMP", "USERPROFILE", "APPDATA", "LOCALAPPDATA",
+}
+
+// PostInstallScript returns the post_install.sh (or post_install.bat) script
+// of the specified platformRelease, or nil if the platform doesn't have one.
+func (pm *PackageManager) PostInstallScript(platformRelease *cores.PlatformRelease) *paths.Path {
 	if !platformRelease.IsInstalled() {
-		return errors.New("platform not installed")
+		return nil
 	}
 	postInstallFilename := "post_install.sh"
 	if runtime.GOOS == "windows" {
@@ -54,14 +100,48 @@ func (pm *PackageManager) RunPostInstallScript(platformRelease *cores.PlatformRe
 	}
 	postInstall := platformRelease.InstallDir.Join(postInstallFilename)
 	if postInstall.Exist() && postInstall.IsNotDir() {
-		cmd, err := executils.NewProcessFromPath(postInstall)
-		if err != nil {
-			return err
+		return postInstall
+	}
+	return nil
+}
+
+// RunPostInstallScript runs the post-install script of the specified
+// platformRelease, if any, passing each line of its output to outputCB. The
+// script runs in the platform folder with a minimal environment and without
+// standard input, it's killed if ctx is canceled or if it runs for longer
+// than PostInstallScriptTimeout.
+func (pm *PackageManager) RunPostInstallScript(ctx context.Context, platformRelease *cores.PlatformRelease, outputCB executils.LineCB) error {
+	if !platformRelease.IsInstalled() {
+		return errors.New("platform not installed")
+	}
+	postInstall := pm.PostInstallScript(platformRelease)
+	if postInstall == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, PostInstallScriptTimeout)
+	defer cancel()
+	cmd, err := executils.NewProcessFromPathWithContext(ctx, postInstall)
+	if err != nil {
+		return err
+	}
+	cmd.SetDirFromPath(platformRelease.InstallDir)
+	env := []string{}
+	for _, key := range postInstallEnvironment {
+		if value, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+value)
 		}
-		cmd.SetDirFromPath(platformRelease.InstallDir)
-		if err := cmd.Run(); err != nil {
-			return err
+	}
+	cmd.SetEnvironment(env)
+	if outputCB != nil {
+		cmd.RedirectStdoutLinesTo(outputCB)
+		cmd.RedirectStderrLinesTo(outputCB)
+	}
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("%s timed out after %s", postInstall.Base(), PostInstallScriptTimeout)
 		}
+		return fmt.Errorf("running %s: %w", postInstall.Base(), err)
 	}
 	return nil
 }
@@ -71,6 +151,9 @@ func (pm *PackageManager) IsManagedPlatformRelease(platformRelease *cores.Platfo
 	if pm.PackagesDir == nil {
 		return false
 	}
+	if pm.IsLinkedPlatformRelease(platformRelease) {
+		return true
+	}
 	installDir := platformRelease.InstallDir.Clone()
 	if installDir.FollowSymLink() != nil {
 		return false
@@ -97,25 +180,55 @@ func (pm *PackageManager) UninstallPlatform(platformRelease *cores.PlatformRelea
 		return fmt.Errorf("%s is not managed by package manager", platformRelease)
 	}
 
-	if err := platformRelease.InstallDir.RemoveAll(); err != nil {
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	if pm.IsLinkedPlatformRelease(platformRelease) {
+		// Remove only the link, leaving the linked directory untouched
+		if err := platformRelease.InstallDir.Remove(); err != nil {
+			return fmt.Errorf("removing platform link: %s", err)
+		}
+	} else if err := platformRelease.InstallDir.RemoveAll(); err != nil {
 		return fmt.Errorf("removing platform files: %s", err)
 	}
 	platformRelease.InstallDir = nil
 	return nil
 }
 
-// InstallTool installs a specific release of a tool.
-func (pm *PackageManager) InstallTool(toolRelease *cores.ToolRelease) error {
+// InstallTool installs a specific release of a tool, reporting the progress of
+// the extraction to progressCB, if not nil.
+func (pm *PackageManager) InstallTool(toolRelease *cores.ToolRelease, progressCB resources.ExtractProgressCB) error {
 	toolResource := toolRelease.GetCompatibleFlavour()
 	if toolResource == nil {
 		return fmt.Errorf("no compatible version of %s tools found for the current os", toolRelease.Tool.Name)
 	}
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
 	destDir := pm.PackagesDir.Join(
 		toolRelease.Tool.Package.Name,
 		"tools",
 		toolRelease.Tool.Name,
 		toolRelease.Version.String())
-	return toolResource.Install(pm.DownloadDir, pm.TempDir, destDir)
+
+	// Tools are extracted once in the store and shared by all the releases
+	// installed from the same archive
+	storeDir := pm.toolsStoreEntry(toolResource)
+	if storeDir == nil {
+		return toolResource.Install(pm.DownloadDir, destDir, nil, progressCB)
+	}
+	if storeDir.IsDir() {
+		pm.Log.WithField("tool", toolRelease).Info("Tool found in store")
+	} else if err := toolResource.Install(pm.DownloadDir, storeDir, nil, progressCB); err != nil {
+		return err
+	}
+	return linkToolDir(storeDir, destDir)
 }
 
 // IsManagedToolRelease returns true if the ToolRelease is managed by the PackageManager
@@ -131,7 +244,15 @@ func (pm *PackageManager) IsManagedToolRelease(toolRelease *cores.ToolRelease) b
 	if packagesDir.FollowSymLink() != nil {
 		return false
 	}
-	managed, err := installDir.IsInsideDir(packagesDir)
+	if managed, err := installDir.IsInsideDir(packagesDir); err == nil && managed {
+		return true
+	}
+	// Tools linked to the store are resolved to the store entry
+	storeDir := pm.ToolsStoreDir()
+	if storeDir == nil || storeDir.FollowSymLink() != nil {
+		return false
+	}
+	managed, err := installDir.IsInsideDir(storeDir)
 	if err != nil {
 		return false
 	}
@@ -149,6 +270,14 @@ func (pm *PackageManager) UninstallTool(toolRelease *cores.ToolRelease) error {
 		return fmt.Errorf("tool %s is not managed by package manager", toolRelease)
 	}
 
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+
+	// Only the link is removed for tools linked to the store, the stored files
+	// are removed by CleanToolsStore once no longer referenced
 	if err := toolRelease.InstallDir.RemoveAll(); err != nil {
 		return fmt.Errorf("removing tool files: %s", err)
 	}
@@ -159,15 +288,74 @@ func (pm *PackageManager) UninstallTool(toolRelease *cores.ToolRelease) error {
 // IsToolRequired returns true if any of the installed platforms requires the toolRelease
 // passed as parameter
 func (pm *PackageManager) IsToolRequired(toolRelease *cores.ToolRelease) bool {
+	return len(pm.ToolReleaseDependants(toolRelease)) > 0
+}
+
+// ToolReleaseDependants returns the installed platforms that require the
+// toolRelease passed as parameter
+func (pm *PackageManager) ToolReleaseDependants(toolRelease *cores.ToolRelease) []*cores.PlatformRelease {
+	dependants := []*cores.PlatformRelease{}
 	// Search in all installed platforms
 	for _, targetPackage := range pm.Packages {
 		for _, platform := range targetPackage.Platforms {
 			if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
 				if platformRelease.RequiresToolRelease(toolRelease) {
-					return true
+					dependants = append(dependants, platformRelease)
+				}
+			}
+		}
+	}
+	sortPlatformReleases(dependants)
+	return dependants
+}
+
+// PlatformReleaseDependants returns the installed platforms having boards that
+// refer to the core or to a variant of the platformRelease passed as parameter
+// (for example `build.core=arduino:arduino`)
+func (pm *PackageManager) PlatformReleaseDependants(platformRelease *cores.PlatformRelease) []*cores.PlatformRelease {
+	referredPackage := platformRelease.Platform.Package.Name
+	architecture := platformRelease.Platform.Architecture
+
+	dependants := []*cores.PlatformRelease{}
+	for _, targetPackage := range pm.Packages {
+		for _, platform := range targetPackage.Platforms {
+			if platform == platformRelease.Platform || platform.Architecture != architecture {
+				continue
+			}
+			release := pm.GetInstalledPlatformRelease(platform)
+			if release == nil {
+				continue
+			}
+			for _, board := range release.Boards {
+				if boardRefersToPackage(board, referredPackage) {
+					dependants = append(dependants, release)
+					break
 				}
 			}
 		}
 	}
+	sortPlatformReleases(dependants)
+	return dependants
+}
+
+// boardRefersToPackage returns true if the build core or the build variant
+// of the board, or of any of its menu options, is taken from the package
+// passed as parameter
+func boardRefersToPackage(board *cores.Board, packageName string) bool {
+	for key, value := range board.Properties.AsMap() {
+		if key != "build.core" && key != "build.variant" &&
+			!strings.HasSuffix(key, ".build.core") && !strings.HasSuffix(key, ".build.variant") {
+			continue
+		}
+		if parts := strings.SplitN(value, ":", 2); len(parts) == 2 && parts[0] == packageName {
+			return true
+		}
+	}
 	return false
 }
+
+func sortPlatformReleases(releases []*cores.PlatformRelease) {
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].String() < releases[j].String()
+	})
+}
@@ -87,10 +87,10 @@ func (pm *PackageManager) LoadHardwareFromDirectory(path *paths.Path) error {
 			continue
 		}
 
-		// Follow symlinks
-		err := packagerPath.FollowSymLink() // ex: .arduino15/packages/arduino/
-		if err != nil {
-			return fmt.Errorf("following possible symlink %s: %s", path, err)
+		// Follow symlinks. The resolution may fail on some network shares
+		// (UNC paths on Windows), in that case the path is used as is.
+		if err := packagerPath.FollowSymLink(); err != nil { // ex: .arduino15/packages/arduino/
+			pm.Log.WithError(err).Warnf("Cannot follow possible symlink %s", packagerPath)
 		}
 
 		// There are two possible package directory structures:
@@ -296,6 +296,11 @@ func (pm *PackageManager) loadPlatformRelease(platform *cores.PlatformRelease, p
 		return err
 	}
 
+	// Platforms installed from an archive carry their own metadata
+	if err := loadArchiveMetadata(platform, path); err != nil {
+		return err
+	}
+
 	return nil
 }
 
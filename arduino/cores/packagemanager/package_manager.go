@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
@@ -43,6 +44,9 @@ type PackageManager struct {
 	DownloadDir            *paths.Path
 	TempDir                *paths.Path
 	CustomGlobalProperties *properties.Map
+	// LockTimeout is how long the installations wait for the data directory
+	// locked by another process
+	LockTimeout time.Duration
 }
 
 // NewPackageManager returns a new instance of the PackageManager
@@ -331,7 +335,12 @@ func (tr *ToolReleaseActions) Get() (*cores.ToolRelease, error) {
 	return tr.release, nil
 }
 
-// GetInstalledPlatformRelease returns the PlatformRelease installed (it is chosen)
+// GetInstalledPlatformRelease returns the PlatformRelease installed (it is chosen).
+// Like in the Arduino IDE, the platforms loaded from the hardware directories of
+// the user (e.g. the sources of a core being developed) and the ones linked with
+// InstallPlatformFromDir have precedence over the ones installed by the
+// PackageManager, that have precedence over the ones bundled in the IDE. Between releases with the same precedence the greater
+// version is chosen.
 func (pm *PackageManager) GetInstalledPlatformRelease(platform *cores.Platform) *cores.PlatformRelease {
 	releases := platform.GetAllInstalled()
 	if len(releases) == 0 {
@@ -344,34 +353,53 @@ func (pm *PackageManager) GetInstalledPlatformRelease(platform *cores.Platform)
 			WithField("managed", pm.IsManagedPlatformRelease(pl)).
 			Debugf("%s: %s", msg, pl)
 	}
+	precedence := func(pl *cores.PlatformRelease) int {
+		if pm.IsLinkedPlatformRelease(pl) {
+			return 2
+		}
+		if pm.IsManagedPlatformRelease(pl) {
+			return 1
+		}
+		if pl.IsIDEBundled {
+			return 0
+		}
+		return 2
+	}
 
 	best := releases[0]
-	bestIsManaged := pm.IsManagedPlatformRelease(best)
+	bestPrecedence := precedence(best)
 	debug("current best", best)
 
 	for _, candidate := range releases[1:] {
-		candidateIsManaged := pm.IsManagedPlatformRelease(candidate)
+		candidatePrecedence := precedence(candidate)
 		debug("candidate", candidate)
-		// TODO: Disentangle this algorithm and make it more straightforward
-		if bestIsManaged == candidateIsManaged {
-			if best.IsIDEBundled == candidate.IsIDEBundled {
-				if candidate.Version.GreaterThan(best.Version) {
-					best = candidate
-				}
-			}
-			if best.IsIDEBundled && !candidate.IsIDEBundled {
-				best = candidate
-			}
-		}
-		if !bestIsManaged && candidateIsManaged {
+		if candidatePrecedence > bestPrecedence ||
+			(candidatePrecedence == bestPrecedence && candidate.Version.GreaterThan(best.Version)) {
 			best = candidate
-			bestIsManaged = true
+			bestPrecedence = candidatePrecedence
 		}
 		debug("current best", best)
 	}
 	return best
 }
 
+// GetInstalledManagedPlatformRelease returns the latest PlatformRelease installed
+// by the PackageManager, ignoring the ones loaded from other hardware directories,
+// or nil if there is none. This is the release to replace or remove when the
+// platform is upgraded or uninstalled.
+func (pm *PackageManager) GetInstalledManagedPlatformRelease(platform *cores.Platform) *cores.PlatformRelease {
+	var best *cores.PlatformRelease
+	for _, release := range platform.GetAllInstalled() {
+		if !pm.IsManagedPlatformRelease(release) {
+			continue
+		}
+		if best == nil || release.Version.GreaterThan(best.Version) {
+			best = release
+		}
+	}
+	return best
+}
+
 // GetAllInstalledToolsReleases FIXMEDOC
 func (pm *PackageManager) GetAllInstalledToolsReleases() []*cores.ToolRelease {
 	tools := []*cores.ToolRelease{}
@@ -16,14 +16,20 @@
 package packagemanager_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/url"
 	"os"
+	"runtime"
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/spf13/viper"
@@ -174,6 +180,37 @@ func TestResolveFQBN(t *testing.T) {
 	require.Equal(t, buildPlatformRelease.Platform.String(), "arduino:avr")
 }
 
+func TestPlatformReleaseDependants(t *testing.T) {
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(dataDir1.Join("packages"))
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(extraHardware)
+
+	installedRelease := func(platformID string) *cores.PlatformRelease {
+		fqbn, err := cores.ParseFQBN(platformID + ":dummy")
+		require.NoError(t, err)
+		platform := pm.Packages[fqbn.Package].Platforms[fqbn.PlatformArch]
+		require.NotNil(t, platform)
+		release := pm.GetInstalledPlatformRelease(platform)
+		require.NotNil(t, release)
+		return release
+	}
+
+	// referenced:avr boards use the core of arduino:avr
+	dependants := pm.PlatformReleaseDependants(installedRelease("arduino:avr"))
+	require.Len(t, dependants, 1)
+	require.Equal(t, "referenced:avr", dependants[0].Platform.String())
+
+	// referenced:samd boards use the core of adafruit:samd
+	dependants = pm.PlatformReleaseDependants(installedRelease("adafruit:samd"))
+	require.Len(t, dependants, 1)
+	require.Equal(t, "referenced:samd", dependants[0].Platform.String())
+
+	// Nothing refers to the referenced platforms
+	require.Empty(t, pm.PlatformReleaseDependants(installedRelease("referenced:avr")))
+	require.Empty(t, pm.PlatformReleaseDependants(installedRelease("referenced:samd")))
+}
+
 func TestBoardOptionsFunctions(t *testing.T) {
 	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
 	pm.LoadHardwareFromDirectory(customHardware)
@@ -296,6 +333,15 @@ func TestFindToolsRequiredForBoard(t *testing.T) {
 		uploadProperties.Merge(requiredTool.RuntimeProperties())
 	}
 	require.Equal(t, bossac18.InstallDir.String(), uploadProperties.Get("runtime.tools.bossac.path"))
+
+	// Check which installed platforms require a tool
+	esp32Dependants := pm.ToolReleaseDependants(esptool231)
+	require.Len(t, esp32Dependants, 1)
+	require.Equal(t, "esp32:esp32", esp32Dependants[0].Platform.String())
+	require.True(t, pm.IsToolRequired(esptool231))
+	bossacDependants := pm.ToolReleaseDependants(bossac17)
+	require.Len(t, bossacDependants, 1)
+	require.Equal(t, "adafruit:samd", bossacDependants[0].Platform.String())
 }
 
 func TestIdentifyBoard(t *testing.T) {
@@ -320,3 +366,192 @@ func TestIdentifyBoard(t *testing.T) {
 	require.Equal(t, "[test:avr:e]", fmt.Sprintf("%v", identify("0xAB00", "0xcd00")))
 	require.Equal(t, "[test:avr:e]", fmt.Sprintf("%v", identify("0xab00", "0xCD00")))
 }
+
+func TestInstallPlatformFromArchive(t *testing.T) {
+	dataDir, err := paths.MkTempDir("", "test_install_archive")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	packagesDir := dataDir.Join("packages")
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+
+	archive := paths.New("testdata", "archives", "myarch-1.0.0.tar.bz2")
+	release, err := pm.InstallPlatformFromArchive(archive, "", "mypackage", "myarch", nil)
+	require.NoError(t, err)
+	require.Equal(t, "mypackage:myarch@1.0.0", release.String())
+	require.True(t, packagesDir.Join("mypackage", "hardware", "myarch", "1.0.0", "boards.txt").Exist())
+	require.Contains(t, release.Boards, "myboard")
+
+	// Installing the same release twice is an error
+	_, err = pm.InstallPlatformFromArchive(archive, "", "mypackage", "myarch", nil)
+	require.Error(t, err)
+
+	// A fresh PackageManager finds the platform along with its metadata
+	pm = packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+	require.NoError(t, pm.LoadHardwareFromDirectory(packagesDir))
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: "mypackage", PlatformArchitecture: "myarch"})
+	require.NotNil(t, platform)
+	require.Equal(t, "My Architecture", platform.Name)
+	installed := pm.GetInstalledPlatformRelease(platform)
+	require.NotNil(t, installed)
+	require.Equal(t, "myarch-1.0.0.tar.bz2", installed.Resource.ArchiveFileName)
+	require.Contains(t, installed.Resource.Checksum, "SHA-256:")
+
+	require.NoError(t, pm.UninstallPlatform(installed))
+	require.False(t, packagesDir.Join("mypackage", "hardware", "myarch", "1.0.0").Exist())
+}
+
+func TestInstallToolFromStore(t *testing.T) {
+	dataDir, err := paths.MkTempDir("", "test_tools_store")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	packagesDir := dataDir.Join("packages")
+	downloadDir := dataDir.Join("staging")
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, downloadDir, dataDir.Join("tmp"))
+
+	// Two releases of a tool distributed with the same archive
+	data, err := paths.New("testdata", "archives", "myarch-1.0.0.tar.bz2").ReadFile()
+	require.NoError(t, err)
+	require.NoError(t, downloadDir.MkdirAll())
+	require.NoError(t, downloadDir.Join("mytool.tar.bz2").WriteFile(data))
+	checksum := sha256.Sum256(data)
+	resource := &resources.DownloadResource{
+		ArchiveFileName: "mytool.tar.bz2",
+		Checksum:        "SHA-256:" + hex.EncodeToString(checksum[:]),
+		Size:            int64(len(data)),
+	}
+	tool := pm.Packages.GetOrCreatePackage("mypackage").GetOrCreateTool("mytool")
+	releases := []*cores.ToolRelease{}
+	for _, version := range []string{"1.0.0", "1.0.1"} {
+		release := tool.GetOrCreateRelease(semver.ParseRelaxed(version))
+		release.Flavors = []*cores.Flavor{{OS: "all", Resource: resource}}
+		require.NoError(t, pm.InstallTool(release, nil))
+		release.InstallDir = packagesDir.Join("mypackage", "tools", "mytool", version)
+		require.True(t, release.InstallDir.Join("boards.txt").Exist())
+		releases = append(releases, release)
+	}
+
+	// The archive is extracted only once
+	entries, err := pm.ToolsStoreDir().ReadDir()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// The store entry is kept as long as an installed release refers to it
+	removed, err := pm.CleanToolsStore()
+	require.NoError(t, err)
+	require.Empty(t, removed)
+	require.Len(t, pm.UnusedToolReleases(), 2)
+	for _, release := range releases {
+		require.True(t, pm.IsManagedToolRelease(release))
+		require.NoError(t, pm.UninstallTool(release))
+	}
+	require.True(t, entries[0].IsDir())
+
+	removed, err = pm.CleanToolsStore()
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	require.False(t, entries[0].Exist())
+}
+
+func TestSketchbookPlatformPrecedence(t *testing.T) {
+	dataDir, err := paths.MkTempDir("", "test_sketchbook_platform")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	packagesDir := dataDir.Join("packages")
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+	_, err = pm.InstallPlatformFromArchive(paths.New("testdata", "archives", "myarch-1.0.0.tar.bz2"), "", "mypackage", "myarch", nil)
+	require.NoError(t, err)
+
+	// The sources of the same platform in the hardware directory of the sketchbook
+	sketchbookHardware := dataDir.Join("sketchbook", "hardware")
+	devPlatformDir := sketchbookHardware.Join("mypackage", "myarch")
+	require.NoError(t, devPlatformDir.MkdirAll())
+	require.NoError(t, devPlatformDir.Join("boards.txt").WriteFile([]byte("devboard.name=Development board\n")))
+	require.NoError(t, devPlatformDir.Join("platform.txt").WriteFile([]byte("name=My Architecture (dev)\n")))
+
+	pm = packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+	require.NoError(t, pm.LoadHardwareFromDirectories(paths.NewPathList(packagesDir.String(), sketchbookHardware.String())))
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: "mypackage", PlatformArchitecture: "myarch"})
+	require.NotNil(t, platform)
+
+	// The sketchbook platform is used, the installed one is the one managed by upgrades and uninstalls
+	installed := pm.GetInstalledPlatformRelease(platform)
+	require.NotNil(t, installed)
+	require.True(t, installed.InstallDir.EquivalentTo(devPlatformDir))
+	require.Contains(t, installed.Boards, "devboard")
+	managed := pm.GetInstalledManagedPlatformRelease(platform)
+	require.NotNil(t, managed)
+	require.Equal(t, "mypackage:myarch@1.0.0", managed.String())
+}
+
+func TestInstallPlatformFromDir(t *testing.T) {
+	dataDir, err := paths.MkTempDir("", "test_install_dir")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	packagesDir := dataDir.Join("packages")
+	pm := packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+
+	sourcesDir := dataDir.Join("src", "myarch")
+	require.NoError(t, sourcesDir.MkdirAll())
+	_, err = pm.InstallPlatformFromDir(sourcesDir, "mypackage", "myarch", nil)
+	require.Error(t, err)
+	require.NoError(t, sourcesDir.Join("boards.txt").WriteFile([]byte("myboard.name=My board\n")))
+	_, err = pm.InstallPlatformFromDir(sourcesDir, "mypackage", "myarch", nil)
+	require.EqualError(t, err, "platform version not specified and not found in platform.txt")
+	require.NoError(t, sourcesDir.Join("platform.txt").WriteFile([]byte("name=My Architecture\nversion=1.2.3\n")))
+
+	release, err := pm.InstallPlatformFromDir(sourcesDir, "mypackage", "myarch", nil)
+	require.NoError(t, err)
+	require.Equal(t, "mypackage:myarch@1.2.3", release.String())
+	require.True(t, pm.IsLinkedPlatformRelease(release))
+	require.True(t, pm.IsManagedPlatformRelease(release))
+
+	// The changes to the linked directory are seen by a fresh PackageManager
+	require.NoError(t, sourcesDir.Join("boards.txt").WriteFile([]byte("otherboard.name=Other board\n")))
+	pm = packagemanager.NewPackageManager(dataDir, packagesDir, dataDir.Join("staging"), dataDir.Join("tmp"))
+	require.NoError(t, pm.LoadHardwareFromDirectory(packagesDir))
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{Package: "mypackage", PlatformArchitecture: "myarch"})
+	require.NotNil(t, platform)
+	installed := pm.GetInstalledPlatformRelease(platform)
+	require.NotNil(t, installed)
+	require.True(t, pm.IsLinkedPlatformRelease(installed))
+	require.Contains(t, installed.Boards, "otherboard")
+
+	// Uninstalling removes the link and leaves the sources untouched
+	require.NoError(t, pm.UninstallPlatform(installed))
+	require.False(t, packagesDir.Join("mypackage", "hardware", "myarch", "1.2.3").Exist())
+	require.True(t, sourcesDir.Join("boards.txt").Exist())
+}
+
+func TestRunPostInstallScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("post_install.sh is not run on Windows")
+	}
+	dataDir, err := paths.MkTempDir("", "test_post_install")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	pm := packagemanager.NewPackageManager(dataDir, dataDir.Join("packages"), dataDir.Join("staging"), dataDir.Join("tmp"))
+
+	sourcesDir := dataDir.Join("src", "myarch")
+	require.NoError(t, sourcesDir.MkdirAll())
+	require.NoError(t, sourcesDir.Join("boards.txt").WriteFile([]byte("myboard.name=My board\n")))
+	require.NoError(t, sourcesDir.Join("platform.txt").WriteFile([]byte("name=My Architecture\nversion=1.0.0\n")))
+	release, err := pm.InstallPlatformFromDir(sourcesDir, "mypackage", "myarch", nil)
+	require.NoError(t, err)
+	require.Nil(t, pm.PostInstallScript(release))
+	require.NoError(t, pm.RunPostInstallScript(context.Background(), release, nil))
+
+	script := "#!/bin/sh\nhead -n1 platform.txt\necho secret=$POST_INSTALL_SECRET\necho failure >&2\n"
+	require.NoError(t, sourcesDir.Join("post_install.sh").WriteFile([]byte(script)))
+	require.NoError(t, os.Chmod(sourcesDir.Join("post_install.sh").String(), 0755))
+	require.NotNil(t, pm.PostInstallScript(release))
+
+	// The script runs in the platform folder without the environment of the caller
+	os.Setenv("POST_INSTALL_SECRET", "1234")
+	defer os.Unsetenv("POST_INSTALL_SECRET")
+	lines := []string{}
+	err = pm.RunPostInstallScript(context.Background(), release, func(line *executils.OutputLine) {
+		lines = append(lines, line.Text)
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"name=My Architecture", "secret=", "failure"}, lines)
+}
@@ -0,0 +1,194 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package packagemanager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ToolsStoreDir returns the directory where the content of the tools archives
+// is stored, one subdirectory for each archive checksum. The tool releases
+// installed from the same archive refer to the same subdirectory instead of
+// keeping a copy of it.
+func (pm *PackageManager) ToolsStoreDir() *paths.Path {
+	if pm.IndexDir == nil {
+		return nil
+	}
+	return pm.IndexDir.Join("tools-store")
+}
+
+// toolsStoreEntry returns the directory of the tools store that holds the
+// content of the archive, or nil if the archive has no checksum.
+func (pm *PackageManager) toolsStoreEntry(resource *resources.DownloadResource) *paths.Path {
+	storeDir := pm.ToolsStoreDir()
+	if storeDir == nil {
+		return nil
+	}
+	split := strings.SplitN(resource.Checksum, ":", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil
+	}
+	return storeDir.Join(strings.ToLower(split[0] + "-" + split[1]))
+}
+
+// linkToolDir makes destDir refer to the tool stored in storeDir. A symlink is
+// used where possible, otherwise the files of storeDir are hard linked (or
+// copied, as a last resort) into destDir.
+func linkToolDir(storeDir, destDir *paths.Path) error {
+	target, err := storeDir.Abs()
+	if err != nil {
+		return err
+	}
+	if err := destDir.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	if _, err := os.Lstat(destDir.String()); err == nil {
+		if err := destDir.RemoveAll(); err != nil {
+			return fmt.Errorf("removing previous installation: %s", err)
+		}
+	}
+	if err := os.Symlink(target.String(), destDir.String()); err == nil {
+		return nil
+	}
+	if err := hardLinkTree(target, destDir); err == nil {
+		return nil
+	}
+	destDir.RemoveAll()
+	if err := target.CopyDirTo(destDir); err != nil {
+		destDir.RemoveAll()
+		return fmt.Errorf("copying tool from store: %s", err)
+	}
+	return nil
+}
+
+// hardLinkTree recreates the directory tree of src in dst, hard linking the
+// files instead of copying them.
+func hardLinkTree(src, dst *paths.Path) error {
+	return filepath.Walk(src.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src.String(), path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst.String(), rel)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		default:
+			return os.Link(path, target)
+		}
+	})
+}
+
+// UnusedToolReleases returns the installed tool releases that are not required
+// by any installed platform. The tools bundled with the CLI are never
+// considered unused.
+func (pm *PackageManager) UnusedToolReleases() []*cores.ToolRelease {
+	unused := []*cores.ToolRelease{}
+	for _, targetPackage := range pm.Packages {
+		if targetPackage.Name == "builtin" {
+			continue
+		}
+		for _, tool := range targetPackage.Tools {
+			for _, toolRelease := range tool.Releases {
+				if !toolRelease.IsInstalled() || !pm.IsManagedToolRelease(toolRelease) {
+					continue
+				}
+				if !pm.IsToolRequired(toolRelease) {
+					unused = append(unused, toolRelease)
+				}
+			}
+		}
+	}
+	return unused
+}
+
+// CleanToolsStore removes the entries of the tools store that are not referenced
+// by any installed tool release and returns the removed directories.
+func (pm *PackageManager) CleanToolsStore() (paths.PathList, error) {
+	storeDir := pm.ToolsStoreDir()
+	if storeDir == nil || !storeDir.IsDir() {
+		return nil, nil
+	}
+	lock, err := pm.lockDataDir()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	resolvedStoreDir := storeDir.Clone()
+	if err := resolvedStoreDir.FollowSymLink(); err != nil {
+		return nil, err
+	}
+
+	referenced := map[string]bool{}
+	for _, targetPackage := range pm.Packages {
+		for _, tool := range targetPackage.Tools {
+			for _, toolRelease := range tool.Releases {
+				if !toolRelease.IsInstalled() {
+					continue
+				}
+				// Linked tools point inside their store entry...
+				installDir := toolRelease.InstallDir.Clone()
+				if installDir.FollowSymLink() == nil {
+					if inside, _ := installDir.IsInsideDir(resolvedStoreDir); inside {
+						if rel, err := resolvedStoreDir.RelTo(installDir); err == nil {
+							referenced[strings.SplitN(filepath.ToSlash(rel.String()), "/", 2)[0]] = true
+						}
+					}
+				}
+				// ...while hard linked or copied ones are matched by checksum, to
+				// share the entry with the next installs of the same archive
+				if resource := toolRelease.GetCompatibleFlavour(); resource != nil {
+					if entry := pm.toolsStoreEntry(resource); entry != nil {
+						referenced[entry.Base()] = true
+					}
+				}
+			}
+		}
+	}
+
+	entries, err := storeDir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("reading tools store: %s", err)
+	}
+	entries.FilterDirs()
+	removed := paths.PathList{}
+	for _, entry := range entries {
+		if referenced[entry.Base()] {
+			continue
+		}
+		if err := entry.RemoveAll(); err != nil {
+			return removed, fmt.Errorf("removing %s from tools store: %s", entry.Base(), err)
+		}
+		removed = append(removed, entry)
+	}
+	return removed, nil
+}
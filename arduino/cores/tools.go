@@ -151,10 +151,6 @@ var (
 	regexpArmBSD     = regexp.MustCompile("arm.*-freebsd[0-9]*")
 )
 
-func (f *Flavor) isCompatibleWithCurrentMachine() bool {
-	return f.isCompatibleWith(runtime.GOOS, runtime.GOARCH)
-}
-
 func (f *Flavor) isCompatibleWith(osName, osArch string) bool {
 	if f.OS == "all" {
 		return true
@@ -184,12 +180,42 @@ func (f *Flavor) isCompatibleWith(osName, osArch string) bool {
 	return false
 }
 
-// GetCompatibleFlavour returns the downloadable resource compatible with the running O.S.
-func (tr *ToolRelease) GetCompatibleFlavour() *resources.DownloadResource {
+// fallbackArchs lists, for each host, the architectures whose binaries can be
+// run when a native flavour is not available (e.g. 32 bit ARM tools on ARM64).
+var fallbackArchs = map[string][]string{
+	"linux,arm64": {"arm"},
+}
+
+// GetFlavourCompatibleWith returns the Flavor best suited for the given O.S. and
+// architecture: a native flavour is preferred, then a flavour built for a compatible
+// architecture and finally a flavour for "all" hosts. Returns nil if none is found.
+func (tr *ToolRelease) GetFlavourCompatibleWith(osName, osArch string) *Flavor {
+	archs := append([]string{osArch}, fallbackArchs[osName+","+osArch]...)
+	for _, arch := range archs {
+		for _, flavour := range tr.Flavors {
+			if flavour.OS != "all" && flavour.isCompatibleWith(osName, arch) {
+				return flavour
+			}
+		}
+	}
 	for _, flavour := range tr.Flavors {
-		if flavour.isCompatibleWithCurrentMachine() {
-			return flavour.Resource
+		if flavour.OS == "all" {
+			return flavour
 		}
 	}
 	return nil
 }
+
+// GetCompatibleFlavour returns the downloadable resource compatible with the running O.S.
+func (tr *ToolRelease) GetCompatibleFlavour() *resources.DownloadResource {
+	if flavour := tr.GetFlavourCompatibleWith(runtime.GOOS, runtime.GOARCH); flavour != nil {
+		return flavour.Resource
+	}
+	return nil
+}
+
+// IsCompatibleWithCurrentMachine returns true if the ToolRelease is already installed
+// or has a flavour that can be installed on the running O.S.
+func (tr *ToolRelease) IsCompatibleWithCurrentMachine() bool {
+	return tr.IsInstalled() || tr.GetFlavourCompatibleWith(runtime.GOOS, runtime.GOARCH) != nil
+}
@@ -94,3 +94,26 @@ func TestFlavorCompatibility(t *testing.T) {
 		}
 	}
 }
+
+func TestFlavourSelection(t *testing.T) {
+	armhf := &Flavor{OS: "arm-linux-gnueabihf"}
+	aarch64 := &Flavor{OS: "aarch64-linux-gnu"}
+	amd64 := &Flavor{OS: "x86_64-pc-linux-gnu"}
+	all := &Flavor{OS: "all"}
+
+	tool := &ToolRelease{Flavors: []*Flavor{all, amd64, armhf, aarch64}}
+	require.Equal(t, aarch64, tool.GetFlavourCompatibleWith("linux", "arm64"))
+	require.Equal(t, armhf, tool.GetFlavourCompatibleWith("linux", "arm"))
+	require.Equal(t, amd64, tool.GetFlavourCompatibleWith("linux", "amd64"))
+	require.Equal(t, all, tool.GetFlavourCompatibleWith("windows", "amd64"))
+
+	// 32 bit ARM flavours are used on ARM64 hosts if nothing better is available
+	tool = &ToolRelease{Flavors: []*Flavor{amd64, all, armhf}}
+	require.Equal(t, armhf, tool.GetFlavourCompatibleWith("linux", "arm64"))
+	tool = &ToolRelease{Flavors: []*Flavor{amd64, all}}
+	require.Equal(t, all, tool.GetFlavourCompatibleWith("linux", "arm64"))
+
+	// but not the other way around
+	tool = &ToolRelease{Flavors: []*Flavor{amd64, aarch64}}
+	require.Nil(t, tool.GetFlavourCompatibleWith("linux", "arm"))
+}
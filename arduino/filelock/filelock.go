@@ -0,0 +1,163 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package filelock implements the locks that keep the concurrent invocations
+// of the CLI, and the daemon, from modifying the same directory at the same
+// time.
+package filelock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// FileName is the name of the lock file created in the locked directories.
+const FileName = ".arduino-cli.lock"
+
+// pollInterval is how often a lock held by another process is checked.
+var pollInterval = 100 * time.Millisecond
+
+// errLocked is returned by tryLock when the lock is held by another process.
+var errLocked = errors.New("locked")
+
+// LockedError is returned when a lock can't be acquired before the timeout.
+type LockedError struct {
+	Path  *paths.Path
+	Owner string
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%s is locked by %s", e.Path.Parent(), e.Owner)
+}
+
+// Lock is an exclusive lock on a directory, held by a single operation among
+// all the processes.
+type Lock struct {
+	path *paths.Path
+	file *os.File
+	sem  chan struct{}
+}
+
+var semaphores = map[string]chan struct{}{}
+var semaphoresMutex sync.Mutex
+
+// semaphore returns the semaphore serializing the operations of this process
+// on the lock file at path: the OS locks are held by the process, so they
+// don't exclude the goroutines of the daemon serving concurrent requests.
+func semaphore(path string) chan struct{} {
+	semaphoresMutex.Lock()
+	defer semaphoresMutex.Unlock()
+	sem, ok := semaphores[path]
+	if !ok {
+		sem = make(chan struct{}, 1)
+		semaphores[path] = sem
+	}
+	return sem
+}
+
+// Acquire locks dir, creating it if needed. If the lock is held by another
+// operation, Acquire waits at most timeout for its release and then returns a
+// LockedError describing the holder.
+func Acquire(dir *paths.Path, timeout time.Duration) (*Lock, error) {
+	if err := dir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating %s: %s", dir, err)
+	}
+	path := dir.Join(FileName)
+	if abs, err := path.Abs(); err == nil {
+		path = abs
+	}
+	deadline := time.Now().Add(timeout)
+
+	sem := semaphore(path.String())
+	select {
+	case sem <- struct{}{}:
+	default:
+		select {
+		case sem <- struct{}{}:
+		case <-time.After(timeout):
+			return nil, &LockedError{Path: path, Owner: fmt.Sprintf("another operation of this process (pid %d)", os.Getpid())}
+		}
+	}
+
+	waiting := false
+	for {
+		file, err := tryLock(path)
+		if err == nil {
+			lock := &Lock{path: path, file: file, sem: sem}
+			lock.writeOwner()
+			return lock, nil
+		}
+		if err != errLocked {
+			<-sem
+			return nil, fmt.Errorf("locking %s: %s", dir, err)
+		}
+		holder := readOwner(path)
+		if !time.Now().Before(deadline) {
+			<-sem
+			return nil, &LockedError{Path: path, Owner: holder}
+		}
+		if !waiting {
+			logrus.WithField("owner", holder).Infof("Waiting for the lock of %s", dir)
+			waiting = true
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// Release releases the lock, it does nothing on a nil Lock.
+func (l *Lock) Release() error {
+	if l == nil {
+		return nil
+	}
+	defer func() { <-l.sem }()
+	if err := unlock(l.file); err != nil {
+		l.file.Close()
+		return fmt.Errorf("unlocking %s: %s", l.path, err)
+	}
+	return l.file.Close()
+}
+
+// writeOwner writes the description of this process in the lock file, to be
+// reported to the processes waiting for the lock.
+func (l *Lock) writeOwner() {
+	if err := l.file.Truncate(0); err != nil {
+		return
+	}
+	l.file.WriteAt([]byte(owner()), 0)
+}
+
+// readOwner returns the description of the process holding the lock at path.
+func readOwner(path *paths.Path) string {
+	data, err := path.ReadFile()
+	if holder := strings.TrimSpace(string(data)); err == nil && holder != "" {
+		return holder
+	}
+	return "another process"
+}
+
+// owner returns the description of this process, e.g.
+// "arduino-cli core install arduino:avr (pid 4242) since 2020-10-16 10:22:01".
+func owner() string {
+	args := append([]string{filepath.Base(os.Args[0])}, os.Args[1:]...)
+	return fmt.Sprintf("%s (pid %d) since %s", strings.Join(args, " "), os.Getpid(), time.Now().Format("2006-01-02 15:04:05"))
+}
@@ -0,0 +1,77 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package filelock
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLock(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_filelock")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	dir := tmp.Join("libraries")
+
+	lock, err := Acquire(dir, time.Second)
+	require.NoError(t, err)
+	require.True(t, dir.Join(FileName).Exist())
+
+	// the other operations of the same process wait for the release...
+	_, err = Acquire(dir, 100*time.Millisecond)
+	var locked *LockedError
+	require.True(t, errors.As(err, &locked))
+	require.Contains(t, err.Error(), "another operation of this process")
+
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		close(released)
+		lock.Release()
+	}()
+	lock, err = Acquire(dir, 5*time.Second)
+	require.NoError(t, err)
+	select {
+	case <-released:
+	default:
+		t.Fatal("lock acquired before its release")
+	}
+	require.NoError(t, lock.Release())
+
+	// ...and the ones of the other processes too, reporting the holder
+	file, err := tryLock(dir.Join(FileName))
+	require.NoError(t, err)
+	require.NoError(t, file.Truncate(0))
+	_, err = file.WriteString("arduino-cli daemon (pid 42) since 2020-10-16 10:22:01")
+	require.NoError(t, err)
+	_, err = Acquire(dir, 200*time.Millisecond)
+	require.True(t, errors.As(err, &locked))
+	require.Equal(t, "arduino-cli daemon (pid 42) since 2020-10-16 10:22:01", locked.Owner)
+	require.Contains(t, err.Error(), "is locked by arduino-cli daemon (pid 42)")
+	require.NoError(t, unlock(file))
+	require.NoError(t, file.Close())
+
+	lock, err = Acquire(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, lock.Release())
+
+	var nilLock *Lock
+	require.NoError(t, nilLock.Release())
+}
@@ -0,0 +1,54 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package filelock
+
+import (
+	"os"
+	"syscall"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+const errorSharingViolation syscall.Errno = 32
+
+// tryLock opens the lock file at path for writing without sharing the write
+// access, so that the other processes can read the owner but can't open it
+// until it's closed, also by the OS if the process quits.
+func tryLock(path *paths.Path) (*os.File, error) {
+	name, err := syscall.UTF16PtrFromString(path.String())
+	if err != nil {
+		return nil, err
+	}
+	handle, err := syscall.CreateFile(name,
+		syscall.GENERIC_READ|syscall.GENERIC_WRITE,
+		syscall.FILE_SHARE_READ,
+		nil,
+		syscall.OPEN_ALWAYS,
+		syscall.FILE_ATTRIBUTE_NORMAL,
+		0)
+	if err == errorSharingViolation {
+		return nil, errLocked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return os.NewFile(uintptr(handle), path.String()), nil
+}
+
+// unlock does nothing, the lock is released when the file is closed.
+func unlock(file *os.File) error {
+	return nil
+}
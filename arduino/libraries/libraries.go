@@ -147,7 +147,9 @@ func (library *Library) SourceDirs() []SourceDir {
 // LocationPriorityFor returns a number representing the location priority for the given library
 // using the given platform and referenced-platform. Higher value means higher priority.
 func (library *Library) LocationPriorityFor(platformRelease, refPlatformRelease *cores.PlatformRelease) int {
-	if library.Location == IDEBuiltIn {
+	if library.Location == Unmanaged {
+		return 5
+	} else if library.Location == IDEBuiltIn {
 		return 1
 	} else if library.ContainerPlatform == refPlatformRelease {
 		return 2
@@ -35,6 +35,10 @@ const (
 	ReferencedPlatformBuiltIn
 	// User are user installed libraries
 	User
+	// Unmanaged are libraries set by the user directly for a build, for
+	// example with the --library flag of compile, they are not managed by
+	// the libraries manager
+	Unmanaged
 )
 
 func (d *LibraryLocation) String() string {
@@ -47,6 +51,8 @@ func (d *LibraryLocation) String() string {
 		return "ref-platform"
 	case User:
 		return "user"
+	case Unmanaged:
+		return "unmanaged"
 	}
 	panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 }
@@ -62,6 +68,8 @@ func (d *LibraryLocation) MarshalJSON() ([]byte, error) {
 		return json.Marshal("ref-platform")
 	case User:
 		return json.Marshal("user")
+	case Unmanaged:
+		return json.Marshal("unmanaged")
 	}
 	return nil, fmt.Errorf("invalid library location value: %d", *d)
 }
@@ -81,6 +89,8 @@ func (d *LibraryLocation) UnmarshalJSON(b []byte) error {
 		*d = ReferencedPlatformBuiltIn
 	case "user":
 		*d = User
+	case "unmanaged":
+		*d = Unmanaged
 	}
 	return fmt.Errorf("invalid library location: %s", s)
 }
@@ -96,6 +106,8 @@ func (d *LibraryLocation) ToRPCLibraryLocation() rpc.LibraryLocation {
 		return rpc.LibraryLocation_referenced_platform_builtin
 	case User:
 		return rpc.LibraryLocation_user
+	case Unmanaged:
+		return rpc.LibraryLocation_unmanaged
 	}
 	panic(fmt.Sprintf("invalid LibraryLocation value %d", *d))
 }
@@ -111,6 +123,8 @@ func FromRPCLibraryLocation(l rpc.LibraryLocation) LibraryLocation {
 		return ReferencedPlatformBuiltIn
 	case rpc.LibraryLocation_user:
 		return User
+	case rpc.LibraryLocation_unmanaged:
+		return Unmanaged
 	}
 	panic(fmt.Sprintf("invalid rpc.LibraryLocation value %d", l))
 }
@@ -54,6 +54,8 @@ type Release struct {
 	Resource         *resources.DownloadResource
 	License          string
 	ProvidesIncludes []string
+	// IndexURL is the URL of the index the release comes from
+	IndexURL string
 
 	Library *Library `json:"-"`
 }
@@ -93,6 +95,38 @@ func (r *Release) String() string {
 	return r.Library.Name + "@" + r.Version.String()
 }
 
+// SetIndexURL records url as the origin of all the releases of the index.
+func (idx *Index) SetIndexURL(url string) {
+	for _, library := range idx.Libraries {
+		for _, release := range library.Releases {
+			release.IndexURL = url
+		}
+	}
+}
+
+// Merge adds the libraries of other to the index. The releases already in
+// the index take precedence over the ones of other with the same version.
+func (idx *Index) Merge(other *Index) {
+	for name, otherLibrary := range other.Libraries {
+		library, exists := idx.Libraries[name]
+		if !exists {
+			otherLibrary.Index = idx
+			idx.Libraries[name] = otherLibrary
+			continue
+		}
+		for version, release := range otherLibrary.Releases {
+			if _, exists := library.Releases[version]; exists {
+				continue
+			}
+			release.Library = library
+			library.Releases[version] = release
+			if library.Latest == nil || library.Latest.Version.LessThan(release.Version) {
+				library.Latest = release
+			}
+		}
+	}
+}
+
 // FindRelease search a library Release in the index. Returns nil if the
 // release is not found. If the version is not specified returns the latest
 // version available.
@@ -107,8 +141,12 @@ func (idx *Index) FindRelease(ref *Reference) *Release {
 }
 
 // FindIndexedLibrary search an indexed library that matches the provided
-// installed library or nil if not found
+// installed library or nil if not found. The library is searched by the name
+// declared in library.properties and then by its directory name.
 func (idx *Index) FindIndexedLibrary(lib *libraries.Library) *Library {
+	if indexLib, ok := idx.Libraries[lib.RealName]; ok {
+		return indexLib
+	}
 	return idx.Libraries[lib.Name]
 }
 
@@ -74,6 +74,12 @@ func TestIndexer(t *testing.T) {
 	require.NotNil(t, rtc)
 	require.Equal(t, "RTCZero", rtc.Name)
 
+	// Libraries installed in a directory not matching the name in the index
+	ssd1306 := index.FindIndexedLibrary(&libraries.Library{Name: "ACROBOTIC_SSD1306-master", RealName: "ACROBOTIC SSD1306"})
+	require.NotNil(t, ssd1306)
+	require.Equal(t, "ACROBOTIC SSD1306", ssd1306.Name)
+	require.Nil(t, index.FindIndexedLibrary(&libraries.Library{Name: "MyLib", RealName: "My Lib"}))
+
 	rtcUpdate := index.FindLibraryUpdate(&libraries.Library{Name: "RTCZero", Version: semver.MustParse("1.0.0")})
 	require.NotNil(t, rtcUpdate)
 	require.Equal(t, "RTCZero@1.6.0", rtcUpdate.String())
@@ -109,3 +115,29 @@ func TestIndexer(t *testing.T) {
 	require.Contains(t, resolve2, bear130)
 	require.Contains(t, resolve2, http040)
 }
+
+func TestMerge(t *testing.T) {
+	index, err := LoadIndex(paths.New("testdata/library_index.json"))
+	require.NoError(t, err)
+	index.SetIndexURL("https://downloads.arduino.cc/libraries/library_index.json")
+	private, err := LoadIndex(paths.New("testdata/private_index.json"))
+	require.NoError(t, err)
+	private.SetIndexURL("file:///opt/libraries/private_index.json")
+	index.Merge(private)
+
+	require.Equal(t, 2381, len(index.Libraries))
+	myLib := index.FindRelease(&Reference{Name: "MyPrivateLib"})
+	require.NotNil(t, myLib)
+	require.Equal(t, "file:///opt/libraries/private_index.json", myLib.IndexURL)
+
+	// the releases of the index take precedence
+	rtc100 := index.FindRelease(&Reference{Name: "RTCZero", Version: semver.MustParse("1.0.0")})
+	require.NotNil(t, rtc100)
+	require.Equal(t, "https://downloads.arduino.cc/libraries/library_index.json", rtc100.IndexURL)
+
+	// new releases are added to the library
+	rtcLatest := index.FindRelease(&Reference{Name: "RTCZero"})
+	require.Equal(t, "RTCZero@9.9.9", rtcLatest.String())
+	require.Equal(t, "file:///opt/libraries/private_index.json", rtcLatest.IndexURL)
+	require.Equal(t, index.Libraries["RTCZero"], rtcLatest.Library)
+}
@@ -0,0 +1,126 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// VersionRange is a range of versions given as a list of comparisons, e.g.
+// ">=1.2 <2.0": a version is in the range if it satisfies all of them. It
+// can be used as a semver.Constraint.
+type VersionRange []*versionComparison
+
+type versionComparison struct {
+	operator string
+	version  *semver.Version
+}
+
+var versionRangeOperators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// IsVersionRange returns true if the given version is a range of versions
+// instead of a single version.
+func IsVersionRange(version string) bool {
+	return strings.ContainsAny(version, "<>=! ")
+}
+
+// ParseVersionRange parses a range of versions made of comparisons separated
+// by spaces. The operators are =, !=, >, >=, < and <=, a version without
+// operator must match exactly.
+func ParseVersionRange(in string) (VersionRange, error) {
+	res := VersionRange{}
+	for _, field := range strings.Fields(in) {
+		comparison := &versionComparison{operator: "="}
+		for _, operator := range versionRangeOperators {
+			if strings.HasPrefix(field, operator) {
+				comparison.operator = operator
+				field = strings.TrimPrefix(field, operator)
+				break
+			}
+		}
+		if field == "" {
+			return nil, fmt.Errorf("invalid version range '%s': missing version after '%s'", in, comparison.operator)
+		}
+		version, err := semver.Parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version range '%s': %s", in, err)
+		}
+		comparison.version = version
+		res = append(res, comparison)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("invalid empty version range")
+	}
+	return res, nil
+}
+
+// Match returns true if the version is in the range.
+func (r VersionRange) Match(version *semver.Version) bool {
+	for _, comparison := range r {
+		if !comparison.match(version) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *versionComparison) match(version *semver.Version) bool {
+	switch c.operator {
+	case ">=":
+		return version.GreaterThanOrEqual(c.version)
+	case "<=":
+		return version.LessThanOrEqual(c.version)
+	case ">":
+		return version.GreaterThan(c.version)
+	case "<":
+		return version.LessThan(c.version)
+	case "!=":
+		return !version.Equal(c.version)
+	default:
+		return version.Equal(c.version)
+	}
+}
+
+func (r VersionRange) String() string {
+	res := []string{}
+	for _, comparison := range r {
+		res = append(res, comparison.operator+comparison.version.String())
+	}
+	return strings.Join(res, " ")
+}
+
+// FindReleasesInRange returns the releases of the library with the given
+// name whose version is in the range, the newest first.
+func (idx *Index) FindReleasesInRange(name string, versionRange semver.Constraint) []*Release {
+	library, exists := idx.Libraries[name]
+	if !exists {
+		return nil
+	}
+	res := []*Release{}
+	for _, release := range library.Releases {
+		if versionRange.Match(release.Version) {
+			res = append(res, release)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Version.GreaterThan(res[j].Version)
+	})
+	return res
+}
@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package librariesindex
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestVersionRange(t *testing.T) {
+	require.False(t, IsVersionRange("1.2.0"))
+	require.True(t, IsVersionRange(">=1.2"))
+	require.True(t, IsVersionRange("1.2 2.0"))
+
+	r, err := ParseVersionRange(">=1.2.0 <2.0.0 !=1.5.0")
+	require.NoError(t, err)
+	require.Equal(t, ">=1.2.0 <2.0.0 !=1.5.0", r.String())
+	require.True(t, r.Match(semver.MustParse("1.2.0")))
+	require.True(t, r.Match(semver.MustParse("1.9.9")))
+	require.False(t, r.Match(semver.MustParse("1.1.0")))
+	require.False(t, r.Match(semver.MustParse("1.5.0")))
+	require.False(t, r.Match(semver.MustParse("2.0.0")))
+
+	exact, err := ParseVersionRange("1.3.0")
+	require.NoError(t, err)
+	require.Equal(t, "=1.3.0", exact.String())
+	require.True(t, exact.Match(semver.MustParse("1.3.0")))
+	require.False(t, exact.Match(semver.MustParse("1.3.1")))
+
+	for _, invalid := range []string{"", " ", ">=", "<", "!= 1.2", ">=1.2 <abc", "~1.2"} {
+		_, err := ParseVersionRange(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestFindReleasesInRange(t *testing.T) {
+	index, err := LoadIndex(paths.New("testdata/library_index.json"))
+	require.NoError(t, err)
+
+	r, err := ParseVersionRange(">=1.4 <1.5")
+	require.NoError(t, err)
+	releases := index.FindReleasesInRange("RTCZero", r)
+	versions := []string{}
+	for _, release := range releases {
+		versions = append(versions, release.Version.String())
+	}
+	require.Equal(t, []string{"1.4.3", "1.4.2", "1.4.1", "1.4.0"}, versions)
+
+	r, err = ParseVersionRange(">2.0")
+	require.NoError(t, err)
+	require.Empty(t, index.FindReleasesInRange("RTCZero", r))
+	require.Empty(t, index.FindReleasesInRange("Inexistent", r))
+}
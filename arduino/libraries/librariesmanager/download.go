@@ -30,3 +30,10 @@ func (lm *LibrariesManager) UpdateIndex(config *downloader.Config) (*downloader.
 	// TODO: Download from gzipped URL index
 	return downloader.DownloadWithConfig(lm.IndexFile.String(), LibraryIndexURL.String(), *config, downloader.NoResume)
 }
+
+// UpdateAdditionalIndex downloads the additional libraries index at URL.
+func (lm *LibrariesManager) UpdateAdditionalIndex(URL *url.URL, config *downloader.Config) (*downloader.Downloader, error) {
+	indexFile := lm.AdditionalIndexFile(URL)
+	indexFile.Parent().MkdirAll()
+	return downloader.DownloadWithConfig(indexFile.String(), URL.String(), *config, downloader.NoResume)
+}
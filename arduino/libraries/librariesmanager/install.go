@@ -19,8 +19,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/arduino/arduino-cli/arduino/filelock"
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	paths "github.com/arduino/go-paths-helper"
 )
@@ -46,6 +48,11 @@ func (lm *LibrariesManager) InstallPrerequisiteCheck(indexLibrary *librariesinde
 			if installedLib.Version.Equal(indexLibrary.Version) {
 				return installedLib.InstallDir, nil, ErrAlreadyInstalled
 			}
+			if lm.isReadOnly(installedLib) {
+				// the new library is installed in the user directory and
+				// takes precedence over the read-only one
+				continue
+			}
 			replaced = installedLib
 		}
 	}
@@ -64,13 +71,26 @@ func (lm *LibrariesManager) InstallPrerequisiteCheck(indexLibrary *librariesinde
 	return libPath, replaced, nil
 }
 
-// Install installs a library on the specified path.
-func (lm *LibrariesManager) Install(indexLibrary *librariesindex.Release, libPath *paths.Path) error {
+// Install installs a library on the specified path, reporting the progress of
+// the extraction to progressCB, if not nil.
+func (lm *LibrariesManager) Install(indexLibrary *librariesindex.Release, libPath *paths.Path, progressCB resources.ExtractProgressCB) error {
 	libsDir := lm.getUserLibrariesDir()
 	if libsDir == nil {
 		return fmt.Errorf("User directory not set")
 	}
-	return indexLibrary.Resource.Install(lm.DownloadsDir, libsDir, libPath)
+	lock, err := filelock.Acquire(libsDir, lm.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
+	return indexLibrary.Resource.Install(lm.DownloadsDir, libPath, verifyLibraryDir, progressCB)
+}
+
+// verifyLibraryDir checks that the content of a library archive, unpacked in
+// dir, can be loaded as a library.
+func verifyLibraryDir(dir *paths.Path) error {
+	_, err := libraries.Load(dir, libraries.User)
+	return err
 }
 
 // Uninstall removes a Library
@@ -78,6 +98,14 @@ func (lm *LibrariesManager) Uninstall(lib *libraries.Library) error {
 	if lib == nil || lib.InstallDir == nil {
 		return fmt.Errorf("install directory not set")
 	}
+	if lm.isReadOnly(lib) {
+		return fmt.Errorf("library %s is in the read-only directory %s", lib, lib.InstallDir.Parent())
+	}
+	lock, err := filelock.Acquire(lib.InstallDir.Parent(), lm.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer lock.Release()
 	if err := lib.InstallDir.RemoveAll(); err != nil {
 		return fmt.Errorf("removing lib directory: %s", err)
 	}
@@ -16,8 +16,12 @@
 package librariesmanager
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -38,6 +42,12 @@ type LibrariesManager struct {
 	Index        *librariesindex.Index
 	IndexFile    *paths.Path
 	DownloadsDir *paths.Path
+	// AdditionalIndexURLs are the URLs of the library indexes merged with
+	// the default one, file:// URLs are read directly from the filesystem
+	AdditionalIndexURLs []*url.URL
+	// LockTimeout is how long the installations wait for the user libraries
+	// directory locked by another process
+	LockTimeout time.Duration
 }
 
 // LibrariesDir is a directory containing libraries
@@ -45,6 +55,9 @@ type LibrariesDir struct {
 	Path            *paths.Path
 	Location        libraries.LibraryLocation
 	PlatformRelease *cores.PlatformRelease
+	// ReadOnly is true if the libraries in this directory must not be
+	// replaced or removed
+	ReadOnly bool
 }
 
 // LibraryAlternatives is a list of different versions of the same library
@@ -108,15 +121,47 @@ func NewLibraryManager(indexDir *paths.Path, downloadsDir *paths.Path) *Librarie
 }
 
 // LoadIndex reads a library_index.json from a file and returns
-// the corresponding Index structure.
+// the corresponding Index structure. The additional indexes are merged into
+// it, the first error encountered is returned after loading all the indexes
+// available.
 func (sc *LibrariesManager) LoadIndex() error {
 	index, err := librariesindex.LoadIndex(sc.IndexFile)
 	if err != nil {
-		sc.Index = librariesindex.EmptyIndex
-		return err
+		index = &librariesindex.Index{Libraries: map[string]*librariesindex.Library{}}
+	} else {
+		index.SetIndexURL(LibraryIndexURL.String())
+	}
+	for _, URL := range sc.AdditionalIndexURLs {
+		additionalIndex, loadErr := librariesindex.LoadIndex(sc.AdditionalIndexFile(URL))
+		if loadErr != nil {
+			logrus.WithField("url", URL).WithError(loadErr).Warn("Error loading additional library index")
+			if err == nil {
+				err = fmt.Errorf("loading library index %s: %s", URL, loadErr)
+			}
+			continue
+		}
+		additionalIndex.SetIndexURL(URL.String())
+		index.Merge(additionalIndex)
 	}
 	sc.Index = index
-	return nil
+	return err
+}
+
+// AdditionalIndexFile returns the local path of the additional library index
+// at URL: file:// URLs point directly to the index, the others to the file
+// where the index is downloaded, named after a hash of the URL to avoid
+// clashes between indexes with the same name.
+func (sc *LibrariesManager) AdditionalIndexFile(URL *url.URL) *paths.Path {
+	if URL.Scheme == "file" {
+		path := URL.Path
+		if len(path) > 2 && path[0] == '/' && path[2] == ':' {
+			// Windows path like /C:/libraries/index.json
+			path = path[1:]
+		}
+		return paths.New(filepath.FromSlash(path))
+	}
+	hash := sha256.Sum256([]byte(URL.String()))
+	return sc.IndexFile.Parent().Join(fmt.Sprintf("library_index_%x.json", hash[:4]))
 }
 
 // AddLibrariesDir adds path to the list of directories
@@ -135,6 +180,17 @@ func (sc *LibrariesManager) AddLibrariesDir(path *paths.Path, location libraries
 	})
 }
 
+// AddReadOnlyLibrariesDir adds path to the list of directories to scan when
+// searching for libraries, like AddLibrariesDir, but the libraries found in
+// path are never replaced or removed.
+func (sc *LibrariesManager) AddReadOnlyLibrariesDir(path *paths.Path, location libraries.LibraryLocation) {
+	n := len(sc.LibrariesDir)
+	sc.AddLibrariesDir(path, location)
+	if len(sc.LibrariesDir) > n {
+		sc.LibrariesDir[n].ReadOnly = true
+	}
+}
+
 // AddPlatformReleaseLibrariesDir add the libraries directory in the
 // specified PlatformRelease to the list of directories to scan when
 // searching for libraries.
@@ -168,7 +224,7 @@ func (sc *LibrariesManager) RescanLibraries() error {
 
 func (sc *LibrariesManager) getUserLibrariesDir() *paths.Path {
 	for _, dir := range sc.LibrariesDir {
-		if dir.Location == libraries.User {
+		if dir.Location == libraries.User && !dir.ReadOnly {
 			return dir.Path
 		}
 	}
@@ -194,6 +250,11 @@ func (sc *LibrariesManager) LoadLibrariesFromDir(librariesDir *LibrariesDir) err
 			return fmt.Errorf("loading library from %s: %s", subDir, err)
 		}
 		library.ContainerPlatform = librariesDir.PlatformRelease
+		if shadowedBy := sc.findShadowingLibrary(library); shadowedBy != nil {
+			logrus.WithField("library", library.InstallDir).WithField("shadowed_by", shadowedBy.InstallDir).
+				Info("Skipping library shadowed by a directory with higher precedence")
+			continue
+		}
 		alternatives, ok := sc.Libraries[library.Name]
 		if !ok {
 			alternatives = &LibraryAlternatives{}
@@ -204,6 +265,52 @@ func (sc *LibrariesManager) LoadLibrariesFromDir(librariesDir *LibrariesDir) err
 	return nil
 }
 
+// findShadowingLibrary returns the user library with the same name of library
+// loaded from another directory, if any: when more directories of user
+// libraries are set, the directories added first have precedence.
+func (sc *LibrariesManager) findShadowingLibrary(library *libraries.Library) *libraries.Library {
+	if library.Location != libraries.User {
+		return nil
+	}
+	alternatives, ok := sc.Libraries[library.Name]
+	if !ok {
+		return nil
+	}
+	for _, lib := range alternatives.Alternatives {
+		if lib.Location == libraries.User && !lib.InstallDir.Parent().EquivalentTo(library.InstallDir.Parent()) {
+			return lib
+		}
+	}
+	return nil
+}
+
+// isReadOnly returns true if library has been loaded from a read-only
+// libraries directory
+func (sc *LibrariesManager) isReadOnly(library *libraries.Library) bool {
+	for _, dir := range sc.LibrariesDir {
+		if dir.ReadOnly && dir.Path.EquivalentTo(library.InstallDir.Parent()) {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadLibraryFromDir loads the single library contained in libRootDir, the
+// library is added to the libraries manager with the given location.
+func (sc *LibrariesManager) LoadLibraryFromDir(libRootDir *paths.Path, location libraries.LibraryLocation) error {
+	library, err := libraries.Load(libRootDir, location)
+	if err != nil {
+		return fmt.Errorf("loading library from %s: %s", libRootDir, err)
+	}
+	alternatives, ok := sc.Libraries[library.Name]
+	if !ok {
+		alternatives = &LibraryAlternatives{}
+		sc.Libraries[library.Name] = alternatives
+	}
+	alternatives.Add(library)
+	return nil
+}
+
 // FindByReference return the installed library matching the Reference
 // name and version or, if the version is nil, the library installed
 // in the User folder.
@@ -211,7 +318,9 @@ func (sc *LibrariesManager) FindByReference(libRef *librariesindex.Reference) *l
 	saneName := utils.SanitizeName(libRef.Name)
 	alternatives, have := sc.Libraries[saneName]
 	if !have {
-		return nil
+		// Manually installed libraries may live in a directory named
+		// differently from the library
+		alternatives = sc.findByDeclaredName(libRef.Name)
 	}
 	// TODO: Move "search into user" into another method...
 	if libRef.Version == nil {
@@ -224,3 +333,17 @@ func (sc *LibrariesManager) FindByReference(libRef *librariesindex.Reference) *l
 	}
 	return alternatives.FindVersion(libRef.Version)
 }
+
+// findByDeclaredName returns the installed libraries whose directory name or
+// name declared in library.properties is equal to name
+func (sc *LibrariesManager) findByDeclaredName(name string) *LibraryAlternatives {
+	res := &LibraryAlternatives{}
+	for _, alternatives := range sc.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Name == name || lib.RealName == name {
+				res.Alternatives = append(res.Alternatives, lib)
+			}
+		}
+	}
+	return res
+}
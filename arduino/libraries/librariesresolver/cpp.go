@@ -118,50 +118,88 @@ func simplify(name string) string {
 }
 
 func computePriority(lib *libraries.Library, header, arch string) int {
-	header = strings.TrimSuffix(header, filepath.Ext(header))
-	header = simplify(header)
-	name := simplify(lib.Name)
-
-	priority := 0
+	return architecturePriority(lib, arch) + namePriority(lib, header) + locationPriority(lib)
+}
 
+func architecturePriority(lib *libraries.Library, arch string) int {
 	// Bonus for core-optimized libraries
 	if lib.IsOptimizedForArchitecture(arch) {
 		// give a slightly better bonus for libraries that have specific optimization
 		// (it is more important than Location but less important than Name)
-		priority += 1010
+		return 1010
 	} else if lib.IsArchitectureIndependent() {
 		// standard bonus for architecture independent (vanilla) libraries
-		priority += 1000
-	} else {
-		// the library is not architecture compatible
-		priority += 0
+		return 1000
 	}
+	// the library is not architecture compatible
+	return 0
+}
+
+func namePriority(lib *libraries.Library, header string) int {
+	header = strings.TrimSuffix(header, filepath.Ext(header))
+	header = simplify(header)
+	name := simplify(lib.Name)
 
 	if name == header {
-		priority += 500
+		return 500
 	} else if name == header+"-master" {
-		priority += 400
+		return 400
 	} else if strings.HasPrefix(name, header) {
-		priority += 300
+		return 300
 	} else if strings.HasSuffix(name, header) {
-		priority += 200
+		return 200
 	} else if strings.Contains(name, header) {
-		priority += 100
+		return 100
 	}
+	return 0
+}
 
+func locationPriority(lib *libraries.Library) int {
 	switch lib.Location {
 	case libraries.IDEBuiltIn:
-		priority += 0
+		return 0
 	case libraries.ReferencedPlatformBuiltIn:
-		priority++
+		return 1
 	case libraries.PlatformBuiltIn:
-		priority += 2
+		return 2
 	case libraries.User:
-		priority += 3
+		return 3
+	case libraries.Unmanaged:
+		return 4
 	default:
 		panic(fmt.Sprintf("Invalid library location: %d", lib.Location))
 	}
-	return priority
+}
+
+// PreferenceReason returns a short explanation of why the selected library
+// has been preferred over the discarded one when resolving the header for
+// the given architecture
+func PreferenceReason(selected, discarded *libraries.Library, header, arch string) string {
+	if computePriority(selected, header, arch) < computePriority(discarded, header, arch) {
+		// the selected library was already imported by the sketch
+		return "the used library is already part of the build"
+	}
+	// Follow the order of the rules applied by the resolver
+	if architecturePriority(discarded, arch) == 0 && architecturePriority(selected, arch) != 0 {
+		return fmt.Sprintf("not compatible with architecture %s", arch)
+	}
+	if namePriority(selected, header) != namePriority(discarded, header) {
+		return fmt.Sprintf("the name of the used library matches %s better", header)
+	}
+	if architecturePriority(selected, arch) != architecturePriority(discarded, arch) {
+		return fmt.Sprintf("the used library is optimized for architecture %s", arch)
+	}
+	if locationPriority(selected) != locationPriority(discarded) {
+		return fmt.Sprintf("libraries in %s location have priority over %s ones", selected.Location.String(), discarded.Location.String())
+	}
+	return fmt.Sprintf("the name of the used library is the closest match for %s", header)
+}
+
+// Shadows returns true if lib is preferred over other, for the given
+// architecture, when resolving a header named after other
+func Shadows(lib, other *libraries.Library, arch string) bool {
+	header := other.Name + ".h"
+	return computePriority(lib, header, arch) > computePriority(other, header, arch)
 }
 
 func findLibraryWithNameBestDistance(name string, libs libraries.List) *libraries.Library {
@@ -89,6 +89,42 @@ func TestArchitecturePriority(t *testing.T) {
 	require.Equal(t, builtinSDesp, res, "selected library")
 }
 
+func TestPreferenceReason(t *testing.T) {
+	userServo := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Architectures: []string{"*"}}
+	userServoNonavr := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Architectures: []string{"sam", "samd"}}
+	userServoAvr := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.User,
+		Architectures: []string{"avr"}}
+	bundleServoAllArch := &libraries.Library{
+		Name:          "Servo",
+		Location:      libraries.IDEBuiltIn,
+		Architectures: []string{"*"}}
+
+	require.Equal(t, userServo, runResolver("Servo.h", "avr", bundleServoAllArch, userServo))
+	require.Equal(t, "libraries in user location have priority over ide ones",
+		PreferenceReason(userServo, bundleServoAllArch, "Servo.h", "avr"))
+	require.Equal(t, "not compatible with architecture avr",
+		PreferenceReason(bundleServo, userServoNonavr, "Servo.h", "avr"))
+	require.Equal(t, "the used library is optimized for architecture avr",
+		PreferenceReason(userServoAvr, userServo, "Servo.h", "avr"))
+	require.Equal(t, "the name of the used library matches calculus_lib.h better",
+		PreferenceReason(l1, l2, "calculus_lib.h", "avr"))
+	require.Equal(t, "the used library is already part of the build",
+		PreferenceReason(bundleServoAllArch, userServo, "Servo.h", "avr"))
+
+	require.True(t, Shadows(userServo, bundleServoAllArch, "avr"))
+	require.False(t, Shadows(bundleServoAllArch, userServo, "avr"))
+	require.False(t, Shadows(userServo, bundleServo, "avr"))
+	require.True(t, Shadows(userServo, bundleServo, "esp32"))
+}
+
 func TestClosestMatchWithTotallyDifferentNames(t *testing.T) {
 	libraryList := libraries.List{}
 	libraryList.Add(l5)
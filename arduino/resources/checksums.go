@@ -106,6 +106,23 @@ func (r *DownloadResource) TestLocalArchiveIntegrity(downloadDir *paths.Path) (b
 	return ok, nil
 }
 
+// VerifyLocalArchive checks the integrity of the downloaded archive. A corrupted
+// archive is removed from the downloadDir, so that it will be downloaded again
+// from scratch.
+func (r *DownloadResource) VerifyLocalArchive(downloadDir *paths.Path) error {
+	ok, err := r.TestLocalArchiveIntegrity(downloadDir)
+	if err == nil && ok {
+		return nil
+	}
+	if archivePath, err := r.ArchivePath(downloadDir); err == nil {
+		archivePath.Remove()
+	}
+	if err != nil {
+		return fmt.Errorf("verifying %s: %s", r.ArchiveFileName, err)
+	}
+	return fmt.Errorf("verifying %s: checksum mismatch", r.ArchiveFileName)
+}
+
 const (
 	filePermissions = 0644
 	packageFileName = "package.json"
@@ -0,0 +1,332 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/utils"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ExtractProgressCB receives the percentage of the archive extracted so far
+type ExtractProgressCB func(percent float32)
+
+// extractArchive extracts the zip or tar (optionally compressed with gzip or
+// bzip2) archive into destDir. The entries that would be written outside of
+// destDir, directly or through a symlink, make the extraction fail.
+func extractArchive(ctx context.Context, archivePath, destDir *paths.Path, progressCB ExtractProgressCB) error {
+	file, err := os.Open(archivePath.String())
+	if err != nil {
+		return fmt.Errorf("opening archive file: %s", err)
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("opening archive file: %s", err)
+	}
+
+	x := &extractor{
+		ctx:  ctx,
+		dest: filepath.Clean(utils.ExtendedLengthPath(destDir.String())),
+	}
+	progress := &progressReader{reader: file, total: info.Size(), progressCB: progressCB}
+	buffered := bufio.NewReader(progress)
+	header, _ := buffered.Peek(512)
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")) || bytes.HasPrefix(header, []byte("PK\x05\x06")):
+		err = x.extractZip(file, info.Size(), progress)
+	case bytes.HasPrefix(header, []byte("\x1f\x8b")):
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(buffered); err == nil {
+			err = x.extractTar(gz)
+		}
+	case bytes.HasPrefix(header, []byte("BZh")):
+		err = x.extractTar(bzip2.NewReader(buffered))
+	case len(header) > 262 && string(header[257:262]) == "ustar":
+		err = x.extractTar(buffered)
+	default:
+		err = fmt.Errorf("unsupported archive format")
+	}
+	if err != nil {
+		return err
+	}
+	if progressCB != nil {
+		progressCB(100)
+	}
+	return nil
+}
+
+type extractor struct {
+	ctx  context.Context
+	dest string
+}
+
+func (x *extractor) extractTar(reader io.Reader) error {
+	archive := tar.NewReader(reader)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading archive: %s", err)
+		}
+		if err := x.ctx.Err(); err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = x.dir(header.Name)
+		case tar.TypeReg, tar.TypeRegA:
+			err = x.file(header.Name, archive, header.FileInfo().Mode(), header.Size)
+		case tar.TypeSymlink:
+			err = x.symlink(header.Name, header.Linkname)
+		case tar.TypeLink:
+			err = x.hardlink(header.Name, header.Linkname)
+		default:
+			// Devices, fifos and the other special files are never needed
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (x *extractor) extractZip(file io.ReaderAt, size int64, progress *progressReader) error {
+	archive, err := zip.NewReader(file, size)
+	if err != nil {
+		return fmt.Errorf("reading archive: %s", err)
+	}
+	// The zip entries are read directly from the file
+	progress.read = 0
+	for _, entry := range archive.File {
+		if err := x.ctx.Err(); err != nil {
+			return err
+		}
+		if err := x.zipEntry(entry); err != nil {
+			return err
+		}
+		progress.add(int64(entry.CompressedSize64))
+	}
+	return nil
+}
+
+func (x *extractor) zipEntry(entry *zip.File) error {
+	mode := entry.Mode()
+	if mode.IsDir() {
+		return x.dir(entry.Name)
+	}
+	content, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("reading %s: %s", entry.Name, err)
+	}
+	defer content.Close()
+	if mode&os.ModeSymlink != 0 {
+		link, err := ioutil.ReadAll(io.LimitReader(content, 4096))
+		if err != nil {
+			return fmt.Errorf("reading %s: %s", entry.Name, err)
+		}
+		return x.symlink(entry.Name, string(link))
+	}
+	return x.file(entry.Name, content, mode, int64(entry.UncompressedSize64))
+}
+
+// target returns the path where the entry name must be extracted, checking
+// that it's inside the destination dir and that none of its parents is a
+// symlink, that could lead outside of it.
+func (x *extractor) target(name string) (string, error) {
+	target := filepath.Join(x.dest, filepath.FromSlash(name))
+	if target == x.dest {
+		return target, nil
+	}
+	if !strings.HasPrefix(target, x.dest+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: path outside of the extraction directory", name)
+	}
+	for parent := filepath.Dir(target); parent != x.dest; parent = filepath.Dir(parent) {
+		if info, err := os.Lstat(parent); err == nil && info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("%s: path extracted through a symlink", name)
+		}
+	}
+	return target, nil
+}
+
+// prepare creates the parents of target and removes the non-directory entry
+// already found at target, if any, so that it's not written through
+func prepare(target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	if info, err := os.Lstat(target); err == nil && !info.IsDir() {
+		return os.Remove(target)
+	}
+	return nil
+}
+
+func (x *extractor) dir(name string) error {
+	target, err := x.target(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return fmt.Errorf("creating directory %s: %s", name, err)
+	}
+	return nil
+}
+
+func (x *extractor) file(name string, content io.Reader, mode os.FileMode, size int64) error {
+	target, err := x.target(name)
+	if err != nil {
+		return err
+	}
+	if err := prepare(target); err != nil {
+		return fmt.Errorf("creating %s: %s", name, err)
+	}
+
+	// Archives created on Windows lose the executable bits: they are
+	// restored looking at the content of the file
+	head := make([]byte, 4)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("reading %s: %s", name, err)
+	}
+	head = head[:n]
+	perm := mode.Perm()&^0022 | 0600
+	if perm&0111 == 0 && isExecutable(head) {
+		perm |= 0111
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return fmt.Errorf("creating %s: %s", name, err)
+	}
+	written, err := file.Write(head)
+	if err == nil {
+		var copied int64
+		copied, err = io.Copy(file, content)
+		written += int(copied)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("writing %s: %s", name, err)
+	}
+	if int64(written) != size {
+		return fmt.Errorf("%s: extracted %d bytes, %d declared in the archive", name, written, size)
+	}
+	if runtime.GOOS != "windows" {
+		// Do not depend on the umask
+		return os.Chmod(target, perm)
+	}
+	return nil
+}
+
+func (x *extractor) symlink(name, link string) error {
+	target, err := x.target(name)
+	if err != nil {
+		return err
+	}
+	link = filepath.FromSlash(link)
+	if filepath.IsAbs(link) {
+		return fmt.Errorf("%s: symlink to absolute path %s", name, link)
+	}
+	if resolved := filepath.Join(filepath.Dir(target), link); resolved != x.dest && !strings.HasPrefix(resolved, x.dest+string(filepath.Separator)) {
+		return fmt.Errorf("%s: symlink to %s, outside of the extraction directory", name, link)
+	}
+	if err := prepare(target); err != nil {
+		return fmt.Errorf("creating %s: %s", name, err)
+	}
+	if err := os.Symlink(link, target); err != nil {
+		return fmt.Errorf("creating symlink %s: %s", name, err)
+	}
+	return nil
+}
+
+func (x *extractor) hardlink(name, link string) error {
+	target, err := x.target(name)
+	if err != nil {
+		return err
+	}
+	linked, err := x.target(link)
+	if err != nil {
+		return err
+	}
+	if err := prepare(target); err != nil {
+		return fmt.Errorf("creating %s: %s", name, err)
+	}
+	if err := os.Link(linked, target); err != nil {
+		return fmt.Errorf("creating hard link %s: %s", name, err)
+	}
+	return nil
+}
+
+// isExecutable returns true if head is the beginning of an ELF or Mach-O
+// binary or of a script
+func isExecutable(head []byte) bool {
+	for _, magic := range [][]byte{
+		[]byte("\x7fELF"),
+		[]byte("\xfe\xed\xfa\xce"), []byte("\xfe\xed\xfa\xcf"),
+		[]byte("\xce\xfa\xed\xfe"), []byte("\xcf\xfa\xed\xfe"),
+		[]byte("\xca\xfe\xba\xbe"),
+		[]byte("#!"),
+	} {
+		if bytes.HasPrefix(head, magic) {
+			return true
+		}
+	}
+	return false
+}
+
+// progressReader reports to progressCB the percentage of the total bytes read
+type progressReader struct {
+	reader      io.Reader
+	total, read int64
+	progressCB  ExtractProgressCB
+	lastPercent int
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.add(int64(n))
+	return n, err
+}
+
+// add reports n more bytes read, each percentage is reported once
+func (r *progressReader) add(n int64) {
+	r.read += n
+	if r.progressCB == nil || r.total <= 0 {
+		return
+	}
+	if percent := int(r.read * 100 / r.total); percent != r.lastPercent && percent < 100 {
+		r.lastPercent = percent
+		r.progressCB(float32(percent))
+	}
+}
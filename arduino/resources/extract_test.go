@@ -0,0 +1,164 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	name, link string
+	typeflag   byte
+	mode       int64
+	content    string
+}
+
+func makeTarGz(t *testing.T, dir *paths.Path, entries []testEntry) *paths.Path {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	archive := tar.NewWriter(gz)
+	for _, e := range entries {
+		header := &tar.Header{Name: e.name, Linkname: e.link, Typeflag: e.typeflag, Mode: e.mode, Size: int64(len(e.content))}
+		if e.mode == 0 {
+			header.Mode = 0644
+		}
+		require.NoError(t, archive.WriteHeader(header))
+		if e.typeflag == tar.TypeReg {
+			_, err := archive.Write([]byte(e.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, archive.Close())
+	require.NoError(t, gz.Close())
+	archivePath := dir.Join("archive.tar.gz")
+	require.NoError(t, archivePath.WriteFile(buf.Bytes()))
+	return archivePath
+}
+
+func extractTestArchive(t *testing.T, entries []testEntry) (*paths.Path, error) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { tmp.RemoveAll() })
+	dest := tmp.Join("dest")
+	require.NoError(t, dest.MkdirAll())
+	err = extractArchive(context.Background(), makeTarGz(t, tmp, entries), dest, nil)
+	return dest, err
+}
+
+func TestExtractArchive(t *testing.T) {
+	var percents []float32
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	archivePath := makeTarGz(t, tmp, []testEntry{
+		{name: "tool/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "tool/bin/gcc", typeflag: tar.TypeReg, content: "\x7fELF binary"},
+		{name: "tool/bin/run.sh", typeflag: tar.TypeReg, mode: 0755, content: "echo"},
+		{name: "tool/README", typeflag: tar.TypeReg, content: "readme"},
+		{name: "tool/gcc", typeflag: tar.TypeSymlink, link: "bin/gcc"},
+	})
+	dest := tmp.Join("dest")
+	err = extractArchive(context.Background(), archivePath, dest, func(p float32) { percents = append(percents, p) })
+	require.NoError(t, err)
+	require.Equal(t, float32(100), percents[len(percents)-1])
+
+	readme, err := dest.Join("tool", "README").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "readme", string(readme))
+	link, err := os.Readlink(dest.Join("tool", "gcc").String())
+	require.NoError(t, err)
+	require.Equal(t, "bin/gcc", link)
+
+	if runtime.GOOS != "windows" {
+		// The ELF binary is made executable even if the archive lacks the bit
+		info, err := dest.Join("tool", "bin", "gcc").Stat()
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+		info, err = dest.Join("tool", "bin", "run.sh").Stat()
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+		info, err = dest.Join("tool", "README").Stat()
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0644), info.Mode().Perm())
+	}
+}
+
+func TestExtractArchiveZip(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	w, err := archive.Create("lib/src/lib.h")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("#pragma once"))
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+	archivePath := tmp.Join("archive.zip")
+	require.NoError(t, archivePath.WriteFile(buf.Bytes()))
+
+	dest := tmp.Join("dest")
+	require.NoError(t, extractArchive(context.Background(), archivePath, dest, nil))
+	content, err := dest.Join("lib", "src", "lib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "#pragma once", string(content))
+}
+
+func TestExtractArchiveOutsideDestination(t *testing.T) {
+	_, err := extractTestArchive(t, []testEntry{
+		{name: "../evil", typeflag: tar.TypeReg, content: "evil"},
+	})
+	require.Error(t, err)
+
+	_, err = extractTestArchive(t, []testEntry{
+		{name: "tool/link", typeflag: tar.TypeSymlink, link: "../../outside"},
+	})
+	require.Error(t, err)
+
+	_, err = extractTestArchive(t, []testEntry{
+		{name: "tool/link", typeflag: tar.TypeSymlink, link: "/etc"},
+	})
+	require.Error(t, err)
+
+	// A file written through a symlink pointing inside the archive is
+	// rejected too: the symlink may have been replaced in the meantime
+	_, err = extractTestArchive(t, []testEntry{
+		{name: "tool/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "link", typeflag: tar.TypeSymlink, link: "tool"},
+		{name: "link/file", typeflag: tar.TypeReg, content: "data"},
+	})
+	require.Error(t, err)
+}
+
+func TestExtractArchiveUnsupported(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	archivePath := tmp.Join("archive.rar")
+	require.NoError(t, archivePath.WriteFile([]byte("Rar!\x1a\x07\x00")))
+	require.Error(t, extractArchive(context.Background(), archivePath, tmp.Join("dest"), nil))
+}
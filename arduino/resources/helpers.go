@@ -60,8 +60,8 @@ func (r *DownloadResource) Download(downloadDir *paths.Path, config *downloader.
 
 	if stats, err := path.Stat(); os.IsNotExist(err) {
 		// normal download
-	} else if err == nil && stats.Size() > r.Size {
-		// file is bigger than expected, retry download...
+	} else if err == nil && stats.Size() >= r.Size {
+		// file is complete but corrupted or bigger than expected, retry download...
 		if err := path.Remove(); err != nil {
 			return nil, fmt.Errorf("removing corrupted archive file: %s", err)
 		}
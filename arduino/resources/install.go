@@ -19,19 +19,23 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	paths "github.com/arduino/go-paths-helper"
-	"github.com/codeclysm/extract/v3"
 	"go.bug.st/cleanup"
 )
 
-// Install installs the resource in three steps:
-// - the archive is unpacked in a temporary subdir of tempPath
-// - there should be only one root dir in the unpacked content
-// - the only root dir is moved/renamed to/as the destination directory
-// Note that tempPath and destDir must be on the same filesystem partition
-// otherwise the last step will fail.
-func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.Path) error {
+// Install installs the resource in destDir, replacing its previous content
+// only if the installation succeeds:
+// - the archive is unpacked in a hidden temporary dir next to destDir
+// - there should be only one root dir in the unpacked content, that is
+// checked by verify, if not nil
+// - the previous content of destDir, if any, is moved aside and the root dir
+// is renamed as destDir, the previous content is restored if the rename fails
+// The leftovers of a previous installation of destDir interrupted by a crash
+// are cleaned up first. The progress of the extraction is reported to
+// progressCB, if not nil.
+func (release *DownloadResource) Install(downloadDir, destDir *paths.Path, verify func(*paths.Path) error, progressCB ExtractProgressCB) error {
 	// Check the integrity of the package
 	if ok, err := release.TestLocalArchiveIntegrity(downloadDir); err != nil {
 		return fmt.Errorf("testing local archive integrity: %s", err)
@@ -39,68 +43,132 @@ func (release *DownloadResource) Install(downloadDir, tempPath, destDir *paths.P
 		return fmt.Errorf("checking local archive integrity")
 	}
 
-	// Create a temporary dir to extract package
-	if err := tempPath.MkdirAll(); err != nil {
-		return fmt.Errorf("creating temp dir for extraction: %s", err)
+	// Ensure container dir exists
+	destDirParent := destDir.Parent()
+	if err := destDirParent.MkdirAll(); err != nil {
+		return err
 	}
-	tempDir, err := tempPath.MkTempDir("package-")
+	defer func() {
+		if empty, err := IsDirEmpty(destDirParent); err == nil && empty {
+			destDirParent.RemoveAll()
+		}
+	}()
+	if err := recoverInterruptedInstall(destDir); err != nil {
+		return err
+	}
+
+	// Create a temporary dir next to destDir to extract package, so that
+	// the extracted content can be renamed into place
+	tempDir, err := destDirParent.MkTempDir(tempDirPrefix(destDir))
 	if err != nil {
 		return fmt.Errorf("creating temp dir for extraction: %s", err)
 	}
 	defer tempDir.RemoveAll()
 
-	// Obtain the archive path and open it
+	// Obtain the archive path and extract it
 	archivePath, err := release.ArchivePath(downloadDir)
 	if err != nil {
 		return fmt.Errorf("getting archive path: %s", err)
 	}
-	file, err := os.Open(archivePath.String())
+	root, err := UnpackArchive(archivePath, tempDir, progressCB)
 	if err != nil {
-		return fmt.Errorf("opening archive file: %s", err)
+		return err
 	}
-	defer file.Close()
-
-	// Extract into temp directory
-	ctx, cancel := cleanup.InterruptableContext(context.Background())
-	defer cancel()
-	if err := extract.Archive(ctx, file, tempDir.String(), nil); err != nil {
-		return fmt.Errorf("extracting archive: %s", err)
+	if verify != nil {
+		if err := verify(root); err != nil {
+			return fmt.Errorf("verifying %s: %s", archivePath.Base(), err)
+		}
 	}
 
-	// Check package content and find package root dir
-	root, err := findPackageRoot(tempDir)
-	if err != nil {
-		return fmt.Errorf("searching package root dir: %s", err)
-	}
+	return replaceDir(root, destDir)
+}
 
-	// Ensure container dir exists
-	destDirParent := destDir.Parent()
-	if err := destDirParent.MkdirAll(); err != nil {
-		return err
+// tempDirPrefix returns the prefix of the temporary dirs where the archives
+// installed in destDir are unpacked.
+func tempDirPrefix(destDir *paths.Path) string {
+	return "." + destDir.Base() + ".tmp-"
+}
+
+// backupDir returns the path where the previous content of destDir is moved
+// while it's replaced.
+func backupDir(destDir *paths.Path) *paths.Path {
+	return destDir.Parent().Join("." + destDir.Base() + ".old")
+}
+
+// exist returns true if path exists, without following symlinks.
+func exist(path *paths.Path) bool {
+	_, err := os.Lstat(path.String())
+	return err == nil
+}
+
+// replaceDir renames dir as destDir, the previous content of destDir is
+// removed only after the rename succeeded and restored otherwise. dir and
+// destDir must be on the same filesystem.
+func replaceDir(dir, destDir *paths.Path) error {
+	backup := backupDir(destDir)
+	if exist(destDir) {
+		if err := destDir.Rename(backup); err != nil {
+			return fmt.Errorf("moving aside the previous content of %s: %s", destDir, err)
+		}
 	}
-	defer func() {
-		if empty, err := IsDirEmpty(destDirParent); err == nil && empty {
-			destDirParent.RemoveAll()
+	if err := dir.Rename(destDir); err != nil {
+		if exist(backup) {
+			backup.Rename(destDir)
+		}
+		return fmt.Errorf("moving extracted archive to destination dir: %s", err)
+	}
+	if exist(backup) {
+		if err := backup.RemoveAll(); err != nil {
+			return fmt.Errorf("removing the previous content of %s: %s", destDir, err)
 		}
-	}()
-
-	// If the destination dir already exists remove it
-	if destDir.IsDir() {
-		destDir.RemoveAll()
 	}
+	return nil
+}
 
-	// Move/rename the extracted root directory in the destination directory
-	if err := root.Rename(destDir); err != nil {
-		return fmt.Errorf("moving extracted archive to destination dir: %s", err)
+// recoverInterruptedInstall cleans up the leftovers of an installation in
+// destDir interrupted by a crash: the previous content of destDir is restored
+// if it was moved aside and not replaced, and the temporary dirs are removed.
+func recoverInterruptedInstall(destDir *paths.Path) error {
+	backup := backupDir(destDir)
+	if exist(backup) {
+		if exist(destDir) {
+			if err := backup.RemoveAll(); err != nil {
+				return fmt.Errorf("removing the previous content of %s: %s", destDir, err)
+			}
+		} else if err := backup.Rename(destDir); err != nil {
+			return fmt.Errorf("restoring the previous content of %s: %s", destDir, err)
+		}
 	}
+	files, err := destDir.Parent().ReadDir()
+	if err != nil {
+		return nil
+	}
+	for _, file := range files {
+		if strings.HasPrefix(file.Base(), tempDirPrefix(destDir)) {
+			if err := file.RemoveAll(); err != nil {
+				return fmt.Errorf("removing temp dir %s: %s", file, err)
+			}
+		}
+	}
+	return nil
+}
 
-	// TODO
-	// // Create a package file
-	// if err := createPackageFile(destDir); err != nil {
-	// 	return err
-	// }
+// UnpackArchive extracts the archive into destDir and returns the only root
+// dir found in the unpacked content. The progress of the extraction is
+// reported to progressCB, if not nil.
+func UnpackArchive(archivePath, destDir *paths.Path, progressCB ExtractProgressCB) (*paths.Path, error) {
+	ctx, cancel := cleanup.InterruptableContext(context.Background())
+	defer cancel()
+	if err := extractArchive(ctx, archivePath, destDir, progressCB); err != nil {
+		return nil, fmt.Errorf("extracting archive: %s", err)
+	}
 
-	return nil
+	// Check package content and find package root dir
+	root, err := findPackageRoot(destDir)
+	if err != nil {
+		return nil, fmt.Errorf("searching package root dir: %s", err)
+	}
+	return root, nil
 }
 
 // IsDirEmpty returns true if the directory specified by path is empty.
@@ -0,0 +1,118 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package resources
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// makeTestResource creates in downloadDir an archive named name, containing
+// a version.txt file with the given content.
+func makeTestResource(t *testing.T, downloadDir *paths.Path, name, version string) *DownloadResource {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	archive := makeTarGz(t, tmp, []testEntry{
+		{name: "lib/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "lib/version.txt", typeflag: tar.TypeReg, content: version},
+	})
+	data, err := archive.ReadFile()
+	require.NoError(t, err)
+	require.NoError(t, downloadDir.Join(name).WriteFile(data))
+	checksum := sha256.Sum256(data)
+	return &DownloadResource{
+		ArchiveFileName: name,
+		Checksum:        "SHA-256:" + hex.EncodeToString(checksum[:]),
+		Size:            int64(len(data)),
+	}
+}
+
+func TestInstall(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	downloadDir := tmp.Join("staging")
+	require.NoError(t, downloadDir.MkdirAll())
+	v1 := makeTestResource(t, downloadDir, "lib-1.0.0.tar.gz", "1.0.0")
+	v2 := makeTestResource(t, downloadDir, "lib-2.0.0.tar.gz", "2.0.0")
+	libsDir := tmp.Join("libraries")
+	destDir := libsDir.Join("lib")
+
+	requireInstalled := func(version string) {
+		data, err := destDir.Join("version.txt").ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, version, string(data))
+		// no leftovers next to the installed dir
+		files, err := libsDir.ReadDir()
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+	}
+
+	require.NoError(t, v1.Install(downloadDir, destDir, nil, nil))
+	requireInstalled("1.0.0")
+
+	// The previous content is left untouched if the verification fails...
+	err = v2.Install(downloadDir, destDir, func(dir *paths.Path) error {
+		require.True(t, dir.Join("version.txt").Exist())
+		return errors.New("invalid library")
+	}, nil)
+	require.EqualError(t, err, "verifying lib-2.0.0.tar.gz: invalid library")
+	requireInstalled("1.0.0")
+
+	// ...and replaced otherwise
+	require.NoError(t, v2.Install(downloadDir, destDir, func(dir *paths.Path) error { return nil }, nil))
+	requireInstalled("2.0.0")
+
+	// A corrupted archive is not installed
+	require.NoError(t, downloadDir.Join("lib-1.0.0.tar.gz").WriteFile([]byte("corrupted")))
+	require.Error(t, v1.Install(downloadDir, destDir, nil, nil))
+	requireInstalled("2.0.0")
+}
+
+func TestRecoverInterruptedInstall(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	destDir := tmp.Join("lib")
+	backup := tmp.Join(".lib.old")
+	leftover := tmp.Join(".lib.tmp-123456")
+
+	// Interrupted after moving aside the previous content
+	require.NoError(t, backup.MkdirAll())
+	require.NoError(t, backup.Join("version.txt").WriteFile([]byte("1.0.0")))
+	require.NoError(t, leftover.Join("lib").MkdirAll())
+	require.NoError(t, recoverInterruptedInstall(destDir))
+	require.True(t, destDir.Join("version.txt").Exist())
+	require.False(t, backup.Exist())
+	require.False(t, leftover.Exist())
+
+	// Interrupted after moving the new content in place
+	require.NoError(t, backup.MkdirAll())
+	require.NoError(t, recoverInterruptedInstall(destDir))
+	require.True(t, destDir.Join("version.txt").Exist())
+	require.False(t, backup.Exist())
+
+	// Nothing to recover
+	require.NoError(t, recoverInterruptedInstall(destDir))
+	require.True(t, destDir.Join("version.txt").Exist())
+}
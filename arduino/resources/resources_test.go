@@ -111,3 +111,28 @@ func TestDownloadAndChecksums(t *testing.T) {
 	_, err = r.TestLocalArchiveChecksum(tmp)
 	require.Error(t, err)
 }
+
+func TestVerifyLocalArchive(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	testFile := tmp.Join("cache", "test.txt")
+	require.NoError(t, testFile.Parent().MkdirAll())
+
+	r := &DownloadResource{
+		ArchiveFileName: "test.txt",
+		CachePath:       "cache",
+		// sha256 of "test"
+		Checksum: "SHA-256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		Size:     4,
+	}
+
+	require.NoError(t, testFile.WriteFile([]byte("test")))
+	require.NoError(t, r.VerifyLocalArchive(tmp))
+	require.True(t, testFile.Exist())
+
+	// A corrupted archive is removed
+	require.NoError(t, testFile.WriteFile([]byte("tset")))
+	require.Error(t, r.VerifyLocalArchive(tmp))
+	require.False(t, testFile.Exist())
+}
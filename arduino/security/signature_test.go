@@ -34,3 +34,24 @@ func TestSignatureVerification(t *testing.T) {
 	require.Nil(t, signer)
 	require.Error(t, err)
 }
+
+func TestSignatureVerificationWithTrustedKeys(t *testing.T) {
+	index := paths.New("testdata/package_index.json")
+	signature := paths.New("testdata/package_index.json.test.sig")
+	trustedKeys := paths.NewPathList("testdata/test_public.asc")
+
+	// Not trusted by default
+	res, _, err := VerifyArduinoDetachedSignature(index, signature)
+	require.False(t, res)
+	require.Error(t, err)
+
+	res, signer, err := VerifyDetachedSignature(index, signature, trustedKeys)
+	require.NoError(t, err)
+	require.True(t, res)
+	require.Equal(t, uint64(0x226332decf0d2616), signer.PrimaryKey.KeyId)
+
+	// Arduino signatures are still accepted
+	res, _, err = VerifyDetachedSignature(index, paths.New("testdata/package_index.json.sig"), trustedKeys)
+	require.NoError(t, err)
+	require.True(t, res)
+}
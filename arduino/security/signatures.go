@@ -16,6 +16,7 @@
 package security
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/arduino/go-paths-helper"
@@ -29,6 +30,14 @@ import (
 // fails this function returns false. The PGP entity in the trusted keychain that
 // produced the signature is returned too.
 func VerifyArduinoDetachedSignature(targetPath *paths.Path, signaturePath *paths.Path) (bool, *openpgp.Entity, error) {
+	return VerifyDetachedSignature(targetPath, signaturePath, nil)
+}
+
+// VerifyDetachedSignature works like VerifyArduinoDetachedSignature but also
+// accepts signatures made by any of the public keys contained in the
+// trustedKeys files. Keys may be stored either in binary or in ASCII armored
+// format.
+func VerifyDetachedSignature(targetPath *paths.Path, signaturePath *paths.Path, trustedKeys paths.PathList) (bool, *openpgp.Entity, error) {
 	keysBox, err := rice.FindBox("keys")
 	if err != nil {
 		panic("could not find bundled signature keys")
@@ -41,6 +50,13 @@ func VerifyArduinoDetachedSignature(targetPath *paths.Path, signaturePath *paths
 	if err != nil {
 		return false, nil, fmt.Errorf("retrieving Arduino public keys: %s", err)
 	}
+	for _, keyPath := range trustedKeys {
+		keys, err := readKeyRing(keyPath)
+		if err != nil {
+			return false, nil, fmt.Errorf("retrieving trusted public keys from %s: %s", keyPath, err)
+		}
+		keyRing = append(keyRing, keys...)
+	}
 
 	target, err := targetPath.Open()
 	if err != nil {
@@ -55,3 +71,14 @@ func VerifyArduinoDetachedSignature(targetPath *paths.Path, signaturePath *paths
 	signer, err := openpgp.CheckDetachedSignature(keyRing, target, signature)
 	return (signer != nil && err == nil), signer, err
 }
+
+func readKeyRing(keyPath *paths.Path) (openpgp.EntityList, error) {
+	data, err := keyPath.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	if keys, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data)); err == nil {
+		return keys, nil
+	}
+	return openpgp.ReadKeyRing(bytes.NewReader(data))
+}
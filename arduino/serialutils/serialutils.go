@@ -71,6 +71,15 @@ func TouchSerialPortAt1200bps(port string) error {
 	return nil
 }
 
+// getPortsList is the function used to list the serial ports, replaced in tests
+var getPortsList = serial.GetPortsList
+
+// uploadPortTimeout is how long to wait for the upload port to appear after a reset
+var uploadPortTimeout = 10 * time.Second
+
+// portBusyDelay is the time to wait before using a port that just appeared
+var portBusyDelay = 500 * time.Millisecond
+
 // WaitForNewSerialPortOrDefaultTo is meant to be called just after a reset. It watches the ports connected
 // to the machine until a port appears. The new appeared port is returned or, if the operation
 // timeouts, the default port provided as parameter is returned.
@@ -81,34 +90,77 @@ func WaitForNewSerialPortOrDefaultTo(defaultPort string) (string, error) {
 		// on OS X, if the port is opened too quickly after it is detected,
 		// a "Resource busy" error occurs, add a delay to workaround.
 		// This apply to other platforms as well.
-		time.Sleep(500 * time.Millisecond)
+		time.Sleep(portBusyDelay)
 
 		return p, nil
 	}
 	return defaultPort, nil
 }
 
-// WaitForNewSerialPort is meant to be called just after a reset. It watches the ports connected
-// to the machine until a port appears. The new appeared port is returned.
-func WaitForNewSerialPort() (string, error) {
-	getPortMap := func() (map[string]bool, error) {
-		ports, err := serial.GetPortsList()
-		if err != nil {
-			return nil, errors.WithMessage(err, "listing serial ports")
-		}
-		res := map[string]bool{}
-		for _, port := range ports {
-			res[port] = true
+// WaitForUploadPort is meant to be called just after a 1200bps touch reset, portsBeforeReset
+// must be the list of the ports available before the reset. It returns the first port that
+// appears, which may be the same port that disappeared during the reset or a port with a new
+// name. If no new port appears within the timeout, defaultPort is returned if it's still
+// available, otherwise an error is returned.
+func WaitForUploadPort(defaultPort string, portsBeforeReset []string) (string, error) {
+	last := map[string]bool{}
+	for _, port := range portsBeforeReset {
+		last[port] = true
+	}
+	p, err := waitForNewSerialPort(last)
+	if err != nil {
+		return "", errors.WithMessage(err, "detecting upload port")
+	}
+	if p != "" {
+		// on OS X, if the port is opened too quickly after it is detected,
+		// a "Resource busy" error occurs, add a delay to workaround.
+		// This apply to other platforms as well.
+		time.Sleep(portBusyDelay)
+		return p, nil
+	}
+	if defaultPort == "" {
+		return "", nil
+	}
+
+	ports, err := getPortsList()
+	if err != nil {
+		return "", errors.WithMessage(err, "listing serial ports")
+	}
+	for _, port := range ports {
+		if port == defaultPort {
+			return defaultPort, nil
 		}
-		return res, nil
 	}
+	return "", errors.Errorf("upload port %s not found after reset", defaultPort)
+}
 
+// WaitForNewSerialPort is meant to be called just after a reset. It watches the ports connected
+// to the machine until a port appears. The new appeared port is returned.
+func WaitForNewSerialPort() (string, error) {
 	last, err := getPortMap()
 	if err != nil {
 		return "", err
 	}
+	return waitForNewSerialPort(last)
+}
+
+func getPortMap() (map[string]bool, error) {
+	ports, err := getPortsList()
+	if err != nil {
+		return nil, errors.WithMessage(err, "listing serial ports")
+	}
+	res := map[string]bool{}
+	for _, port := range ports {
+		res[port] = true
+	}
+	return res, nil
+}
 
-	deadline := time.Now().Add(10 * time.Second)
+// waitForNewSerialPort watches the ports connected to the machine until a port not
+// in last appears. A port that disappears and then reappears with the same name is
+// considered new too. Returns an empty string if no port appears before the timeout.
+func waitForNewSerialPort(last map[string]bool) (string, error) {
+	deadline := time.Now().Add(uploadPortTimeout)
 	for time.Now().Before(deadline) {
 		now, err := getPortMap()
 		if err != nil {
@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package serialutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockPortsList makes getPortsList return the given lists, one per call,
+// repeating the last one when exhausted
+func mockPortsList(t *testing.T, lists ...[]string) {
+	oldGetPortsList, oldTimeout, oldDelay := getPortsList, uploadPortTimeout, portBusyDelay
+	t.Cleanup(func() {
+		getPortsList, uploadPortTimeout, portBusyDelay = oldGetPortsList, oldTimeout, oldDelay
+	})
+	uploadPortTimeout = time.Second
+	portBusyDelay = 0
+	getPortsList = func() ([]string, error) {
+		res := lists[0]
+		if len(lists) > 1 {
+			lists = lists[1:]
+		}
+		return res, nil
+	}
+}
+
+func TestWaitForUploadPort(t *testing.T) {
+	before := []string{"/dev/ttyS0", "/dev/ttyACM0"}
+
+	// The port reappears with a new name
+	mockPortsList(t, []string{"/dev/ttyS0"}, []string{"/dev/ttyS0", "/dev/ttyACM1"})
+	port, err := WaitForUploadPort("/dev/ttyACM0", before)
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM1", port)
+
+	// The port disappears and reappears with the same name
+	mockPortsList(t, before, []string{"/dev/ttyS0"}, before)
+	port, err = WaitForUploadPort("/dev/ttyACM0", before)
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM0", port)
+
+	// The port doesn't change, the default one is used
+	mockPortsList(t, before)
+	port, err = WaitForUploadPort("/dev/ttyACM0", before)
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM0", port)
+
+	// The port disappears and never comes back
+	mockPortsList(t, []string{"/dev/ttyS0"})
+	_, err = WaitForUploadPort("/dev/ttyACM0", before)
+	require.Error(t, err)
+}
@@ -71,6 +71,55 @@ type Sketch struct {
 	AdditionalFiles  []*Item
 }
 
+// The layouts of the source files of a sketch, as returned by Sketch.Layout
+const (
+	// LayoutFlat is used when all the source files are in the sketch folder
+	LayoutFlat = "flat"
+	// LayoutSrc is used when the source files other than the .ino files are
+	// in the `src` subfolder, that is compiled recursively
+	LayoutSrc = "src"
+	// LayoutMixed is used when there are source files both in the sketch
+	// folder and in the `src` subfolder
+	LayoutMixed = "mixed"
+)
+
+// RelativePath returns the path of the item relative to the sketch folder,
+// using forward slashes as separator on every OS.
+func (s *Sketch) RelativePath(item *Item) string {
+	rel, err := filepath.Rel(s.LocationPath, item.Path)
+	if err != nil {
+		return item.Path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// Layout returns where the compiled source files of the sketch are placed,
+// one of LayoutFlat, LayoutSrc or LayoutMixed. A sketch made only of .ino
+// files has a flat layout. Only the additional files in the sketch folder and
+// in the `src` subfolder are compiled, the others can only be included.
+func (s *Sketch) Layout() string {
+	inRoot, inSrc := false, false
+	for _, item := range s.AdditionalFiles {
+		if _, isSource := globals.SourceFilesValidExtensions[strings.ToLower(filepath.Ext(item.Path))]; !isSource {
+			continue
+		}
+		rel := s.RelativePath(item)
+		if !strings.Contains(rel, "/") {
+			inRoot = true
+		} else if strings.HasPrefix(rel, "src/") {
+			inSrc = true
+		}
+	}
+	switch {
+	case inSrc && inRoot:
+		return LayoutMixed
+	case inSrc:
+		return LayoutSrc
+	default:
+		return LayoutFlat
+	}
+}
+
 // New creates an Sketch instance by reading all the files composing a sketch and grouping them
 // by file type.
 func New(sketchFolderPath, mainFilePath, buildPath string, allFilesPaths []string) (*Sketch, error) {
@@ -71,3 +71,29 @@ func TestNew(t *testing.T) {
 	assert.Len(t, sketch.OtherSketchFiles, 0)
 	assert.Len(t, sketch.AdditionalFiles, 1)
 }
+
+func TestLayout(t *testing.T) {
+	sketchFolderPath := filepath.Join("testdata", t.Name())
+	mainFilePath := filepath.Join(sketchFolderPath, t.Name()+".ino")
+	rootFile := filepath.Join(sketchFolderPath, "root.cpp")
+	srcHeader := filepath.Join(sketchFolderPath, "src", "nested", "helper.h")
+	srcFile := filepath.Join(sketchFolderPath, "src", "nested", "helper.cpp")
+
+	s, err := sketch.New(sketchFolderPath, mainFilePath, "", []string{mainFilePath})
+	assert.Nil(t, err)
+	assert.Equal(t, sketch.LayoutFlat, s.Layout())
+
+	s, err = sketch.New(sketchFolderPath, mainFilePath, "", []string{mainFilePath, rootFile, srcHeader})
+	assert.Nil(t, err)
+	assert.Equal(t, sketch.LayoutFlat, s.Layout())
+
+	s, err = sketch.New(sketchFolderPath, mainFilePath, "", []string{mainFilePath, srcHeader, srcFile})
+	assert.Nil(t, err)
+	assert.Equal(t, sketch.LayoutSrc, s.Layout())
+	assert.Equal(t, "src/nested/helper.cpp", s.RelativePath(s.AdditionalFiles[0]))
+
+	s, err = sketch.New(sketchFolderPath, mainFilePath, "", []string{mainFilePath, rootFile, srcHeader, srcFile})
+	assert.Nil(t, err)
+	assert.Equal(t, sketch.LayoutMixed, s.Layout())
+	assert.Equal(t, "root.cpp", s.RelativePath(s.AdditionalFiles[0]))
+}
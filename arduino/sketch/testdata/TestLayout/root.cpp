@@ -0,0 +1 @@
+#include "src/nested/helper.h"
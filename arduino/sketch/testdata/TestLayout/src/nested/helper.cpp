@@ -0,0 +1,2 @@
+#include "helper.h"
+void helper() {}
@@ -0,0 +1 @@
+void helper();
@@ -0,0 +1,91 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketches
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectFile is the content of the sketch.yaml file in the root path of a
+// sketch
+type ProjectFile struct {
+	Profiles       map[string]*Profile `yaml:"profiles"`
+	DefaultProfile string              `yaml:"default_profile"`
+	Secrets        *Secrets            `yaml:"secrets"`
+}
+
+// Profile pins the platform, the libraries and the flags used to build a
+// sketch, so that the same binary is produced on every machine
+type Profile struct {
+	Fqbn            string   `yaml:"fqbn"`
+	Platform        string   `yaml:"platform"`
+	Libraries       []string `yaml:"libraries"`
+	BuildProperties []string `yaml:"build_properties"`
+	ExtraFlags      []string `yaml:"extra_flags"`
+}
+
+// LoadProjectFile reads the sketch.yaml file in the given sketch folder, it
+// returns nil if the sketch has no such file
+func LoadProjectFile(sketchPath *paths.Path) (*ProjectFile, error) {
+	projectFile := sketchPath.Join("sketch.yaml")
+	if projectFile.NotExist() {
+		return nil, nil
+	}
+	content, err := projectFile.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %s", projectFile, err)
+	}
+	project := &ProjectFile{}
+	if err := yaml.Unmarshal(content, project); err != nil {
+		return nil, fmt.Errorf("decoding %s: %s", projectFile, err)
+	}
+	return project, nil
+}
+
+// GetProfile returns the profile with the given name, or the default one if
+// name is empty
+func (p *ProjectFile) GetProfile(name string) (*Profile, error) {
+	if name == "" {
+		name = p.DefaultProfile
+	}
+	if name == "" {
+		return nil, fmt.Errorf("no profile specified and no default profile set")
+	}
+	if profile, ok := p.Profiles[name]; ok {
+		return profile, nil
+	}
+	available := []string{}
+	for profileName := range p.Profiles {
+		available = append(available, profileName)
+	}
+	sort.Strings(available)
+	return nil, fmt.Errorf("profile '%s' not found, available profiles: %s", name, strings.Join(available, ", "))
+}
+
+// ParsePinnedReference splits a reference in the NAME@VERSION form used by
+// profiles for platforms and libraries. The version is required.
+func ParsePinnedReference(ref string) (string, string, error) {
+	i := strings.LastIndex(ref, "@")
+	if i <= 0 || i == len(ref)-1 {
+		return "", "", fmt.Errorf("invalid reference '%s': must be in the NAME@VERSION form", ref)
+	}
+	return ref[:i], ref[i+1:], nil
+}
@@ -0,0 +1,172 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketches
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"golang.org/x/crypto/pbkdf2"
+	"gopkg.in/yaml.v3"
+)
+
+// SecretsHeaderFileName is the name of the header generated from the secrets
+// of the sketch.yaml file
+const SecretsHeaderFileName = "arduino_secrets.h"
+
+// SecretsKeyEnvVar is the environment variable containing the passphrase of
+// the encrypted secrets file
+const SecretsKeyEnvVar = "ARDUINO_SECRETS_KEY"
+
+// Secrets declares the values written in arduino_secrets.h when a sketch is
+// compiled, e.g. the credentials of the Wi-Fi network, so that they are not
+// stored in the sketch
+type Secrets struct {
+	// Env maps the name of each secret to the environment variable
+	// containing its value
+	Env map[string]string `yaml:"env"`
+	// File is the path, relative to the sketch, of a YAML file with the name
+	// and the value of each secret, encrypted with
+	// `openssl enc -aes-256-cbc -pbkdf2 -salt` using the passphrase in the
+	// ARDUINO_SECRETS_KEY environment variable
+	File string `yaml:"file"`
+}
+
+var secretNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Values returns the value of each secret, read from the encrypted file and
+// from the environment with getenv. The environment variables take precedence
+// over the file. The values are never part of the errors returned.
+func (s *Secrets) Values(sketchPath *paths.Path, getenv func(string) (string, bool)) (map[string]string, error) {
+	values := map[string]string{}
+	if s.File != "" {
+		passphrase, ok := getenv(SecretsKeyEnvVar)
+		if !ok || passphrase == "" {
+			return nil, fmt.Errorf("the %s environment variable is required to decrypt %s", SecretsKeyEnvVar, s.File)
+		}
+		encrypted, err := sketchPath.Join(s.File).ReadFile()
+		if err != nil {
+			return nil, fmt.Errorf("reading secrets file: %s", err)
+		}
+		content, err := decryptSecrets(encrypted, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("decrypting %s: %s", s.File, err)
+		}
+		if err := yaml.Unmarshal(content, &values); err != nil {
+			// The error of the decoder may quote the content
+			return nil, fmt.Errorf("decoding %s: the decrypted content is not a map of secrets", s.File)
+		}
+	}
+	for name, envVar := range s.Env {
+		if value, ok := getenv(envVar); ok {
+			values[name] = value
+		} else if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("secret %s: the environment variable %s is not set", name, envVar)
+		}
+	}
+	for name := range values {
+		if !secretNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid secret name '%s': it must be a valid C identifier", name)
+		}
+	}
+	return values, nil
+}
+
+// SecretsHeader returns the content of arduino_secrets.h, with a #define for
+// each secret
+func SecretsHeader(values map[string]string) []byte {
+	names := []string{}
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var header bytes.Buffer
+	header.WriteString("// Generated from the secrets of sketch.yaml, do not edit\n")
+	header.WriteString("#pragma once\n\n")
+	for _, name := range names {
+		fmt.Fprintf(&header, "#define %s %s\n", name, cStringLiteral(values[name]))
+	}
+	return header.Bytes()
+}
+
+// cStringLiteral quotes s as a C string literal, the characters that are not
+// printable ASCII are written as octal escapes
+func cStringLiteral(s string) string {
+	var res strings.Builder
+	res.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			res.WriteByte('\\')
+			res.WriteByte(c)
+		case c == '?':
+			// Avoids trigraphs
+			res.WriteString(`\?`)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&res, "\\%03o", c)
+		default:
+			res.WriteByte(c)
+		}
+	}
+	res.WriteByte('"')
+	return res.String()
+}
+
+// decryptSecrets decrypts data encrypted by
+// `openssl enc -aes-256-cbc -pbkdf2 -salt` with the given passphrase: the
+// "Salted__" magic is followed by 8 bytes of salt and by the ciphertext, the
+// key and the IV are derived with PBKDF2-HMAC-SHA256 and 10000 iterations.
+func decryptSecrets(data []byte, passphrase string) ([]byte, error) {
+	const magic = "Salted__"
+	if len(data) < len(magic)+8 || string(data[:len(magic)]) != magic {
+		return nil, errors.New("not encrypted with openssl enc -salt")
+	}
+	salt := data[len(magic) : len(magic)+8]
+	ciphertext := data[len(magic)+8:]
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted data length")
+	}
+
+	keyIV := pbkdf2.Key([]byte(passphrase), salt, 10000, 32+aes.BlockSize, sha256.New)
+	block, err := aes.NewCipher(keyIV[:32])
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, keyIV[32:]).CryptBlocks(plaintext, ciphertext)
+
+	// A wrong passphrase gives an invalid PKCS#7 padding most of the times
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, errors.New("wrong passphrase")
+	}
+	for _, b := range plaintext[len(plaintext)-padding:] {
+		if int(b) != padding {
+			return nil, errors.New("wrong passphrase")
+		}
+	}
+	return plaintext[:len(plaintext)-padding], nil
+}
@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketches
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecrets(t *testing.T) {
+	sketchPath := paths.New("testdata", "SketchWithSecrets")
+	project, err := LoadProjectFile(sketchPath)
+	require.NoError(t, err)
+	require.NotNil(t, project.Secrets)
+
+	env := map[string]string{}
+	getenv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	// The passphrase is required
+	_, err = project.Secrets.Values(sketchPath, getenv)
+	require.EqualError(t, err, "the ARDUINO_SECRETS_KEY environment variable is required to decrypt secrets.yaml.enc")
+
+	env["ARDUINO_SECRETS_KEY"] = "wrong"
+	_, err = project.Secrets.Values(sketchPath, getenv)
+	require.EqualError(t, err, "decrypting secrets.yaml.enc: wrong passphrase")
+
+	env["ARDUINO_SECRETS_KEY"] = "hunter2"
+	values, err := project.Secrets.Values(sketchPath, getenv)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"SECRET_SSID": `my "network"`, "SECRET_PASS": "from the file"}, values)
+
+	// The environment takes precedence over the file
+	env["WIFI_PASSWORD"] = "from\tthe env?"
+	values, err = project.Secrets.Values(sketchPath, getenv)
+	require.NoError(t, err)
+	require.Equal(t, "from\tthe env?", values["SECRET_PASS"])
+
+	require.Equal(t,
+		"// Generated from the secrets of sketch.yaml, do not edit\n"+
+			"#pragma once\n\n"+
+			"#define SECRET_PASS \"from\\011the env\\?\"\n"+
+			"#define SECRET_SSID \"my \\\"network\\\"\"\n",
+		string(SecretsHeader(values)))
+
+	// The environment variables must be set when there's no file
+	secrets := &Secrets{Env: map[string]string{"SECRET_TOKEN": "API_TOKEN"}}
+	_, err = secrets.Values(sketchPath, getenv)
+	require.EqualError(t, err, "secret SECRET_TOKEN: the environment variable API_TOKEN is not set")
+
+	secrets = &Secrets{Env: map[string]string{"SECRET-TOKEN": "WIFI_PASSWORD"}}
+	_, err = secrets.Values(sketchPath, getenv)
+	require.EqualError(t, err, "invalid secret name 'SECRET-TOKEN': it must be a valid C identifier")
+}
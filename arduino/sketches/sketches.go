@@ -18,6 +18,7 @@ package sketches
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
@@ -39,7 +40,40 @@ type Metadata struct {
 type BoardMetadata struct {
 	Fqbn string `json:"fqbn,required"`
 	Name string `json:"name,omitempty"`
-	Port string `json:"port,omitepty"`
+	Port string `json:"port,omitempty"`
+	// SerialNumber is the serial number of the attached board, used to find
+	// its port even if the OS assigns it a different name
+	SerialNumber string `json:"serial_number,omitempty"`
+}
+
+// PortAddress returns the address of the serial port attached to the sketch,
+// stripping the "serial://" scheme used to store it (e.g. "serial:///dev/ttyACM0"
+// gives "/dev/ttyACM0"). An empty string is returned if no serial port is attached.
+func (b *BoardMetadata) PortAddress() (string, error) {
+	if b.Port == "" {
+		return "", nil
+	}
+	deviceURI, err := url.Parse(b.Port)
+	if err != nil {
+		return "", fmt.Errorf("invalid Device URL format: %s", err)
+	}
+	if deviceURI.Scheme != "serial" && deviceURI.Scheme != "tty" {
+		return "", nil
+	}
+	// to support both cases:
+	// serial:///dev/ttyACM2 parsing gives: deviceURI.Host = ""      and deviceURI.Path = /dev/ttyACM2
+	// serial://COM3 parsing gives:         deviceURI.Host = "COM3"  and deviceURI.Path = ""
+	return deviceURI.Host + deviceURI.Path, nil
+}
+
+// PortSelector returns the port to use for the attached board: the
+// "serial://<serial number>" selector if the serial number of the board is
+// known, the address of the attached port otherwise (see PortAddress).
+func (b *BoardMetadata) PortSelector() (string, error) {
+	if b.SerialNumber != "" {
+		return "serial://" + b.SerialNumber, nil
+	}
+	return b.PortAddress()
 }
 
 // NewSketchFromPath loads a sketch from the specified path
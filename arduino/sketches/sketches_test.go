@@ -43,3 +43,69 @@ func TestSketchLoadingFromFolderOrMainFile(t *testing.T) {
 		require.True(t, sk.FullPath.EquivalentTo(skFolder))
 	}
 }
+
+func TestBoardMetadataPortAddress(t *testing.T) {
+	for port, address := range map[string]string{
+		"":                      "",
+		"serial:///dev/ttyACM0": "/dev/ttyACM0",
+		"serial://COM3":         "COM3",
+		"tty:///dev/ttyUSB1":    "/dev/ttyUSB1",
+		"tcp://192.168.1.5:80":  "",
+	} {
+		meta := &BoardMetadata{Port: port}
+		res, err := meta.PortAddress()
+		require.NoError(t, err)
+		require.Equal(t, address, res, "port %s", port)
+	}
+
+	_, err := (&BoardMetadata{Port: "serial://%zz"}).PortAddress()
+	require.Error(t, err)
+}
+
+func TestBoardMetadataPortSelector(t *testing.T) {
+	meta := &BoardMetadata{Port: "serial:///dev/ttyACM0"}
+	res, err := meta.PortSelector()
+	require.NoError(t, err)
+	require.Equal(t, "/dev/ttyACM0", res)
+
+	meta.SerialNumber = "75830333238351806212"
+	res, err = meta.PortSelector()
+	require.NoError(t, err)
+	require.Equal(t, "serial://75830333238351806212", res)
+}
+
+func TestLoadProjectFile(t *testing.T) {
+	project, err := LoadProjectFile(paths.New("testdata/Sketch1"))
+	require.NoError(t, err)
+	require.Nil(t, project)
+
+	project, err = LoadProjectFile(paths.New("testdata/SketchWithProfiles"))
+	require.NoError(t, err)
+	require.NotNil(t, project)
+
+	profile, err := project.GetProfile("")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr:uno", profile.Fqbn)
+	require.Equal(t, "arduino:avr@1.8.3", profile.Platform)
+	require.Equal(t, []string{"Servo@1.1.6", "Arduino_JSON@0.1.0"}, profile.Libraries)
+	require.Equal(t, []string{"-DDEBUG_LEVEL=0"}, profile.ExtraFlags)
+
+	profile, err = project.GetProfile("debug")
+	require.NoError(t, err)
+	require.Equal(t, []string{"build.extra_flags=-DDEBUG_LEVEL=3"}, profile.BuildProperties)
+
+	_, err = project.GetProfile("missing")
+	require.EqualError(t, err, "profile 'missing' not found, available profiles: debug, release")
+}
+
+func TestParsePinnedReference(t *testing.T) {
+	name, version, err := ParsePinnedReference("arduino:avr@1.8.3")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr", name)
+	require.Equal(t, "1.8.3", version)
+
+	for _, ref := range []string{"arduino:avr", "@1.8.3", "Servo@"} {
+		_, _, err := ParsePinnedReference(ref)
+		require.Error(t, err, ref)
+	}
+}
@@ -0,0 +1,4 @@
+#include "arduino_secrets.h"
+
+void setup() {}
+void loop() {}
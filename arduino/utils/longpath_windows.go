@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// maxDirPath is the maximum length of a path that the Windows APIs (and the
+// tools that use them without the extended-length prefix) can handle: it's
+// MAX_PATH minus the room for a 8.3 file name.
+const maxDirPath = 248
+
+// ExtendedLengthPath returns the absolute, extended-length form of path
+// (`\\?\C:\...` or `\\?\UNC\server\share\...`) that lifts the MAX_PATH limit
+// of the Windows APIs.
+func ExtendedLengthPath(path string) string {
+	if strings.HasPrefix(path, `\\?\`) || strings.HasPrefix(path, `\\.\`) {
+		return path
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	if strings.HasPrefix(abs, `\\`) {
+		return `\\?\UNC\` + abs[2:]
+	}
+	return `\\?\` + abs
+}
+
+// IsLongPath returns true if path is too long to be handled without the
+// extended-length prefix.
+func IsLongPath(path string) bool {
+	return len(path) >= maxDirPath
+}
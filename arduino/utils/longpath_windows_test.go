@@ -0,0 +1,36 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedLengthPath(t *testing.T) {
+	require.Equal(t, `\\?\C:\Users\me\Arduino`, ExtendedLengthPath(`C:\Users\me\Arduino`))
+	require.Equal(t, `\\?\C:\Users\me`, ExtendedLengthPath(`C:\Users\me\Arduino\..`))
+	require.Equal(t, `\\?\UNC\server\share\Arduino`, ExtendedLengthPath(`\\server\share\Arduino`))
+	require.Equal(t, `\\?\C:\Users`, ExtendedLengthPath(`\\?\C:\Users`))
+	require.True(t, strings.HasPrefix(ExtendedLengthPath(`relative\path`), `\\?\`))
+}
+
+func TestIsLongPath(t *testing.T) {
+	require.False(t, IsLongPath(`C:\Users\me\Arduino`))
+	require.True(t, IsLongPath(`C:\`+strings.Repeat(`a\`, 130)))
+}
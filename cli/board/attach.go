@@ -34,20 +34,27 @@ func initAttachCommand() *cobra.Command {
 	attachCommand := &cobra.Command{
 		Use:   "attach <port>|<FQBN> [sketchPath]",
 		Short: "Attaches a sketch to a board.",
-		Long:  "Attaches a sketch to a board.",
+		Long: "Attaches a sketch to a board.\n" +
+			"The board and the port are saved in the sketch.json file of the sketch, so that\n" +
+			"the --fqbn and --port flags can be omitted by the compile, upload and debug commands.\n" +
+			"If a port is given the connected board is detected automatically.",
 		Example: "  " + os.Args[0] + " board attach serial:///dev/ttyACM0\n" +
 			"  " + os.Args[0] + " board attach serial:///dev/ttyACM0 HelloWorld\n" +
-			"  " + os.Args[0] + " board attach arduino:samd:mkr1000",
+			"  " + os.Args[0] + " board attach arduino:samd:mkr1000\n" +
+			"  " + os.Args[0] + " board attach arduino:samd:mkr1000 -p /dev/ttyACM0",
 		Args: cobra.RangeArgs(1, 2),
 		Run:  runAttachCommand,
 	}
 	attachCommand.Flags().StringVar(&attachFlags.searchTimeout, "timeout", "5s",
 		"The connected devices search timeout, raise it if your board doesn't show up (e.g. to 10s).")
+	attachCommand.Flags().StringVarP(&attachFlags.port, "port", "p", "",
+		"The port to attach together with the FQBN, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>")
 	return attachCommand
 }
 
 var attachFlags struct {
 	searchTimeout string // Expressed in a parsable duration, is the timeout for the list and attach commands.
+	port          string // The port to attach when the board is given as FQBN.
 }
 
 func runAttachCommand(cmd *cobra.Command, args []string) {
@@ -69,6 +76,7 @@ func runAttachCommand(cmd *cobra.Command, args []string) {
 		BoardUri:      args[0],
 		SketchPath:    path.String(),
 		SearchTimeout: attachFlags.searchTimeout,
+		Port:          attachFlags.port,
 	}, output.TaskProgress()); err != nil {
 		feedback.Errorf("Attach board error: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
@@ -57,7 +57,7 @@ func initDetailsCommand() *cobra.Command {
 func runDetailsCommand(cmd *cobra.Command, args []string) {
 	inst, err := instance.CreateInstance()
 	if err != nil {
-		feedback.Errorf(tr("Error getting board details: %v"), err)
+		feedback.Errorf("Error getting board details: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
@@ -72,8 +72,8 @@ func runDetailsCommand(cmd *cobra.Command, args []string) {
 	})
 
 	if err != nil {
-		feedback.Errorf(tr("Error getting board details: %v"), err)
-		os.Exit(errorcodes.ErrGeneric)
+		feedback.Errorf("Error getting board details: %v", err)
+		os.Exit(errorcodes.FromError(err))
 	}
 
 	feedback.PrintResult(detailsResult{details: res})
@@ -94,9 +94,9 @@ func (dr detailsResult) String() string {
 
 	if listProgrammers {
 		t := table.New()
-		t.AddRow(tr("Id"), tr("Programmer name"))
+		t.AddRow(tr("Id"), tr("Programmer name"), tr("Tool"))
 		for _, programmer := range details.Programmers {
-			t.AddRow(programmer.GetId(), programmer.GetName())
+			t.AddRow(programmer.GetId(), programmer.GetName(), programmer.GetTool())
 		}
 		return t.Render()
 	}
@@ -123,6 +123,9 @@ func (dr detailsResult) String() string {
 	t.SetColumnWidthMode(1, table.Average)
 	t.AddRow(tr("Board name:"), details.Name)
 	t.AddRow("FQBN:", details.Fqbn)
+	if details.NormalizedFqbn != details.Fqbn {
+		t.AddRow(tr("Configured FQBN:"), details.NormalizedFqbn)
+	}
 	addIfNotEmpty(tr("Board version:"), details.Version)
 
 	if details.Official {
@@ -131,6 +134,12 @@ func (dr detailsResult) String() string {
 			table.NewCell("✔", color.New(color.FgGreen)))
 	}
 
+	if details.DebuggingSupported {
+		t.AddRow() // get some space from above
+		t.AddRow(tr("Debugging supported:"),
+			table.NewCell("✔", color.New(color.FgGreen)))
+	}
+
 	for i, idp := range details.IdentificationPref {
 		if i == 0 {
 			t.AddRow() // get some space from above
@@ -16,6 +16,7 @@
 package board
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -65,7 +66,7 @@ func runListCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	ports, err := board.List(inst.GetId())
+	ports, err := board.List(context.Background(), inst.GetId())
 	if err != nil {
 		feedback.Errorf("Error detecting boards: %v", err)
 		os.Exit(errorcodes.ErrNetwork)
@@ -96,13 +97,14 @@ func (dr result) String() string {
 	})
 
 	t := table.New()
-	t.SetHeader("Port", "Type", "Board Name", "FQBN", "Core")
+	t.SetHeader("Port", "Type", "Board Name", "FQBN", "Core", "Serial Number")
 	for _, port := range dr.ports {
 		address := port.GetProtocol() + "://" + port.GetAddress()
 		if port.GetProtocol() == "serial" {
 			address = port.GetAddress()
 		}
 		protocol := port.GetProtocolLabel()
+		serialNumber := port.GetSerialNumber()
 		if boards := port.GetBoards(); len(boards) > 0 {
 			sort.Slice(boards, func(i, j int) bool {
 				x, y := boards[i], boards[j]
@@ -119,17 +121,18 @@ func (dr result) String() string {
 					coreName = fmt.Sprintf("%s:%s", fqbn.Package, fqbn.PlatformArch)
 				}
 
-				t.AddRow(address, protocol, board, fqbn, coreName)
+				t.AddRow(address, protocol, board, fqbn, coreName, serialNumber)
 
-				// reset address and protocol, we only show them on the first row
+				// reset address, protocol and serial number, we only show them on the first row
 				address = ""
 				protocol = ""
+				serialNumber = ""
 			}
 		} else {
 			board := "Unknown"
 			fqbn := ""
 			coreName := ""
-			t.AddRow(address, protocol, board, fqbn, coreName)
+			t.AddRow(address, protocol, board, fqbn, coreName, serialNumber)
 		}
 	}
 	return t.Render()
@@ -38,16 +38,24 @@ func initListAllCommand() *cobra.Command {
 			"for a specific board if you specify the board name",
 		Example: "" +
 			"  " + os.Args[0] + " board listall\n" +
-			"  " + os.Args[0] + " board listall zero",
+			"  " + os.Args[0] + " board listall zero\n" +
+			"  " + os.Args[0] + " board listall --fuzzy mkrwf",
 		Args: cobra.ArbitraryArgs,
 		Run:  runListAllCommand,
 	}
 	listAllCommand.Flags().BoolVarP(&showHiddenBoard, "show-hidden", "a", false, "Show also boards marked as 'hidden' in the platform")
+	listAllCommand.Flags().BoolVar(&listAllFlags.fuzzy, "fuzzy", false, "Match the board names approximately, the best matches are listed first")
+	listAllCommand.Flags().BoolVar(&listAllFlags.configOptions, "config-options", false, "Include the configuration options of each board in the JSON output")
 	return listAllCommand
 }
 
 var showHiddenBoard bool
 
+var listAllFlags struct {
+	fuzzy         bool
+	configOptions bool
+}
+
 // runListAllCommand list all installed boards
 func runListAllCommand(cmd *cobra.Command, args []string) {
 	inst, err := instance.CreateInstance()
@@ -57,22 +65,26 @@ func runListAllCommand(cmd *cobra.Command, args []string) {
 	}
 
 	list, err := board.ListAll(context.Background(), &rpc.BoardListAllReq{
-		Instance:            inst,
-		SearchArgs:          args,
-		IncludeHiddenBoards: showHiddenBoard,
+		Instance:             inst,
+		SearchArgs:           args,
+		IncludeHiddenBoards:  showHiddenBoard,
+		Fuzzy:                listAllFlags.fuzzy,
+		IncludeConfigOptions: listAllFlags.configOptions,
 	})
 	if err != nil {
 		feedback.Errorf("Error listing boards: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	feedback.PrintResult(resultAll{list})
+	feedback.PrintResult(resultAll{list, listAllFlags.fuzzy})
 }
 
 // output from this command requires special formatting, let's create a dedicated
 // feedback.Result implementation
 type resultAll struct {
 	list *rpc.BoardListAllResp
+	// sorted is true if the boards are already sorted by relevance
+	sorted bool
 }
 
 func (dr resultAll) Data() interface{} {
@@ -80,9 +92,11 @@ func (dr resultAll) Data() interface{} {
 }
 
 func (dr resultAll) String() string {
-	sort.Slice(dr.list.Boards, func(i, j int) bool {
-		return dr.list.Boards[i].GetName() < dr.list.Boards[j].GetName()
-	})
+	if !dr.sorted {
+		sort.Slice(dr.list.Boards, func(i, j int) bool {
+			return dr.list.Boards[i].GetName() < dr.list.Boards[j].GetName()
+		})
+	}
 
 	t := table.New()
 	t.SetHeader("Board Name", "FQBN", "")
@@ -0,0 +1,110 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/board"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func initSetupCommand() *cobra.Command {
+	setupCommand := &cobra.Command{
+		Use:   "setup <FQBN>",
+		Short: tr("Sets up the system to use a board."),
+		Long:  tr("Sets up the system to use a board: installs the udev rules on Linux, so that the serial port of the board can be used without root privileges, or shows where to get the drivers on Windows. The rules and the drivers are declared by the platform of the board."),
+		Example: "  " + os.Args[0] + " board setup arduino:mbed:nano33ble\n" +
+			"  " + os.Args[0] + " board setup arduino:mbed:nano33ble --dry-run",
+		Args: cobra.ExactArgs(1),
+		Run:  runSetupCommand,
+	}
+	setupCommand.Flags().BoolVar(&setupFlags.dryRun, "dry-run", false, tr("Show what would be installed without installing it."))
+	return setupCommand
+}
+
+var setupFlags struct {
+	dryRun bool
+}
+
+func runSetupCommand(cmd *cobra.Command, args []string) {
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error setting up the board: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res, err := board.Setup(context.Background(), &rpc.BoardSetupReq{
+		Instance: inst,
+		Fqbn:     args[0],
+		DryRun:   setupFlags.dryRun,
+	})
+	if err != nil {
+		feedback.Errorf("Error setting up the board: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	feedback.PrintResult(setupResult{fqbn: args[0], actions: res.GetActions(), dryRun: setupFlags.dryRun})
+}
+
+type setupResult struct {
+	fqbn    string
+	actions []*rpc.BoardSetupAction
+	dryRun  bool
+}
+
+func (sr setupResult) Data() interface{} {
+	return sr.actions
+}
+
+func (sr setupResult) String() string {
+	if len(sr.actions) == 0 {
+		return tr("The board %s doesn't need any setup on this system.", sr.fqbn)
+	}
+
+	t := table.New()
+	green := color.New(color.FgGreen)
+	for _, action := range sr.actions {
+		status := table.NewCell("✔", green)
+		if !action.GetDone() {
+			status = table.NewCell("", nil)
+		}
+		t.AddRow(status, action.GetDescription())
+		if action.GetDestination() != "" {
+			t.AddRow("", "  "+action.GetSource()+" → "+action.GetDestination())
+		} else if action.GetSource() != "" {
+			t.AddRow("", "  "+action.GetSource())
+		}
+		if action.GetCommand() != "" && !action.GetDone() {
+			t.AddRow("", "  "+tr("Command:")+" "+action.GetCommand())
+		}
+		if action.GetUrl() != "" {
+			t.AddRow("", "  URL: "+action.GetUrl())
+		}
+	}
+	res := t.Render()
+	if sr.dryRun {
+		res = tr("The following actions would be done to set up %s:", sr.fqbn) + "\n" + res
+	}
+	return res
+}
@@ -0,0 +1,37 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package build
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCommand created a new `build` command
+func NewCommand() *cobra.Command {
+	buildCommand := &cobra.Command{
+		Use:   "build",
+		Short: "Build diagnostic commands.",
+		Long:  "Commands to diagnose the builds, using the log recorded with compile --log-build.",
+		Example: "  " + os.Args[0] + " compile --log-build build.log -b arduino:avr:uno Blink\n" +
+			"  " + os.Args[0] + " build replay build.log 12",
+	}
+
+	buildCommand.AddCommand(initReplayCommand())
+
+	return buildCommand
+}
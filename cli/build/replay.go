@@ -0,0 +1,200 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package build
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/interrupt"
+	"github.com/arduino/arduino-cli/executils"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+)
+
+func initReplayCommand() *cobra.Command {
+	replayCommand := &cobra.Command{
+		Use:   "replay LOG_FILE [ID]",
+		Short: "Runs again a command recorded in a build log.",
+		Long: "Runs again the command with the given ID recorded with compile --log-build, in the same working directory and with the same environment. " +
+			"Without ID the recorded commands are listed.",
+		Example: "  " + os.Args[0] + " build replay build.log\n" +
+			"  " + os.Args[0] + " build replay build.log 12",
+		Args: cobra.RangeArgs(1, 2),
+		Run:  runReplayCommand,
+	}
+	return replayCommand
+}
+
+func runReplayCommand(cmd *cobra.Command, args []string) {
+	entries, err := builder.LoadBuildLog(paths.New(args[0]))
+	if err != nil {
+		feedback.Errorf("Error reading build log: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if len(args) == 1 {
+		feedback.PrintResult(&buildLogResult{Entries: entries})
+		return
+	}
+
+	id, err := strconv.Atoi(args[1])
+	if err != nil {
+		feedback.Errorf("Invalid command ID '%s': %v", args[1], err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	var entry *builder.BuildLogEntry
+	for _, e := range entries {
+		if e.ID == id {
+			entry = e
+		}
+	}
+	if entry == nil {
+		feedback.Errorf("Command %d not found in %s", id, args[0])
+		os.Exit(errorcodes.ErrNotFound)
+	}
+
+	exitCode, duration, streams, err := replay(entry)
+	feedback.PrintResult(&replayResult{
+		OutputStreamsResult: streams,
+		ID:                  entry.ID,
+		ExitCode:            exitCode,
+		RecordedExitCode:    entry.ExitCode,
+		DurationMs:          duration.Milliseconds(),
+		RecordedDurationMs:  entry.DurationMs,
+	})
+	if err != nil {
+		feedback.Errorf("Error running command %d: %v", entry.ID, err)
+		os.Exit(errorcodes.FromError(err))
+	}
+	if exitCode != 0 {
+		os.Exit(errorcodes.ErrToolFailure)
+	}
+}
+
+// replay runs the command of entry, the returned error is set only if the
+// command couldn't be run
+func replay(entry *builder.BuildLogEntry) (int, time.Duration, *feedback.OutputStreamsResult, error) {
+	outStream, errStream, streams := feedback.OutputStreams()
+	if len(entry.Arguments) == 0 {
+		return -1, 0, streams(), fmt.Errorf("no command recorded")
+	}
+	ctx, stop := interrupt.Context()
+	defer stop()
+	process, err := executils.NewProcessWithContext(ctx, entry.Arguments...)
+	if err != nil {
+		return -1, 0, streams(), err
+	}
+	process.SetDir(entry.Directory)
+	process.SetEnvironment(replayEnv(entry.Env))
+	process.RedirectStdoutTo(outStream)
+	process.RedirectStderrTo(errStream)
+
+	fmt.Fprintln(outStream, commandLine(entry.Arguments))
+	start := time.Now()
+	if err := process.Start(); err != nil {
+		return -1, 0, streams(), err
+	}
+	err = process.Wait()
+	duration := time.Since(start)
+	if ctx.Err() != nil {
+		return -1, duration, streams(), ctx.Err()
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return exitErr.ExitCode(), duration, streams(), nil
+	}
+	if err != nil {
+		return -1, duration, streams(), err
+	}
+	return 0, duration, streams(), nil
+}
+
+// replayEnv returns the environment of the CLI with the variables recorded by
+// the builder, the redacted ones are taken from the current environment
+func replayEnv(recorded []string) []string {
+	env := os.Environ()
+	for _, variable := range recorded {
+		if strings.HasSuffix(variable, "="+builder.BuildLogRedacted) {
+			continue
+		}
+		env = append(env, variable)
+	}
+	return env
+}
+
+// commandLine returns args as a command line that can be copied in a shell
+func commandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"'") {
+			arg = strconv.Quote(arg)
+		}
+		quoted[i] = arg
+	}
+	return strings.Join(quoted, " ")
+}
+
+type buildLogResult struct {
+	Entries []*builder.BuildLogEntry
+}
+
+func (r *buildLogResult) Data() interface{} {
+	return r.Entries
+}
+
+func (r *buildLogResult) String() string {
+	if len(r.Entries) == 0 {
+		return "No commands recorded."
+	}
+	t := table.New()
+	t.SetHeader("ID", "Recipe", "Exit code", "Duration", "Command")
+	for _, entry := range r.Entries {
+		command := ""
+		if len(entry.Arguments) > 0 {
+			command = paths.New(entry.Arguments[0]).Base()
+		}
+		duration := time.Duration(entry.DurationMs) * time.Millisecond
+		t.AddRow(strconv.Itoa(entry.ID), entry.Recipe, strconv.Itoa(entry.ExitCode), duration.String(), command)
+	}
+	return t.Render()
+}
+
+type replayResult struct {
+	*feedback.OutputStreamsResult
+	ID                 int   `json:"id"`
+	ExitCode           int   `json:"exit_code"`
+	RecordedExitCode   int   `json:"recorded_exit_code"`
+	DurationMs         int64 `json:"duration_ms"`
+	RecordedDurationMs int64 `json:"recorded_duration_ms"`
+}
+
+func (r *replayResult) Data() interface{} {
+	return r
+}
+
+func (r *replayResult) String() string {
+	// The output of the command is already printed via os.Stdout/os.Stderr
+	duration := time.Duration(r.DurationMs) * time.Millisecond
+	recordedDuration := time.Duration(r.RecordedDurationMs) * time.Millisecond
+	return fmt.Sprintf("Exit code %d in %s (recorded: exit code %d in %s)", r.ExitCode, duration, r.RecordedExitCode, recordedDuration)
+}
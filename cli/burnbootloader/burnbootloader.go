@@ -22,6 +22,7 @@ import (
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/interrupt"
 	"github.com/arduino/arduino-cli/commands/upload"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/arduino/arduino-cli/table"
@@ -43,19 +44,20 @@ var (
 // NewCommand created a new `burn-bootloader` command
 func NewCommand() *cobra.Command {
 	burnBootloaderCommand := &cobra.Command{
-		Use:     "burn-bootloader",
-		Short:   "Upload the bootloader.",
-		Long:    "Upload the bootloader on the board using an external programmer.",
-		Example: "  " + os.Args[0] + " burn-bootloader -b arduino:avr:uno -P atmel-ice",
-		Args:    cobra.MaximumNArgs(1),
-		Run:     run,
+		Use:   "burn-bootloader",
+		Short: "Upload the bootloader.",
+		Long:  "Upload the bootloader on the board using an external programmer.",
+		Example: "  " + os.Args[0] + " burn-bootloader -b arduino:avr:uno -P atmel-ice\n" +
+			"  " + os.Args[0] + " burn-bootloader -b arduino:avr:uno -P list",
+		Args: cobra.MaximumNArgs(1),
+		Run:  run,
 	}
 
 	burnBootloaderCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
-	burnBootloaderCommand.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10 or /dev/ttyACM0")
+	burnBootloaderCommand.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>")
 	burnBootloaderCommand.Flags().BoolVarP(&verify, "verify", "t", false, "Verify uploaded binary after the upload.")
 	burnBootloaderCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, "Turns on verbose mode.")
-	burnBootloaderCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Use the specified programmer to upload.")
+	burnBootloaderCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Use the specified programmer to upload or 'list' to list supported programmers.")
 
 	return burnBootloaderCommand
 }
@@ -67,16 +69,34 @@ func run(command *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	if _, err := upload.BurnBootloader(context.Background(), &rpc.BurnBootloaderReq{
+	if programmer == "list" {
+		resp, err := upload.ListProgrammersAvailableForUpload(context.Background(), &rpc.ListProgrammersAvailableForUploadReq{
+			Instance: instance,
+			Fqbn:     fqbn,
+		})
+		if err != nil {
+			feedback.Errorf("Error listing programmers: %v", err)
+			os.Exit(errorcodes.FromError(err))
+		}
+		feedback.PrintResult(&programmersList{Programmers: resp.GetProgrammers()})
+		os.Exit(0)
+	}
+
+	outStream, errStream, burnStreams := feedback.OutputStreams()
+	ctx, stop := interrupt.Context()
+	defer stop()
+	_, err = upload.BurnBootloader(ctx, &rpc.BurnBootloaderReq{
 		Instance:   instance,
 		Fqbn:       fqbn,
 		Port:       port,
 		Verbose:    verbose,
 		Verify:     verify,
 		Programmer: programmer,
-	}, os.Stdout, os.Stderr); err != nil {
+	}, outStream, errStream)
+	feedback.PrintResult(&burnBootloaderResult{OutputStreamsResult: burnStreams(), Success: err == nil})
+	if err != nil {
 		feedback.Errorf("Error during Upload: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
 	}
 	os.Exit(0)
 }
@@ -106,9 +126,23 @@ func (p *programmersList) Data() interface{} {
 
 func (p *programmersList) String() string {
 	t := table.New()
-	t.SetHeader("ID", "Programmer Name", "Platform")
+	t.SetHeader("ID", "Programmer Name", "Tool", "Platform")
 	for _, prog := range p.Programmers {
-		t.AddRow(prog.GetId(), prog.GetName(), prog.GetPlatform())
+		t.AddRow(prog.GetId(), prog.GetName(), prog.GetTool(), prog.GetPlatform())
 	}
 	return t.Render()
 }
+
+type burnBootloaderResult struct {
+	*feedback.OutputStreamsResult
+	Success bool `json:"success"`
+}
+
+func (r *burnBootloaderResult) Data() interface{} {
+	return r
+}
+
+func (r *burnBootloaderResult) String() string {
+	// The output is already printed via os.Stdout/os.Stderr
+	return ""
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/cli/board"
+	"github.com/arduino/arduino-cli/cli/build"
 	"github.com/arduino/arduino-cli/cli/burnbootloader"
 	"github.com/arduino/arduino-cli/cli/cache"
 	"github.com/arduino/arduino-cli/cli/compile"
@@ -30,20 +31,25 @@ import (
 	"github.com/arduino/arduino-cli/cli/core"
 	"github.com/arduino/arduino-cli/cli/daemon"
 	"github.com/arduino/arduino-cli/cli/debug"
+	"github.com/arduino/arduino-cli/cli/env"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/generatedocs"
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/cli/lib"
+	"github.com/arduino/arduino-cli/cli/logging"
 	"github.com/arduino/arduino-cli/cli/outdated"
 	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/cli/selfupdate"
 	"github.com/arduino/arduino-cli/cli/sketch"
 	"github.com/arduino/arduino-cli/cli/update"
 	"github.com/arduino/arduino-cli/cli/upgrade"
 	"github.com/arduino/arduino-cli/cli/upload"
 	"github.com/arduino/arduino-cli/cli/version"
+	"github.com/arduino/arduino-cli/configuration"
 	"github.com/arduino/arduino-cli/i18n"
 	"github.com/arduino/arduino-cli/inventory"
+	"github.com/arduino/go-paths-helper"
 	"github.com/mattn/go-colorable"
 	"github.com/rifflock/lfshook"
 	"github.com/sirupsen/logrus"
@@ -73,6 +79,7 @@ func NewCommand() *cobra.Command {
 	arduinoCli.SetUsageTemplate(usageTemplate)
 
 	createCliCommandTree(arduinoCli)
+	localizeCommands(arduinoCli)
 
 	return arduinoCli
 }
@@ -80,15 +87,18 @@ func NewCommand() *cobra.Command {
 // this is here only for testing
 func createCliCommandTree(cmd *cobra.Command) {
 	cmd.AddCommand(board.NewCommand())
+	cmd.AddCommand(build.NewCommand())
 	cmd.AddCommand(cache.NewCommand())
 	cmd.AddCommand(compile.NewCommand())
 	cmd.AddCommand(completion.NewCommand())
 	cmd.AddCommand(config.NewCommand())
 	cmd.AddCommand(core.NewCommand())
 	cmd.AddCommand(daemon.NewCommand())
+	cmd.AddCommand(env.NewCommand())
 	cmd.AddCommand(generatedocs.NewCommand())
 	cmd.AddCommand(lib.NewCommand())
 	cmd.AddCommand(outdated.NewCommand())
+	cmd.AddCommand(selfupdate.NewCommand())
 	cmd.AddCommand(sketch.NewCommand())
 	cmd.AddCommand(update.NewCommand())
 	cmd.AddCommand(upgrade.NewCommand())
@@ -105,9 +115,11 @@ func createCliCommandTree(cmd *cobra.Command) {
 	cmd.PersistentFlags().String("log-format", "", "The output format for the logs, can be {text|json}.")
 	viper.BindPFlag("logging.format", cmd.PersistentFlags().Lookup("log-format"))
 	cmd.PersistentFlags().StringVar(&outputFormat, "format", "text", "The output format, can be {text|json}.")
-	cmd.PersistentFlags().StringVar(&configFile, "config-file", "", "The custom config file (if not specified the default will be used).")
+	cmd.PersistentFlags().StringVar(&configFile, "config-file", "", "A project config file layered on top of the global one, only its directories, additional URLs and build settings are used.")
 	cmd.PersistentFlags().StringSlice("additional-urls", []string{}, "Comma-separated list of additional URLs for the Boards Manager.")
 	viper.BindPFlag("board_manager.additional_urls", cmd.PersistentFlags().Lookup("additional-urls"))
+	cmd.PersistentFlags().Bool("offline", false, "Disable network access, use only the indexes and archives already downloaded.")
+	viper.BindPFlag("network.offline", cmd.PersistentFlags().Lookup("offline"))
 }
 
 // convert the string passed to the `--log-level` option to the corresponding
@@ -126,6 +138,16 @@ func toLogLevel(s string) (t logrus.Level, found bool) {
 	return
 }
 
+// commandName returns the name of the command without the name of the
+// executable, e.g. "core install"
+func commandName(cmd *cobra.Command) string {
+	name := strings.TrimSpace(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()))
+	if name == "" {
+		return cmd.Root().Name()
+	}
+	return name
+}
+
 func parseFormatString(arg string) (feedback.OutputFormat, bool) {
 	f, found := map[string]feedback.OutputFormat{
 		"json": feedback.JSON,
@@ -162,10 +184,18 @@ func preRun(cmd *cobra.Command, args []string) {
 		logrus.SetFormatter(&logrus.JSONFormatter{})
 	}
 
+	// tag the log entries with the command being run, the hook must be added
+	// before the one writing the log file
+	command := commandName(cmd)
+	logrus.AddHook(&logging.FieldsHook{Fields: logrus.Fields{"command": command}})
+
 	// should we log to file?
 	logFile := viper.GetString("logging.file")
 	if logFile != "" {
-		file, err := os.OpenFile(logFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		// the placeholder allows to use a different log file for each command
+		logFile = strings.ReplaceAll(logFile, "{command}", strings.ReplaceAll(command, " ", "_"))
+		maxSize := viper.GetInt64("logging.max_size") * 1024 * 1024
+		file, err := logging.OpenRotatingFile(paths.New(logFile), maxSize, viper.GetInt("logging.max_backups"))
 		if err != nil {
 			fmt.Printf("Unable to open file for logging: %s", logFile)
 			os.Exit(errorcodes.ErrBadCall)
@@ -214,6 +244,9 @@ func preRun(cmd *cobra.Command, args []string) {
 	} else {
 		logrus.Info("Config file not found, using default values")
 	}
+	if projectConfigFile := configuration.ProjectConfigFile(); projectConfigFile != "" {
+		logrus.Infof("Using project config file: %s", projectConfigFile)
+	}
 
 	logrus.Info(globals.VersionInfo.Application + " version " + globals.VersionInfo.VersionString)
 
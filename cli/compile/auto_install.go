@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// askInstallMissingLibraries is used when a build fails in an interactive
+// terminal: for each header of the sketch that can't be found and that is
+// provided by exactly one library of the index, it asks the user to install
+// the library. It returns the libraries installed, the build can be tried
+// again if there is any.
+func askInstallMissingLibraries(req *rpc.CompileReq) []string {
+	if !configuration.IsInteractive || feedback.GetFormat() != feedback.Text {
+		return nil
+	}
+	includes, err := compile.SketchIncludes(context.Background(), &rpc.SketchIncludesReq{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+		Libraries:       req.GetLibraries(),
+		Library:         req.GetLibrary(),
+		Profile:         req.GetProfile(),
+	}, ioutil.Discard)
+	if err != nil {
+		return nil
+	}
+
+	installed := []string{}
+	asked := map[string]bool{}
+	stdin := bufio.NewReader(os.Stdin)
+	for _, unresolved := range includes.GetUnresolvedIncludes() {
+		suggested := unresolved.GetSuggestedLibraries()
+		if len(suggested) != 1 || asked[suggested[0]] {
+			continue
+		}
+		name := suggested[0]
+		asked[name] = true
+
+		fmt.Printf("%s is provided by the library %s, install it? [y/N] ", unresolved.GetHeader(), name)
+		answer, _ := stdin.ReadString('\n')
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer != "y" && answer != "yes" {
+			continue
+		}
+		err := lib.LibraryInstall(context.Background(), &rpc.LibraryInstallReq{
+			Instance: req.GetInstance(),
+			Name:     name,
+		}, output.ProgressBar(), output.TaskProgress())
+		if err != nil {
+			feedback.Errorf("Error installing %s: %v", name, err)
+			os.Exit(errorcodes.FromError(err))
+		}
+		release := name
+		if lm := commands.GetLibraryManager(req.GetInstance().GetId()); lm != nil {
+			if indexLib, ok := lm.Index.Libraries[name]; ok && indexLib.Latest != nil {
+				release = indexLib.Latest.String()
+			}
+		}
+		installed = append(installed, release)
+	}
+	return installed
+}
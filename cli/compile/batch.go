@@ -0,0 +1,280 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/globals"
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+)
+
+var tr = i18n.Tr
+
+// batchJob is a sketch to compile for a board in batch mode
+type batchJob struct {
+	name   string
+	sketch *paths.Path
+	fqbn   string
+}
+
+// checkBatchFlags returns an error if a flag that only makes sense when a
+// single sketch is compiled is used together with --examples-of or --sketches-in
+func checkBatchFlags(args []string) error {
+	if examplesOf != "" && sketchesIn != "" {
+		return fmt.Errorf("--examples-of and --sketches-in can't be used together")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("a sketch path can't be given with --examples-of or --sketches-in")
+	}
+	if len(fqbns) == 0 {
+		return fmt.Errorf("at least one board must be given with --fqbn when compiling in batch")
+	}
+	if uploadAfterCompile || showProperties || preprocess || compilationDatabaseOnly ||
+		exportDir != "" || artifactsName != "" || generateMapFile || generateDisassembly || buildPath != "" || profile != "" || autoInstallDeps {
+		return fmt.Errorf("--upload, --show-properties, --preprocess, --only-compilation-database, --output-dir, --artifacts-name, --map-file, --disassemble, --build-path, --profile and --auto-install-deps can't be used when compiling in batch")
+	}
+	return nil
+}
+
+// batchJobs returns the sketches to compile for each board: the examples of
+// the library used for the board or the sketches found in a directory.
+func batchJobs(inst *rpc.Instance) ([]*batchJob, error) {
+	batch := []*batchJob{}
+	if sketchesIn != "" {
+		root, err := paths.New(sketchesIn).Abs()
+		if err != nil {
+			return nil, err
+		}
+		sketches, err := findSketches(root)
+		if err != nil {
+			return nil, fmt.Errorf("searching sketches in %s: %s", root, err)
+		}
+		if len(sketches) == 0 {
+			return nil, fmt.Errorf("no sketches found in %s", root)
+		}
+		for _, sketch := range sketches {
+			for _, fqbn := range fqbns {
+				batch = append(batch, &batchJob{name: relativeName(root, sketch), sketch: sketch, fqbn: fqbn})
+			}
+		}
+		return batch, nil
+	}
+
+	// the library providing the examples may be a different one for each board,
+	// e.g. when it's bundled with the platforms
+	for _, fqbn := range fqbns {
+		res, err := lib.LibraryExamples(context.Background(), &rpc.LibraryExamplesReq{
+			Instance: inst,
+			Name:     examplesOf,
+			Fqbn:     fqbn,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.GetLibraries()) == 0 {
+			return nil, fmt.Errorf("no examples found for library %s and board %s", examplesOf, fqbn)
+		}
+		for _, example := range res.GetLibraries()[0].GetExamples() {
+			batch = append(batch, &batchJob{name: example.GetRelativePath(), sketch: paths.New(example.GetPath()), fqbn: fqbn})
+		}
+	}
+	return batch, nil
+}
+
+// findSketches returns the sketch folders found in root and in its
+// subfolders, the subfolders of a sketch are not searched
+func findSketches(root *paths.Path) (paths.PathList, error) {
+	res := paths.NewPathList()
+	if isSketch(root) {
+		res.Add(root)
+		return res, nil
+	}
+	files, err := root.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	files.Sort()
+	for _, file := range files {
+		if !file.IsDir() || strings.HasPrefix(file.Base(), ".") {
+			continue
+		}
+		sketches, err := findSketches(file)
+		if err != nil {
+			return nil, err
+		}
+		res.AddAll(sketches)
+	}
+	return res, nil
+}
+
+func isSketch(dir *paths.Path) bool {
+	for ext := range globals.MainFileValidExtensions {
+		if mainFile := dir.Join(dir.Base() + ext); mainFile.Exist() && mainFile.IsNotDir() {
+			return true
+		}
+	}
+	return false
+}
+
+func relativeName(root, sketch *paths.Path) string {
+	rel, err := filepath.Rel(root.String(), sketch.String())
+	if err != nil || rel == "." {
+		return sketch.Base()
+	}
+	return filepath.ToSlash(rel)
+}
+
+// runBatch compiles every sketch for every board, a line is printed as soon
+// as each build is done and a summary table at the end. It exits with an
+// error if any of the builds failed.
+func runBatch(inst *rpc.Instance, sizeReportSymbols int32) {
+	batch, err := batchJobs(inst)
+	if err != nil {
+		feedback.Errorf("Error compiling in batch: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res := &batchResult{Builds: []*batchBuildResult{}}
+	for _, job := range batch {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		compileResp, err := compile.Compile(context.Background(), &rpc.CompileReq{
+			Instance:          inst,
+			Fqbn:              job.fqbn,
+			SketchPath:        job.sketch.String(),
+			BuildCachePath:    buildCachePath,
+			BuildProperties:   append(buildProperties, buildProperty...),
+			Warnings:          warnings,
+			Verbose:           verbose,
+			Quiet:             quiet,
+			VidPid:            vidPid,
+			DryRun:            true,
+			Libraries:         libraries,
+			Library:           library,
+			OptimizeForDebug:  optimizeForDebug,
+			Clean:             clean,
+			ExtraFlags:        extraFlags,
+			BuildMetadata:     buildMetadata,
+			Partitions:        partitions,
+			LinkerScript:      linkerScript,
+			Jobs:              jobs,
+			SizeReportSymbols: sizeReportSymbols,
+		}, stdout, stderr, false)
+
+		build := &batchBuildResult{
+			Sketch:      job.name,
+			SketchPath:  job.sketch.String(),
+			Fqbn:        job.fqbn,
+			Success:     err == nil,
+			CompilerOut: stdout.String(),
+			CompilerErr: stderr.String(),
+		}
+		if err != nil {
+			build.Error = err.Error()
+		}
+		if compileResp != nil {
+			build.Diagnostics = compileResp.GetDiagnostics()
+			build.SizeReport = compileResp.GetSizeReport()
+		}
+		res.Builds = append(res.Builds, build)
+
+		if feedback.GetFormat() == feedback.Text {
+			if build.Success {
+				feedback.Printf("%s %s (%s)", color.GreenString("PASS"), build.Sketch, build.Fqbn)
+			} else {
+				feedback.Printf("%s %s (%s)\n%s", color.RedString("FAIL"), build.Sketch, build.Fqbn, build.CompilerErr)
+			}
+		}
+	}
+
+	feedback.PrintResult(res)
+	if res.Failed() > 0 {
+		feedback.Errorf("%d of %d builds failed", res.Failed(), len(res.Builds))
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type batchBuildResult struct {
+	Sketch      string                    `json:"sketch"`
+	SketchPath  string                    `json:"sketch_path"`
+	Fqbn        string                    `json:"fqbn"`
+	Success     bool                      `json:"success"`
+	Error       string                    `json:"error,omitempty"`
+	CompilerOut string                    `json:"compiler_out"`
+	CompilerErr string                    `json:"compiler_err"`
+	Diagnostics []*rpc.CompilerDiagnostic `json:"diagnostics"`
+	SizeReport  *rpc.SizeReport           `json:"size_report,omitempty"`
+}
+
+type batchResult struct {
+	Builds []*batchBuildResult `json:"builds"`
+}
+
+// Failed returns the number of failed builds
+func (r *batchResult) Failed() int {
+	failed := 0
+	for _, build := range r.Builds {
+		if !build.Success {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (r *batchResult) Data() interface{} {
+	return r
+}
+
+func (r *batchResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Sketch"), tr("Board"), tr("Result"), tr("Errors"), tr("Warnings"), tr("Flash"))
+	for _, build := range r.Builds {
+		result := color.GreenString(tr("passed"))
+		if !build.Success {
+			result = color.RedString(tr("failed"))
+		}
+		errs, warns := 0, 0
+		for _, diag := range build.Diagnostics {
+			switch diag.GetSeverity() {
+			case "error":
+				errs++
+			case "warning":
+				warns++
+			}
+		}
+		flash := ""
+		for _, memory := range build.SizeReport.GetMemory() {
+			if memory.GetName() == "flash" {
+				flash = fmt.Sprint(memory.GetSize())
+			}
+		}
+		t.AddRow(build.Sketch, build.Fqbn, result, fmt.Sprint(errs), fmt.Sprint(warns), flash)
+	}
+	return "\n" + t.Render() + "\n" + tr("%d builds, %d failed", len(r.Builds), r.Failed())
+}
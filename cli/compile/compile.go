@@ -16,16 +16,20 @@
 package compile
 
 import (
-	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/feedback"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/interrupt"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -33,70 +37,132 @@ import (
 )
 
 var (
-	fqbn               string   // Fully Qualified Board Name, e.g.: arduino:avr:uno.
-	showProperties     bool     // Show all build preferences used instead of compiling.
-	preprocess         bool     // Print preprocessed code to stdout.
-	buildCachePath     string   // Builds of 'core.a' are saved into this path to be cached and reused.
-	buildPath          string   // Path where to save compiled files.
-	buildProperties    []string // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
-	warnings           string   // Used to tell gcc which warning level to use.
-	verbose            bool     // Turns on verbose mode.
-	quiet              bool     // Suppresses almost every output.
-	vidPid             string   // VID/PID specific build properties.
-	uploadAfterCompile bool     // Upload the binary after the compilation.
-	port               string   // Upload port, e.g.: COM10 or /dev/ttyACM0.
-	verify             bool     // Upload, verify uploaded binary after the upload.
-	exportDir          string   // The compiled binary is written to this file
-	dryRun             bool     // Use this flag to now write the output file
-	libraries          []string // List of custom libraries paths separated by commas. Or can be used multiple times for multiple libraries paths.
-	optimizeForDebug   bool     // Optimize compile output for debug, not for release
-	programmer         string   // Use the specified programmer to upload
-	clean              bool     // Cleanup the build folder and do not use any cached build
+	fqbns                   []string // Fully Qualified Board Names, e.g.: arduino:avr:uno, more than one only in batch mode.
+	showProperties          bool     // Show all build preferences used instead of compiling.
+	preprocess              bool     // Print preprocessed code to stdout.
+	buildCachePath          string   // Builds of 'core.a' are saved into this path to be cached and reused.
+	buildPath               string   // Path where to save compiled files.
+	buildProperties         []string // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
+	buildProperty           []string // Custom build property, can be used multiple times for multiple properties.
+	extraFlags              []string // Flags added to the compiler command line for every source file.
+	warnings                string   // Used to tell gcc which warning level to use.
+	verbose                 bool     // Turns on verbose mode.
+	quiet                   bool     // Suppresses almost every output.
+	vidPid                  string   // VID/PID specific build properties.
+	uploadAfterCompile      bool     // Upload the binary after the compilation.
+	port                    string   // Upload port, e.g.: COM10 or /dev/ttyACM0.
+	verify                  bool     // Upload, verify uploaded binary after the upload.
+	exportDir               string   // The compiled binary is written to this file
+	artifactsName           string   // Template of the name of the exported artifacts
+	generateMapFile         bool     // Ask the linker for the map file
+	generateDisassembly     bool     // Write the disassembly of the sketch
+	buildFs                 bool     // Build the filesystem image with the data folder
+	partitions              string   // Partition table declared by the platform
+	linkerScript            string   // Linker script declared by the platform
+	signKey                 string   // Key used to sign the executable
+	logBuild                string   // File recording every tool run by the build
+	dryRun                  bool     // Use this flag to now write the output file
+	libraries               []string // List of custom libraries paths separated by commas. Or can be used multiple times for multiple libraries paths.
+	library                 []string // List of paths to single libraries root folders. Can be used multiple times.
+	optimizeForDebug        bool     // Optimize compile output for debug, not for release
+	programmer              string   // Use the specified programmer to upload
+	clean                   bool     // Cleanup the build folder and do not use any cached build
+	compilationDatabaseOnly bool     // Only create compilation database without actually compiling
+	profile                 string   // Profile of the sketch.yaml file to use
+	installProfileDeps      bool     // Install the platform and libraries pinned by the profile
+	autoInstallDeps         bool     // Install the libraries providing the missing headers
+	buildMetadata           bool     // Define the macros describing the build
+	jobs                    int32    // Max number of parallel compiles
+	sizeReport              string   // Print the memory used by the sketch, "short" or "full"
+	examplesOf              string   // Compile all the examples of this library
+	sketchesIn              string   // Compile all the sketches in this directory
 )
 
 // NewCommand created a new `compile` command
 func NewCommand() *cobra.Command {
 	command := &cobra.Command{
-		Use:     "compile",
-		Short:   "Compiles Arduino sketches.",
-		Long:    "Compiles Arduino sketches.",
-		Example: "  " + os.Args[0] + " compile -b arduino:avr:uno /home/user/Arduino/MySketch",
-		Args:    cobra.MaximumNArgs(1),
-		Run:     run,
+		Use:   "compile",
+		Short: "Compiles Arduino sketches.",
+		Long:  "Compiles Arduino sketches.",
+		Example: "  " + os.Args[0] + " compile -b arduino:avr:uno /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno --upload -p /dev/ttyACM0 /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno -b arduino:samd:mkr1000 --examples-of Servo\n" +
+			"  " + os.Args[0] + " compile -b arduino:avr:uno --sketches-in /home/user/Arduino",
+		Args: cobra.MaximumNArgs(1),
+		Run:  run,
 	}
 
-	command.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	command.Flags().StringArrayVarP(&fqbns, "fqbn", "b", []string{},
+		"Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times together with --examples-of or --sketches-in to compile for multiple boards.")
 	command.Flags().BoolVar(&showProperties, "show-properties", false, "Show all build properties used instead of compiling.")
 	command.Flags().BoolVar(&preprocess, "preprocess", false, "Print preprocessed code to stdout instead of compiling.")
 	command.Flags().StringVar(&buildCachePath, "build-cache-path", "", "Builds of 'core.a' are saved into this path to be cached and reused.")
 	command.Flags().StringVarP(&exportDir, "output-dir", "", "", "Save build artifacts in this directory.")
+	command.Flags().StringVar(&artifactsName, "artifacts-name", "",
+		"Name of the artifacts saved in the output directory, instead of <sketch>.ino. The placeholders {sketch}, {fqbn}, {board}, {git} (git describe of the sketch folder) and {timestamp} are expanded, e.g.: {sketch}-{board}-{git}")
+	command.Flags().BoolVar(&generateMapFile, "map-file", false, "Produce the linker map file of the sketch, <sketch>.ino.map, in the build path and the output directory.")
+	command.Flags().BoolVar(&generateDisassembly, "disassemble", false, "Write the disassembly of the sketch, interleaved with the source code, in <sketch>.ino.lst in the build path and the output directory.")
+	command.Flags().BoolVar(&buildFs, "build-fs", false,
+		"Build the image of the filesystem of the board (e.g. SPIFFS or LittleFS) with the content of the data folder of the sketch, in <sketch>.ino.fs.bin. Upload it with upload --fs.")
+	command.Flags().StringVar(&partitions, "partitions", "",
+		"Partition table to use, e.g. huge_app, among the ones provided by the platform for the board, instead of the one selected by the board options.")
+	command.Flags().StringVar(&linkerScript, "linker-script", "",
+		"Linker script to use among the ones provided by the platform for the board, instead of the one selected by the board options.")
+	command.Flags().StringVar(&signKey, "sign-key", "",
+		"Path of the key used to sign the executable of the sketch with the signing tool of the platform. The signed executable is exported with the other artifacts.")
+	command.Flags().StringVar(&logBuild, "log-build", "",
+		"Record every tool run by the build, with its recipe, command line, environment, exit code and duration, in this file. Run a recorded command again with build replay.")
 	command.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Perform the build but do not copy the compile output file.")
 	command.Flags().StringVar(&buildPath, "build-path", "",
 		"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.")
 	command.Flags().StringSliceVar(&buildProperties, "build-properties", []string{},
 		"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.")
-	command.Flags().StringVar(&warnings, "warnings", "none",
-		`Optional, can be "none", "default", "more" and "all". Defaults to "none". Used to tell gcc which warning level to use (-W flag).`)
+	command.Flags().StringArrayVar(&buildProperty, "build-property", []string{},
+		"Override a build property with a custom value, in the key=value form. Can be used multiple times for multiple properties.")
+	command.Flags().StringArrayVar(&extraFlags, "build-extra-flags", []string{},
+		"Add flags to the compiler command line for every source file, e.g.: \"-DDEBUG_LEVEL=3\". Can be used multiple times.")
+	command.Flags().StringVar(&warnings, "warnings", "",
+		`Optional, can be "none", "default", "more" and "all". Defaults to the build.warnings setting, "none" if not set. Used to tell gcc which warning level to use (-W flag).`)
 	command.Flags().BoolVarP(&verbose, "verbose", "v", false, "Optional, turns on verbose mode.")
 	command.Flags().BoolVar(&quiet, "quiet", false, "Optional, suppresses almost every output.")
 	command.Flags().BoolVarP(&uploadAfterCompile, "upload", "u", false, "Upload the binary after the compilation.")
-	command.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10 or /dev/ttyACM0")
+	command.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>")
 	command.Flags().BoolVarP(&verify, "verify", "t", false, "Verify uploaded binary after the upload.")
 	command.Flags().StringVar(&vidPid, "vid-pid", "", "When specified, VID/PID specific build properties are used, if board supports them.")
 	command.Flags().StringSliceVar(&libraries, "libraries", []string{},
-		"List of custom libraries paths separated by commas. Or can be used multiple times for multiple libraries paths.")
+		"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths. Their libraries have priority over the installed ones.")
+	command.Flags().StringArrayVar(&library, "library", []string{},
+		"Path to a single library's root folder. Can be used multiple times for multiple libraries. The libraries have priority over the installed ones.")
 	command.Flags().BoolVar(&optimizeForDebug, "optimize-for-debug", false, "Optional, optimize compile output for debugging, rather than for release.")
 	command.Flags().StringVarP(&programmer, "programmer", "P", "", "Optional, use the specified programmer to upload.")
 	command.Flags().BoolVar(&clean, "clean", false, "Optional, cleanup the build folder and do not use any cached build.")
+	command.Flags().BoolVarP(&compilationDatabaseOnly, "only-compilation-database", "", false, "Just produce the compilation database, without actually compiling.")
+	command.Flags().StringVar(&profile, "profile", "", "Build profile of the sketch.yaml file to use, if omitted the default profile is used.")
+	command.Flags().Int32VarP(&jobs, "jobs", "j", 0, "Max number of parallel compiles. If set to 0 the build.jobs setting is used, or the number of available CPU cores if it is 0 too.")
+	command.Flags().StringVar(&sizeReport, "size-report", "",
+		`Optional, print the memory used by the compiled sketch. "short" (the default if no value is given) shows the memory usage and the 10 largest symbols, "full" adds the sections of the executable and all the symbols.`)
+	command.Flags().Lookup("size-report").NoOptDefVal = "short"
+	command.Flags().BoolVar(&installProfileDeps, "install-profile-deps", false, "Install the platform and the libraries required by the build profile if they are missing.")
+	command.Flags().BoolVar(&autoInstallDeps, "auto-install-deps", false,
+		"Install the library providing each missing header, when exactly one library of the library index provides it, without asking for confirmation.")
+	command.Flags().BoolVar(&buildMetadata, "build-metadata", false,
+		"Define the macros ARDUINO_CLI_BUILD_TIMESTAMP, ARDUINO_CLI_BUILD_GIT, ARDUINO_CLI_BUILD_PROFILE and ARDUINO_CLI_VERSION describing the build, or the ones of the build.metadata setting.")
+	command.Flags().StringVar(&examplesOf, "examples-of", "", "Compile all the examples of the given library for each board, instead of a single sketch.")
+	command.Flags().StringVar(&sketchesIn, "sketches-in", "", "Compile all the sketches found in the given directory and its subfolders for each board, instead of a single sketch.")
 
 	return command
 }
 
 func run(cmd *cobra.Command, args []string) {
-	inst, err := instance.CreateInstance()
-	if err != nil {
-		feedback.Errorf("Error creating instance: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+	batchMode := examplesOf != "" || sketchesIn != ""
+	if batchMode {
+		if err := checkBatchFlags(args); err != nil {
+			feedback.Errorf("Invalid arguments: %v", err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+	} else if len(fqbns) > 1 {
+		feedback.Errorf("Multiple boards can be given only together with --examples-of or --sketches-in.")
+		os.Exit(errorcodes.ErrBadArgument)
 	}
 
 	var path *paths.Path
@@ -105,49 +171,158 @@ func run(cmd *cobra.Command, args []string) {
 	}
 
 	sketchPath := initSketchPath(path)
+	if !batchMode {
+		if err := configuration.MergeSketchConfig(sketchPath); err != nil {
+			feedback.Errorf("Error reading sketch config file: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
+	for _, prop := range buildProperty {
+		if !strings.Contains(prop, "=") {
+			feedback.Errorf("Invalid build property '%s', it must be in the key=value form.", prop)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+	}
+
+	if compilationDatabaseOnly && uploadAfterCompile {
+		feedback.Errorf("Can't upload when only the compilation database is produced.")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	sizeReportSymbols := int32(0)
+	switch sizeReport {
+	case "":
+	case "short":
+		sizeReportSymbols = 10
+	case "full":
+		sizeReportSymbols = -1
+	default:
+		feedback.Errorf("Invalid size report '%s', it must be either 'short' or 'full'.", sizeReport)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
 
-	_, err = compile.Compile(context.Background(), &rpc.CompileReq{
-		Instance:         inst,
-		Fqbn:             fqbn,
-		SketchPath:       sketchPath.String(),
-		ShowProperties:   showProperties,
-		Preprocess:       preprocess,
-		BuildCachePath:   buildCachePath,
-		BuildPath:        buildPath,
-		BuildProperties:  buildProperties,
-		Warnings:         warnings,
-		Verbose:          verbose,
-		Quiet:            quiet,
-		VidPid:           vidPid,
-		ExportDir:        exportDir,
-		DryRun:           dryRun,
-		Libraries:        libraries,
-		OptimizeForDebug: optimizeForDebug,
-		Clean:            clean,
-	}, os.Stdout, os.Stderr, viper.GetString("logging.level") == "debug")
+	if (showProperties || preprocess) && uploadAfterCompile {
+		feedback.Errorf("Can't upload when the sketch is not compiled.")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
 
+	inst, err := instance.CreateInstance()
 	if err != nil {
-		feedback.Errorf("Error during build: %v", err)
+		feedback.Errorf("Error creating instance: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
+	if batchMode {
+		runBatch(inst, sizeReportSymbols)
+		return
+	}
+
+	fqbn := ""
+	if len(fqbns) > 0 {
+		fqbn = fqbns[0]
+	}
+
+	// in JSON mode the compiler output is reported as part of the result
+	outStream, errStream, compileStreams := feedback.OutputStreams()
+	compileReq := &rpc.CompileReq{
+		Instance:                   inst,
+		Fqbn:                       fqbn,
+		SketchPath:                 sketchPath.String(),
+		ShowProperties:             showProperties,
+		Preprocess:                 preprocess,
+		BuildCachePath:             buildCachePath,
+		BuildPath:                  buildPath,
+		BuildProperties:            append(buildProperties, buildProperty...),
+		Warnings:                   warnings,
+		Verbose:                    verbose,
+		Quiet:                      quiet,
+		VidPid:                     vidPid,
+		ExportDir:                  exportDir,
+		ArtifactsName:              artifactsName,
+		GenerateMapFile:            generateMapFile,
+		GenerateDisassembly:        generateDisassembly,
+		BuildFs:                    buildFs,
+		Partitions:                 partitions,
+		LinkerScript:               linkerScript,
+		SignKey:                    signKey,
+		LogBuild:                   logBuild,
+		DryRun:                     dryRun,
+		Libraries:                  libraries,
+		Library:                    library,
+		OptimizeForDebug:           optimizeForDebug,
+		Clean:                      clean,
+		OnlyCompilationDatabase:    compilationDatabaseOnly,
+		ExtraFlags:                 extraFlags,
+		BuildMetadata:              buildMetadata,
+		Profile:                    profile,
+		InstallProfileDependencies: installProfileDeps,
+		Jobs:                       jobs,
+		SizeReportSymbols:          sizeReportSymbols,
+		AutoInstallDeps:            autoInstallDeps,
+	}
+	// Interrupting the build kills the compiler and the other tools that are
+	// running, instead of leaving them around
+	ctx, stop := interrupt.Context()
+	defer stop()
+	compileResp, err := compile.Compile(ctx, compileReq, outStream, errStream, viper.GetString("logging.level") == "debug")
+	installedLibraries := []string{}
+	if err != nil && ctx.Err() == nil && !autoInstallDeps && !showProperties && !preprocess {
+		// The user may install the missing libraries and build again
+		if installedLibraries = askInstallMissingLibraries(compileReq); len(installedLibraries) > 0 {
+			compileResp, err = compile.Compile(ctx, compileReq, outStream, errStream, viper.GetString("logging.level") == "debug")
+		}
+	}
+
+	streams := compileStreams()
+	res := &compileResult{
+		CompilerOut: streams.Stdout,
+		CompilerErr: streams.Stderr,
+		Success:     err == nil,
+	}
+	if compileResp != nil {
+		res.Diagnostics = compileResp.GetDiagnostics()
+		res.LibraryResolutions = compileResp.GetLibraryResolutions()
+		res.Artifacts = compileResp.GetArtifacts()
+		res.MapFile = compileResp.GetMapFile()
+		res.DisassemblyFile = compileResp.GetDisassemblyFile()
+		res.SignedArtifact = compileResp.GetSignedArtifact()
+		res.InstalledLibraries = append(installedLibraries, compileResp.GetInstalledLibraries()...)
+		if sizeReport != "" {
+			res.SizeReport = compileResp.GetSizeReport()
+		}
+	}
+
+	if err != nil {
+		feedback.PrintResult(res)
+		feedback.Errorf("Error during build: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
 	if uploadAfterCompile {
-		_, err := upload.Upload(context.Background(), &rpc.UploadReq{
+		// Upload the artifacts just built, for the same board they have been
+		// built for, instead of resolving the sketch build again
+		uploadOut, uploadErr, uploadStreams := feedback.OutputStreams()
+		_, err := upload.Upload(ctx, &rpc.UploadReq{
 			Instance:   inst,
-			Fqbn:       fqbn,
+			Fqbn:       compileResp.GetFqbn(),
 			SketchPath: sketchPath.String(),
 			Port:       port,
 			Verbose:    verbose,
 			Verify:     verify,
-			ImportDir:  exportDir,
+			ImportDir:  compileResp.GetBuildPath(),
 			Programmer: programmer,
-		}, os.Stdout, os.Stderr)
+		}, uploadOut, uploadErr, nil)
+		res.UploadResult = uploadStreams()
+		res.Success = err == nil
 
 		if err != nil {
+			feedback.PrintResult(res)
 			feedback.Errorf("Error during Upload: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
+
+	feedback.PrintResult(res)
 }
 
 // initSketchPath returns the current working directory
@@ -164,3 +339,81 @@ func initSketchPath(sketchPath *paths.Path) *paths.Path {
 	logrus.Infof("Reading sketch from dir: %s", wd)
 	return wd
 }
+
+type compileResult struct {
+	CompilerOut string                    `json:"compiler_out"`
+	CompilerErr string                    `json:"compiler_err"`
+	Success     bool                      `json:"success"`
+	Diagnostics []*rpc.CompilerDiagnostic `json:"diagnostics"`
+	// Headers provided by more than one library and which one has been used
+	LibraryResolutions []*rpc.LibraryResolution `json:"library_resolutions,omitempty"`
+	// Output of the upload, only present with --upload
+	UploadResult *feedback.OutputStreamsResult `json:"upload_result,omitempty"`
+	// Memory used by the sketch, only present with --size-report
+	SizeReport *rpc.SizeReport `json:"size_report,omitempty"`
+	// Paths of the artifacts copied to the output directory
+	Artifacts []string `json:"artifacts,omitempty"`
+	// Linker map file and disassembly of the sketch in the build path
+	MapFile         string `json:"map_file,omitempty"`
+	DisassemblyFile string `json:"disassembly_file,omitempty"`
+	// Signed executable, only present with --sign-key
+	SignedArtifact string `json:"signed_artifact,omitempty"`
+	// Libraries installed to provide the missing headers
+	InstalledLibraries []string `json:"installed_libraries,omitempty"`
+}
+
+func (r *compileResult) Data() interface{} {
+	return r
+}
+
+func (r *compileResult) String() string {
+	// The output is already printed via os.Stdout/os.Stderr
+	res := ""
+	if len(r.InstalledLibraries) > 0 {
+		res += fmt.Sprintf("Installed libraries: %s\n", strings.Join(r.InstalledLibraries, ", "))
+	}
+	if r.MapFile != "" && generateMapFile {
+		res += fmt.Sprintf("Linker map file: %s\n", r.MapFile)
+	}
+	if r.DisassemblyFile != "" {
+		res += fmt.Sprintf("Disassembly: %s\n", r.DisassemblyFile)
+	}
+	if r.SignedArtifact != "" {
+		res += fmt.Sprintf("Signed executable: %s\n", r.SignedArtifact)
+	}
+	if r.SizeReport == nil {
+		return res
+	}
+
+	res += "\n"
+	t := table.New()
+	t.SetHeader("Memory", "Used", "Maximum", "Usage")
+	for _, memory := range r.SizeReport.GetMemory() {
+		if memory.GetMaxSize() > 0 {
+			usage := fmt.Sprintf("%d%%", memory.GetSize()*100/memory.GetMaxSize())
+			t.AddRow(memory.GetName(), fmt.Sprint(memory.GetSize()), fmt.Sprint(memory.GetMaxSize()), usage)
+		} else {
+			t.AddRow(memory.GetName(), fmt.Sprint(memory.GetSize()), "", "")
+		}
+	}
+	res += t.Render()
+
+	if sizeReport == "full" && len(r.SizeReport.GetSections()) > 0 {
+		t := table.New()
+		t.SetHeader("Section", "Address", "Size")
+		for _, section := range r.SizeReport.GetSections() {
+			t.AddRow(section.GetName(), fmt.Sprintf("0x%08x", section.GetAddress()), fmt.Sprint(section.GetSize()))
+		}
+		res += "\n" + t.Render()
+	}
+
+	if len(r.SizeReport.GetSymbols()) > 0 {
+		t := table.New()
+		t.SetHeader("Symbol", "Type", "Section", "Size")
+		for _, symbol := range r.SizeReport.GetSymbols() {
+			t.AddRow(symbol.GetName(), symbol.GetType(), symbol.GetSection(), fmt.Sprint(symbol.GetSize()))
+		}
+		res += "\n" + t.Render()
+	}
+	return res
+}
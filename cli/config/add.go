@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initAddCommand() *cobra.Command {
+	addCommand := &cobra.Command{
+		Use:   "add <key> <value>...",
+		Short: "Adds one or more values to a setting.",
+		Long:  "Adds one or more values to a setting. Only settings holding a list of values are supported.",
+		Example: "" +
+			"  " + os.Args[0] + " config add board_manager.additional_urls https://example.com/package_example_index.json\n" +
+			"  " + os.Args[0] + " config add board_manager.additional_urls https://example.com/package_example_index.json https://another-url.com/package_another_index.json",
+		Args: cobra.MinimumNArgs(2),
+		Run:  runAddCommand,
+	}
+	return addCommand
+}
+
+func runAddCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino config add`")
+	key, values := args[0], args[1:]
+	if err := configuration.AddToFile(configFilePath(), key, values); err != nil {
+		feedback.Errorf("Cannot add value: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
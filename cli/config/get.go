@@ -0,0 +1,75 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func initGetCommand() *cobra.Command {
+	getCommand := &cobra.Command{
+		Use:   "get <key>",
+		Short: "Prints the value of a setting.",
+		Long:  "Prints the value of a setting.",
+		Example: "" +
+			"  " + os.Args[0] + " config get logging.level\n" +
+			"  " + os.Args[0] + " config get board_manager.additional_urls",
+		Args: cobra.ExactArgs(1),
+		Run:  runGetCommand,
+	}
+	return getCommand
+}
+
+func runGetCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino config get`")
+	key := args[0]
+	if err := configuration.ValidateKey(key); err != nil {
+		feedback.Errorf("Cannot get value: %v", err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	feedback.PrintResult(getResult{viper.Get(key)})
+}
+
+type getResult struct {
+	value interface{}
+}
+
+func (gr getResult) Data() interface{} {
+	return gr.value
+}
+
+func (gr getResult) String() string {
+	if list, ok := gr.value.([]string); ok {
+		return strings.Join(list, "\n")
+	}
+	if list, ok := gr.value.([]interface{}); ok {
+		res := []string{}
+		for _, item := range list {
+			res = append(res, fmt.Sprint(item))
+		}
+		return strings.Join(res, "\n")
+	}
+	return fmt.Sprint(gr.value)
+}
@@ -69,7 +69,18 @@ func runInitCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	msg := "Config file written to: " + configFileAbsPath
-	logrus.Info(msg)
-	feedback.Print(msg)
+	logrus.Infof("Config file written to: %s", configFileAbsPath)
+	feedback.PrintResult(&configInitResult{ConfigFile: configFileAbsPath})
+}
+
+type configInitResult struct {
+	ConfigFile string `json:"config_file"`
+}
+
+func (r *configInitResult) Data() interface{} {
+	return r
+}
+
+func (r *configInitResult) String() string {
+	return "Config file written to: " + r.ConfigFile
 }
@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/configuration"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func initSetCommand() *cobra.Command {
+	setCommand := &cobra.Command{
+		Use:   "set <key> <value>...",
+		Short: "Sets a setting value.",
+		Long:  "Sets a setting value in the configuration file. List settings accept multiple values, replacing the current list.",
+		Example: "" +
+			"  " + os.Args[0] + " config set logging.level debug\n" +
+			"  " + os.Args[0] + " config set board_manager.additional_urls https://example.com/package_example_index.json https://another-url.com/package_another_index.json",
+		Args: cobra.MinimumNArgs(1),
+		Run:  runSetCommand,
+	}
+	return setCommand
+}
+
+func runSetCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino config set`")
+	key, values := args[0], args[1:]
+	if err := configuration.SetInFile(configFilePath(), key, values); err != nil {
+		feedback.Errorf("Cannot set value: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+// configFilePath returns the path of the global configuration file in use, or
+// the default one in the data directory if none is being used. The project
+// configuration files are never changed.
+func configFilePath() string {
+	if configFile := viper.ConfigFileUsed(); configFile != "" {
+		return configFile
+	}
+	return filepath.Join(viper.GetString("directories.Data"), defaultFileName)
+}
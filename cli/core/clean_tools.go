@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/core"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initCleanToolsCommand() *cobra.Command {
+	cleanToolsCommand := &cobra.Command{
+		Use:   "clean-tools",
+		Short: "Removes the tools no longer used by the installed cores.",
+		Long: "Removes the tools no longer used by the installed cores.\n" +
+			"Tools archives are extracted once and shared by all the tool versions using the same\n" +
+			"archive: the extracted archives no longer referenced by any tool are removed as well.",
+		Example: "  " + os.Args[0] + " core clean-tools",
+		Args:    cobra.NoArgs,
+		Run:     runCleanToolsCommand,
+	}
+	return cleanToolsCommand
+}
+
+func runCleanToolsCommand(cmd *cobra.Command, args []string) {
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error cleaning tools: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	logrus.Info("Executing `arduino core clean-tools`")
+
+	_, err = core.PlatformCleanTools(context.Background(), &rpc.PlatformCleanToolsReq{Instance: inst}, output.NewTaskProgressCB())
+	if err != nil {
+		feedback.Errorf("Error cleaning tools: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+}
@@ -30,6 +30,8 @@ func NewCommand() *cobra.Command {
 		Example: "  " + os.Args[0] + " core update-index",
 	}
 
+	coreCommand.AddCommand(initCleanCacheCommand())
+	coreCommand.AddCommand(initCleanToolsCommand())
 	coreCommand.AddCommand(initDownloadCommand())
 	coreCommand.AddCommand(initInstallCommand())
 	coreCommand.AddCommand(initListCommand())
@@ -26,25 +26,47 @@ import (
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands/core"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
+var downloadFlags struct {
+	manifest string
+}
+
 func initDownloadCommand() *cobra.Command {
 	downloadCommand := &cobra.Command{
 		Use:   "download [PACKAGER:ARCH[@VERSION]](S)",
 		Short: "Downloads one or more cores and corresponding tool dependencies.",
-		Long:  "Downloads one or more cores and corresponding tool dependencies.",
+		Long: "Downloads one or more cores and corresponding tool dependencies. The archives are verified and kept in the " +
+			"downloads directory, so that the cores can be installed later without internet access.",
 		Example: "" +
 			"  " + os.Args[0] + " core download arduino:samd       # to download the latest version of Arduino SAMD core.\n" +
-			"  " + os.Args[0] + " core download arduino:samd@1.6.9 # for a specific version (in this case 1.6.9).",
-		Args: cobra.MinimumNArgs(1),
+			"  " + os.Args[0] + " core download arduino:samd@1.6.9 # for a specific version (in this case 1.6.9).\n" +
+			"  " + os.Args[0] + " core download --manifest manifest.yaml # for the platforms listed in the manifest.",
+		Args: cobra.ArbitraryArgs,
 		Run:  runDownloadCommand,
 	}
+	downloadCommand.Flags().StringVar(&downloadFlags.manifest, "manifest", "",
+		"YAML file listing the platforms to download, in the PACKAGER:ARCH@VERSION form, under the \"platforms\" key.")
 	return downloadCommand
 }
 
 func runDownloadCommand(cmd *cobra.Command, args []string) {
+	if downloadFlags.manifest != "" {
+		manifest, err := globals.LoadDownloadManifest(paths.New(downloadFlags.manifest))
+		if err != nil {
+			feedback.Errorf("Error reading manifest: %v", err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		args = append(args, manifest.Platforms...)
+	}
+	if len(args) == 0 {
+		feedback.Error("No platforms to download: specify them as arguments or with --manifest")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
 	inst, err := instance.CreateInstance()
 	if err != nil {
 		feedback.Errorf("Error downloading: %v", err)
@@ -69,7 +91,7 @@ func runDownloadCommand(cmd *cobra.Command, args []string) {
 		_, err := core.PlatformDownload(context.Background(), platformDownloadreq, output.ProgressBar())
 		if err != nil {
 			feedback.Errorf("Error downloading %s: %v", args[i], err)
-			os.Exit(errorcodes.ErrNetwork)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 }
@@ -16,8 +16,11 @@
 package core
 
 import (
+	"bufio"
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -39,14 +42,27 @@ func initInstallCommand() *cobra.Command {
 		Example: "  # download the latest version of Arduino SAMD core.\n" +
 			"  " + os.Args[0] + " core install arduino:samd\n\n" +
 			"  # download a specific version (in this case 1.6.9).\n" +
-			"  " + os.Args[0] + " core install arduino:samd@1.6.9",
+			"  " + os.Args[0] + " core install arduino:samd@1.6.9\n\n" +
+			"  # install a platform from a local archive, not listed in any package index.\n" +
+			"  " + os.Args[0] + " core install mycompany:myarch --archive ./myarch-1.0.0.tar.bz2\n\n" +
+			"  # install a working tree of the platform sources as a link, to test the changes without reinstalling.\n" +
+			"  " + os.Args[0] + " core install mycompany:myarch --from-dir ~/src/myarch",
 		Args: cobra.MinimumNArgs(1),
 		Run:  runInstallCommand,
 	}
 	AddPostInstallFlagsToCommand(installCommand)
+	installCommand.Flags().StringVar(&installFlags.archive, "archive", "",
+		"Path or URL of a platform archive to install as PACKAGER:ARCH, without looking it up in the package index.")
+	installCommand.Flags().StringVar(&installFlags.fromDir, "from-dir", "",
+		"Path of a platform directory to install as PACKAGER:ARCH by linking it, the changes to the directory are used without reinstalling.")
 	return installCommand
 }
 
+var installFlags struct {
+	archive string
+	fromDir string
+}
+
 var postInstallFlags struct {
 	runPostInstall  bool
 	skipPostInstall bool
@@ -55,8 +71,8 @@ var postInstallFlags struct {
 // AddPostInstallFlagsToCommand adds flags that can be used to force running or skipping
 // of post installation scripts
 func AddPostInstallFlagsToCommand(cmd *cobra.Command) {
-	cmd.Flags().BoolVar(&postInstallFlags.runPostInstall, "run-post-install", false, "Force run of post-install scripts (if the CLI is not running interactively).")
-	cmd.Flags().BoolVar(&postInstallFlags.skipPostInstall, "skip-post-install", false, "Force skip of post-install scripts (if the CLI is running interactively).")
+	cmd.Flags().BoolVar(&postInstallFlags.runPostInstall, "run-post-install", false, "Run post-install scripts without asking for confirmation.")
+	cmd.Flags().BoolVar(&postInstallFlags.skipPostInstall, "skip-post-install", false, "Skip post-install scripts without asking for confirmation.")
 }
 
 // DetectSkipPostInstallValue returns true if a post install script must be run
@@ -78,10 +94,42 @@ func DetectSkipPostInstallValue() bool {
 		logrus.Info("Not running from console, will skip post-install by default")
 		return true
 	}
-	logrus.Info("Running from console, will run post-install by default")
+	logrus.Info("Running from console, will ask confirmation to run post-install")
 	return false
 }
 
+// AskPostInstallConfirmation returns true if the user must confirm the run of the
+// post-install scripts, that is when the CLI is running interactively and
+// neither --run-post-install nor --skip-post-install is set
+func AskPostInstallConfirmation() bool {
+	return configuration.IsInteractive && !postInstallFlags.runPostInstall && !postInstallFlags.skipPostInstall
+}
+
+// confirmPostInstall asks the user to run the post-install script left
+// pending by the install of a platform, and runs it if the user agrees
+func confirmPostInstall(inst *rpc.Instance, platformRef *globals.ReferenceArg, script string) {
+	if script == "" || !AskPostInstallConfirmation() {
+		return
+	}
+	fmt.Printf("The platform %s provides a post-install script (%s), run it? [y/N] ", platformRef, script)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer != "y" && answer != "yes" {
+		feedback.Printf("Skipping the post-install script of %s", platformRef)
+		return
+	}
+
+	_, err := core.PlatformPostInstall(context.Background(), &rpc.PlatformPostInstallReq{
+		Instance:        inst,
+		PlatformPackage: platformRef.PackageName,
+		Architecture:    platformRef.Architecture,
+	}, output.TaskProgress())
+	if err != nil {
+		feedback.Errorf("Error running post-install script: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+}
+
 func runInstallCommand(cmd *cobra.Command, args []string) {
 	inst, err := instance.CreateInstance()
 	if err != nil {
@@ -97,18 +145,30 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
+	if installFlags.archive != "" && len(platformsRefs) != 1 {
+		feedback.Errorf("Only one platform can be installed from an archive.")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if installFlags.fromDir != "" && len(platformsRefs) != 1 {
+		feedback.Errorf("Only one platform can be installed from a directory.")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
 	for _, platformRef := range platformsRefs {
 		platformInstallReq := &rpc.PlatformInstallReq{
 			Instance:        inst,
 			PlatformPackage: platformRef.PackageName,
 			Architecture:    platformRef.Architecture,
 			Version:         platformRef.Version,
-			SkipPostInstall: DetectSkipPostInstallValue(),
+			SkipPostInstall: DetectSkipPostInstallValue() || AskPostInstallConfirmation(),
+			Archive:         installFlags.archive,
+			FromDir:         installFlags.fromDir,
 		}
-		_, err := core.PlatformInstall(context.Background(), platformInstallReq, output.ProgressBar(), output.TaskProgress())
+		resp, err := core.PlatformInstall(context.Background(), platformInstallReq, output.ProgressBar(), output.TaskProgress())
 		if err != nil {
 			feedback.Errorf("Error during install: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
+		confirmPostInstall(inst, platformRef, resp.GetPostInstallScript())
 	}
 }
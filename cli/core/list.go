@@ -58,7 +58,7 @@ func runListCommand(cmd *cobra.Command, args []string) {
 	platforms, err := core.GetPlatforms(inst.Id, listFlags.updatableOnly)
 	if err != nil {
 		feedback.Errorf("Error listing platforms: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
 	}
 
 	feedback.PrintResult(installedResult{platforms})
@@ -85,7 +85,16 @@ func (ir installedResult) String() string {
 		return ir.platforms[i].Platform.String() < ir.platforms[j].Platform.String()
 	})
 	for _, p := range ir.platforms {
-		t.AddRow(p.Platform.String(), p.Version.String(), p.Platform.GetLatestRelease().Version.String(), p.Platform.Name)
+		latest := p.Platform.GetLatestRelease()
+		latestVersion := latest.Version.String()
+		if !latest.IsCompatibleWithCurrentMachine() {
+			latestVersion += " (incompatible)"
+		}
+		name := p.Platform.Name
+		if p.Platform.Deprecated {
+			name = "[DEPRECATED] " + name
+		}
+		t.AddRow(p.Platform.String(), p.Version.String(), latestVersion, name)
 	}
 
 	return t.Render()
@@ -36,12 +36,15 @@ var (
 
 func initSearchCommand() *cobra.Command {
 	searchCommand := &cobra.Command{
-		Use:     "search <keywords...>",
-		Short:   "Search for a core in Boards Manager.",
-		Long:    "Search for a core in Boards Manager using the specified keywords.",
-		Example: "  " + os.Args[0] + " core search MKRZero -a -v",
-		Args:    cobra.ArbitraryArgs,
-		Run:     runSearchCommand,
+		Use:   "search <keywords...>",
+		Short: "Search for a core in Boards Manager.",
+		Long: "Search for a core in Boards Manager using the specified keywords.\n\n" +
+			"A core matches when each keyword is found in its name, architecture, package,\n" +
+			"maintainer, website or in the name of one of its boards.",
+		Example: "  " + os.Args[0] + " core search MKRZero -a -v\n" +
+			"  " + os.Args[0] + " core search mkr1000 samd",
+		Args: cobra.ArbitraryArgs,
+		Run:  runSearchCommand,
 	}
 	searchCommand.Flags().BoolVarP(&allVersions, "all", "a", false, "Show all available core versions.")
 
@@ -81,14 +84,38 @@ func (sr searchResults) Data() interface{} {
 func (sr searchResults) String() string {
 	if len(sr.platforms) > 0 {
 		t := table.New()
-		t.SetHeader("ID", "Version", "Name")
-		sort.Slice(sr.platforms, func(i, j int) bool {
+		t.SetHeader("ID", "Version", "Name", "Maintainer", "Boards")
+		t.SetColumnWidthMode(2, table.Average)
+		t.SetColumnWidthMode(3, table.Average)
+		sort.SliceStable(sr.platforms, func(i, j int) bool {
 			return sr.platforms[i].ID < sr.platforms[j].ID
 		})
 		for _, item := range sr.platforms {
-			t.AddRow(item.GetID(), item.GetLatest(), item.GetName())
+			version := item.GetLatest()
+			if item.GetIncompatible() {
+				version += " (incompatible)"
+			}
+			name := item.GetName()
+			if item.GetDeprecated() {
+				name = "[DEPRECATED] " + name
+			}
+			t.AddRow(item.GetID(), version, name, item.GetMaintainer(), boardsNames(item.GetBoards()))
 		}
 		return t.Render()
 	}
 	return "No platforms matching your search."
 }
+
+// boardsNames returns the names of the boards as a comma separated list,
+// shortened if too long to fit in a table column
+func boardsNames(boards []*rpc.Board) string {
+	names := []string{}
+	for _, board := range boards {
+		names = append(names, board.GetName())
+	}
+	res := strings.Join(names, ", ")
+	if len(res) > 40 {
+		res = res[:37] + "..."
+	}
+	return res
+}
@@ -31,14 +31,23 @@ import (
 )
 
 func initUninstallCommand() *cobra.Command {
-	return &cobra.Command{
-		Use:     "uninstall PACKAGER:ARCH ...",
-		Short:   "Uninstalls one or more cores and corresponding tool dependencies if no longer used.",
-		Long:    "Uninstalls one or more cores and corresponding tool dependencies if no longer used.",
-		Example: "  " + os.Args[0] + " core uninstall arduino:samd\n",
-		Args:    cobra.MinimumNArgs(1),
-		Run:     runUninstallCommand,
+	uninstallCommand := &cobra.Command{
+		Use:   "uninstall PACKAGER:ARCH ...",
+		Short: "Uninstalls one or more cores and corresponding tool dependencies if no longer used.",
+		Long: "Uninstalls one or more cores and corresponding tool dependencies if no longer used.\n" +
+			"Tools still required by other installed cores are kept. A core whose core or variants\n" +
+			"are referenced by other installed cores is not uninstalled unless --force is given.",
+		Example: "  " + os.Args[0] + " core uninstall arduino:samd\n" +
+			"  " + os.Args[0] + " core uninstall --force arduino:avr",
+		Args: cobra.MinimumNArgs(1),
+		Run:  runUninstallCommand,
 	}
+	uninstallCommand.Flags().BoolVar(&uninstallFlags.force, "force", false, "Uninstall the cores even if other installed cores depend on them.")
+	return uninstallCommand
+}
+
+var uninstallFlags struct {
+	force bool
 }
 
 func runUninstallCommand(cmd *cobra.Command, args []string) {
@@ -67,10 +76,11 @@ func runUninstallCommand(cmd *cobra.Command, args []string) {
 			Instance:        inst,
 			PlatformPackage: platformRef.PackageName,
 			Architecture:    platformRef.Architecture,
+			Force:           uninstallFlags.force,
 		}, output.NewTaskProgressCB())
 		if err != nil {
 			feedback.Errorf("Error during uninstall: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 }
@@ -18,6 +18,7 @@ package core
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -25,10 +26,15 @@ import (
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
+var updateIndexFlags struct {
+	ifOlderThan time.Duration
+}
+
 func initUpdateIndexCommand() *cobra.Command {
 	updateIndexCommand := &cobra.Command{
 		Use:     "update-index",
@@ -38,6 +44,8 @@ func initUpdateIndexCommand() *cobra.Command {
 		Args:    cobra.NoArgs,
 		Run:     runUpdateIndexCommand,
 	}
+	updateIndexCommand.Flags().DurationVar(&updateIndexFlags.ifOlderThan, "if-older-than", 0,
+		"Skip the update if the index has been checked less than this duration ago, e.g. 1h.")
 	return updateIndexCommand
 }
 
@@ -45,11 +53,37 @@ func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
 	instance := instance.CreateInstanceIgnorePlatformIndexErrors()
 	logrus.Info("Executing `arduino core update-index`")
 
-	_, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexReq{
-		Instance: instance,
+	resp, err := commands.UpdateIndex(context.Background(), &rpc.UpdateIndexReq{
+		Instance:    instance,
+		IfOlderThan: updateIndexFlags.ifOlderThan.String(),
 	}, output.ProgressBar())
 	if err != nil {
 		feedback.Errorf("Error updating index: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	feedback.PrintResult(updateIndexResult{resp.GetSignatures()})
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type updateIndexResult struct {
+	signatures []*rpc.IndexSignature
+}
+
+func (r updateIndexResult) Data() interface{} {
+	return r.signatures
+}
+
+func (r updateIndexResult) String() string {
+	t := table.New()
+	t.SetHeader("Index", "Signature")
+	for _, signature := range r.signatures {
+		status := "not signed"
+		if signature.GetVerified() {
+			status = "verified, signed by " + signature.GetSigner()
+		}
+		t.AddRow(signature.GetUrl(), status)
 	}
+	return t.Render()
 }
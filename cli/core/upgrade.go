@@ -18,7 +18,9 @@ package core
 import (
 	"context"
 	"os"
+	"sort"
 
+	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/globals"
@@ -26,6 +28,7 @@ import (
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands/core"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -39,13 +42,20 @@ func initUpgradeCommand() *cobra.Command {
 			"  # upgrade everything to the latest version\n" +
 			"  " + os.Args[0] + " core upgrade\n\n" +
 			"  # upgrade arduino:samd to the latest version\n" +
-			"  " + os.Args[0] + " core upgrade arduino:samd",
+			"  " + os.Args[0] + " core upgrade arduino:samd\n\n" +
+			"  # show what would be upgraded\n" +
+			"  " + os.Args[0] + " core upgrade --dry-run",
 		Run: runUpgradeCommand,
 	}
 	AddPostInstallFlagsToCommand(upgradeCommand)
+	upgradeCommand.Flags().BoolVar(&upgradeFlags.dryRun, "dry-run", false, "Show the platforms that would be upgraded without upgrading them.")
 	return upgradeCommand
 }
 
+var upgradeFlags struct {
+	dryRun bool
+}
+
 func runUpgradeCommand(cmd *cobra.Command, args []string) {
 	inst, err := instance.CreateInstance()
 	if err != nil {
@@ -55,6 +65,22 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 
 	logrus.Info("Executing `arduino core upgrade`")
 
+	if upgradeFlags.dryRun {
+		updatable, err := core.GetPlatforms(inst.Id, true)
+		if err != nil {
+			feedback.Errorf("Error retrieving core list: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		plan := []*cores.PlatformRelease{}
+		for _, platform := range updatable {
+			if len(args) == 0 || contains(args, platform.Platform.String()) {
+				plan = append(plan, platform)
+			}
+		}
+		feedback.PrintResult(upgradePlanResult{plan})
+		return
+	}
+
 	// if no platform was passed, upgrade allthethings
 	if len(args) == 0 {
 		targets, err := core.GetPlatforms(inst.Id, true)
@@ -92,15 +118,17 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 			Instance:        inst,
 			PlatformPackage: platformRef.PackageName,
 			Architecture:    platformRef.Architecture,
-			SkipPostInstall: DetectSkipPostInstallValue(),
+			SkipPostInstall: DetectSkipPostInstallValue() || AskPostInstallConfirmation(),
 		}
 
-		_, err := core.PlatformUpgrade(context.Background(), r, output.ProgressBar(), output.TaskProgress())
+		resp, err := core.PlatformUpgrade(context.Background(), r, output.ProgressBar(), output.TaskProgress())
 		if err == core.ErrAlreadyLatest {
 			feedback.Printf("Platform %s is already at the latest version", platformRef)
 		} else if err != nil {
 			feedback.Errorf("Error during upgrade: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
+		} else {
+			confirmPostInstall(inst, platformRef, resp.GetPostInstallScript())
 		}
 	}
 
@@ -108,3 +136,36 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 }
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradePlanResult lists the platforms that would be upgraded
+type upgradePlanResult struct {
+	platforms []*cores.PlatformRelease
+}
+
+func (r upgradePlanResult) Data() interface{} {
+	return r.platforms
+}
+
+func (r upgradePlanResult) String() string {
+	if len(r.platforms) == 0 {
+		return "All the cores are already at the latest version"
+	}
+	sort.Slice(r.platforms, func(i, j int) bool {
+		return r.platforms[i].Platform.String() < r.platforms[j].Platform.String()
+	})
+	t := table.New()
+	t.SetHeader("ID", "Installed", "Upgrade to")
+	for _, p := range r.platforms {
+		t.AddRow(p.Platform.String(), p.Version.String(), p.Platform.GetLatestRelease().Version.String())
+	}
+	return t.Render()
+}
@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// dial connects to the daemon listening on the address, port or socket set
+// in the daemon settings, using the configured TLS certificate and token
+func dial(ctx context.Context, timeout time.Duration) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	opts := []grpc.DialOption{grpc.WithBlock()}
+	target := net.JoinHostPort(viper.GetString("daemon.address"), viper.GetString("daemon.port"))
+	if socket := viper.GetString("daemon.socket"); socket != "" {
+		target = socket
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", addr)
+		}))
+	}
+	if sslCert := viper.GetString("daemon.ssl_cert"); sslCert != "" {
+		// The certificate of the daemon is trusted even if self-signed
+		creds, err := credentials.NewClientTLSFromFile(sslCert, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if token := viper.GetString("daemon.token"); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
+	return grpc.DialContext(ctx, target, opts...)
+}
+
+// tokenCredentials sends the daemon token in the `authorization` metadata
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	// The daemon accepts tokens on plain connections too, e.g. on the loopback
+	return false
+}
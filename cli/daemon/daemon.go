@@ -16,6 +16,7 @@
 package daemon
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -23,10 +24,12 @@ import (
 	"net"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/daemon"
 	srv_commands "github.com/arduino/arduino-cli/rpc/commands"
 	srv_debug "github.com/arduino/arduino-cli/rpc/debug"
@@ -38,6 +41,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 // NewCommand created a new `daemon` command
@@ -52,11 +59,19 @@ func NewCommand() *cobra.Command {
 	}
 	cmd.PersistentFlags().String("port", "", "The TCP port the daemon will listen to")
 	viper.BindPFlag("daemon.port", cmd.PersistentFlags().Lookup("port"))
+	cmd.PersistentFlags().String("address", "", "The IP address the daemon will listen to")
+	viper.BindPFlag("daemon.address", cmd.PersistentFlags().Lookup("address"))
+	cmd.PersistentFlags().String("socket", "", "The Unix domain socket the daemon will listen to, instead of the TCP port")
+	viper.BindPFlag("daemon.socket", cmd.PersistentFlags().Lookup("socket"))
 	cmd.Flags().BoolVar(&daemonize, "daemonize", false, "Do not terminate daemon process if the parent process dies")
+	cmd.Flags().BoolVar(&debug, "debug", false, "Enable gRPC server reflection, to inspect the services with tools like grpcurl")
+	cmd.AddCommand(initHealthCommand())
+	cmd.AddCommand(initShutdownCommand())
 	return cmd
 }
 
 var daemonize bool
+var debug bool
 
 func runDaemonCommand(cmd *cobra.Command, args []string) {
 
@@ -65,15 +80,80 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 		stats.Incr("daemon", stats.T("success", "true"))
 		defer stats.Flush()
 	}
+	address := viper.GetString("daemon.address")
 	port := viper.GetString("daemon.port")
-	s := grpc.NewServer()
+	socket := viper.GetString("daemon.socket")
+
+	serverOpts := []grpc.ServerOption{}
+	sslCert := viper.GetString("daemon.ssl_cert")
+	sslKey := viper.GetString("daemon.ssl_key")
+	if sslCert != "" || sslKey != "" {
+		if sslCert == "" || sslKey == "" {
+			feedback.Errorf("Both daemon.ssl_cert and daemon.ssl_key must be set to enable TLS.")
+			os.Exit(errorcodes.ErrCoreConfig)
+		}
+		creds, err := credentials.NewServerTLSFromFile(sslCert, sslKey)
+		if err != nil {
+			feedback.Errorf("Error loading TLS certificate: %v", err)
+			os.Exit(errorcodes.ErrCoreConfig)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	}
+	// every call is logged, even the rejected ones
+	unaryInterceptors := []grpc.UnaryServerInterceptor{daemon.LoggingUnaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{daemon.LoggingStreamInterceptor}
+	if viper.GetBool("telemetry.enabled") {
+		unaryInterceptors = append(unaryInterceptors, daemon.MetricsUnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, daemon.MetricsStreamInterceptor)
+	}
+	if token := viper.GetString("daemon.token"); token != "" {
+		auth := &daemon.TokenAuth{Token: token}
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor)
+	}
+	// the errors are converted first, so that the status codes are logged
+	// and recorded as sent to the client
+	unaryInterceptors = append(unaryInterceptors, daemon.ErrorsUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, daemon.ErrorsStreamInterceptor)
+	serverOpts = append(serverOpts,
+		grpc.UnaryInterceptor(daemon.ChainUnaryInterceptors(unaryInterceptors...)),
+		grpc.StreamInterceptor(daemon.ChainStreamInterceptors(streamInterceptors...)))
+	if ip := net.ParseIP(address); socket == "" && (ip == nil || !ip.IsLoopback()) {
+		if sslCert == "" {
+			logrus.Warnf("Daemon is listening on %s without TLS, connections can be eavesdropped", address)
+		}
+		if viper.GetString("daemon.token") == "" {
+			logrus.Warnf("Daemon is listening on %s without a token, anyone can connect", address)
+		}
+	}
+	s := grpc.NewServer(serverOpts...)
 
 	// Set specific user-agent for the daemon
 	viper.Set("network.user_agent_ext", "daemon")
 
+	// Register the standard health checking service, the daemon is reported
+	// as not serving as soon as it starts shutting down
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
 	// register the commands service
 	srv_commands.RegisterArduinoCoreServer(s, &daemon.ArduinoCoreServerImpl{
 		VersionString: globals.VersionInfo.VersionString,
+		OnShutdown: func(timeout time.Duration) {
+			logrus.Info("Shutting down the daemon")
+			healthServer.Shutdown()
+			stopped := make(chan struct{})
+			go func() {
+				s.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-time.After(timeout):
+				logrus.Warn("Canceling the calls still in progress")
+				s.Stop()
+			}
+		},
 	})
 
 	// Register the monitors service
@@ -85,6 +165,11 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 	// Register the debug session service
 	srv_debug.RegisterDebugServer(s, &daemon.DebugService{})
 
+	if debug {
+		// Allow clients to discover the available services and messages
+		reflection.Register(s)
+	}
+
 	if !daemonize {
 		// When parent process ends terminate also the daemon
 		go func() {
@@ -92,12 +177,53 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 			_, _ = io.Copy(ioutil.Discard, os.Stdin)
 			// Flush telemetry stats (this is a no-op if telemetry is disabled)
 			stats.Flush()
+			if socket != "" {
+				os.Remove(socket)
+			}
 			os.Exit(0)
 		}()
 	}
 
-	logrus.Infof("Starting daemon on TCP address 127.0.0.1:%s", port)
-	lis, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%s", port))
+	if interval := viper.GetDuration("index.update_interval"); interval > 0 {
+		go refreshIndexes(interval)
+	}
+
+	var lis net.Listener
+	var listenAddress string
+	if socket != "" {
+		lis = listenUnix(socket)
+		listenAddress = socket
+	} else {
+		lis = listenTCP(address, port)
+		listenAddress = net.JoinHostPort(address, port)
+	}
+	defer lis.Close()
+
+	// This message will show up on the stdout of the daemon process so that gRPC clients know it is time to connect.
+	logrus.Infof("Daemon is now listening on %s...", listenAddress)
+	if err := s.Serve(lis); err != nil {
+		logrus.Fatalf("Failed to serve: %v", err)
+	}
+	// Serve returns without errors only when the daemon is shut down
+	stats.Flush()
+	logrus.Info("Daemon stopped")
+}
+
+// refreshIndexes keeps the platforms and libraries indexes up to date,
+// checking them once every interval
+func refreshIndexes(interval time.Duration) {
+	for {
+		if err := commands.RefreshIndexes(context.Background(), interval); err != nil {
+			logrus.WithError(err).Warn("Error refreshing the indexes")
+		}
+		time.Sleep(interval)
+	}
+}
+
+// listenTCP opens the TCP listener of the daemon, exiting on failure
+func listenTCP(address, port string) net.Listener {
+	logrus.Infof("Starting daemon on TCP address %s", net.JoinHostPort(address, port))
+	lis, err := net.Listen("tcp", net.JoinHostPort(address, port))
 	if err != nil {
 		// Invalid port, such as "Foo"
 		var dnsError *net.DNSError
@@ -120,9 +246,38 @@ func runDaemonCommand(cmd *cobra.Command, args []string) {
 		feedback.Errorf("Failed to listen on TCP port: %s. Unexpected error: %v", port, err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
-	// This message will show up on the stdout of the daemon process so that gRPC clients know it is time to connect.
-	logrus.Infof("Daemon is now listening on 127.0.0.1:%s...", port)
-	if err := s.Serve(lis); err != nil {
-		logrus.Fatalf("Failed to serve: %v", err)
+	return lis
+}
+
+// listenUnix opens the Unix domain socket listener of the daemon, exiting
+// on failure. Access to the socket is restricted to the current user.
+func listenUnix(socket string) net.Listener {
+	logrus.Infof("Starting daemon on socket %s", socket)
+	if info, err := os.Lstat(socket); err == nil {
+		// Remove the socket left behind by a daemon that didn't terminate cleanly
+		if conn, err := net.Dial("unix", socket); err == nil {
+			conn.Close()
+			feedback.Errorf("Failed to listen on socket: %s. Address already in use.", socket)
+			os.Exit(errorcodes.ErrNetwork)
+		}
+		if info.Mode()&os.ModeSocket == 0 {
+			feedback.Errorf("Failed to listen on socket: %s. File exists.", socket)
+			os.Exit(errorcodes.ErrCoreConfig)
+		}
+		if err := os.Remove(socket); err != nil {
+			feedback.Errorf("Failed to remove stale socket: %s. %v", socket, err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		feedback.Errorf("Failed to listen on socket: %s. Unexpected error: %v", socket, err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if err := os.Chmod(socket, 0600); err != nil {
+		lis.Close()
+		feedback.Errorf("Failed to set socket permissions: %s. %v", socket, err)
+		os.Exit(errorcodes.ErrGeneric)
 	}
+	return lis
 }
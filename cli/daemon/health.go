@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/spf13/cobra"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var healthFlags struct {
+	timeout time.Duration
+}
+
+func initHealthCommand() *cobra.Command {
+	healthCommand := &cobra.Command{
+		Use:   "health",
+		Short: "Checks if the daemon is serving requests.",
+		Long: "Connects to the daemon listening on the configured address, port or socket and prints its health " +
+			"status. Exits with an error if the daemon can't be reached or is not serving requests.",
+		Example: "  " + os.Args[0] + " daemon health --port 50051",
+		Args:    cobra.NoArgs,
+		Run:     runHealthCommand,
+	}
+	healthCommand.Flags().DurationVar(&healthFlags.timeout, "timeout", 5*time.Second, "How long to wait for the daemon to answer")
+	return healthCommand
+}
+
+func runHealthCommand(cmd *cobra.Command, args []string) {
+	conn, err := dial(context.Background(), healthFlags.timeout)
+	if err != nil {
+		feedback.Errorf("Error connecting to the daemon: %v", err)
+		os.Exit(errorcodes.ErrNetwork)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthFlags.timeout)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		feedback.Errorf("Error checking the daemon health: %v", err)
+		os.Exit(errorcodes.ErrNetwork)
+	}
+	feedback.PrintResult(healthResult{Status: resp.GetStatus().String()})
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type healthResult struct {
+	Status string `json:"status"`
+}
+
+func (hr healthResult) Data() interface{} {
+	return hr
+}
+
+func (hr healthResult) String() string {
+	return hr.Status
+}
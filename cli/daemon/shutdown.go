@@ -0,0 +1,67 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	srv_commands "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/spf13/cobra"
+)
+
+var shutdownFlags struct {
+	timeout     time.Duration
+	gracePeriod time.Duration
+}
+
+func initShutdownCommand() *cobra.Command {
+	shutdownCommand := &cobra.Command{
+		Use:   "shutdown",
+		Short: "Stops a running daemon.",
+		Long: "Connects to the daemon listening on the configured address, port or socket and asks it to stop. " +
+			"The daemon waits for the calls in progress to end, up to the grace period, before stopping.",
+		Example: "  " + os.Args[0] + " daemon shutdown --port 50051 --grace-period 10s",
+		Args:    cobra.NoArgs,
+		Run:     runShutdownCommand,
+	}
+	shutdownCommand.Flags().DurationVar(&shutdownFlags.timeout, "timeout", 5*time.Second, "How long to wait for the daemon to answer")
+	shutdownCommand.Flags().DurationVar(&shutdownFlags.gracePeriod, "grace-period", 5*time.Second, "How long the daemon waits for the calls in progress to end")
+	return shutdownCommand
+}
+
+func runShutdownCommand(cmd *cobra.Command, args []string) {
+	conn, err := dial(context.Background(), shutdownFlags.timeout)
+	if err != nil {
+		feedback.Errorf("Error connecting to the daemon: %v", err)
+		os.Exit(errorcodes.ErrNetwork)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownFlags.timeout)
+	defer cancel()
+	_, err = srv_commands.NewArduinoCoreClient(conn).Shutdown(ctx, &srv_commands.ShutdownReq{
+		Timeout: shutdownFlags.gracePeriod.String(),
+	})
+	if err != nil {
+		feedback.Errorf("Error shutting down the daemon: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.Print("Daemon is shutting down")
+}
@@ -17,15 +17,20 @@ package debug
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/interrupt"
 	"github.com/arduino/arduino-cli/commands/debug"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -38,6 +43,11 @@ var (
 	verify      bool
 	interpreter string
 	importDir   string
+	printInfo   bool
+	programmer  string
+	ideConfig   string
+	rtos        string
+	gdbInit     []string
 )
 
 // NewCommand created a new `upload` command
@@ -52,18 +62,23 @@ func NewCommand() *cobra.Command {
 	}
 
 	debugCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
-	debugCommand.Flags().StringVarP(&port, "port", "p", "", "Debug port, e.g.: COM10 or /dev/ttyACM0")
+	debugCommand.Flags().StringVarP(&port, "port", "p", "", "Debug port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>")
+	debugCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Programmer to use for debugging")
 	debugCommand.Flags().StringVar(&interpreter, "interpreter", "console", "Debug interpreter e.g.: console, mi, mi1, mi2, mi3")
 	debugCommand.Flags().StringVarP(&importDir, "input-dir", "", "", "Directory containing binaries for debug.")
+	debugCommand.Flags().BoolVarP(&printInfo, "info", "I", false, "Show metadata about the debug session instead of starting the debugger.")
+	debugCommand.Flags().StringVar(&rtos, "rtos", "", "RTOS running on the board for thread-aware debugging, e.g.: FreeRTOS, Zephyr or auto")
+	debugCommand.Flags().StringArrayVar(&gdbInit, "gdb-init", []string{}, "GDB command to run at the start of the debug session. Can be used multiple times.")
+	debugCommand.Flags().StringVar(&ideConfig, "generate-config", "",
+		fmt.Sprintf("Write the launch configuration of the debug session for an IDE in the sketch folder instead of starting the debugger, one of: %s", strings.Join(debug.LaunchConfigFormats, ", ")))
 
 	return debugCommand
 }
 
 func run(command *cobra.Command, args []string) {
-	instance, err := instance.CreateInstance()
-	if err != nil {
-		feedback.Errorf("Error during Debug: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+	if ideConfig != "" && printInfo {
+		feedback.Errorf("Can't use --info and --generate-config together.")
+		os.Exit(errorcodes.ErrBadArgument)
 	}
 
 	var path *paths.Path
@@ -71,22 +86,99 @@ func run(command *cobra.Command, args []string) {
 		path = paths.New(args[0])
 	}
 	sketchPath := initSketchPath(path)
+	if err := configuration.MergeSketchConfig(sketchPath); err != nil {
+		feedback.Errorf("Error reading sketch config file: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	instance, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error during Debug: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	debugConfigRequested := &dbg.DebugConfigReq{
+		Instance:        &rpc.Instance{Id: instance.GetId()},
+		Fqbn:            fqbn,
+		SketchPath:      sketchPath.String(),
+		Port:            port,
+		Interpreter:     interpreter,
+		ImportDir:       importDir,
+		Programmer:      programmer,
+		Rtos:            rtos,
+		GdbInitCommands: gdbInit,
+	}
+
+	if ideConfig != "" {
+		generateLaunchConfig(debugConfigRequested, sketchPath)
+		return
+	}
+
+	if printInfo {
+		if res, err := debug.GetDebugConfig(context.Background(), debugConfigRequested); err != nil {
+			feedback.Errorf("Error getting Debug info: %v", err)
+			os.Exit(errorcodes.FromError(err))
+		} else {
+			feedback.PrintResult(&debugInfoResult{res})
+		}
+		return
+	}
 
 	// Intercept SIGINT and forward them to debug process
 	ctrlc := make(chan os.Signal, 1)
 	signal.Notify(ctrlc, os.Interrupt)
 
-	if _, err := debug.Debug(context.Background(), &dbg.DebugConfigReq{
-		Instance:    &rpc.Instance{Id: instance.GetId()},
-		Fqbn:        fqbn,
-		SketchPath:  sketchPath.String(),
-		Port:        port,
-		Interpreter: interpreter,
-		ImportDir:   importDir,
-	}, os.Stdin, os.Stdout, ctrlc); err != nil {
+	// The debug session is closed, and the GDB server killed, only when the
+	// CLI is terminated
+	ctx, stop := interrupt.TerminationContext()
+	defer stop()
+	if _, err := debug.Debug(ctx, debugConfigRequested, os.Stdin, os.Stdout, ctrlc, nil, nil); err != nil {
 		feedback.Errorf("Error during Debug: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+}
+
+// generateLaunchConfig writes the IDE launch configuration of the debug session
+func generateLaunchConfig(req *dbg.DebugConfigReq, sketchPath *paths.Path) {
+	sketchPath, err := sketchPath.Abs()
+	if err != nil {
+		feedback.Errorf("Error getting the sketch path: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	sketchName := sketchPath.Base()
+	fileName, err := debug.LaunchConfigFileName(ideConfig, sketchName)
+	if err != nil {
+		feedback.Errorf("Invalid argument: %v", err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	info, err := debug.GetDebugConfig(context.Background(), req)
+	if err != nil {
+		feedback.Errorf("Error getting Debug info: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	configFile := sketchPath.Join(fileName)
+	var existing []byte
+	if configFile.Exist() {
+		if existing, err = configFile.ReadFile(); err != nil {
+			feedback.Errorf("Error reading %s: %v", configFile, err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+	data, err := debug.LaunchConfig(ideConfig, info, sketchName, existing)
+	if err != nil {
+		feedback.Errorf("Error generating the launch configuration: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if err := configFile.Parent().MkdirAll(); err != nil {
+		feedback.Errorf("Error writing %s: %v", configFile, err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	if err := configFile.WriteFile(data); err != nil {
+		feedback.Errorf("Error writing %s: %v", configFile, err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
+	feedback.Printf("Launch configuration written to %s", configFile)
 }
 
 // initSketchPath returns the current working directory
@@ -103,3 +195,37 @@ func initSketchPath(sketchPath *paths.Path) *paths.Path {
 	logrus.Infof("Reading sketch from dir: %s", wd)
 	return wd
 }
+
+type debugInfoResult struct {
+	info *dbg.GetDebugConfigResp
+}
+
+func (r *debugInfoResult) Data() interface{} {
+	return r.info
+}
+
+func (r *debugInfoResult) String() string {
+	t := table.New()
+	t.AddRow("Executable to debug", r.info.GetExecutable())
+	t.AddRow("Debug tool", r.info.GetTool())
+	t.AddRow("GDB path", r.info.GetGdbPath())
+	if programmer := r.info.GetProgrammer(); programmer != "" {
+		t.AddRow("Programmer", programmer)
+	}
+	if server := r.info.GetServer(); server != "" {
+		t.AddRow("Server type", server)
+		t.AddRow("Server path", r.info.GetServerPath())
+		t.AddRow("Server arguments", strings.Join(r.info.GetServerArgs(), " "))
+	}
+	if svd := r.info.GetSvdFile(); svd != "" {
+		t.AddRow("SVD file", svd)
+	}
+	if rtos := r.info.GetRtos(); rtos != "" {
+		t.AddRow("RTOS", rtos)
+	}
+	if commands := r.info.GetGdbInitCommands(); len(commands) > 0 {
+		t.AddRow("GDB init commands", strings.Join(commands, "; "))
+	}
+	t.AddRow("Command line", strings.Join(r.info.GetCommandLine(), " "))
+	return t.Render()
+}
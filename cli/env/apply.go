@@ -0,0 +1,88 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/env"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func initApplyCommand() *cobra.Command {
+	applyCommand := &cobra.Command{
+		Use:   "apply LOCKFILE",
+		Short: "Installs the platforms, tools and libraries listed in a lockfile.",
+		Long: "Installs the exact versions of the platforms, tools and libraries listed in a lockfile created with " +
+			"`env export`. The additional package index URLs of the lockfile are used together with the configured ones.",
+		Example: "  " + os.Args[0] + " env apply arduino-lock.yaml",
+		Args:    cobra.ExactArgs(1),
+		Run:     runApplyCommand,
+	}
+	return applyCommand
+}
+
+func runApplyCommand(cmd *cobra.Command, args []string) {
+	lockfile, err := env.LoadLockfile(paths.New(args[0]))
+	if err != nil {
+		feedback.Errorf("Error reading lockfile: %v", err)
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	// The additional URLs of the lockfile are needed to find its platforms,
+	// they're used for this run only without changing the configuration file
+	urls := viper.GetStringSlice("board_manager.additional_urls")
+	for _, url := range lockfile.AdditionalURLs {
+		found := false
+		for _, u := range urls {
+			found = found || u == url
+		}
+		if !found {
+			urls = append(urls, url)
+		}
+	}
+	viper.Set("board_manager.additional_urls", urls)
+
+	inst := instance.CreateInstanceIgnorePlatformIndexErrors()
+
+	logrus.Info("Executing `arduino env apply`")
+
+	if !commands.IsOffline() {
+		err := commands.UpdateCoreLibrariesIndex(context.Background(), &rpc.UpdateCoreLibrariesIndexReq{
+			Instance: inst,
+		}, output.ProgressBar())
+		if err != nil {
+			feedback.Errorf("Error updating core and libraries index: %v", err)
+			os.Exit(errorcodes.ErrNetwork)
+		}
+	}
+
+	err = env.Apply(context.Background(), inst, lockfile, output.ProgressBar(), output.TaskProgress())
+	if err != nil {
+		feedback.Errorf("Error applying lockfile: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
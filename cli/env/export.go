@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/env"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initExportCommand() *cobra.Command {
+	exportCommand := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the installed platforms, tools and libraries to a lockfile.",
+		Long: "Prints a lockfile listing the additional package index URLs and the exact versions of the installed " +
+			"platforms, tools and libraries. The lockfile can be committed in version control and installed " +
+			"on another machine with `env apply`.",
+		Example: "  " + os.Args[0] + " env export > arduino-lock.yaml",
+		Args:    cobra.NoArgs,
+		Run:     runExportCommand,
+	}
+	return exportCommand
+}
+
+func runExportCommand(cmd *cobra.Command, args []string) {
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error exporting environment: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	logrus.Info("Executing `arduino env export`")
+
+	lockfile, err := env.Export(context.Background(), inst)
+	if err != nil {
+		feedback.Errorf("Error exporting environment: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	feedback.PrintResult(exportResult{lockfile})
+}
+
+// output from this command requires special formatting, let's create a dedicated
+// feedback.Result implementation
+type exportResult struct {
+	lockfile *env.Lockfile
+}
+
+func (er exportResult) Data() interface{} {
+	return er.lockfile
+}
+
+func (er exportResult) String() string {
+	data, err := er.lockfile.Encode()
+	if err != nil {
+		feedback.Errorf("Error encoding lockfile: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+	return string(data)
+}
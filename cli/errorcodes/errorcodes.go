@@ -15,6 +15,14 @@
 
 package errorcodes
 
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
 // Error codes to be used for os.Exit().
 const (
 	_          = iota // 0 is not a valid exit error code
@@ -28,4 +36,44 @@ const (
 	// directories vital for the CLI to work.
 	ErrCoreConfig
 	ErrBadArgument
+	// ErrNotFound is returned when a platform, library, board or any other
+	// resource requested doesn't exist.
+	ErrNotFound
+	// ErrFailedPrecondition is returned when a request is valid but can't be
+	// fulfilled in the current state, for example because a required platform
+	// is not installed.
+	ErrFailedPrecondition
+	// ErrToolFailure is returned when an external tool (compiler, uploader,
+	// debugger...) fails.
+	ErrToolFailure
+	// ErrCanceled is returned when the operation has been interrupted.
+	ErrCanceled
 )
+
+// FromError returns the exit code matching the category of err, the errors
+// returned by the commands carry a gRPC status that is used to pick the code.
+func FromError(err error) int {
+	if errors.Is(err, context.Canceled) {
+		return ErrCanceled
+	}
+	var withStatus interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &withStatus) {
+		return ErrGeneric
+	}
+	switch withStatus.GRPCStatus().Code() {
+	case codes.InvalidArgument:
+		return ErrBadArgument
+	case codes.NotFound:
+		return ErrNotFound
+	case codes.FailedPrecondition:
+		return ErrFailedPrecondition
+	case codes.Unavailable:
+		return ErrNetwork
+	case codes.Aborted:
+		return ErrToolFailure
+	case codes.Canceled:
+		return ErrCanceled
+	default:
+		return ErrGeneric
+	}
+}
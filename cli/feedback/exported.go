@@ -34,6 +34,11 @@ func SetFormat(f OutputFormat) {
 	fb.SetFormat(f)
 }
 
+// GetFormat returns the output format currently in use
+func GetFormat() OutputFormat {
+	return fb.GetFormat()
+}
+
 // OutputWriter returns the underlying io.Writer to be used when the Print*
 // api is not enough
 func OutputWriter() io.Writer {
@@ -46,6 +51,12 @@ func ErrorWriter() io.Writer {
 	return fb.ErrorWriter()
 }
 
+// OutputStreams returns the writers to be used by commands that stream their
+// output, see Feedback.OutputStreams
+func OutputStreams() (io.Writer, io.Writer, func() *OutputStreamsResult) {
+	return fb.OutputStreams()
+}
+
 // Printf behaves like fmt.Printf but writes on the out writer and adds a newline.
 func Printf(format string, v ...interface{}) {
 	fb.Printf(format, v...)
@@ -57,7 +68,8 @@ func Print(v interface{}) {
 }
 
 // Errorf behaves like fmt.Printf but writes on the error writer and adds a
-// newline. It also logs the error.
+// newline. The message is translated to the selected locale. It also logs
+// the error.
 func Errorf(format string, v ...interface{}) {
 	fb.Errorf(format, v...)
 }
@@ -16,14 +16,21 @@
 package feedback
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
+	"github.com/arduino/arduino-cli/i18n"
 	"github.com/sirupsen/logrus"
 )
 
+// translate is i18n.Tr under another name, so that the catalog generator
+// doesn't complain about the variable message: the formats are extracted
+// from the calls to Errorf
+var translate = i18n.Tr
+
 // OutputFormat is used to determine the output format
 type OutputFormat int
 
@@ -68,6 +75,11 @@ func (fb *Feedback) SetFormat(f OutputFormat) {
 	fb.format = f
 }
 
+// GetFormat returns the output format currently in use
+func (fb *Feedback) GetFormat() OutputFormat {
+	return fb.format
+}
+
 // OutputWriter returns the underlying io.Writer to be used when the Print*
 // api is not enough.
 func (fb *Feedback) OutputWriter() io.Writer {
@@ -77,7 +89,32 @@ func (fb *Feedback) OutputWriter() io.Writer {
 // ErrorWriter is the same as OutputWriter but exposes the underlying error
 // writer.
 func (fb *Feedback) ErrorWriter() io.Writer {
-	return fb.out
+	return fb.err
+}
+
+// OutputStreamsResult contains the accumulated stdout and stderr output
+// when the selected output format is JSON
+type OutputStreamsResult struct {
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+}
+
+// OutputStreams returns the writers to be used by commands that stream their
+// output, like compile or upload. In text mode they're the usual output and
+// error writers. In JSON mode the streams are buffered instead, so that they
+// don't mix with the JSON document, and the returned function gives back the
+// buffered content to be embedded in the command result.
+func (fb *Feedback) OutputStreams() (io.Writer, io.Writer, func() *OutputStreamsResult) {
+	if fb.format != JSON {
+		return fb.out, fb.err, func() *OutputStreamsResult { return &OutputStreamsResult{} }
+	}
+	out, err := &bytes.Buffer{}, &bytes.Buffer{}
+	return out, err, func() *OutputStreamsResult {
+		return &OutputStreamsResult{
+			Stdout: out.String(),
+			Stderr: err.String(),
+		}
+	}
 }
 
 // Printf behaves like fmt.Printf but writes on the out writer and adds a newline.
@@ -95,9 +132,12 @@ func (fb *Feedback) Print(v interface{}) {
 }
 
 // Errorf behaves like fmt.Printf but writes on the error writer and adds a
-// newline. It also logs the error.
+// newline. The message is translated to the selected locale, format must be
+// a literal string to be extracted in the i18n catalog. It also logs the
+// error, untranslated.
 func (fb *Feedback) Errorf(format string, v ...interface{}) {
-	fb.Error(fmt.Sprintf(format, v...))
+	fmt.Fprintln(fb.err, translate(format, v...))
+	logrus.Error(fmt.Sprintf(format, v...))
 }
 
 // Error behaves like fmt.Print but writes on the error writer and adds a
@@ -119,11 +159,11 @@ func (fb *Feedback) printJSON(v interface{}) {
 
 // PrintResult is a convenient wrapper to provide feedback for complex data,
 // where the contents can't be just serialized to JSON but requires more
-// structure.
+// structure. In text mode nothing is printed if the result is empty.
 func (fb *Feedback) PrintResult(res Result) {
 	if fb.format == JSON {
 		fb.printJSON(res.Data())
-	} else {
-		fb.Print(fmt.Sprintf("%s", res))
+	} else if s := res.String(); s != "" {
+		fb.Print(s)
 	}
 }
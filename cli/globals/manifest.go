@@ -0,0 +1,65 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package globals
+
+import (
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+	"gopkg.in/yaml.v3"
+)
+
+// DownloadManifest lists the platforms and the libraries to download with the
+// `core download` and `lib download` commands, it's used to fill the downloads
+// cache of the machines that have no internet access. The items are in the
+// NAME@VERSION form, e.g.:
+//
+//	platforms:
+//	  - arduino:samd@1.8.9
+//	libraries:
+//	  - AudioZero@1.0.0
+type DownloadManifest struct {
+	Platforms []string `yaml:"platforms"`
+	Libraries []string `yaml:"libraries"`
+}
+
+// LoadDownloadManifest reads a DownloadManifest from a YAML file, the version
+// of each platform and library is required.
+func LoadDownloadManifest(file *paths.Path) (*DownloadManifest, error) {
+	content, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %s", err)
+	}
+	manifest := &DownloadManifest{}
+	if err := yaml.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest %s: %s", file, err)
+	}
+	for _, platform := range manifest.Platforms {
+		if ref, err := ParseReferenceArg(platform, true); err != nil {
+			return nil, fmt.Errorf("invalid platform in manifest: %s", err)
+		} else if ref.Version == "" {
+			return nil, fmt.Errorf("missing version of platform %s in manifest", platform)
+		}
+	}
+	for _, library := range manifest.Libraries {
+		if ref, err := ParseReferenceArg(library, false); err != nil {
+			return nil, fmt.Errorf("invalid library in manifest: %s", err)
+		} else if ref.Version == "" {
+			return nil, fmt.Errorf("missing version of library %s in manifest", library)
+		}
+	}
+	return manifest, nil
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package globals_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDownloadManifest(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_download_manifest")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	manifestFile := tmp.Join("manifest.yaml")
+	load := func(content string) (*globals.DownloadManifest, error) {
+		require.NoError(t, manifestFile.WriteFile([]byte(content)))
+		return globals.LoadDownloadManifest(manifestFile)
+	}
+
+	manifest, err := load("platforms:\n  - arduino:samd@1.8.9\nlibraries:\n  - AudioZero@1.0.0\n  - Servo@1.1.6\n")
+	require.NoError(t, err)
+	require.Equal(t, []string{"arduino:samd@1.8.9"}, manifest.Platforms)
+	require.Equal(t, []string{"AudioZero@1.0.0", "Servo@1.1.6"}, manifest.Libraries)
+
+	_, err = load("platforms:\n  - arduino:samd\n")
+	require.EqualError(t, err, "missing version of platform arduino:samd in manifest")
+	_, err = load("libraries:\n  - AudioZero\n")
+	require.EqualError(t, err, "missing version of library AudioZero in manifest")
+	_, err = load("platforms:\n  - samd@1.8.9\n")
+	require.Error(t, err)
+	_, err = load("platforms: [")
+	require.Error(t, err)
+	_, err = globals.LoadDownloadManifest(tmp.Join("missing.yaml"))
+	require.Error(t, err)
+}
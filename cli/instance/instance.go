@@ -54,6 +54,9 @@ func getInitResponse() (*rpc.InitResp, error) {
 	// Init() succeeded but there were errors loading library indexes,
 	// let's rescan and try again
 	if resp.GetLibrariesIndexError() != "" {
+		if commands.IsOffline() {
+			return nil, errors.New("library index not available in offline mode: " + resp.GetLibrariesIndexError())
+		}
 		logrus.Warnf("There were errors loading the library index, trying again...")
 
 		// update all indexes
@@ -82,7 +85,7 @@ func getInitResponse() (*rpc.InitResp, error) {
 
 	// Init() succeeded but there were errors loading platform indexes,
 	// let's rescan and try again
-	if resp.GetPlatformsIndexErrors() != nil {
+	if resp.GetPlatformsIndexErrors() != nil && !commands.IsOffline() {
 
 		// log each error
 		for _, err := range resp.GetPlatformsIndexErrors() {
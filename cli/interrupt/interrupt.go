@@ -0,0 +1,75 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package interrupt cancels the operations of the CLI when it's interrupted,
+// so that the tools they spawned are killed and the partial results are
+// cleaned up before exiting.
+package interrupt
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/sirupsen/logrus"
+)
+
+// Context returns a context that is canceled when the CLI receives an
+// interrupt (Ctrl-C) or a termination signal. If a second signal is received
+// before stop is called the CLI exits immediately with the ErrCanceled exit
+// code, without waiting for the cleanup.
+func Context() (ctx context.Context, stop context.CancelFunc) {
+	return notifyContext(os.Interrupt, syscall.SIGTERM)
+}
+
+// TerminationContext returns a context like Context, that is canceled only
+// by a termination signal. It's used by the commands that give another
+// meaning to Ctrl-C, like debug that forwards it to the debugger.
+func TerminationContext() (ctx context.Context, stop context.CancelFunc) {
+	return notifyContext(syscall.SIGTERM)
+}
+
+func notifyContext(signals ...os.Signal) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan os.Signal, 1)
+	signal.Notify(received, signals...)
+	stopped := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-received:
+			logrus.WithField("signal", sig).Info("Interrupted, canceling the running operation")
+			cancel()
+		case <-stopped:
+			return
+		}
+		select {
+		case <-received:
+			os.Exit(errorcodes.ErrCanceled)
+		case <-stopped:
+		}
+	}()
+	var stopOnce sync.Once
+	stop := func() {
+		stopOnce.Do(func() {
+			signal.Stop(received)
+			close(stopped)
+			cancel()
+		})
+	}
+	return ctx, stop
+}
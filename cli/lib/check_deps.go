@@ -33,7 +33,7 @@ func initDepsCommand() *cobra.Command {
 	depsCommand := &cobra.Command{
 		Use:   "deps LIBRARY[@VERSION_NUMBER](S)",
 		Short: "Check dependencies status for the specified library.",
-		Long:  "Check dependencies status for the specified library.",
+		Long:  "Check dependencies status for the specified library, printing the dependency tree annotated with the installation status of each library.",
 		Example: "" +
 			"  " + os.Args[0] + " lib deps AudioZero       # for the latest version.\n" +
 			"  " + os.Args[0] + " lib deps AudioZero@1.0.0 # for the specific version.",
@@ -58,26 +58,74 @@ func runDepsCommand(cmd *cobra.Command, args []string) {
 	})
 	if err != nil {
 		feedback.Errorf("Error resolving dependencies for %s: %s", libRef, err)
+		os.Exit(errorcodes.FromError(err))
 	}
 
-	feedback.PrintResult(&checkDepResult{deps: deps})
+	feedback.PrintResult(&checkDepResult{root: libRef.Name, deps: deps})
 }
 
 // output from this command requires special formatting, let's create a dedicated
 // feedback.Result implementation
 type checkDepResult struct {
+	root string
 	deps *rpc.LibraryResolveDependenciesResp
 }
 
+// dependencyStatusResult is the JSON output of a dependency, with the status
+// printed by name
+type dependencyStatusResult struct {
+	Name             string   `json:"name,omitempty"`
+	VersionRequired  string   `json:"versionRequired,omitempty"`
+	VersionInstalled string   `json:"versionInstalled,omitempty"`
+	Dependencies     []string `json:"dependencies,omitempty"`
+	Status           string   `json:"status"`
+}
+
 func (dr checkDepResult) Data() interface{} {
-	return dr.deps
+	deps := []*dependencyStatusResult{}
+	for _, dep := range dr.deps.GetDependencies() {
+		deps = append(deps, &dependencyStatusResult{
+			Name:             dep.GetName(),
+			VersionRequired:  dep.GetVersionRequired(),
+			VersionInstalled: dep.GetVersionInstalled(),
+			Dependencies:     dep.GetDependencies(),
+			Status:           dep.GetStatus().String(),
+		})
+	}
+	return struct {
+		Dependencies []*dependencyStatusResult `json:"dependencies"`
+	}{deps}
 }
 
 func (dr checkDepResult) String() string {
-	res := ""
+	depsByName := map[string]*rpc.LibraryDependencyStatus{}
 	for _, dep := range dr.deps.GetDependencies() {
-		res += outputDep(dep)
+		depsByName[dep.GetName()] = dep
+	}
+	root, ok := depsByName[dr.root]
+	if !ok {
+		// should never happen: the resolved dependencies include the library itself
+		res := ""
+		for _, dep := range dr.deps.GetDependencies() {
+			res += outputDep(dep)
+		}
+		return res
+	}
+	return outputDepTree(root, depsByName, "", map[string]bool{})
+}
+
+// outputDepTree prints the dependency and, indented, all its dependencies.
+// Libraries already printed in the current branch are skipped to avoid
+// looping on circular dependencies.
+func outputDepTree(dep *rpc.LibraryDependencyStatus, depsByName map[string]*rpc.LibraryDependencyStatus, indent string, visiting map[string]bool) string {
+	res := indent + outputDep(dep)
+	visiting[dep.GetName()] = true
+	for _, name := range dep.GetDependencies() {
+		if child, ok := depsByName[name]; ok && !visiting[name] {
+			res += outputDepTree(child, depsByName, indent+"  ", visiting)
+		}
 	}
+	delete(visiting, dep.GetName())
 	return res
 }
 
@@ -86,13 +134,14 @@ func outputDep(dep *rpc.LibraryDependencyStatus) string {
 	green := color.New(color.FgGreen)
 	red := color.New(color.FgRed)
 	yellow := color.New(color.FgYellow)
-	if dep.GetVersionInstalled() == "" {
+	switch dep.GetStatus() {
+	case rpc.LibraryDependencyInstallStatus_missing:
 		res += fmt.Sprintf("%s must be installed.\n",
 			red.Sprintf("✕ %s %s", dep.GetName(), dep.GetVersionRequired()))
-	} else if dep.GetVersionInstalled() == dep.GetVersionRequired() {
+	case rpc.LibraryDependencyInstallStatus_installed:
 		res += fmt.Sprintf("%s is already installed.\n",
 			green.Sprintf("✓ %s %s", dep.GetName(), dep.GetVersionRequired()))
-	} else {
+	default:
 		res += fmt.Sprintf("%s is required but %s is currently installed.\n",
 			yellow.Sprintf("✕ %s %s", dep.GetName(), dep.GetVersionRequired()),
 			yellow.Sprintf("%s", dep.GetVersionInstalled()))
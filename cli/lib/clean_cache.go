@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initCleanCacheCommand() *cobra.Command {
+	cleanCacheCommand := &cobra.Command{
+		Use:   "clean-cache",
+		Short: "Removes the downloaded library archives.",
+		Long: "Removes the library archives from the downloads directory.\n" +
+			"The archives can be filtered by age and by whether they're still listed in the libraries index,\n" +
+			"use --dry-run to only list them with their size.",
+		Example: "  " + os.Args[0] + " lib clean-cache\n" +
+			"  " + os.Args[0] + " lib clean-cache --older-than 30 --orphaned --dry-run",
+		Args: cobra.NoArgs,
+		Run:  runCleanCacheCommand,
+	}
+	cleanCacheCommand.Flags().Int32Var(&cleanCacheFlags.olderThan, "older-than", 0, "Remove only the archives downloaded more than the given number of days ago.")
+	cleanCacheCommand.Flags().BoolVar(&cleanCacheFlags.orphaned, "orphaned", false, "Remove only the archives no longer listed in the libraries index.")
+	cleanCacheCommand.Flags().BoolVar(&cleanCacheFlags.dryRun, "dry-run", false, "List the archives to remove and their size without removing them.")
+	return cleanCacheCommand
+}
+
+var cleanCacheFlags struct {
+	olderThan int32
+	orphaned  bool
+	dryRun    bool
+}
+
+func runCleanCacheCommand(cmd *cobra.Command, args []string) {
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error cleaning cache: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	logrus.Info("Executing `arduino lib clean-cache`")
+
+	resp, err := lib.LibraryCleanCache(context.Background(), &rpc.LibraryCleanCacheReq{
+		Instance:      inst,
+		OlderThanDays: cleanCacheFlags.olderThan,
+		OnlyOrphaned:  cleanCacheFlags.orphaned,
+		DryRun:        cleanCacheFlags.dryRun,
+	})
+	if err != nil {
+		feedback.Errorf("Error cleaning cache: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	feedback.PrintResult(output.CachedArchivesResult{
+		Archives: resp.GetArchives(),
+		Size:     resp.GetSize(),
+		DryRun:   cleanCacheFlags.dryRun,
+	})
+}
@@ -21,28 +21,51 @@ import (
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/cli/instance"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands/lib"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-paths-helper"
 	"github.com/spf13/cobra"
 )
 
+var downloadFlags struct {
+	manifest string
+}
+
 func initDownloadCommand() *cobra.Command {
 	downloadCommand := &cobra.Command{
 		Use:   "download [LIBRARY_NAME(S)]",
 		Short: "Downloads one or more libraries without installing them.",
-		Long:  "Downloads one or more libraries without installing them.",
+		Long: "Downloads one or more libraries without installing them. The archives are verified and kept in the " +
+			"downloads directory, so that the libraries can be installed later without internet access.",
 		Example: "" +
 			"  " + os.Args[0] + " lib download AudioZero       # for the latest version.\n" +
-			"  " + os.Args[0] + " lib download AudioZero@1.0.0 # for a specific version.",
-		Args: cobra.MinimumNArgs(1),
+			"  " + os.Args[0] + " lib download AudioZero@1.0.0 # for a specific version.\n" +
+			"  " + os.Args[0] + " lib download --manifest manifest.yaml # for the libraries listed in the manifest.",
+		Args: cobra.ArbitraryArgs,
 		Run:  runDownloadCommand,
 	}
+	downloadCommand.Flags().StringVar(&downloadFlags.manifest, "manifest", "",
+		"YAML file listing the libraries to download, in the NAME@VERSION form, under the \"libraries\" key.")
 	return downloadCommand
 }
 
 func runDownloadCommand(cmd *cobra.Command, args []string) {
+	if downloadFlags.manifest != "" {
+		manifest, err := globals.LoadDownloadManifest(paths.New(downloadFlags.manifest))
+		if err != nil {
+			feedback.Errorf("Error reading manifest: %v", err)
+			os.Exit(errorcodes.ErrBadArgument)
+		}
+		args = append(args, manifest.Libraries...)
+	}
+	if len(args) == 0 {
+		feedback.Error("No libraries to download: specify them as arguments or with --manifest")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
 	instance := instance.CreateInstanceIgnorePlatformIndexErrors()
 	refs, err := ParseLibraryReferenceArgsAndAdjustCase(instance, args)
 	if err != nil {
@@ -59,7 +82,7 @@ func runDownloadCommand(cmd *cobra.Command, args []string) {
 		_, err := lib.LibraryDownload(context.Background(), libraryDownloadReq, output.ProgressBar())
 		if err != nil {
 			feedback.Errorf("Error downloading %s: %v", library, err)
-			os.Exit(errorcodes.ErrNetwork)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 }
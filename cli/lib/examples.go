@@ -35,19 +35,22 @@ import (
 
 func initExamplesCommand() *cobra.Command {
 	examplesCommand := &cobra.Command{
-		Use:     "examples [LIBRARY_NAME]",
-		Short:   "Shows the list of the examples for libraries.",
-		Long:    "Shows the list of the examples for libraries. A name may be given as argument to search a specific library.",
-		Example: "  " + os.Args[0] + " lib examples Wire",
-		Args:    cobra.MaximumNArgs(1),
-		Run:     runExamplesCommand,
+		Use:   "examples [LIBRARY_NAME]",
+		Short: "Shows the list of the examples for libraries.",
+		Long:  "Shows the list of the examples for libraries. A name may be given as argument to search a specific library.",
+		Example: "  " + os.Args[0] + " lib examples Wire\n" +
+			"  " + os.Args[0] + " lib examples -b arduino:avr:uno --compatible-only",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runExamplesCommand,
 	}
 	examplesCommand.Flags().StringVarP(&examplesFlags.fqbn, "fqbn", "b", "", "Show libraries for the specified board FQBN.")
+	examplesCommand.Flags().BoolVar(&examplesFlags.compatibleOnly, "compatible-only", false, "Show only the libraries compatible with the board specified with --fqbn.")
 	return examplesCommand
 }
 
 var examplesFlags struct {
-	fqbn string
+	fqbn           string
+	compatibleOnly bool
 }
 
 func runExamplesCommand(cmd *cobra.Command, args []string) {
@@ -59,22 +62,32 @@ func runExamplesCommand(cmd *cobra.Command, args []string) {
 		name = args[0]
 	}
 
-	res, err := lib.LibraryList(context.Background(), &rpc.LibraryListReq{
-		Instance: instance,
-		All:      true,
-		Name:     name,
-		Fqbn:     examplesFlags.fqbn,
+	if examplesFlags.compatibleOnly && examplesFlags.fqbn == "" {
+		feedback.Errorf("The --compatible-only flag requires --fqbn.")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+
+	res, err := lib.LibraryExamples(context.Background(), &rpc.LibraryExamplesReq{
+		Instance:       instance,
+		Name:           name,
+		Fqbn:           examplesFlags.fqbn,
+		CompatibleOnly: examplesFlags.compatibleOnly,
 	})
 	if err != nil {
 		feedback.Errorf("Error getting libraries info: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
 	}
 
 	found := []*libraryExamples{}
-	for _, lib := range res.GetInstalledLibrary() {
+	for _, lib := range res.GetLibraries() {
+		examples := []string{}
+		for _, example := range lib.GetExamples() {
+			examples = append(examples, example.GetPath())
+		}
 		found = append(found, &libraryExamples{
-			Library:  lib.Library,
-			Examples: lib.Library.Examples,
+			Library:    lib.GetLibrary(),
+			Examples:   examples,
+			Compatible: lib.GetCompatible(),
 		})
 	}
 
@@ -86,8 +99,9 @@ func runExamplesCommand(cmd *cobra.Command, args []string) {
 // feedback.Result implementation
 
 type libraryExamples struct {
-	Library  *rpc.Library `json:"library"`
-	Examples []string     `json:"examples"`
+	Library    *rpc.Library `json:"library"`
+	Examples   []string     `json:"examples"`
+	Compatible bool         `json:"compatible"`
 }
 
 type libraryExamplesResult struct {
@@ -115,6 +129,9 @@ func (ir libraryExamplesResult) String() string {
 		} else if lib.Library.Location != rpc.LibraryLocation_user {
 			name += " (" + lib.Library.GetLocation().String() + ")"
 		}
+		if !lib.Compatible {
+			name += " " + color.YellowString("(not compatible with the board)")
+		}
 		r := fmt.Sprintf("Examples for library %s\n", color.GreenString("%s", name))
 		sort.Slice(lib.Examples, func(i, j int) bool {
 			return strings.ToLower(lib.Examples[i]) < strings.ToLower(lib.Examples[j])
@@ -35,7 +35,8 @@ func initInstallCommand() *cobra.Command {
 		Long:  "Installs one or more specified libraries into the system.",
 		Example: "" +
 			"  " + os.Args[0] + " lib install AudioZero       # for the latest version.\n" +
-			"  " + os.Args[0] + " lib install AudioZero@1.0.0 # for the specific version.",
+			"  " + os.Args[0] + " lib install AudioZero@1.0.0 # for the specific version.\n" +
+			"  " + os.Args[0] + " lib install \"ArduinoJson@>=6.0 <7.0\" # for the newest version in the range.",
 		Args: cobra.MinimumNArgs(1),
 		Run:  runInstallCommand,
 	}
@@ -55,50 +56,17 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 		os.Exit(errorcodes.ErrBadArgument)
 	}
 
-	toInstall := map[string]*rpc.LibraryDependencyStatus{}
-	if installFlags.noDeps {
-		for _, libRef := range libRefs {
-			toInstall[libRef.Name] = &rpc.LibraryDependencyStatus{
-				Name:            libRef.Name,
-				VersionRequired: libRef.Version,
-			}
-		}
-	} else {
-		for _, libRef := range libRefs {
-			depsResp, err := lib.LibraryResolveDependencies(context.Background(), &rpc.LibraryResolveDependenciesReq{
-				Instance: instance,
-				Name:     libRef.Name,
-				Version:  libRef.Version,
-			})
-			if err != nil {
-				feedback.Errorf("Error resolving dependencies for %s: %s", libRef, err)
-				os.Exit(errorcodes.ErrGeneric)
-			}
-			for _, dep := range depsResp.GetDependencies() {
-				feedback.Printf("%s depends on %s@%s", libRef, dep.GetName(), dep.GetVersionRequired())
-				if existingDep, has := toInstall[dep.GetName()]; has {
-					if existingDep.GetVersionRequired() != dep.GetVersionRequired() {
-						// TODO: make a better error
-						feedback.Errorf("The library %s is required in two different versions: %s and %s",
-							dep.GetName(), dep.GetVersionRequired(), existingDep.GetVersionRequired())
-						os.Exit(errorcodes.ErrGeneric)
-					}
-				}
-				toInstall[dep.GetName()] = dep
-			}
-		}
-	}
-
-	for _, library := range toInstall {
+	for _, libRef := range libRefs {
 		libraryInstallReq := &rpc.LibraryInstallReq{
 			Instance: instance,
-			Name:     library.Name,
-			Version:  library.VersionRequired,
+			Name:     libRef.Name,
+			Version:  libRef.Version,
+			NoDeps:   installFlags.noDeps,
 		}
 		err := lib.LibraryInstall(context.Background(), libraryInstallReq, output.ProgressBar(), output.TaskProgress())
 		if err != nil {
-			feedback.Errorf("Error installing %s: %v", library, err)
-			os.Exit(errorcodes.ErrGeneric)
+			feedback.Errorf("Error installing %s: %v", libRef, err)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 }
@@ -32,6 +32,7 @@ func NewCommand() *cobra.Command {
 			"  " + os.Args[0] + " lib update-index",
 	}
 
+	libCommand.AddCommand(initCleanCacheCommand())
 	libCommand.AddCommand(initDownloadCommand())
 	libCommand.AddCommand(initInstallCommand())
 	libCommand.AddCommand(initListCommand())
@@ -38,7 +38,13 @@ func initListCommand() *cobra.Command {
 		Long: "Shows a list of installed libraries.\n\n" +
 			"If the LIBNAME parameter is specified the listing is limited to that specific\n" +
 			"library. By default the libraries provided as built-in by platforms/core are\n" +
-			"not listed, they can be listed by adding the --all flag.",
+			"not listed, they can be listed by adding the --all flag.\n\n" +
+			"Libraries installed in the sketchbook that are not in the libraries index\n" +
+			"(for example installed from a zip file or a git repository) are marked as\n" +
+			"of unknown origin, they can be uninstalled by name as any other library.\n\n" +
+			"Built-in libraries replaced by a library with the same name installed in the\n" +
+			"sketchbook are marked as shadowed, the JSON output reports the path of the\n" +
+			"library used in their place.",
 		Example: "  " + os.Args[0] + " lib list",
 		Args:    cobra.MaximumNArgs(1),
 		Run:     runListCommand,
@@ -73,7 +79,7 @@ func runListCommand(cmd *cobra.Command, args []string) {
 	})
 	if err != nil {
 		feedback.Errorf("Error listing Libraries: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
 	}
 
 	libs := []*rpc.InstalledLibrary{}
@@ -136,6 +142,12 @@ func (ir installedResult) String() string {
 		if lib.ContainerPlatform != "" {
 			location = lib.GetContainerPlatform()
 		}
+		if libMeta.GetUnknownOrigin() {
+			location += " (unknown origin)"
+		}
+		if libMeta.GetShadowedBy() != "" {
+			location += " (shadowed by user)"
+		}
 
 		if libMeta.GetRelease() != nil {
 			available := libMeta.GetRelease().GetVersion()
@@ -148,7 +160,11 @@ func (ir installedResult) String() string {
 			} else if len(sentence) > 40 {
 				sentence = sentence[:37] + "..."
 			}
-			t.AddRow(name, lib.Version, available, location, sentence)
+			version := lib.Version
+			if version == "" {
+				version = "-"
+			}
+			t.AddRow(name, version, available, location, sentence)
 		}
 	}
 
@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
@@ -34,12 +35,20 @@ import (
 
 func initSearchCommand() *cobra.Command {
 	searchCommand := &cobra.Command{
-		Use:     "search [LIBRARY_NAME]",
-		Short:   "Searches for one or more libraries data.",
-		Long:    "Search for one or more libraries data (case insensitive search).",
-		Example: "  " + os.Args[0] + " lib search audio",
-		Args:    cobra.ArbitraryArgs,
-		Run:     runSearchCommand,
+		Use:   "search [LIBRARY_NAME]",
+		Short: "Searches for one or more libraries data.",
+		Long: "Search for one or more libraries data (case insensitive search).\n" +
+			"The name, sentence, paragraph, architectures and provided headers of the libraries are searched, the\n" +
+			"results are sorted by relevance. The search can be narrowed with the following qualifiers:\n" +
+			"  author:NAME    the author or maintainer contains NAME\n" +
+			"  arch:ARCH      the library is compatible with the ARCH architecture\n" +
+			"  header:HEADER  the library provides the HEADER include file\n" +
+			"  topic:TOPIC    the library category contains TOPIC",
+		Example: "  " + os.Args[0] + " lib search audio\n" +
+			"  " + os.Args[0] + " lib search servo arch:samd\n" +
+			"  " + os.Args[0] + " lib search header:ArduinoJson.h",
+		Args: cobra.ArbitraryArgs,
+		Run:  runSearchCommand,
 	}
 	searchCommand.Flags().BoolVar(&searchFlags.namesOnly, "names", false, "Show library names only.")
 	return searchCommand
@@ -106,11 +115,6 @@ func (res result) String() string {
 		return "No libraries matching your search."
 	}
 
-	// get a sorted slice of results
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].Name < results[j].Name
-	})
-
 	var out strings.Builder
 
 	if res.results.GetStatus() == rpc.LibrarySearchStatus_failed {
@@ -157,6 +161,9 @@ func (res result) String() string {
 		if len(latest.Dependencies) > 0 {
 			out.WriteString(fmt.Sprintf("  Dependencies: %s\n", strings.Join(deps, ", ")))
 		}
+		if latest.IndexUrl != "" && latest.IndexUrl != librariesmanager.LibraryIndexURL.String() {
+			out.WriteString(fmt.Sprintf("  Index: %s\n", latest.IndexUrl))
+		}
 	}
 
 	return fmt.Sprintf("%s", out.String())
@@ -59,7 +59,7 @@ func runUninstallCommand(cmd *cobra.Command, args []string) {
 		}, output.TaskProgress())
 		if err != nil {
 			feedback.Errorf("Error uninstalling %s: %v", library, err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 
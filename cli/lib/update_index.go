@@ -18,6 +18,7 @@ package lib
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
@@ -28,8 +29,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var updateIndexFlags struct {
+	ifOlderThan time.Duration
+}
+
 func initUpdateIndexCommand() *cobra.Command {
-	return &cobra.Command{
+	updateIndexCommand := &cobra.Command{
 		Use:     "update-index",
 		Short:   "Updates the libraries index.",
 		Long:    "Updates the libraries index to the latest version.",
@@ -38,12 +43,16 @@ func initUpdateIndexCommand() *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			instance := instance.CreateInstanceIgnorePlatformIndexErrors()
 			err := commands.UpdateLibrariesIndex(context.Background(), &rpc.UpdateLibrariesIndexReq{
-				Instance: instance,
+				Instance:    instance,
+				IfOlderThan: updateIndexFlags.ifOlderThan.String(),
 			}, output.ProgressBar())
 			if err != nil {
 				feedback.Errorf("Error updating library index: %v", err)
-				os.Exit(errorcodes.ErrGeneric)
+				os.Exit(errorcodes.FromError(err))
 			}
 		},
 	}
+	updateIndexCommand.Flags().DurationVar(&updateIndexFlags.ifOlderThan, "if-older-than", 0,
+		"Skip the update if the index has been checked less than this duration ago, e.g. 1h.")
+	return updateIndexCommand
 }
@@ -16,13 +16,18 @@
 package lib
 
 import (
+	"context"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -36,29 +41,88 @@ func initUpgradeCommand() *cobra.Command {
 			"the command will upgrade all the installed libraries where an update is available.",
 		Example: "  " + os.Args[0] + " lib upgrade \n" +
 			"  " + os.Args[0] + " lib upgrade Audio\n" +
-			"  " + os.Args[0] + " lib upgrade Audio ArduinoJson",
+			"  " + os.Args[0] + " lib upgrade Audio ArduinoJson\n" +
+			"  " + os.Args[0] + " lib upgrade --dry-run",
 		Args: cobra.ArbitraryArgs,
 		Run:  runUpgradeCommand,
 	}
+	listCommand.Flags().BoolVar(&upgradeFlags.dryRun, "dry-run", false, "Show the libraries that would be upgraded without upgrading them.")
 	return listCommand
 }
 
+var upgradeFlags struct {
+	dryRun bool
+}
+
 func runUpgradeCommand(cmd *cobra.Command, args []string) {
 	instance := instance.CreateInstanceIgnorePlatformIndexErrors()
 
+	if upgradeFlags.dryRun {
+		res, err := lib.LibraryList(context.Background(), &rpc.LibraryListReq{
+			Instance:  instance,
+			All:       true,
+			Updatable: true,
+		})
+		if err != nil {
+			feedback.Errorf("Error listing libraries: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		plan := []*rpc.InstalledLibrary{}
+		for _, installed := range res.GetInstalledLibrary() {
+			if len(args) == 0 || contains(args, installed.GetLibrary().GetName()) {
+				plan = append(plan, installed)
+			}
+		}
+		feedback.PrintResult(upgradePlanResult{plan})
+		return
+	}
+
 	if len(args) == 0 {
-		err := lib.LibraryUpgradeAll(instance.Id, output.ProgressBar(), output.TaskProgress())
+		err := lib.LibraryUpgradeAll(context.Background(), instance.Id, output.ProgressBar(), output.TaskProgress())
 		if err != nil {
 			feedback.Errorf("Error upgrading libraries: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
 	} else {
-		err := lib.LibraryUpgrade(instance.Id, args, output.ProgressBar(), output.TaskProgress())
+		err := lib.LibraryUpgrade(context.Background(), instance.Id, args, output.ProgressBar(), output.TaskProgress())
 		if err != nil {
 			feedback.Errorf("Error upgrading libraries: %v", err)
-			os.Exit(errorcodes.ErrGeneric)
+			os.Exit(errorcodes.FromError(err))
 		}
 	}
 
 	logrus.Info("Done")
 }
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// upgradePlanResult lists the libraries that would be upgraded
+type upgradePlanResult struct {
+	libs []*rpc.InstalledLibrary
+}
+
+func (r upgradePlanResult) Data() interface{} {
+	return r.libs
+}
+
+func (r upgradePlanResult) String() string {
+	if len(r.libs) == 0 {
+		return "All the libraries are already at the latest version."
+	}
+	sort.Slice(r.libs, func(i, j int) bool {
+		return strings.ToLower(r.libs[i].GetLibrary().GetName()) < strings.ToLower(r.libs[j].GetLibrary().GetName())
+	})
+	t := table.New()
+	t.SetHeader("Name", "Installed", "Upgrade to")
+	for _, installed := range r.libs {
+		t.AddRow(installed.GetLibrary().GetName(), installed.GetLibrary().GetVersion(), installed.GetRelease().GetVersion())
+	}
+	return t.Render()
+}
@@ -0,0 +1,42 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package logging
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// FieldsHook adds the given fields to every log entry, unless the entry
+// already has a field with the same name. It must be added before the hooks
+// writing the entries, so that they see the fields.
+type FieldsHook struct {
+	Fields logrus.Fields
+}
+
+// Levels returns all the log levels
+func (h *FieldsHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire adds the fields to the entry
+func (h *FieldsHook) Fire(entry *logrus.Entry) error {
+	for name, value := range h.Fields {
+		if _, has := entry.Data[name]; !has {
+			entry.Data[name] = value
+		}
+	}
+	return nil
+}
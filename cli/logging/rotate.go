@@ -0,0 +1,119 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// RotatingFile is a log file that is rotated when it grows over a maximum
+// size: the file is renamed appending `.1` to its name, the previous rotated
+// files are shifted to `.2`, `.3` and so on, up to the maximum number of
+// backups, and the oldest one is removed.
+type RotatingFile struct {
+	path       *paths.Path
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	lock       sync.Mutex
+}
+
+// OpenRotatingFile opens the log file for appending, creating it if missing.
+// A maxSize of 0 disables the rotation.
+func OpenRotatingFile(path *paths.Path, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path.String(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// Write appends the data to the log file, the file is rotated first if the
+// data doesn't fit in it. The data is never split between two files.
+func (f *RotatingFile) Write(data []byte) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(data)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(data)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close closes the log file
+func (f *RotatingFile) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.file.Close()
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	backup := func(n int) *paths.Path {
+		return paths.New(fmt.Sprintf("%s.%d", f.path, n))
+	}
+	if f.maxBackups < 1 {
+		if err := f.path.Remove(); err != nil {
+			return err
+		}
+		return f.open()
+	}
+	if oldest := backup(f.maxBackups); oldest.Exist() {
+		if err := oldest.Remove(); err != nil {
+			return err
+		}
+	}
+	for n := f.maxBackups - 1; n >= 1; n-- {
+		if backup(n).Exist() {
+			if err := backup(n).Rename(backup(n + 1)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := f.path.Rename(backup(1)); err != nil {
+		return err
+	}
+	return f.open()
+}
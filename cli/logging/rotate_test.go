@@ -0,0 +1,68 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	logFile := tmp.Join("cli.log")
+	require.NoError(t, logFile.WriteFile([]byte("old\n")))
+
+	f, err := OpenRotatingFile(logFile, 10, 2)
+	require.NoError(t, err)
+	// the existing content is kept
+	_, err = f.Write([]byte("first\n"))
+	require.NoError(t, err)
+	// no room left, the file is rotated
+	_, err = f.Write([]byte("second\n"))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("third\n"))
+	require.NoError(t, err)
+	_, err = f.Write([]byte("fourth\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	data, err := logFile.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "fourth\n", string(data))
+	data, err = tmp.Join("cli.log.1").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "third\n", string(data))
+	data, err = tmp.Join("cli.log.2").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "second\n", string(data))
+	// only 2 backups are kept
+	require.False(t, tmp.Join("cli.log.3").Exist())
+
+	// the rotation is disabled
+	f, err = OpenRotatingFile(logFile, 0, 2)
+	require.NoError(t, err)
+	_, err = f.Write([]byte("a very long line that doesn't fit\n"))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	data, err = logFile.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "fourth\na very long line that doesn't fit\n", string(data))
+}
@@ -58,27 +58,80 @@ func runOutdatedCommand(cmd *cobra.Command, args []string) {
 	})
 	if err != nil {
 		feedback.Errorf("Error retrieving outdated cores and libraries: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	feedback.PrintResult(NewResult(outdatedResp))
+
+	logrus.Info("Done")
+}
+
+// NewResult wraps the response of the Outdated command to be printed
+// with feedback.PrintResult
+func NewResult(resp *rpc.OutdatedResp) feedback.Result {
+	res := &outdatedResult{
+		Platforms: []*outdatedEntry{},
+		Libraries: []*outdatedEntry{},
+	}
+	for _, p := range resp.GetOutdatedPlatform() {
+		res.Platforms = append(res.Platforms, &outdatedEntry{
+			ID:        p.GetID(),
+			Name:      p.GetName(),
+			Installed: p.GetInstalled(),
+			Latest:    p.GetLatest(),
+		})
 	}
+	for _, l := range resp.GetOutdatedLibrary() {
+		res.Libraries = append(res.Libraries, &outdatedEntry{
+			Name:      l.GetLibrary().GetName(),
+			Installed: l.GetLibrary().GetVersion(),
+			Latest:    l.GetRelease().GetVersion(),
+		})
+	}
+	return res
+}
+
+type outdatedEntry struct {
+	ID        string `json:"id,omitempty"`
+	Name      string `json:"name"`
+	Installed string `json:"installed"`
+	Latest    string `json:"latest"`
+}
+
+type outdatedResult struct {
+	Platforms []*outdatedEntry `json:"platforms"`
+	Libraries []*outdatedEntry `json:"libraries"`
+}
+
+func (or outdatedResult) Data() interface{} {
+	return or
+}
+
+func (or outdatedResult) String() string {
+	res := ""
 
 	// Prints outdated cores
-	tab := table.New()
-	tab.SetHeader("Core name", "Installed version", "New version")
-	if len(outdatedResp.OutdatedPlatform) > 0 {
-		for _, p := range outdatedResp.OutdatedPlatform {
+	if len(or.Platforms) > 0 {
+		tab := table.New()
+		tab.SetHeader("Core name", "Installed version", "New version")
+		for _, p := range or.Platforms {
 			tab.AddRow(p.Name, p.Installed, p.Latest)
 		}
-		feedback.Print(tab.Render())
+		res += tab.Render()
 	}
 
 	// Prints outdated libraries
-	tab = table.New()
-	tab.SetHeader("Library name", "Installed version", "New version")
-	if len(outdatedResp.OutdatedLibrary) > 0 {
-		for _, l := range outdatedResp.OutdatedLibrary {
-			tab.AddRow(l.Library.Name, l.Library.Version, l.Release.Version)
+	if len(or.Libraries) > 0 {
+		if res != "" {
+			res += "\n"
 		}
-		feedback.Print(tab.Render())
+		tab := table.New()
+		tab.SetHeader("Library name", "Installed version", "New version")
+		for _, l := range or.Libraries {
+			tab.AddRow(l.Name, l.Installed, l.Latest)
+		}
+		res += tab.Render()
 	}
 
-	logrus.Info("Done")
+	return res
 }
@@ -0,0 +1,75 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package output
+
+import (
+	"fmt"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+)
+
+// CachedArchivesResult is the feedback.Result of the commands cleaning the
+// downloads directory
+type CachedArchivesResult struct {
+	Archives []*rpc.CachedArchive `json:"archives"`
+	Size     int64                `json:"size"`
+	DryRun   bool                 `json:"dry_run"`
+}
+
+// Data implements feedback.Result
+func (r CachedArchivesResult) Data() interface{} {
+	return r
+}
+
+// String implements feedback.Result
+func (r CachedArchivesResult) String() string {
+	if len(r.Archives) == 0 {
+		return tr("No archives to remove.")
+	}
+	downloadsDir := paths.New(viper.GetString("directories.Downloads"))
+	t := table.New()
+	t.SetHeader(tr("Archive"), tr("Size"), tr("Downloaded"))
+	for _, archive := range r.Archives {
+		path := paths.New(archive.GetPath())
+		if rel, err := downloadsDir.RelTo(path); err == nil {
+			path = rel
+		}
+		t.AddRow(path.String(), formatSize(archive.GetSize()), time.Unix(archive.GetModified(), 0).Format("2006-01-02"))
+	}
+	if r.DryRun {
+		return t.Render() + tr("%d archives, %s would be freed", len(r.Archives), formatSize(r.Size))
+	}
+	return t.Render() + tr("%d archives removed, %s freed", len(r.Archives), formatSize(r.Size))
+}
+
+// formatSize formats a size in bytes using the largest fitting unit
+func formatSize(size int64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+	value := float64(size)
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%d %s", size, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", value, units[unit])
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/cmaglie/pb"
 )
@@ -26,6 +27,8 @@ import (
 // OutputFormat can be "text" or "json"
 var OutputFormat string
 
+var tr = i18n.Tr
+
 // ProgressBar returns a DownloadProgressCB that prints a progress bar.
 // If JSON output format has been selected, the callback outputs nothing.
 func ProgressBar() commands.DownloadProgressCB {
@@ -57,7 +60,7 @@ func NewDownloadProgressBarCB() func(*rpc.DownloadProgress) {
 		// fmt.Printf(">>> %v\n", curr)
 		if filename := curr.GetFile(); filename != "" {
 			if curr.GetCompleted() {
-				fmt.Println(filename + " already downloaded")
+				fmt.Println(tr("%s already downloaded", filename))
 				return
 			}
 			prefix = filename
@@ -69,7 +72,7 @@ func NewDownloadProgressBarCB() func(*rpc.DownloadProgress) {
 			bar.Set(int(curr.GetDownloaded()))
 		}
 		if curr.GetCompleted() {
-			bar.FinishPrintOver(prefix + " downloaded")
+			bar.FinishPrintOver(tr("%s downloaded", prefix))
 		}
 	}
 }
@@ -85,7 +88,16 @@ func NewTaskProgressCB() func(curr *rpc.TaskProgress) {
 	var name string
 	return func(curr *rpc.TaskProgress) {
 		// fmt.Printf(">>> %v\n", curr)
+		if out := curr.GetOutput(); out != "" {
+			// Output of a running tool is printed as is
+			fmt.Println(out)
+			return
+		}
 		msg := curr.GetMessage()
+		if msg == "" && curr.GetPercent() != 0 && !curr.GetCompleted() {
+			// Percentage-only updates are not printed
+			return
+		}
 		if curr.GetName() != "" {
 			name = curr.GetName()
 			if msg == "" {
@@ -0,0 +1,95 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package selfupdate
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/cli/output"
+	"github.com/arduino/arduino-cli/commands/updater"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var selfUpdateFlags struct {
+	check   bool
+	channel string
+}
+
+// NewCommand creates a new `self-update` command
+func NewCommand() *cobra.Command {
+	selfUpdateCommand := &cobra.Command{
+		Use:   "self-update",
+		Short: "Updates Arduino CLI to the latest release.",
+		Long: "Downloads the latest release of Arduino CLI, verifies its signature and replaces the executable in use. " +
+			"The release channel, stable or nightly, is taken from the updater.channel setting unless specified.",
+		Example: "" +
+			"  " + os.Args[0] + " self-update --check\n" +
+			"  " + os.Args[0] + " self-update --channel nightly",
+		Args: cobra.NoArgs,
+		Run:  runSelfUpdateCommand,
+	}
+	selfUpdateCommand.Flags().BoolVar(&selfUpdateFlags.check, "check", false, "Only check if a newer release is available")
+	selfUpdateCommand.Flags().StringVar(&selfUpdateFlags.channel, "channel", "", "Release channel to use, stable or nightly")
+	return selfUpdateCommand
+}
+
+func runSelfUpdateCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino self-update`")
+
+	currentVersion := globals.VersionInfo.VersionString
+	if selfUpdateFlags.check {
+		resp, err := updater.CheckForUpdates(context.Background(), &rpc.CheckForUpdatesReq{
+			Channel: selfUpdateFlags.channel,
+		}, currentVersion)
+		if err != nil {
+			feedback.Errorf("Error checking for updates: %v", err)
+			os.Exit(errorcodes.ErrNetwork)
+		}
+		feedback.PrintResult(checkResult{resp})
+		return
+	}
+
+	_, err := updater.SelfUpdate(context.Background(), &rpc.SelfUpdateReq{
+		Channel: selfUpdateFlags.channel,
+	}, currentVersion, output.ProgressBar(), output.TaskProgress())
+	if err != nil {
+		feedback.Errorf("Error updating Arduino CLI: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+}
+
+type checkResult struct {
+	resp *rpc.CheckForUpdatesResp
+}
+
+func (cr checkResult) Data() interface{} {
+	return cr.resp
+}
+
+func (cr checkResult) String() string {
+	if !cr.resp.GetUpdateAvailable() {
+		return fmt.Sprintf("Arduino CLI %s is up to date", cr.resp.GetCurrentVersion())
+	}
+	return fmt.Sprintf("A new release of Arduino CLI is available: %s (in use: %s)\n%s",
+		cr.resp.GetLatestVersion(), cr.resp.GetCurrentVersion(), cr.resp.GetUrl())
+}
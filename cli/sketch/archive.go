@@ -21,13 +21,19 @@ import (
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
 	"github.com/arduino/arduino-cli/commands/sketch"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
-var includeBuildDir bool
+var (
+	includeBuildDir bool
+	includeManifest bool
+	fqbn            string
+	exclude         []string
+)
 
 // initArchiveCommand creates a new `archive` command
 func initArchiveCommand() *cobra.Command {
@@ -46,6 +52,9 @@ func initArchiveCommand() *cobra.Command {
 	}
 
 	command.Flags().BoolVar(&includeBuildDir, "include-build-dir", false, "Includes build directory in the archive.")
+	command.Flags().BoolVar(&includeManifest, "include-manifest", false, "Includes a manifest of the platform and libraries used by the sketch.")
+	command.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name used to find the platform listed in the manifest, e.g.: arduino:avr:uno")
+	command.Flags().StringSliceVar(&exclude, "exclude", []string{}, "Excludes the files matching the given .gitignore-style patterns, in addition to the ones in the sketch .gitignore.")
 
 	return command
 }
@@ -63,11 +72,24 @@ func runArchiveCommand(cmd *cobra.Command, args []string) {
 		archivePath = args[1]
 	}
 
+	var inst *rpc.Instance
+	if includeManifest {
+		var err error
+		if inst, err = instance.CreateInstance(); err != nil {
+			feedback.Errorf("Error archiving: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+
 	_, err := sketch.ArchiveSketch(context.Background(),
 		&rpc.ArchiveSketchReq{
+			Instance:        inst,
 			SketchPath:      sketchPath,
 			ArchivePath:     archivePath,
 			IncludeBuildDir: includeBuildDir,
+			IncludeManifest: includeManifest,
+			Fqbn:            fqbn,
+			Exclude:         exclude,
 		})
 
 	if err != nil {
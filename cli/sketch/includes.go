@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var includesFlags struct {
+	fqbn            string
+	buildPath       string
+	buildProperties []string
+	libraries       []string
+	library         []string
+	profile         string
+}
+
+func initIncludesCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "includes [sketchPath]",
+		Short: "Lists the libraries used by the sketch and the missing includes.",
+		Long: "Lists the libraries used by the sketch and the missing includes.\n" +
+			"Only the detection of the includes is run, nothing is compiled. The headers\n" +
+			"that can't be found in the core or in the installed libraries are reported\n" +
+			"with the libraries of the library index that provide them.",
+		Example: "" +
+			"  " + os.Args[0] + " sketch includes -b arduino:avr:uno /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " sketch includes -b arduino:avr:uno --format json .",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runIncludesCommand,
+	}
+
+	command.Flags().StringVarP(&includesFlags.fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	command.Flags().StringVar(&includesFlags.buildPath, "build-path", "", "Path where to save the files used to find the includes.")
+	command.Flags().StringArrayVar(&includesFlags.buildProperties, "build-property", []string{},
+		"Override a build property with a custom value. Can be used multiple times for multiple properties.")
+	command.Flags().StringSliceVar(&includesFlags.libraries, "libraries", []string{},
+		"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.")
+	command.Flags().StringArrayVar(&includesFlags.library, "library", []string{},
+		"Path to a single library's root folder. Can be used multiple times for multiple libraries.")
+	command.Flags().StringVar(&includesFlags.profile, "profile", "", "Build profile of the sketch.yaml file to use, if omitted the default profile is used.")
+
+	return command
+}
+
+func runIncludesCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino sketch includes`")
+
+	sketchPath := paths.New(".")
+	if len(args) > 0 {
+		sketchPath = paths.New(args[0])
+	}
+	if err := configuration.MergeSketchConfig(sketchPath); err != nil {
+		feedback.Errorf("Error reading sketch config file: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error creating instance: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res, err := compile.SketchIncludes(context.Background(), &rpc.SketchIncludesReq{
+		Instance:        inst,
+		Fqbn:            includesFlags.fqbn,
+		SketchPath:      sketchPath.String(),
+		BuildPath:       includesFlags.buildPath,
+		BuildProperties: includesFlags.buildProperties,
+		Libraries:       includesFlags.libraries,
+		Library:         includesFlags.library,
+		Profile:         includesFlags.profile,
+	}, feedback.ErrorWriter())
+	if err != nil {
+		feedback.Errorf("Error finding the includes of the sketch: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	feedback.PrintResult(&sketchIncludesResult{
+		Libraries:          res.GetLibraries(),
+		UnresolvedIncludes: res.GetUnresolvedIncludes(),
+	})
+}
+
+type sketchIncludesResult struct {
+	Libraries          []*rpc.LibraryResolution `json:"libraries"`
+	UnresolvedIncludes []*rpc.UnresolvedInclude `json:"unresolved_includes"`
+}
+
+func (r *sketchIncludesResult) Data() interface{} {
+	return r
+}
+
+func (r *sketchIncludesResult) String() string {
+	if len(r.Libraries) == 0 && len(r.UnresolvedIncludes) == 0 {
+		return "The sketch doesn't use any library."
+	}
+
+	res := ""
+	if len(r.Libraries) > 0 {
+		t := table.New()
+		t.SetHeader("Header", "Library", "Version", "Location")
+		for _, resolution := range r.Libraries {
+			used := resolution.GetUsed()
+			t.AddRow(resolution.GetHeader(), used.GetName(), used.GetVersion(), used.GetLocation().String())
+		}
+		res += t.Render()
+	}
+
+	if len(r.UnresolvedIncludes) > 0 {
+		if res != "" {
+			res += "\n"
+		}
+		t := table.New()
+		t.SetHeader("Missing header", "Included by", "Provided by")
+		for _, unresolved := range r.UnresolvedIncludes {
+			t.AddRow(unresolved.GetHeader(), paths.New(unresolved.GetFile()).Base(), strings.Join(unresolved.GetSuggestedLibraries(), ", "))
+		}
+		res += t.Render()
+	}
+	return res
+}
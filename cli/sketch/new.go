@@ -16,36 +16,41 @@
 package sketch
 
 import (
-	"io/ioutil"
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/commands/sketch"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/spf13/cobra"
 )
 
+var newFlags struct {
+	template string
+	author   string
+}
+
 func initNewCommand() *cobra.Command {
 	newCommand := &cobra.Command{
-		Use:     "new",
-		Short:   "Create a new Sketch",
-		Long:    "Create a new Sketch",
-		Example: "  " + os.Args[0] + " sketch new MultiBlinker",
-		Args:    cobra.ExactArgs(1),
-		Run:     runNewCommand,
+		Use:   "new",
+		Short: "Create a new Sketch",
+		Long: "Create a new Sketch.\n" +
+			"A template can be used to scaffold the Sketch: templates are folders in the\n" +
+			"'templates' subfolder of the user directory, the {{name}}, {{author}} and\n" +
+			"{{date}} placeholders are replaced in file names and contents.",
+		Example: "  " + os.Args[0] + " sketch new MultiBlinker\n" +
+			"  " + os.Args[0] + " sketch new MultiBlinker --template my-template --author \"John Doe\"",
+		Args: cobra.ExactArgs(1),
+		Run:  runNewCommand,
 	}
+	newCommand.Flags().StringVarP(&newFlags.template, "template", "t", "", "Template name or path to a template folder.")
+	newCommand.Flags().StringVar(&newFlags.author, "author", "", "Author of the Sketch, defaults to the current user.")
 	return newCommand
 }
 
-var emptySketch = []byte(`
-void setup() {
-}
-
-void loop() {
-}
-`)
-
 func runNewCommand(cmd *cobra.Command, args []string) {
 	// Trim to avoid issues if user creates a sketch adding the .ino extesion to the name
 	trimmedSketchName := strings.TrimSuffix(args[0], ".ino")
@@ -54,16 +59,29 @@ func runNewCommand(cmd *cobra.Command, args []string) {
 		feedback.Errorf("Error creating sketch: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
-	if err := os.MkdirAll(sketchDir, os.FileMode(0755)); err != nil {
-		feedback.Errorf("Could not create sketch directory: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
-	}
-	sketchName := filepath.Base(sketchDir)
-	sketchFile := filepath.Join(sketchDir, sketchName+".ino")
-	if err := ioutil.WriteFile(sketchFile, emptySketch, os.FileMode(0644)); err != nil {
+
+	_, err = sketch.NewSketch(context.Background(), &rpc.NewSketchReq{
+		SketchName: filepath.Base(sketchDir),
+		SketchDir:  filepath.Dir(sketchDir),
+		Template:   newFlags.template,
+		Author:     newFlags.author,
+	})
+	if err != nil {
 		feedback.Errorf("Error creating sketch: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	feedback.Print("Sketch created in: " + sketchDir)
+	feedback.PrintResult(&sketchNewResult{SketchPath: sketchDir})
+}
+
+type sketchNewResult struct {
+	SketchPath string `json:"sketch_path"`
+}
+
+func (r *sketchNewResult) Data() interface{} {
+	return r
+}
+
+func (r *sketchNewResult) String() string {
+	return "Sketch created in: " + r.SketchPath
 }
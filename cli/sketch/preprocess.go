@@ -0,0 +1,137 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/commands/compile"
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var preprocessFlags struct {
+	fqbn            string
+	outputDir       string
+	buildPath       string
+	buildProperties []string
+	libraries       []string
+	library         []string
+	profile         string
+}
+
+func initPreprocessCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "preprocess [sketchPath]",
+		Short: "Translates the sketch into the C++ source that is compiled.",
+		Long: "Translates the sketch into the C++ source that is compiled.\n" +
+			"The .ino files are merged, the missing prototypes are generated and the\n" +
+			"#line directives pointing to the original files are added. The generated\n" +
+			"source is printed on the standard output, or saved in the --output-dir directory.",
+		Example: "" +
+			"  " + os.Args[0] + " sketch preprocess -b arduino:avr:uno /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " sketch preprocess -b arduino:avr:uno --output-dir /tmp/MySketch .",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runPreprocessCommand,
+	}
+
+	command.Flags().StringVarP(&preprocessFlags.fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	command.Flags().StringVar(&preprocessFlags.outputDir, "output-dir", "", "Save the generated source in this directory instead of printing it.")
+	command.Flags().StringVar(&preprocessFlags.buildPath, "build-path", "", "Path where to save the files used to preprocess the sketch.")
+	command.Flags().StringArrayVar(&preprocessFlags.buildProperties, "build-property", []string{},
+		"Override a build property with a custom value. Can be used multiple times for multiple properties.")
+	command.Flags().StringSliceVar(&preprocessFlags.libraries, "libraries", []string{},
+		"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.")
+	command.Flags().StringArrayVar(&preprocessFlags.library, "library", []string{},
+		"Path to a single library's root folder. Can be used multiple times for multiple libraries.")
+	command.Flags().StringVar(&preprocessFlags.profile, "profile", "", "Build profile of the sketch.yaml file to use, if omitted the default profile is used.")
+
+	return command
+}
+
+func runPreprocessCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino sketch preprocess`")
+
+	sketchPath := paths.New(".")
+	if len(args) > 0 {
+		sketchPath = paths.New(args[0])
+	}
+	if err := configuration.MergeSketchConfig(sketchPath); err != nil {
+		feedback.Errorf("Error reading sketch config file: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	inst, err := instance.CreateInstance()
+	if err != nil {
+		feedback.Errorf("Error creating instance: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	res, err := compile.Preprocess(context.Background(), &rpc.PreprocessReq{
+		Instance:        inst,
+		Fqbn:            preprocessFlags.fqbn,
+		SketchPath:      sketchPath.String(),
+		BuildPath:       preprocessFlags.buildPath,
+		BuildProperties: preprocessFlags.buildProperties,
+		Libraries:       preprocessFlags.libraries,
+		Library:         preprocessFlags.library,
+		Profile:         preprocessFlags.profile,
+	}, feedback.ErrorWriter())
+	if err != nil {
+		feedback.Errorf("Error preprocessing sketch: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
+	result := &sketchPreprocessResult{FileName: res.GetFileName(), Source: res.GetSource()}
+	if preprocessFlags.outputDir != "" {
+		outputDir := paths.New(preprocessFlags.outputDir)
+		if err := outputDir.MkdirAll(); err != nil {
+			feedback.Errorf("Error creating output directory: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		outputFile := outputDir.Join(res.GetFileName())
+		if err := outputFile.WriteFile([]byte(res.GetSource())); err != nil {
+			feedback.Errorf("Error saving preprocessed sketch: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+		result = &sketchPreprocessResult{FileName: res.GetFileName(), Path: outputFile.String()}
+	}
+	feedback.PrintResult(result)
+}
+
+type sketchPreprocessResult struct {
+	FileName string `json:"file_name"`
+	Source   string `json:"source,omitempty"`
+	Path     string `json:"path,omitempty"`
+}
+
+func (r *sketchPreprocessResult) Data() interface{} {
+	return r
+}
+
+func (r *sketchPreprocessResult) String() string {
+	if r.Path != "" {
+		return "Preprocessed sketch saved in " + r.Path
+	}
+	return r.Source
+}
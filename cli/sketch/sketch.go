@@ -32,6 +32,8 @@ func NewCommand() *cobra.Command {
 
 	cmd.AddCommand(initNewCommand())
 	cmd.AddCommand(initArchiveCommand())
+	cmd.AddCommand(initPreprocessCommand())
+	cmd.AddCommand(initIncludesCommand())
 
 	return cmd
 }
@@ -22,10 +22,10 @@ import (
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/outdated"
 	"github.com/arduino/arduino-cli/cli/output"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
-	"github.com/arduino/arduino-cli/table"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -67,27 +67,9 @@ func runUpdateCommand(cmd *cobra.Command, args []string) {
 		})
 		if err != nil {
 			feedback.Errorf("Error retrieving outdated cores and libraries: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
 		}
-
-		// Prints outdated cores
-		tab := table.New()
-		tab.SetHeader("Core name", "Installed version", "New version")
-		if len(outdatedResp.OutdatedPlatform) > 0 {
-			for _, p := range outdatedResp.OutdatedPlatform {
-				tab.AddRow(p.Name, p.Installed, p.Latest)
-			}
-			feedback.Print(tab.Render())
-		}
-
-		// Prints outdated libraries
-		tab = table.New()
-		tab.SetHeader("Library name", "Installed version", "New version")
-		if len(outdatedResp.OutdatedLibrary) > 0 {
-			for _, l := range outdatedResp.OutdatedLibrary {
-				tab.AddRow(l.Library.Name, l.Library.Version, l.Release.Version)
-			}
-			feedback.Print(tab.Render())
-		}
+		feedback.PrintResult(outdated.NewResult(outdatedResp))
 	}
 
 	logrus.Info("Done")
@@ -54,9 +54,11 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 
 	logrus.Info("Executing `arduino upgrade`")
 
+	// The post-install scripts can't be confirmed one by one while upgrading
+	// everything, they run only if --run-post-install is set
 	err = commands.Upgrade(context.Background(), &rpc.UpgradeReq{
 		Instance:        inst,
-		SkipPostInstall: core.DetectSkipPostInstallValue(),
+		SkipPostInstall: core.DetectSkipPostInstallValue() || core.AskPostInstallConfirmation(),
 	}, output.NewDownloadProgressBarCB(), output.TaskProgress())
 
 	if err != nil {
@@ -0,0 +1,139 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/arduino/arduino-cli/cli/errorcodes"
+	"github.com/arduino/arduino-cli/cli/feedback"
+	"github.com/arduino/arduino-cli/cli/interrupt"
+	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/table"
+	"github.com/arduino/go-paths-helper"
+	"github.com/fatih/color"
+)
+
+var tr = i18n.Tr
+
+// runMultiple uploads the sketch to all the requested boards at the same
+// time, a line is printed as soon as each upload is done and a summary table
+// at the end. It exits with an error if any of the uploads failed.
+func runMultiple(instance *rpc.Instance, sketchPath *paths.Path) {
+	res := &multipleResult{Uploads: []*portUploadResult{}}
+	stdout := map[string]*bytes.Buffer{}
+	stderr := map[string]*bytes.Buffer{}
+	buffer := func(buffers map[string]*bytes.Buffer, port string) *bytes.Buffer {
+		if buffers[port] == nil {
+			buffers[port] = &bytes.Buffer{}
+		}
+		return buffers[port]
+	}
+
+	ctx, stop := interrupt.Context()
+	defer stop()
+	err := upload.UploadMultiple(ctx, &rpc.UploadMultipleReq{
+		Instance:    instance,
+		Fqbn:        fqbn,
+		SketchPath:  sketchPath.String(),
+		Ports:       ports,
+		AllMatching: allMatching,
+		Verbose:     verbose,
+		Verify:      verify,
+		ImportFile:  importFile,
+		ImportDir:   importDir,
+		Programmer:  programmer,
+		Jobs:        jobs,
+		Filesystem:  filesystem,
+	}, func(resp *rpc.UploadMultipleResp) {
+		port := resp.GetPort()
+		buffer(stdout, port).Write(resp.GetOutStream())
+		buffer(stderr, port).Write(resp.GetErrStream())
+		if resp.GetResult() == nil {
+			return
+		}
+
+		result := &portUploadResult{
+			Port:      port,
+			Success:   resp.GetResult().GetSuccess(),
+			Error:     resp.GetResult().GetError(),
+			UploadOut: buffer(stdout, port).String(),
+			UploadErr: buffer(stderr, port).String(),
+		}
+		res.Uploads = append(res.Uploads, result)
+
+		if feedback.GetFormat() == feedback.Text {
+			if result.Success {
+				feedback.Printf("%s %s", color.GreenString("PASS"), result.Port)
+			} else {
+				feedback.Printf("%s %s: %s\n%s", color.RedString("FAIL"), result.Port, result.Error, result.UploadErr)
+			}
+		}
+	})
+	if err != nil {
+		feedback.Errorf("Error during Upload: %v", err)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	feedback.PrintResult(res)
+	if res.Failed() > 0 {
+		feedback.Errorf("%d of %d uploads failed", res.Failed(), len(res.Uploads))
+		os.Exit(errorcodes.ErrToolFailure)
+	}
+}
+
+type portUploadResult struct {
+	Port      string `json:"port"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+	UploadOut string `json:"upload_out"`
+	UploadErr string `json:"upload_err"`
+}
+
+type multipleResult struct {
+	Uploads []*portUploadResult `json:"uploads"`
+}
+
+// Failed returns the number of failed uploads
+func (r *multipleResult) Failed() int {
+	failed := 0
+	for _, upload := range r.Uploads {
+		if !upload.Success {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (r *multipleResult) Data() interface{} {
+	return r
+}
+
+func (r *multipleResult) String() string {
+	t := table.New()
+	t.SetHeader(tr("Port"), tr("Result"), tr("Error"))
+	for _, upload := range r.Uploads {
+		result := color.GreenString(tr("passed"))
+		if !upload.Success {
+			result = color.RedString(tr("failed"))
+		}
+		t.AddRow(upload.Port, result, upload.Error)
+	}
+	return "\n" + t.Render() + "\n" + tr("%d uploads, %d failed", len(r.Uploads), r.Failed())
+}
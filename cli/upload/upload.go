@@ -17,48 +17,72 @@ package upload
 
 import (
 	"context"
+	"io"
 	"os"
+	"os/signal"
 
+	"github.com/arduino/arduino-cli/arduino/monitors"
 	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/instance"
+	"github.com/arduino/arduino-cli/cli/interrupt"
 	"github.com/arduino/arduino-cli/commands/upload"
+	"github.com/arduino/arduino-cli/configuration"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/arduino/arduino-cli/table"
 	"github.com/arduino/go-paths-helper"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 var (
-	fqbn       string
-	port       string
-	verbose    bool
-	verify     bool
-	importDir  string
-	importFile string
-	programmer string
+	fqbn        string
+	ports       []string
+	allMatching string
+	jobs        int32
+	verbose     bool
+	verify      bool
+	importDir   string
+	importFile  string
+	programmer  string
+	filesystem  bool
+
+	attachMonitor   bool
+	monitorBaudRate int
 )
 
 // NewCommand created a new `upload` command
 func NewCommand() *cobra.Command {
 	uploadCommand := &cobra.Command{
-		Use:     "upload",
-		Short:   "Upload Arduino sketches.",
-		Long:    "Upload Arduino sketches. This does NOT compile the sketch prior to upload.",
-		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch",
-		Args:    cobra.MaximumNArgs(1),
-		PreRun:  checkFlagsConflicts,
-		Run:     run,
+		Use:   "upload",
+		Short: "Upload Arduino sketches.",
+		Long: "Upload Arduino sketches. This does NOT compile the sketch prior to upload.\n" +
+			"If the FQBN is not given, the board connected to the port is detected automatically.",
+		Example: "  " + os.Args[0] + " upload /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -b arduino:avr:uno -p /dev/ttyACM0 -i /home/user/firmware/Blink.ino.hex\n" +
+			"  " + os.Args[0] + " upload -p /dev/ttyACM0 /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -p /dev/ttyACM0 --fs /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -p /dev/ttyACM0 --attach-monitor --monitor-baudrate 115200 /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload -b arduino:avr:uno -p /dev/ttyACM0 -p /dev/ttyACM1 /home/user/Arduino/MySketch\n" +
+			"  " + os.Args[0] + " upload --all-matching arduino:avr:uno /home/user/Arduino/MySketch",
+		Args:   cobra.MaximumNArgs(1),
+		PreRun: checkFlagsConflicts,
+		Run:    run,
 	}
 
 	uploadCommand.Flags().StringVarP(&fqbn, "fqbn", "b", "", "Fully Qualified Board Name, e.g.: arduino:avr:uno")
-	uploadCommand.Flags().StringVarP(&port, "port", "p", "", "Upload port, e.g.: COM10 or /dev/ttyACM0")
+	uploadCommand.Flags().StringArrayVarP(&ports, "port", "p", []string{}, "Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>. Can be used multiple times to upload to many boards at the same time.")
+	uploadCommand.Flags().StringVar(&allMatching, "all-matching", "", "Upload to all the connected boards identified by the given FQBN, e.g.: arduino:avr:uno")
+	uploadCommand.Flags().Int32VarP(&jobs, "jobs", "j", 0, "Max number of boards uploaded at the same time when uploading to many boards, all of them if set to 0.")
 	uploadCommand.Flags().StringVarP(&importDir, "input-dir", "", "", "Directory containing binaries to upload.")
-	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", "Binary file to upload.")
+	uploadCommand.Flags().StringVarP(&importFile, "input-file", "i", "", "Binary file to upload (e.g. path/to/Sketch.ino.hex), no sketch is needed.")
 	uploadCommand.Flags().BoolVarP(&verify, "verify", "t", false, "Verify uploaded binary after the upload.")
 	uploadCommand.Flags().BoolVarP(&verbose, "verbose", "v", false, "Optional, turns on verbose mode.")
-	uploadCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Optional, use the specified programmer to upload.")
+	uploadCommand.Flags().StringVarP(&programmer, "programmer", "P", "", "Optional, use the specified programmer to upload or 'list' to list supported programmers.")
+	uploadCommand.Flags().BoolVar(&filesystem, "fs", false, "Upload the filesystem image built with compile --build-fs instead of the sketch.")
+	uploadCommand.Flags().BoolVar(&attachMonitor, "attach-monitor", false, "Open the serial monitor on the board port after a successful upload.")
+	uploadCommand.Flags().IntVar(&monitorBaudRate, "monitor-baudrate", 9600, "Baud rate of the serial monitor opened with --attach-monitor.")
 
 	return uploadCommand
 }
@@ -68,34 +92,131 @@ func checkFlagsConflicts(command *cobra.Command, args []string) {
 		feedback.Errorf("error: --input-file and --input-dir flags cannot be used together")
 		os.Exit(errorcodes.ErrBadArgument)
 	}
+	if attachMonitor && feedback.GetFormat() != feedback.Text {
+		feedback.Errorf("error: --attach-monitor can be used only with the text output format")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if attachMonitor && programmer == "list" {
+		feedback.Errorf("error: --attach-monitor cannot be used when listing programmers")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+	if attachMonitor && isMultipleUpload() {
+		feedback.Errorf("error: --attach-monitor cannot be used when uploading to many boards")
+		os.Exit(errorcodes.ErrBadArgument)
+	}
+}
+
+// isMultipleUpload returns true if the upload targets more than one board
+func isMultipleUpload() bool {
+	return len(ports) > 1 || allMatching != ""
 }
 
 func run(command *cobra.Command, args []string) {
+	var path *paths.Path
+	if len(args) > 0 {
+		path = paths.New(args[0])
+	}
+	sketchPath := initSketchPath(path)
+	if err := configuration.MergeSketchConfig(sketchPath); err != nil {
+		feedback.Errorf("Error reading sketch config file: %v", err)
+		os.Exit(errorcodes.ErrGeneric)
+	}
+
 	instance, err := instance.CreateInstance()
 	if err != nil {
 		feedback.Errorf("Error during Upload: %v", err)
 		os.Exit(errorcodes.ErrGeneric)
 	}
 
-	var path *paths.Path
-	if len(args) > 0 {
-		path = paths.New(args[0])
+	if programmer == "list" {
+		resp, err := upload.ListProgrammersAvailableForUpload(context.Background(), &rpc.ListProgrammersAvailableForUploadReq{
+			Instance: instance,
+			Fqbn:     fqbn,
+		})
+		if err != nil {
+			feedback.Errorf("Error listing programmers: %v", err)
+			os.Exit(errorcodes.FromError(err))
+		}
+		feedback.PrintResult(&programmersList{Programmers: resp.GetProgrammers()})
+		os.Exit(0)
+	}
+
+	if isMultipleUpload() {
+		runMultiple(instance, sketchPath)
+		return
 	}
-	sketchPath := initSketchPath(path)
 
-	if _, err := upload.Upload(context.Background(), &rpc.UploadReq{
-		Instance:   instance,
-		Fqbn:       fqbn,
-		SketchPath: sketchPath.String(),
-		Port:       port,
-		Verbose:    verbose,
-		Verify:     verify,
-		ImportFile: importFile,
-		ImportDir:  importDir,
-		Programmer: programmer,
-	}, os.Stdout, os.Stderr); err != nil {
+	port := ""
+	if len(ports) > 0 {
+		port = ports[0]
+	}
+	// The monitor handles Ctrl-C by itself, the upload is interruptible
+	// only until it's started
+	ctx, stop := interrupt.Context()
+	outStream, errStream, uploadStreams := feedback.OutputStreams()
+	resp, err := upload.Upload(ctx, &rpc.UploadReq{
+		Instance:              instance,
+		Fqbn:                  fqbn,
+		SketchPath:            sketchPath.String(),
+		Port:                  port,
+		Verbose:               verbose,
+		Verify:                verify,
+		ImportFile:            importFile,
+		ImportDir:             importDir,
+		Programmer:            programmer,
+		DetectPortAfterUpload: attachMonitor,
+		Filesystem:            filesystem,
+	}, outStream, errStream, nil)
+	stop()
+	feedback.PrintResult(&uploadResult{OutputStreamsResult: uploadStreams(), Success: err == nil})
+	if err != nil {
 		feedback.Errorf("Error during Upload: %v", err)
-		os.Exit(errorcodes.ErrGeneric)
+		os.Exit(errorcodes.FromError(err))
+	}
+
+	if attachMonitor {
+		if err := runMonitor(resp.GetPort(), monitorBaudRate); err != nil {
+			feedback.Errorf("Error during Upload: %v", err)
+			os.Exit(errorcodes.ErrGeneric)
+		}
+	}
+}
+
+// runMonitor connects the terminal to the serial port of the board until
+// the user presses Ctrl-C.
+func runMonitor(portName string, baudRate int) error {
+	if portName == "" {
+		return errors.New("cannot open serial monitor: board port not found")
+	}
+	monitor, err := monitors.OpenSerialMonitor(portName, baudRate)
+	if err != nil {
+		return err
+	}
+	defer monitor.Close()
+
+	feedback.Printf("Connected to %s at %d baud, press Ctrl-C to exit.", portName, baudRate)
+
+	ctrlc := make(chan os.Signal, 1)
+	signal.Notify(ctrlc, os.Interrupt)
+	defer signal.Stop(ctrlc)
+
+	// The goroutine reading from stdin is left running on exit, it's
+	// blocked on the terminal and it's cleaned up when the process ends.
+	go io.Copy(monitor, os.Stdin)
+	copyErr := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(os.Stdout, monitor)
+		copyErr <- err
+	}()
+
+	select {
+	case <-ctrlc:
+		return nil
+	case err := <-copyErr:
+		if err != nil {
+			return errors.Wrap(err, "reading from serial port")
+		}
+		return errors.New("serial port closed")
 	}
 }
 
@@ -124,9 +245,23 @@ func (p *programmersList) Data() interface{} {
 
 func (p *programmersList) String() string {
 	t := table.New()
-	t.SetHeader("ID", "Programmer Name", "Platform")
+	t.SetHeader("ID", "Programmer Name", "Tool", "Platform")
 	for _, prog := range p.Programmers {
-		t.AddRow(prog.GetId(), prog.GetName(), prog.GetPlatform())
+		t.AddRow(prog.GetId(), prog.GetName(), prog.GetTool(), prog.GetPlatform())
 	}
 	return t.Render()
 }
+
+type uploadResult struct {
+	*feedback.OutputStreamsResult
+	Success bool `json:"success"`
+}
+
+func (r *uploadResult) Data() interface{} {
+	return r
+}
+
+func (r *uploadResult) String() string {
+	// The output is already printed via os.Stdout/os.Stderr
+	return ""
+}
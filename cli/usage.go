@@ -17,6 +17,8 @@ package cli
 
 import (
 	"github.com/arduino/arduino-cli/i18n"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Declare ids used in usage
@@ -56,3 +58,38 @@ const usageTemplate = `{{tr "Usage:"}}{{if .Runnable}}
 
 {{tr "Use %s for more information about a command." (printf "%s %s" .CommandPath "[command] --help" | printf "%q")}}{{end}}
 `
+
+// localizeCommands translates the descriptions of cmd, of its subcommands and
+// of their flags. The messages are extracted by the i18n catalog generator
+// from the cobra.Command literals and from the flag definitions.
+func localizeCommands(cmd *cobra.Command) {
+	// i18n.Tr under another name, the catalog generator requires tr to be
+	// called with literal strings. The empty strings are not translated, the
+	// translation of "" is the header of the catalog.
+	translate := i18n.Tr
+	localized := map[*pflag.Flag]bool{}
+	localizeFlag := func(flag *pflag.Flag) {
+		// Persistent flags are shared by the subcommands
+		if !localized[flag] {
+			if flag.Usage != "" {
+				flag.Usage = translate(flag.Usage)
+			}
+			localized[flag] = true
+		}
+	}
+	var localize func(cmd *cobra.Command)
+	localize = func(cmd *cobra.Command) {
+		if cmd.Short != "" {
+			cmd.Short = translate(cmd.Short)
+		}
+		if cmd.Long != "" {
+			cmd.Long = translate(cmd.Long)
+		}
+		cmd.Flags().VisitAll(localizeFlag)
+		cmd.PersistentFlags().VisitAll(localizeFlag)
+		for _, subCommand := range cmd.Commands() {
+			localize(subCommand)
+		}
+	}
+	localize(cmd)
+}
@@ -16,16 +16,26 @@
 package version
 
 import (
+	"context"
+	"fmt"
 	"os"
 
+	"github.com/arduino/arduino-cli/cli/errorcodes"
 	"github.com/arduino/arduino-cli/cli/feedback"
 	"github.com/arduino/arduino-cli/cli/globals"
+	"github.com/arduino/arduino-cli/commands/updater"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/version"
 	"github.com/spf13/cobra"
 )
 
+var versionFlags struct {
+	check bool
+}
+
 // NewCommand created a new `version` command
 func NewCommand() *cobra.Command {
-	return &cobra.Command{
+	versionCommand := &cobra.Command{
 		Use:     "version",
 		Short:   "Shows version number of Arduino CLI.",
 		Long:    "Shows the version number of Arduino CLI which is installed on your system.",
@@ -33,8 +43,46 @@ func NewCommand() *cobra.Command {
 		Args:    cobra.NoArgs,
 		Run:     run,
 	}
+	versionCommand.Flags().BoolVar(&versionFlags.check, "check", false, "Check if a newer release of Arduino CLI is available")
+	return versionCommand
 }
 
 func run(cmd *cobra.Command, args []string) {
-	feedback.Print(globals.VersionInfo)
+	if !versionFlags.check {
+		feedback.Print(globals.VersionInfo)
+		return
+	}
+
+	resp, err := updater.CheckForUpdates(context.Background(), &rpc.CheckForUpdatesReq{}, globals.VersionInfo.VersionString)
+	if err != nil {
+		feedback.Print(globals.VersionInfo)
+		feedback.Errorf("Error checking for updates: %v", err)
+		os.Exit(errorcodes.ErrNetwork)
+	}
+	feedback.PrintResult(checkResult{
+		Info:            globals.VersionInfo,
+		LatestVersion:   resp.GetLatestVersion(),
+		UpdateAvailable: resp.GetUpdateAvailable(),
+		URL:             resp.GetUrl(),
+	})
+}
+
+type checkResult struct {
+	*version.Info
+	LatestVersion   string `json:"LatestVersion"`
+	UpdateAvailable bool   `json:"UpdateAvailable"`
+	URL             string `json:"URL"`
+}
+
+func (cr checkResult) Data() interface{} {
+	return cr
+}
+
+func (cr checkResult) String() string {
+	if !cr.UpdateAvailable {
+		return cr.Info.String()
+	}
+	return fmt.Sprintf("%s\n\nA new release of Arduino CLI is available: %s -> %s\n"+
+		"Run `%s self-update` to update, or download it from %s",
+		cr.Info, cr.VersionString, cr.LatestVersion, cr.Application, cr.URL)
 }
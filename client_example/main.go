@@ -89,6 +89,10 @@ func main() {
 	log.Println("calling GetValue(foo)")
 	callGetValue(settingsClient)
 
+	// Write the current settings to a file in the data directory.
+	log.Println("calling Write()")
+	callWrite(settingsClient)
+
 	// Before we can do anything with the CLI, an "instance" must be created.
 	// We keep a reference to the created instance because we will need it to
 	// run subsequent commands.
@@ -241,6 +245,17 @@ func callGetValue(client settings.SettingsClient) {
 	log.Printf("Value: %s: %s", getValueResp.GetKey(), getValueResp.GetJsonData())
 }
 
+func callWrite(client settings.SettingsClient) {
+	_, err := client.Write(context.Background(),
+		&settings.WriteRequest{
+			FilePath: path.Join(dataDir, "arduino-cli.yaml"),
+		})
+
+	if err != nil {
+		log.Fatalf("Error writing settings: %s", err)
+	}
+}
+
 func callGetAll(client settings.SettingsClient) {
 	getAllResp, err := client.GetAll(context.Background(), &settings.GetAllRequest{})
 
@@ -27,11 +27,15 @@ import (
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/arduino/board-discovery"
 	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 )
 
+var tr = i18n.Tr
+
 // Attach FIXMEDOC
 func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskProgressCB) (*rpc.BoardAttachResp, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
@@ -49,13 +53,26 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 
 	boardURI := req.GetBoardUri()
 	fqbn, err := cores.ParseFQBN(boardURI)
-	if err != nil && !strings.HasPrefix(boardURI, "serial") {
-		boardURI = "serial://" + boardURI
+	if err != nil {
+		boardURI = portURI(boardURI)
 	}
 
 	if fqbn != nil {
+		port := sketch.Metadata.CPU.Port
+		if req.GetPort() != "" {
+			port = portURI(req.GetPort())
+		}
 		sketch.Metadata.CPU = sketches.BoardMetadata{
 			Fqbn: fqbn.String(),
+			Port: port,
+		}
+		// the platform may not be installed yet, the name is just informative
+		// and the configuration can be checked only if the board is found
+		if _, _, board, _, _, _ := pm.ResolveFQBN(fqbn); board != nil {
+			if _, err := normalizeFQBN(pm, fqbn); err != nil {
+				return nil, err
+			}
+			sketch.Metadata.CPU.Name = board.Name()
 		}
 	} else {
 		deviceURI, err := url.Parse(boardURI)
@@ -88,7 +105,7 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 		if board == nil {
 			return nil, fmt.Errorf("no supported board found at %s", deviceURI.String())
 		}
-		taskCB(&rpc.TaskProgress{Name: "Board found: " + board.Name()})
+		taskCB(&rpc.TaskProgress{Name: tr("Board found: %s", board.Name())})
 
 		// TODO: should be stoped the monitor: when running as a pure CLI  is released
 		// by the OS, when run as daemon the resource's state is unknown and could be leaked.
@@ -99,14 +116,43 @@ func Attach(ctx context.Context, req *rpc.BoardAttachReq, taskCB commands.TaskPr
 		}
 	}
 
+	// Remember the serial number of the board, to find its port even if the
+	// OS assigns it another name; the port may also be given as a
+	// "serial://<serial number>" selector
+	sketch.Metadata.CPU.SerialNumber = ""
+	if address, err := sketch.Metadata.CPU.PortAddress(); err == nil && address != "" {
+		if ports, err := commands.ListBoards(ctx, pm); err != nil {
+			logrus.WithError(err).Warn("Cannot detect the serial number of the board")
+		} else if port := commands.FindPort(ports, address); port != nil {
+			sketch.Metadata.CPU.Port = portURI(port.Address)
+			sketch.Metadata.CPU.SerialNumber = port.SerialNumber()
+		}
+	}
+
 	err = sketch.ExportMetadata()
 	if err != nil {
 		return nil, fmt.Errorf("cannot export sketch metadata: %s", err)
 	}
-	taskCB(&rpc.TaskProgress{Name: "Selected fqbn: " + sketch.Metadata.CPU.Fqbn, Completed: true})
+	if sketch.Metadata.CPU.Port != "" {
+		taskCB(&rpc.TaskProgress{Name: tr("Selected port: %s", sketch.Metadata.CPU.Port)})
+	}
+	if sketch.Metadata.CPU.SerialNumber != "" {
+		taskCB(&rpc.TaskProgress{Name: tr("Board serial number: %s", sketch.Metadata.CPU.SerialNumber)})
+	}
+	taskCB(&rpc.TaskProgress{Name: tr("Selected fqbn: %s", sketch.Metadata.CPU.Fqbn), Completed: true})
 	return &rpc.BoardAttachResp{}, nil
 }
 
+// portURI converts a port address given by the user (e.g. /dev/ttyACM0 or COM3)
+// into the URI stored in the sketch metadata, addresses that already have a
+// scheme are returned unchanged.
+func portURI(port string) string {
+	if strings.Contains(port, "://") {
+		return port
+	}
+	return "serial://" + port
+}
+
 // FIXME: Those should probably go in a "BoardManager" pkg or something
 // findSerialConnectedBoard find the board which is connected to the specified URI via serial port, using a monitor and a set of Boards
 // for the matching.
@@ -118,7 +164,7 @@ func findSerialConnectedBoard(pm *packagemanager.PackageManager, monitor *discov
 	location := deviceURI.Host + deviceURI.Path
 	var serialDevice discovery.SerialDevice
 	for _, device := range monitor.Serial() {
-		if device.Port == location {
+		if device.Port == location || (device.SerialNumber != "" && device.SerialNumber == location) {
 			// Found the device !
 			found = true
 			serialDevice = *device
@@ -19,10 +19,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-properties-orderedmap"
 )
 
 // Details returns all details for a board including tools and HW identifiers.
@@ -38,7 +40,13 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 		return nil, fmt.Errorf("parsing fqbn: %s", err)
 	}
 
-	boardPackage, boardPlatform, board, _, _, err := pm.ResolveFQBN(fqbn)
+	// Check the configuration first, to report the invalid options clearly
+	normalized, err := normalizeFQBN(pm, fqbn)
+	if err != nil {
+		return nil, err
+	}
+
+	boardPackage, boardPlatform, board, boardProperties, _, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
 		return nil, fmt.Errorf("loading board data: %s", err)
 	}
@@ -46,9 +54,13 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 	details := &rpc.BoardDetailsResp{}
 	details.Name = board.Name()
 	details.Fqbn = board.FQBN()
+	details.NormalizedFqbn = normalized.String()
 	details.PropertiesId = board.BoardID
 	details.Official = fqbn.Package == "arduino"
 	details.Version = board.PlatformRelease.Version.String()
+	// Boards that can be debugged declare the debugger to be used, see the
+	// "debug.tool" property lookup in the debug command
+	details.DebuggingSupported = boardProperties.Get("debug.tool") != ""
 
 	details.Package = &rpc.Package{
 		Name:       boardPackage.Name,
@@ -82,29 +94,7 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 		}
 	}
 
-	details.ConfigOptions = []*rpc.ConfigOption{}
-	options := board.GetConfigOptions()
-	for _, option := range options.Keys() {
-		configOption := &rpc.ConfigOption{}
-		configOption.Option = option
-		configOption.OptionLabel = options.Get(option)
-		selected, hasSelected := fqbn.Configs.GetOk(option)
-
-		values := board.GetConfigOptionValues(option)
-		for i, value := range values.Keys() {
-			configValue := &rpc.ConfigValue{}
-			if hasSelected && value == selected {
-				configValue.Selected = true
-			} else if !hasSelected && i == 0 {
-				configValue.Selected = true
-			}
-			configValue.Value = value
-			configValue.ValueLabel = values.Get(value)
-			configOption.Values = append(configOption.Values, configValue)
-		}
-
-		details.ConfigOptions = append(details.ConfigOptions, configOption)
-	}
+	details.ConfigOptions = getConfigOptions(board, normalized.Configs)
 
 	details.ToolsDependencies = []*rpc.ToolsDependencies{}
 	for _, tool := range boardPlatform.Dependencies {
@@ -135,8 +125,42 @@ func Details(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsRe
 			Platform: boardPlatform.Platform.Name,
 			Id:       id,
 			Name:     p.Name,
+			Tool:     p.Properties.Get("program.tool"),
 		})
 	}
+	sort.Slice(details.Programmers, func(i, j int) bool {
+		return details.Programmers[i].Id < details.Programmers[j].Id
+	})
 
 	return details, nil
 }
+
+// getConfigOptions returns the custom configuration options of the board with
+// all their values. The values set in configs are selected, for the other
+// options the first value is selected.
+func getConfigOptions(board *cores.Board, configs *properties.Map) []*rpc.ConfigOption {
+	res := []*rpc.ConfigOption{}
+	options := board.GetConfigOptions()
+	for _, option := range options.Keys() {
+		configOption := &rpc.ConfigOption{}
+		configOption.Option = option
+		configOption.OptionLabel = options.Get(option)
+		selected, hasSelected := configs.GetOk(option)
+
+		values := board.GetConfigOptionValues(option)
+		for i, value := range values.Keys() {
+			configValue := &rpc.ConfigValue{}
+			if hasSelected && value == selected {
+				configValue.Selected = true
+			} else if !hasSelected && i == 0 {
+				configValue.Selected = true
+			}
+			configValue.Value = value
+			configValue.ValueLabel = values.Get(value)
+			configOption.Values = append(configOption.Values, configValue)
+		}
+
+		res = append(res, configOption)
+	}
+	return res
+}
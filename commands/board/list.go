@@ -16,6 +16,7 @@
 package board
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -26,8 +27,8 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/httpclient"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/telemetry"
 	"github.com/pkg/errors"
-	"github.com/segmentio/stats/v4"
 	"github.com/sirupsen/logrus"
 )
 
@@ -53,8 +54,6 @@ func apiByVidPid(vid, pid string) ([]*rpc.BoardListItem, error) {
 	req, _ := http.NewRequest("GET", url, nil)
 	req.Header.Set("Content-Type", "application/json")
 
-	// TODO: use proxy if set
-
 	httpClient, err := httpclient.New()
 
 	if err != nil {
@@ -108,19 +107,13 @@ func identifyViaCloudAPI(port *commands.BoardPort) ([]*rpc.BoardListItem, error)
 }
 
 // List FIXMEDOC
-func List(instanceID int32) (r []*rpc.DetectedPort, e error) {
+func List(ctx context.Context, instanceID int32) (r []*rpc.DetectedPort, e error) {
 	m.Lock()
 	defer m.Unlock()
 
-	tags := map[string]string{}
-	// Use defer func() to evaluate tags map when function returns
-	// and set success flag inspecting the error named return parameter
+	// Use defer func() to inspect the error named return parameter
 	defer func() {
-		tags["success"] = "true"
-		if e != nil {
-			tags["success"] = "false"
-		}
-		stats.Incr("compile", stats.M(tags)...)
+		telemetry.CommandInvoked("board_list", e)
 	}()
 
 	pm := commands.GetPackageManager(instanceID)
@@ -128,7 +121,7 @@ func List(instanceID int32) (r []*rpc.DetectedPort, e error) {
 		return nil, errors.New("invalid instance")
 	}
 
-	ports, err := commands.ListBoards(pm)
+	ports, err := commands.ListBoards(ctx, pm)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting port list from serial-discovery")
 	}
@@ -171,6 +164,9 @@ func List(instanceID int32) (r []*rpc.DetectedPort, e error) {
 			Protocol:      port.Protocol,
 			ProtocolLabel: port.ProtocolLabel,
 			Boards:        b,
+			SerialNumber:  port.SerialNumber(),
+			UsbLocation:   port.USBLocation(),
+			HardwareId:    port.HardwareID(),
 		}
 		retVal = append(retVal, p)
 	}
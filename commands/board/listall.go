@@ -18,10 +18,12 @@ package board
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/go-properties-orderedmap"
 )
 
 // ListAll FIXMEDOC
@@ -32,20 +34,28 @@ func ListAll(ctx context.Context, req *rpc.BoardListAllReq) (*rpc.BoardListAllRe
 	}
 
 	args := req.GetSearchArgs()
-	match := func(name string) bool {
+	match := func(name string) int {
 		if len(args) == 0 {
-			return true
+			return 1
 		}
-		name = strings.ToLower(name)
+		score := 0
 		for _, term := range args {
-			if !strings.Contains(name, strings.ToLower(term)) {
-				return false
+			termScore := 0
+			if req.GetFuzzy() {
+				termScore = fuzzyMatch(name, term)
+			} else if strings.Contains(strings.ToLower(name), strings.ToLower(term)) {
+				termScore = 1
 			}
+			if termScore == 0 {
+				return 0
+			}
+			score += termScore
 		}
-		return true
+		return score
 	}
 
 	list := &rpc.BoardListAllResp{Boards: []*rpc.BoardListItem{}}
+	scores := map[*rpc.BoardListItem]int{}
 	for _, targetPackage := range pm.Packages {
 		for _, platform := range targetPackage.Platforms {
 			platformRelease := pm.GetInstalledPlatformRelease(platform)
@@ -53,20 +63,116 @@ func ListAll(ctx context.Context, req *rpc.BoardListAllReq) (*rpc.BoardListAllRe
 				continue
 			}
 			for _, board := range platformRelease.Boards {
-				if !match(board.Name()) {
+				score := match(board.Name())
+				if score == 0 {
 					continue
 				}
 				if !req.GetIncludeHiddenBoards() && board.IsHidden() {
 					continue
 				}
-				list.Boards = append(list.Boards, &rpc.BoardListItem{
+				item := &rpc.BoardListItem{
 					Name:     board.Name(),
 					FQBN:     board.FQBN(),
 					IsHidden: board.IsHidden(),
-				})
+				}
+				if req.GetIncludeConfigOptions() {
+					item.ConfigOptions = getConfigOptions(board, properties.NewMap())
+				}
+				scores[item] = score
+				list.Boards = append(list.Boards, item)
 			}
 		}
 	}
 
+	if req.GetFuzzy() {
+		sort.SliceStable(list.Boards, func(i, j int) bool {
+			a, b := list.Boards[i], list.Boards[j]
+			if scores[a] != scores[b] {
+				return scores[a] > scores[b]
+			}
+			return a.GetName() < b.GetName()
+		})
+	}
 	return list, nil
 }
+
+// fuzzyMatch returns how well term matches the board name, or 0 if it doesn't
+// match at all: a substring of the name is the best match, followed by a word
+// of the name with a typo and by a sequence of characters of the name.
+func fuzzyMatch(name, term string) int {
+	name = strings.ToLower(name)
+	term = strings.ToLower(term)
+	if strings.Contains(name, term) {
+		return 3
+	}
+	if len(term) >= 3 {
+		maxDistance := 1
+		if len(term) >= 7 {
+			maxDistance = 2
+		}
+		for _, word := range strings.Fields(name) {
+			if editDistance(word, term) <= maxDistance {
+				return 2
+			}
+		}
+	}
+	if isSubsequence(term, name) {
+		return 1
+	}
+	return 0
+}
+
+// isSubsequence returns true if all the characters of term appear in s, in
+// the same order
+func isSubsequence(term, s string) bool {
+	t := []rune(term)
+	if len(t) == 0 {
+		return true
+	}
+	for _, c := range s {
+		if c == t[0] {
+			t = t[1:]
+			if len(t) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// editDistance returns the number of insertions, deletions, substitutions and
+// transpositions of adjacent characters needed to change a into b
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
+
+func minInt(first int, values ...int) int {
+	res := first
+	for _, v := range values {
+		if v < res {
+			res = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	// Substrings are the best match
+	require.Equal(t, 3, fuzzyMatch("Arduino Uno", "uno"))
+	require.Equal(t, 3, fuzzyMatch("Arduino MKR WiFi 1010", "WiFi 10"))
+	// Words with a typo
+	require.Equal(t, 2, fuzzyMatch("Arduino Uno", "uon"))
+	require.Equal(t, 2, fuzzyMatch("Arduino Leonardo", "leonrado"))
+	require.Equal(t, 2, fuzzyMatch("Arduino Leonardo", "leonado"))
+	// Sequences of characters
+	require.Equal(t, 1, fuzzyMatch("Arduino MKR WiFi 1010", "mkrwf"))
+	require.Equal(t, 1, fuzzyMatch("Arduino Nano 33 IoT", "nn33i"))
+	// No match
+	require.Equal(t, 0, fuzzyMatch("Arduino Uno", "mega"))
+	require.Equal(t, 0, fuzzyMatch("Arduino Uno", "ab"))
+}
+
+func TestEditDistance(t *testing.T) {
+	require.Equal(t, 0, editDistance("uno", "uno"))
+	require.Equal(t, 1, editDistance("uno", "uon"))
+	require.Equal(t, 1, editDistance("mega", "mga"))
+	require.Equal(t, 3, editDistance("", "abc"))
+	require.Equal(t, 2, editDistance("kitten", "sittenn"))
+}
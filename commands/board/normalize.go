@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// NormalizeFQBN checks the configuration options of the FQBN against the
+// board's menus and returns the FQBN with all the options set
+func NormalizeFQBN(ctx context.Context, req *rpc.BoardNormalizeFQBNReq) (*rpc.BoardNormalizeFQBNResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "parsing fqbn")
+	}
+	normalized, err := normalizeFQBN(pm, fqbn)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.BoardNormalizeFQBNResp{Fqbn: normalized.String()}, nil
+}
+
+// normalizeFQBN returns a copy of fqbn with all the options of the board set,
+// the ones missing in fqbn to their default value. An error is returned if
+// the board is not found or if fqbn contains an invalid option or value.
+func normalizeFQBN(pm *packagemanager.PackageManager, fqbn *cores.FQBN) (*cores.FQBN, error) {
+	_, _, board, _, _, err := pm.ResolveFQBN(fqbn)
+	if board == nil {
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "loading board data")
+	}
+	configs, err := board.NormalizeConfigs(fqbn.Configs)
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "invalid configuration for board %s", board)
+	}
+	return &cores.FQBN{
+		Package:      fqbn.Package,
+		PlatformArch: fqbn.PlatformArch,
+		BoardID:      fqbn.BoardID,
+		Configs:      configs,
+	}, nil
+}
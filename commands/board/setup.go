@@ -0,0 +1,195 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/executils"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// UdevRulesDir is the folder where the udev rules of the boards are installed
+var UdevRulesDir = paths.New("/etc/udev/rules.d")
+
+// udevReloadCommands are run after installing udev rules, to apply them to
+// the boards already connected
+var udevReloadCommands = [][]string{
+	{"udevadm", "control", "--reload-rules"},
+	{"udevadm", "trigger"},
+}
+
+// Setup installs the udev rules (Linux) or points to the drivers (Windows)
+// declared by the platform of a board, with the `setup.linux.udev_rules` and
+// `setup.windows.drivers` properties of boards.txt or platform.txt.
+func Setup(ctx context.Context, req *rpc.BoardSetupReq) (*rpc.BoardSetupResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	fqbn, err := cores.ParseFQBN(req.GetFqbn())
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "parsing fqbn")
+	}
+	_, boardPlatform, board, boardProperties, _, err := pm.ResolveFQBN(fqbn)
+	if board == nil {
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "loading board data")
+	}
+
+	// The properties of the board override the ones of the platform
+	props := boardPlatform.Properties.Clone()
+	props.Merge(boardProperties)
+	actions, err := setupActions(props, boardPlatform.InstallDir, runtime.GOOS, req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.BoardSetupResp{Actions: actions}, nil
+}
+
+// setupActions returns the actions needed to use a board on the goos system,
+// doing them unless dryRun is true. The files declared in props are relative
+// to platformDir.
+func setupActions(props *properties.Map, platformDir *paths.Path, goos string, dryRun bool) ([]*rpc.BoardSetupAction, error) {
+	actions := []*rpc.BoardSetupAction{}
+	switch goos {
+	case "linux":
+		reload := false
+		for _, rules := range setupPropertiesList(props, "setup.linux.udev_rules") {
+			src := platformDir.Join(rules)
+			if !src.Exist() {
+				return nil, commands.FailedPreconditionError(commands.CodeInstallFailed, nil, "udev rules %s not found in the platform", rules)
+			}
+			dst := UdevRulesDir.Join(src.Base())
+			action := &rpc.BoardSetupAction{
+				Description: "Install the udev rules " + src.Base(),
+				Source:      src.String(),
+				Destination: dst.String(),
+			}
+			actions = append(actions, action)
+			if sameContent(src, dst) {
+				action.Done = true
+				continue
+			}
+			reload = true
+			if dryRun {
+				continue
+			}
+			if err := src.CopyTo(dst); err != nil {
+				if errors.Is(err, os.ErrPermission) {
+					return nil, commands.FailedPreconditionError(commands.CodePermissionDenied, err,
+						"cannot install the udev rules, run the command as root or install them with `sudo cp %s %s`", src, UdevRulesDir)
+				}
+				return nil, commands.FailedPreconditionError(commands.CodeInstallFailed, err, "installing the udev rules %s", src.Base())
+			}
+			action.Done = true
+		}
+		if reload {
+			commandLines := []string{}
+			for _, command := range udevReloadCommands {
+				commandLines = append(commandLines, strings.Join(command, " "))
+			}
+			action := &rpc.BoardSetupAction{
+				Description: "Reload the udev rules",
+				Command:     strings.Join(commandLines, " && "),
+			}
+			actions = append(actions, action)
+			if !dryRun {
+				// If the reload fails the rules are applied anyway once the
+				// board is reconnected, the user is asked to run the command
+				action.Done = runCommands(udevReloadCommands)
+			}
+		}
+
+	case "windows":
+		for _, drivers := range setupPropertiesList(props, "setup.windows.drivers") {
+			action := &rpc.BoardSetupAction{}
+			if strings.Contains(drivers, "://") {
+				action.Description = "Download and install the drivers from " + drivers
+				action.Url = drivers
+			} else {
+				src := platformDir.Join(drivers)
+				action.Description = "Install the drivers in " + src.String()
+				action.Source = src.String()
+			}
+			actions = append(actions, action)
+		}
+	}
+	return actions, nil
+}
+
+// sameContent returns true if the files a and b exist and have the same
+// content
+func sameContent(a, b *paths.Path) bool {
+	dataA, err := a.ReadFile()
+	if err != nil {
+		return false
+	}
+	dataB, err := b.ReadFile()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(dataA, dataB)
+}
+
+// runCommands runs the commands in sequence and returns true if all of them
+// succeeded
+func runCommands(commandLines [][]string) bool {
+	for _, command := range commandLines {
+		cmd, err := executils.NewProcess(command...)
+		if err != nil {
+			return false
+		}
+		if err := cmd.Run(); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// setupPropertiesList returns the value of key, or the values of key.N in
+// numerical order if key is a list
+func setupPropertiesList(props *properties.Map, key string) []string {
+	res := []string{}
+	if value, ok := props.GetOk(key); ok && value != "" {
+		res = append(res, value)
+	}
+	list := props.SubTree(key)
+	indexes := []int{}
+	for _, k := range list.FirstLevelKeys() {
+		if i, err := strconv.Atoi(k); err == nil {
+			indexes = append(indexes, i)
+		}
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		if value := list.Get(strconv.Itoa(i)); value != "" {
+			res = append(res, value)
+		}
+	}
+	return res
+}
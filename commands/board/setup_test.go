@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package board
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupActions(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_board_setup")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	platformDir := tmp.Join("platform")
+	require.NoError(t, platformDir.Join("udev").MkdirAll())
+	require.NoError(t, platformDir.Join("udev", "60-myboard.rules").WriteFile([]byte("SUBSYSTEMS==\"usb\", MODE=\"0666\"\n")))
+	defer func(dir *paths.Path, reload [][]string) {
+		UdevRulesDir = dir
+		udevReloadCommands = reload
+	}(UdevRulesDir, udevReloadCommands)
+	UdevRulesDir = tmp.Join("rules.d")
+	require.NoError(t, UdevRulesDir.MkdirAll())
+	udevReloadCommands = [][]string{}
+
+	props := properties.NewMap()
+	props.Set("setup.linux.udev_rules", "udev/60-myboard.rules")
+	props.Set("setup.windows.drivers.0", "https://example.com/drivers")
+	props.Set("setup.windows.drivers.1", "drivers/myboard.inf")
+
+	// Nothing is needed on macOS
+	actions, err := setupActions(props, platformDir, "darwin", false)
+	require.NoError(t, err)
+	require.Empty(t, actions)
+
+	// The drivers are only pointed to
+	actions, err = setupActions(props, platformDir, "windows", false)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.Equal(t, "https://example.com/drivers", actions[0].GetUrl())
+	require.Equal(t, platformDir.Join("drivers", "myboard.inf").String(), actions[1].GetSource())
+	require.False(t, actions[0].GetDone())
+	require.False(t, actions[1].GetDone())
+
+	// The dry-run doesn't install the rules
+	actions, err = setupActions(props, platformDir, "linux", true)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.Equal(t, UdevRulesDir.Join("60-myboard.rules").String(), actions[0].GetDestination())
+	require.False(t, actions[0].GetDone())
+	require.False(t, actions[1].GetDone())
+	require.False(t, UdevRulesDir.Join("60-myboard.rules").Exist())
+
+	actions, err = setupActions(props, platformDir, "linux", false)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	require.True(t, actions[0].GetDone())
+	require.True(t, actions[1].GetDone())
+	require.True(t, UdevRulesDir.Join("60-myboard.rules").Exist())
+
+	// The rules already installed are not installed again
+	actions, err = setupActions(props, platformDir, "linux", true)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.True(t, actions[0].GetDone())
+
+	// The rules declared by the platform must exist
+	props.Set("setup.linux.udev_rules", "udev/missing.rules")
+	_, err = setupActions(props, platformDir, "linux", false)
+	require.Error(t, err)
+}
@@ -0,0 +1,105 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// SerialNumber returns the serial number of the USB device of the port, or
+// an empty string if it's not available
+func (p *BoardPort) SerialNumber() string {
+	if p.Prefs == nil {
+		return ""
+	}
+	return p.Prefs.Get("serialNumber")
+}
+
+// USBLocation returns the position of the USB device of the port in the USB
+// tree (e.g. "1-1.2", the bus followed by the hub ports), or an empty string
+// if it can't be determined. The location doesn't change until the device is
+// plugged in another USB port.
+func (p *BoardPort) USBLocation() string {
+	if p.Protocol != "serial" {
+		return ""
+	}
+	return usbLocation(p.Address)
+}
+
+// HardwareID returns an identifier of the USB device of the port that doesn't
+// depend on the name assigned to the port by the OS: VID and PID followed by
+// the serial number (e.g. "2341:0043:75830333238351806212") or, for the devices
+// without a serial number, by the USB location (e.g. "1a86:7523@1-1.2").
+// An empty string is returned if the device can't be identified.
+func (p *BoardPort) HardwareID() string {
+	if p.IdentificationPrefs == nil {
+		return ""
+	}
+	vid := p.IdentificationPrefs.Get("vid")
+	pid := p.IdentificationPrefs.Get("pid")
+	if vid == "" || pid == "" {
+		return ""
+	}
+	id := strings.TrimPrefix(strings.ToLower(vid), "0x") + ":" + strings.TrimPrefix(strings.ToLower(pid), "0x")
+	if serialNumber := p.SerialNumber(); serialNumber != "" {
+		return id + ":" + serialNumber
+	}
+	if location := p.USBLocation(); location != "" {
+		return id + "@" + location
+	}
+	return ""
+}
+
+// FindPort returns the port selected by selector among ports: the selector
+// may be the address of the port, the serial number or the hardware ID of the
+// connected device. nil is returned if no port matches.
+func FindPort(ports []*BoardPort, selector string) *BoardPort {
+	for _, port := range ports {
+		if port.Address == selector {
+			return port
+		}
+	}
+	for _, port := range ports {
+		if serialNumber := port.SerialNumber(); serialNumber != "" && serialNumber == selector {
+			return port
+		}
+		if hardwareID := port.HardwareID(); hardwareID != "" && hardwareID == selector {
+			return port
+		}
+	}
+	return nil
+}
+
+// ResolvePort returns the address of the port selected by port. The ports in
+// the form "serial://<selector>" are searched among the connected ones, see
+// FindPort for the selectors supported, the other ports are returned unchanged.
+func ResolvePort(ctx context.Context, pm *packagemanager.PackageManager, port string) (string, error) {
+	if !strings.HasPrefix(port, "serial://") {
+		return port, nil
+	}
+	selector := strings.TrimPrefix(port, "serial://")
+	ports, err := ListBoards(ctx, pm)
+	if err != nil {
+		return "", ToolFailureError(CodePortNotFound, err, "detecting serial ports")
+	}
+	if found := FindPort(ports, selector); found != nil {
+		return found.Address, nil
+	}
+	return "", NotFoundError(CodePortNotFound, nil, "no connected board matches port %s", port)
+}
@@ -0,0 +1,41 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"path/filepath"
+	"regexp"
+)
+
+// usbDeviceName matches the name given by Linux to the USB devices in sysfs,
+// e.g. "1-1.2" is the device connected to the port 2 of the hub connected to
+// the port 1 of the bus 1
+var usbDeviceName = regexp.MustCompile(`^\d+-\d+(\.\d+)*$`)
+
+// usbLocation returns the name of the USB device owning the tty port in
+// sysfs, which is its location in the USB tree
+func usbLocation(address string) string {
+	device, err := filepath.EvalSymlinks(filepath.Join("/sys/class/tty", filepath.Base(address), "device"))
+	if err != nil {
+		return ""
+	}
+	for ; device != "/" && device != "."; device = filepath.Dir(device) {
+		if name := filepath.Base(device); usbDeviceName.MatchString(name) {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"testing"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardPortIdentity(t *testing.T) {
+	uno := &BoardPort{
+		Address:             "/dev/ttyACM0",
+		Protocol:            "serial",
+		Prefs:               properties.NewFromHashmap(map[string]string{"serialNumber": "75830333238351806212"}),
+		IdentificationPrefs: properties.NewFromHashmap(map[string]string{"vid": "0x2341", "pid": "0x0043"}),
+	}
+	require.Equal(t, "75830333238351806212", uno.SerialNumber())
+	require.Equal(t, "2341:0043:75830333238351806212", uno.HardwareID())
+
+	// Non-USB ports have no hardware id
+	uart := &BoardPort{
+		Address:             "/dev/ttyS0",
+		Protocol:            "serial",
+		Prefs:               properties.NewMap(),
+		IdentificationPrefs: properties.NewMap(),
+	}
+	require.Equal(t, "", uart.SerialNumber())
+	require.Equal(t, "", uart.HardwareID())
+
+	ports := []*BoardPort{uart, uno}
+	require.Equal(t, uno, FindPort(ports, "/dev/ttyACM0"))
+	require.Equal(t, uno, FindPort(ports, "75830333238351806212"))
+	require.Equal(t, uno, FindPort(ports, "2341:0043:75830333238351806212"))
+	require.Equal(t, uart, FindPort(ports, "/dev/ttyS0"))
+	require.Nil(t, FindPort(ports, "/dev/ttyACM1"))
+	require.Nil(t, FindPort(ports, ""))
+}
+
+func TestResolvePortWithoutSelector(t *testing.T) {
+	// The ports without the serial:// scheme are used as they are, without
+	// running the discovery
+	for _, port := range []string{"", "/dev/ttyACM0", "COM3", "192.168.1.5"} {
+		res, err := ResolvePort(context.Background(), nil, port)
+		require.NoError(t, err)
+		require.Equal(t, port, res)
+	}
+}
@@ -16,15 +16,18 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"runtime"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 )
 
-// DownloadToolRelease downloads a ToolRelease
-func DownloadToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease, downloadCB DownloadProgressCB) error {
+// DownloadToolRelease downloads a ToolRelease, the download is interrupted if
+// ctx is canceled
+func DownloadToolRelease(ctx context.Context, pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease, downloadCB DownloadProgressCB) error {
 	config, err := GetDownloaderConfig()
 	if err != nil {
 		return err
@@ -33,7 +36,13 @@ func DownloadToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.T
 	if err != nil {
 		return err
 	}
-	return Download(resp, toolRelease.String(), downloadCB)
+	if err := Download(ctx, resp, toolRelease.String(), downloadCB); err != nil {
+		return err
+	}
+	if resp != nil {
+		return toolRelease.GetCompatibleFlavour().VerifyLocalArchive(pm.DownloadDir)
+	}
+	return nil
 }
 
 // InstallToolRelease installs a ToolRelease
@@ -42,19 +51,24 @@ func InstallToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.To
 
 	if toolRelease.IsInstalled() {
 		log.Warn("Tool already installed")
-		taskCB(&rpc.TaskProgress{Name: "Tool " + toolRelease.String() + " already installed", Completed: true})
+		taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", toolRelease.String()), Completed: true})
 		return nil
 	}
 
+	name := "Installing " + toolRelease.String()
+	if flavour := toolRelease.GetFlavourCompatibleWith(runtime.GOOS, runtime.GOARCH); flavour != nil {
+		log = log.WithField("flavor", flavour.OS)
+		name += " (" + flavour.OS + ")"
+	}
 	log.Info("Installing tool")
-	taskCB(&rpc.TaskProgress{Name: "Installing " + toolRelease.String()})
-	err := pm.InstallTool(toolRelease)
+	taskCB(&rpc.TaskProgress{Name: name})
+	err := pm.InstallTool(toolRelease, NewExtractProgressCB(name, taskCB))
 	if err != nil {
 		log.WithError(err).Warn("Cannot install tool")
 		return fmt.Errorf("installing tool %s: %s", toolRelease, err)
 	}
 	log.Info("Tool installed")
-	taskCB(&rpc.TaskProgress{Message: toolRelease.String() + " installed", Completed: true})
+	taskCB(&rpc.TaskProgress{Message: tr("%s installed", toolRelease.String()), Completed: true})
 
 	return nil
 }
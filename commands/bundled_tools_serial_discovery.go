@@ -16,6 +16,7 @@
 package commands
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sync"
@@ -112,8 +113,9 @@ type eventJSON struct {
 	Ports     []*BoardPort `json:"ports"`
 }
 
-// ListBoards foo
-func ListBoards(pm *packagemanager.PackageManager) ([]*BoardPort, error) {
+// ListBoards runs the serial-discovery tool to list the boards connected to
+// the serial ports, the tool is killed if ctx is canceled.
+func ListBoards(ctx context.Context, pm *packagemanager.PackageManager) ([]*BoardPort, error) {
 	// ensure the connection to the discoverer is unique to avoid messing up
 	// the messages exchanged
 	mutex.Lock()
@@ -131,7 +133,7 @@ func ListBoards(pm *packagemanager.PackageManager) ([]*BoardPort, error) {
 	}
 
 	// build the command to be executed
-	cmd, err := executils.NewProcessFromPath(t.InstallDir.Join("serial-discovery"))
+	cmd, err := executils.NewProcessFromPathWithContext(ctx, t.InstallDir.Join("serial-discovery"))
 	if err != nil {
 		return nil, errors.Wrap(err, "creating discovery process")
 	}
@@ -178,6 +180,8 @@ func ListBoards(pm *packagemanager.PackageManager) ([]*BoardPort, error) {
 		}
 	case <-time.After(10 * time.Second):
 		finalError = fmt.Errorf("decoding LIST command: timeout")
+	case <-ctx.Done():
+		finalError = ctx.Err()
 	}
 
 	// tell the process to quit
@@ -0,0 +1,114 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/executils"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+var placeholderRegexp = regexp.MustCompile(`\{[^{}]*\}`)
+
+// artifactsName expands the template of the name of the exported artifacts.
+// The placeholders are {sketch} (the sketch name), {fqbn} (the FQBN without
+// the configuration, with dots instead of colons), {board} (the board ID),
+// {git} (the output of `git describe --tags --always --dirty` run in the
+// sketch folder) and {timestamp} (the UTC build time, e.g. 20210315-142501).
+func artifactsName(ctx context.Context, template string, sketchName string, sketchPath *paths.Path, fqbn *cores.FQBN) (string, error) {
+	name, err := expandPlaceholders(template, func(placeholder string) (string, error) {
+		return placeholderValue(ctx, placeholder, sketchName, sketchPath, fqbn)
+	})
+	if err != nil {
+		return "", err
+	}
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid artifacts name '%s'", name)
+	}
+	return name, nil
+}
+
+// placeholderValue returns the value of a placeholder of the artifacts name
+func placeholderValue(ctx context.Context, placeholder string, sketchName string, sketchPath *paths.Path, fqbn *cores.FQBN) (string, error) {
+	switch placeholder {
+	case "{sketch}":
+		return sketchName, nil
+	case "{fqbn}":
+		return strings.Replace(fqbn.StringWithoutConfig(), ":", ".", -1), nil
+	case "{board}":
+		return fqbn.BoardID, nil
+	case "{git}":
+		return gitDescribe(ctx, sketchPath)
+	case "{timestamp}":
+		return buildTimestamp()
+	default:
+		return "", fmt.Errorf("unknown placeholder %s", placeholder)
+	}
+}
+
+// expandPlaceholders replaces each {placeholder} of template with the value
+// returned by valueOf, the first error returned by valueOf is returned
+func expandPlaceholders(template string, valueOf func(placeholder string) (string, error)) (string, error) {
+	var expandErr error
+	res := placeholderRegexp.ReplaceAllStringFunc(template, func(placeholder string) string {
+		value, err := valueOf(placeholder)
+		if err != nil && expandErr == nil {
+			expandErr = err
+		}
+		return value
+	})
+	return res, expandErr
+}
+
+// gitDescribe returns the description of the commit of the repository
+// containing dir
+func gitDescribe(ctx context.Context, dir *paths.Path) (string, error) {
+	cmd, err := executils.NewProcessWithContext(ctx, "git", "describe", "--tags", "--always", "--dirty")
+	if err != nil {
+		return "", fmt.Errorf("running git: %s", err)
+	}
+	cmd.SetDirFromPath(dir)
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.RedirectStdoutTo(stdout)
+	cmd.RedirectStderrTo(stderr)
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running git describe in %s: %s %s", dir, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// buildTimestamp returns the time of the build, SOURCE_DATE_EPOCH is used if
+// set (see https://reproducible-builds.org/specs/source-date-epoch/)
+func buildTimestamp() (string, error) {
+	now := time.Now()
+	if epoch, ok := os.LookupEnv("SOURCE_DATE_EPOCH"); ok {
+		seconds, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid SOURCE_DATE_EPOCH: %s", epoch)
+		}
+		now = time.Unix(seconds, 0)
+	}
+	return now.UTC().Format("20060102-150405"), nil
+}
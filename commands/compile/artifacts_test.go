@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArtifactsName(t *testing.T) {
+	fqbn, err := cores.ParseFQBN("arduino:avr:nano:cpu=atmega328old")
+	require.NoError(t, err)
+	sketchPath := paths.New("testdata", "Blink")
+
+	os.Setenv("SOURCE_DATE_EPOCH", "1615818301")
+	defer os.Unsetenv("SOURCE_DATE_EPOCH")
+
+	name, err := artifactsName(context.Background(), "{sketch}-{fqbn}-{board}-{timestamp}", "Blink", sketchPath, fqbn)
+	require.NoError(t, err)
+	require.Equal(t, "Blink-arduino.avr.nano-nano-20210315-142501", name)
+
+	name, err = artifactsName(context.Background(), "firmware", "Blink", sketchPath, fqbn)
+	require.NoError(t, err)
+	require.Equal(t, "firmware", name)
+
+	_, err = artifactsName(context.Background(), "{sketch}-{version}", "Blink", sketchPath, fqbn)
+	require.Error(t, err)
+
+	_, err = artifactsName(context.Background(), "out/{sketch}", "Blink", sketchPath, fqbn)
+	require.Error(t, err)
+
+	os.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+	_, err = artifactsName(context.Background(), "{timestamp}", "Blink", sketchPath, fqbn)
+	require.Error(t, err)
+}
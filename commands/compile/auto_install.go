@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// installMissingLibraries finds the includes of the sketch that can't be
+// resolved and installs, with its dependencies, the library of the index
+// providing each of them, if there is exactly one. The headers provided by
+// more than one library are only reported. It returns the libraries
+// installed, in the NAME@VERSION form.
+func installMissingLibraries(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer) ([]string, error) {
+	includes, err := SketchIncludes(ctx, &rpc.SketchIncludesReq{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+		Libraries:       req.GetLibraries(),
+		Library:         req.GetLibrary(),
+		Profile:         req.GetProfile(),
+	}, errStream)
+	if err != nil {
+		return nil, err
+	}
+
+	toInstall := []string{}
+	providing := map[string]string{}
+	for _, unresolved := range includes.GetUnresolvedIncludes() {
+		suggested := unresolved.GetSuggestedLibraries()
+		switch {
+		case len(suggested) > 1:
+			fmt.Fprintf(outStream, "%s is provided by more than one library (%s), install one of them with `lib install`\n",
+				unresolved.GetHeader(), strings.Join(suggested, ", "))
+		case len(suggested) == 1:
+			if _, ok := providing[suggested[0]]; !ok {
+				toInstall = append(toInstall, suggested[0])
+				providing[suggested[0]] = unresolved.GetHeader()
+			}
+		}
+	}
+
+	taskCB := func(p *rpc.TaskProgress) {
+		if p.GetMessage() != "" {
+			fmt.Fprintln(outStream, p.GetMessage())
+		}
+	}
+	downloadCB := func(*rpc.DownloadProgress) {}
+	installed := []string{}
+	for _, name := range toInstall {
+		fmt.Fprintf(outStream, "Installing library %s, providing %s\n", name, providing[name])
+		err := lib.LibraryInstall(ctx, &rpc.LibraryInstallReq{
+			Instance: req.GetInstance(),
+			Name:     name,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return installed, commands.FailedPreconditionError(commands.CodeInstallFailed, err, "installing library %s", name)
+		}
+		release := name
+		if lm := commands.GetLibraryManager(req.GetInstance().GetId()); lm != nil {
+			if indexLib, ok := lm.Index.Libraries[name]; ok && indexLib.Latest != nil {
+				release = indexLib.Latest.String()
+			}
+		}
+		installed = append(installed, release)
+	}
+	return installed, nil
+}
@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+)
+
+// The kinds of board options that can be selected with the fields of the
+// compile request. Each option is declared by the platform (platform.txt) or
+// by the board (boards.txt) as the set of build properties it sets, e.g.:
+//
+//	partitions.huge_app.build.partitions=huge_app
+//	partitions.huge_app.upload.maximum_size=3145728
+//	linker_scripts.no_bootloader.build.ldscript=linker_scripts/gcc/flash_without_bootloader.ld
+const (
+	optionPartitions    = "partitions"
+	optionLinkerScripts = "linker_scripts"
+)
+
+var optionDescriptions = map[string]string{
+	optionPartitions:    "partition table",
+	optionLinkerScripts: "linker script",
+}
+
+// boardOptionProperties returns the build properties, in the key=value form,
+// set by the option with the given id among the ones of the given kind
+// available for the board.
+func boardOptionProperties(pm *packagemanager.PackageManager, fqbn *cores.FQBN, kind, id string) ([]string, error) {
+	_, platform, _, boardProperties, _, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "incorrect FQBN")
+	}
+	props := platform.Properties.Clone()
+	props.Merge(boardProperties)
+	options := props.SubTree(kind)
+
+	available := options.FirstLevelKeys()
+	if len(available) == 0 {
+		return nil, commands.FailedPreconditionError(commands.CodeInvalidArgument, nil, "the board %s doesn't provide any %s", fqbn.StringWithoutConfig(), optionDescriptions[kind])
+	}
+	option := options.SubTree(id)
+	if option.Size() == 0 {
+		sort.Strings(available)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "%s '%s' not available for the board %s, available: %s", optionDescriptions[kind], id, fqbn.StringWithoutConfig(), strings.Join(available, ", "))
+	}
+
+	res := []string{}
+	for _, key := range option.Keys() {
+		res = append(res, key+"="+option.Get(key))
+	}
+	return res, nil
+}
@@ -0,0 +1,54 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardOptionProperties(t *testing.T) {
+	hardware := paths.New("testdata", "hardware")
+	pm := packagemanager.NewPackageManager(hardware, hardware, hardware, hardware)
+	require.NoError(t, pm.LoadHardwareFromDirectory(hardware))
+
+	dev, err := cores.ParseFQBN("test:esp:dev")
+	require.NoError(t, err)
+	mini, err := cores.ParseFQBN("test:esp:mini")
+	require.NoError(t, err)
+
+	// Options declared by the platform
+	props, err := boardOptionProperties(pm, dev, optionPartitions, "huge_app")
+	require.NoError(t, err)
+	require.Equal(t, []string{"build.partitions=huge_app", "upload.maximum_size=3145728"}, props)
+
+	_, err = boardOptionProperties(pm, mini, optionPartitions, "tiny")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "available: default, huge_app")
+
+	// Options declared by the board
+	props, err = boardOptionProperties(pm, dev, optionLinkerScripts, "ram")
+	require.NoError(t, err)
+	require.Equal(t, []string{"build.ldscript=ram.ld"}, props)
+
+	_, err = boardOptionProperties(pm, mini, optionLinkerScripts, "ram")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't provide any linker script")
+}
@@ -21,11 +21,13 @@ import (
 	"io"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
+	"time"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/configuration"
@@ -37,70 +39,97 @@ import (
 	paths "github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
-	"github.com/segmentio/stats/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
 // Compile FIXMEDOC
 func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer, debug bool) (r *rpc.CompileResp, e error) {
+	installed := []string{}
+	if req.GetAutoInstallDeps() {
+		var err error
+		if installed, err = installMissingLibraries(ctx, req, outStream, errStream); err != nil {
+			return &rpc.CompileResp{InstalledLibraries: installed}, err
+		}
+	}
 
-	tags := map[string]string{
-		"fqbn":            req.Fqbn,
-		"sketchPath":      telemetry.Sanitize(req.SketchPath),
-		"showProperties":  strconv.FormatBool(req.ShowProperties),
-		"preprocess":      strconv.FormatBool(req.Preprocess),
-		"buildProperties": strings.Join(req.BuildProperties, ","),
-		"warnings":        req.Warnings,
-		"verbose":         strconv.FormatBool(req.Verbose),
-		"quiet":           strconv.FormatBool(req.Quiet),
-		"vidPid":          req.VidPid,
-		"exportFile":      telemetry.Sanitize(req.ExportFile), // deprecated
-		"exportDir":       telemetry.Sanitize(req.GetExportDir()),
-		"jobs":            strconv.FormatInt(int64(req.Jobs), 10),
-		"libraries":       strings.Join(req.Libraries, ","),
-		"clean":           strconv.FormatBool(req.GetClean()),
+	resp, err := compile(ctx, req, outStream, errStream, debug, nil, nil)
+	if resp != nil {
+		resp.InstalledLibraries = installed
 	}
+	return resp, err
+}
+
+// preprocessedCB receives the name and the content of the C++ file generated
+// from the sketch when the compile request asks only for preprocessing.
+type preprocessedCB func(fileName, source string)
+
+// includesFoundCB receives the builder context after the libraries used by the
+// sketch have been found, when only the includes are requested: nothing is
+// compiled.
+type includesFoundCB func(builderCtx *types.Context)
+
+func compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.Writer, debug bool, preprocessed preprocessedCB, includesFound includesFoundCB) (r *rpc.CompileResp, e error) {
 
 	if req.GetExportFile() != "" {
 		outStream.Write([]byte(fmt.Sprintln("Compile.ExportFile has been deprecated. The ExportFile parameter will be ignored, use ExportDir instead.")))
 	}
 
-	// Use defer func() to evaluate tags map when function returns
-	// and set success flag inspecting the error named return parameter
+	// Use defer func() to inspect the error named return parameter
+	startTime := time.Now()
 	defer func() {
-		tags["success"] = "true"
-		if e != nil {
-			tags["success"] = "false"
-		}
-		stats.Incr("compile", stats.M(tags)...)
+		telemetry.CommandInvoked("compile", e)
+		telemetry.CompileDone(time.Since(startTime), e)
 	}()
 
-	pm := commands.GetPackageManager(req.GetInstance().GetId())
-	if pm == nil {
-		return nil, errors.New("invalid instance")
+	if commands.GetPackageManager(req.GetInstance().GetId()) == nil {
+		return nil, commands.ErrInvalidInstance
 	}
 
 	logrus.Tracef("Compile %s for %s started", req.GetSketchPath(), req.GetFqbn())
 	if req.GetSketchPath() == "" {
-		return nil, fmt.Errorf("missing sketchPath")
+		return nil, commands.InvalidArgumentError(commands.CodeMissingSketchPath, nil, "missing sketchPath")
 	}
 	sketchPath := paths.New(req.GetSketchPath())
 	sketch, err := sketches.NewSketchFromPath(sketchPath)
 	if err != nil {
-		return nil, fmt.Errorf("opening sketch: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidSketch, err, "opening sketch")
+	}
+
+	secretsHeader, err := loadSecrets(sketch)
+	if err != nil {
+		return nil, err
 	}
 
+	profileName, profile, err := loadProfile(sketch, req.GetProfile())
+	if err != nil {
+		return nil, fmt.Errorf("loading sketch profile: %w", err)
+	}
+	if profile != nil {
+		if err := installProfile(ctx, req.GetInstance(), profile, req.GetInstallProfileDependencies(), outStream); err != nil {
+			return nil, err
+		}
+	}
+
+	// The platforms may have been changed by the profile
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+
 	fqbnIn := req.GetFqbn()
+	if fqbnIn == "" && profile != nil {
+		fqbnIn = profile.Fqbn
+	}
 	if fqbnIn == "" && sketch != nil && sketch.Metadata != nil {
 		fqbnIn = sketch.Metadata.CPU.Fqbn
 	}
 	if fqbnIn == "" {
-		return nil, fmt.Errorf("no FQBN provided")
+		fqbnIn = viper.GetString("build.default_fqbn")
+	}
+	if fqbnIn == "" {
+		return nil, commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "no FQBN provided")
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "incorrect FQBN")
 	}
 
 	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
@@ -113,7 +142,16 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		// 	"\"%[1]s:%[2]s\" platform is not installed, please install it by running \""+
 		// 		version.GetAppName()+" core install %[1]s:%[2]s\".", fqbn.Package, fqbn.PlatformArch)
 		// feedback.Error(errorMessage)
-		return nil, fmt.Errorf("platform not installed")
+		return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform not installed")
+	}
+
+	// The artifacts name is expanded before building to report errors early
+	exportName := ""
+	if template := req.GetArtifactsName(); template != "" {
+		exportName, err = artifactsName(ctx, template, sketch.Name, sketch.FullPath, fqbn)
+		if err != nil {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, err, "expanding artifacts name")
+		}
 	}
 
 	builderCtx := &types.Context{}
@@ -125,28 +163,101 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 	builderCtx.HardwareDirs = configuration.HardwareDirectories()
 	builderCtx.BuiltInToolsDirs = configuration.BundleToolsDirectories()
 
-	builderCtx.OtherLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
+	builderCtx.OtherLibrariesDirs = paths.NewPathList()
 	builderCtx.OtherLibrariesDirs.Add(configuration.LibrariesDir())
+	builderCtx.OtherLibrariesDirs.AddAll(configuration.AdditionalLibrariesDirs())
+	builderCtx.UnmanagedLibrariesDirs = paths.NewPathList(req.GetLibraries()...)
+	// Libraries vendored in the sketch folder are used only to build this sketch
+	if sketchLibrariesDir := sketch.FullPath.Join("libraries"); sketchLibrariesDir.IsDir() {
+		builderCtx.UnmanagedLibrariesDirs.Add(sketchLibrariesDir)
+	}
+	builderCtx.LibraryDirs = paths.NewPathList(req.GetLibrary()...)
+	for _, libDir := range builderCtx.LibraryDirs {
+		if !libDir.IsDir() {
+			return nil, commands.NotFoundError(commands.CodeLibraryNotFound, nil, "library folder not found: %s", libDir)
+		}
+	}
 
 	if req.GetBuildPath() != "" {
-		builderCtx.BuildPath = paths.New(req.GetBuildPath())
+		// Absolute paths are required for the long paths support on Windows
+		builderCtx.BuildPath, err = paths.New(req.GetBuildPath()).Abs()
+		if err != nil {
+			return nil, fmt.Errorf("cannot create build directory: %s", err)
+		}
 		err = builderCtx.BuildPath.MkdirAll()
 		if err != nil {
 			return nil, fmt.Errorf("cannot create build directory: %s", err)
 		}
 	}
 
+	if req.GetLogBuild() != "" {
+		buildLog, err := bldr.NewBuildLog(paths.New(req.GetLogBuild()))
+		if err != nil {
+			return nil, fmt.Errorf("cannot create build log: %s", err)
+		}
+		defer buildLog.Close()
+		builderCtx.BuildLog = buildLog
+	}
+
 	builderCtx.Verbose = req.GetVerbose()
 
 	// Optimize for debug
 	builderCtx.OptimizeForDebug = req.GetOptimizeForDebug()
+	builderCtx.SecretsHeader = secretsHeader
+	builderCtx.GenerateMapFile = req.GetGenerateMapFile()
+	builderCtx.GenerateDisassembly = req.GetGenerateDisassembly()
+	if req.GetBuildFs() {
+		dataDir := sketch.FullPath.Join("data")
+		if !dataDir.IsDir() {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "cannot build the filesystem image: the sketch has no data folder")
+		}
+		builderCtx.FilesystemDataDir = dataDir
+	}
+	if req.GetSignKey() != "" {
+		signKey, err := paths.New(req.GetSignKey()).Abs()
+		if err != nil || !signKey.Exist() {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, err, "signing key %s not found", req.GetSignKey())
+		}
+		builderCtx.SignKey = signKey
+	}
 
 	builderCtx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
 
 	builderCtx.Jobs = int(req.GetJobs())
+	builderCtx.SizeReportSymbols = int(req.GetSizeReportSymbols())
+	if builderCtx.Jobs == 0 {
+		builderCtx.Jobs = viper.GetInt("build.jobs")
+	}
+	if builderCtx.Jobs < 0 {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "invalid number of parallel jobs: %d", builderCtx.Jobs)
+	}
+
+	builderCtx.CompilerLauncher = viper.GetString("build.compiler_launcher")
+	builderCtx.CompilerLauncherEnv = viper.GetStringSlice("build.compiler_launcher_env")
+	for _, env := range builderCtx.CompilerLauncherEnv {
+		if !strings.Contains(env, "=") {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "invalid compiler launcher environment variable '%s', it must be in the KEY=value form", env)
+		}
+	}
+
+	for _, dir := range viper.GetStringSlice("build.sketch_include_dirs") {
+		dir = filepath.Clean(dir)
+		if filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "invalid sketch include dir '%s', it must be a subfolder of the sketch", dir)
+		}
+		builderCtx.SketchIncludeDirs = append(builderCtx.SketchIncludeDirs, filepath.ToSlash(dir))
+	}
 
 	builderCtx.USBVidPid = req.GetVidPid()
 	builderCtx.WarningsLevel = req.GetWarnings()
+	if builderCtx.WarningsLevel == "" {
+		builderCtx.WarningsLevel = viper.GetString("build.warnings")
+	}
+	switch builderCtx.WarningsLevel {
+	case "", "none", "default", "more", "all":
+	default:
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "invalid warnings level '%s', it must be one of none, default, more or all", builderCtx.WarningsLevel)
+	}
 
 	if debug {
 		builderCtx.DebugLevel = 100
@@ -154,7 +265,41 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 		builderCtx.DebugLevel = 5
 	}
 
-	builderCtx.CustomBuildProperties = append(req.GetBuildProperties(), "build.warn_data_percentage=75")
+	// The properties of the board options come first, then the build
+	// properties from the configuration and the ones of the sketch profile,
+	// so that the ones in the request can override them
+	builderCtx.CustomBuildProperties = []string{}
+	for _, option := range []struct{ kind, id string }{
+		{optionPartitions, req.GetPartitions()},
+		{optionLinkerScripts, req.GetLinkerScript()},
+	} {
+		if option.id == "" {
+			continue
+		}
+		optionProperties, err := boardOptionProperties(pm, fqbn, option.kind, option.id)
+		if err != nil {
+			return nil, err
+		}
+		builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, optionProperties...)
+	}
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, viper.GetStringSlice("build.properties")...)
+	builderCtx.ExtraCompilerFlags = []string{}
+	if profile != nil {
+		builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, profile.BuildProperties...)
+		builderCtx.ExtraCompilerFlags = append(builderCtx.ExtraCompilerFlags, profile.ExtraFlags...)
+	}
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, req.GetBuildProperties()...)
+	builderCtx.CustomBuildProperties = append(builderCtx.CustomBuildProperties, "build.warn_data_percentage=75")
+	builderCtx.ExtraCompilerFlags = append(builderCtx.ExtraCompilerFlags, req.GetExtraFlags()...)
+
+	// The macros describing the build come last, they can't be overridden
+	if templates := viper.GetStringSlice("build.metadata"); req.GetBuildMetadata() || len(templates) > 0 {
+		metadataFlags, err := buildMetadataFlags(ctx, templates, sketch.Name, sketch.FullPath, fqbn, profileName)
+		if err != nil {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, err, "expanding build metadata")
+		}
+		builderCtx.ExtraCompilerFlags = append(builderCtx.ExtraCompilerFlags, metadataFlags...)
+	}
 
 	if req.GetBuildCachePath() != "" {
 		builderCtx.BuildCachePath = paths.New(req.GetBuildCachePath())
@@ -188,21 +333,66 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 
 	builderCtx.ExecStdout = outStream
 	builderCtx.ExecStderr = errStream
+	builderCtx.RequestCtx = ctx
 	builderCtx.SetLogger(i18n.LoggerToCustomStreams{Stdout: outStream, Stderr: errStream})
 	builderCtx.Clean = req.GetClean()
+	builderCtx.OnlyUpdateCompilationDatabase = req.GetOnlyCompilationDatabase()
 
-	// if --preprocess or --show-properties were passed, we can stop here
+	// if --preprocess or --show-properties were passed, or only the includes
+	// are requested, we can stop here
 	if req.GetShowProperties() {
 		return &rpc.CompileResp{}, builder.RunParseHardwareAndDumpBuildProperties(builderCtx)
 	} else if req.GetPreprocess() {
-		return &rpc.CompileResp{}, builder.RunPreprocess(builderCtx)
+		if err := builder.RunPreprocess(builderCtx); err != nil {
+			return &rpc.CompileResp{}, commands.ToolFailureError(commands.CodeCompileFailed, err, "preprocessing failed")
+		}
+		if preprocessed != nil {
+			preprocessed(builderCtx.Sketch.MainFile.Name.Base()+".cpp", builderCtx.Source)
+		} else {
+			outStream.Write([]byte(fmt.Sprintln(builderCtx.Source)))
+		}
+		return &rpc.CompileResp{}, nil
+	} else if includesFound != nil {
+		builderCtx.ContinueOnMissingIncludes = true
+		if err := builder.RunFindIncludes(builderCtx); err != nil {
+			return &rpc.CompileResp{}, commands.ToolFailureError(commands.CodeCompileFailed, err, "finding the includes failed")
+		}
+		includesFound(builderCtx)
+		return &rpc.CompileResp{}, nil
 	}
 
 	// if it's a regular build, go on...
 	if err := builder.RunBuilder(builderCtx); err != nil {
-		return nil, err
+		if ctx.Err() != nil {
+			// The tools killed while writing may have left truncated object
+			// files behind, that would be reused by the next build: the build
+			// folder is removed unless it has been chosen by the user
+			if req.GetBuildPath() == "" {
+				logrus.WithField("path", builderCtx.BuildPath).Info("Build interrupted, removing the build folder")
+				builderCtx.BuildPath.RemoveAll()
+			}
+			return nil, fmt.Errorf("compilation interrupted: %w", ctx.Err())
+		}
+		// the diagnostics are the most useful thing to give back on failure
+		return &rpc.CompileResp{
+			Diagnostics:        diagnosticsToRPC(builderCtx.CompilerDiagnostics),
+			LibraryResolutions: libraryResolutionsToRPC(builderCtx.LibrariesResolutionResults),
+		}, commands.ToolFailureError(commands.CodeCompileFailed, err, "compilation failed")
+	}
+
+	// nothing has been compiled, so there are no artifacts to export
+	if req.GetOnlyCompilationDatabase() {
+		if !req.GetQuiet() {
+			outStream.Write([]byte(fmt.Sprintln("Compilation database written to:", builderCtx.CompilationDatabase.File)))
+		}
+		return &rpc.CompileResp{}, nil
 	}
 
+	artifacts := []string{}
+	signedArtifact := ""
+	if builderCtx.SignedArtifact != nil {
+		signedArtifact = builderCtx.SignedArtifact.String()
+	}
 	if !req.GetDryRun() {
 		var exportPath *paths.Path
 		if exportDir := req.GetExportDir(); exportDir != "" {
@@ -228,8 +418,14 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 			return nil, errors.Errorf("reading build directory: %s", err)
 		}
 		buildFiles.FilterPrefix(baseName)
+
+		// The artifacts are renamed replacing "sketch.ino" with the expanded
+		// template, keeping the rest of the name (e.g. ".with_bootloader.hex")
+		if exportName == "" {
+			exportName = baseName
+		}
 		for _, buildFile := range buildFiles {
-			exportedFile := exportPath.Join(buildFile.Base())
+			exportedFile := exportPath.Join(exportName + strings.TrimPrefix(buildFile.Base(), baseName))
 			logrus.
 				WithField("src", buildFile).
 				WithField("dest", exportedFile).
@@ -237,9 +433,128 @@ func Compile(ctx context.Context, req *rpc.CompileReq, outStream, errStream io.W
 			if err = buildFile.CopyTo(exportedFile); err != nil {
 				return nil, errors.Wrapf(err, "copying output file %s", buildFile)
 			}
+			if builderCtx.SignedArtifact != nil && buildFile.EquivalentTo(builderCtx.SignedArtifact) {
+				signedArtifact = exportedFile.String()
+			}
+			artifacts = append(artifacts, exportedFile.String())
 		}
 	}
 
 	logrus.Tracef("Compile %s for %s successful", sketch.Name, fqbnIn)
-	return &rpc.CompileResp{}, nil
+	projectName := builderCtx.BuildProperties.Get("build.project_name")
+	mapFile := ""
+	if f := builderCtx.BuildPath.Join(projectName + ".map"); f.Exist() {
+		mapFile = f.String()
+	}
+	disassemblyFile := ""
+	if f := builderCtx.BuildPath.Join(projectName + ".lst"); req.GetGenerateDisassembly() && f.Exist() {
+		disassemblyFile = f.String()
+	}
+	return &rpc.CompileResp{
+		Diagnostics:        diagnosticsToRPC(builderCtx.CompilerDiagnostics),
+		LibraryResolutions: libraryResolutionsToRPC(builderCtx.LibrariesResolutionResults),
+		Fqbn:               fqbnIn,
+		BuildPath:          builderCtx.BuildPath.String(),
+		SizeReport:         sizeReportToRPC(builderCtx.SizeReport),
+		Artifacts:          artifacts,
+		MapFile:            mapFile,
+		DisassemblyFile:    disassemblyFile,
+		SignedArtifact:     signedArtifact,
+	}, nil
+}
+
+func sizeReportToRPC(report *bldr.SizeReport) *rpc.SizeReport {
+	if report == nil {
+		return nil
+	}
+	res := &rpc.SizeReport{
+		Memory:   []*rpc.MemoryUsage{},
+		Sections: []*rpc.SectionSize{},
+		Symbols:  []*rpc.SymbolSize{},
+	}
+	for _, memory := range report.Memory {
+		res.Memory = append(res.Memory, &rpc.MemoryUsage{
+			Name:    memory.Name,
+			Size:    int64(memory.Size),
+			MaxSize: int64(memory.MaxSize),
+		})
+	}
+	for _, section := range report.Sections {
+		res.Sections = append(res.Sections, &rpc.SectionSize{
+			Name:    section.Name,
+			Address: section.Address,
+			Size:    section.Size,
+		})
+	}
+	for _, symbol := range report.Symbols {
+		res.Symbols = append(res.Symbols, &rpc.SymbolSize{
+			Name:    symbol.Name,
+			Section: symbol.Section,
+			Type:    symbol.Type,
+			Size:    symbol.Size,
+		})
+	}
+	return res
+}
+
+func diagnosticsToRPC(diags []*bldr.CompilerDiagnostic) []*rpc.CompilerDiagnostic {
+	res := []*rpc.CompilerDiagnostic{}
+	for _, diag := range diags {
+		res = append(res, &rpc.CompilerDiagnostic{
+			File:     diag.File,
+			Line:     int32(diag.Line),
+			Column:   int32(diag.Column),
+			Severity: diag.Severity,
+			Message:  diag.Message,
+			Notes:    diagnosticsToRPC(diag.Notes),
+			Option:   diag.Option,
+			Promoted: diag.Promoted,
+		})
+	}
+	return res
+}
+
+// libraryResolutionsToRPC returns the resolution of the headers provided by
+// more than one library, sorted by header
+func libraryResolutionsToRPC(results map[string]types.LibraryResolutionResult) []*rpc.LibraryResolution {
+	res := []*rpc.LibraryResolution{}
+	for header, result := range results {
+		if len(result.NotUsedLibraries) == 0 {
+			continue
+		}
+		res = append(res, libraryResolutionToRPC(header, result))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Header < res[j].Header
+	})
+	return res
+}
+
+func libraryResolutionToRPC(header string, result types.LibraryResolutionResult) *rpc.LibraryResolution {
+	resolution := &rpc.LibraryResolution{
+		Header: header,
+		Used:   resolvedLibraryToRPC(result.Library, ""),
+	}
+	for i, notUsed := range result.NotUsedLibraries {
+		reason := ""
+		if i < len(result.NotUsedReasons) {
+			reason = result.NotUsedReasons[i]
+		}
+		resolution.NotUsed = append(resolution.NotUsed, resolvedLibraryToRPC(notUsed, reason))
+	}
+	return resolution
+}
+
+func resolvedLibraryToRPC(lib *libraries.Library, reason string) *rpc.ResolvedLibrary {
+	installDir := ""
+	if lib.InstallDir != nil {
+		installDir = lib.InstallDir.String()
+	}
+	return &rpc.ResolvedLibrary{
+		Name:       lib.Name,
+		Version:    lib.Version.String(),
+		InstallDir: installDir,
+		Location:   lib.Location.ToRPCLibraryLocation(),
+		Reason:     reason,
+	}
 }
@@ -0,0 +1,92 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// SketchIncludes runs only the include detection stage of the build, it
+// returns the libraries used by the sketch and the includes that can't be
+// resolved, together with the libraries of the index that provide them. The
+// missing includes don't stop the detection, nothing is compiled.
+// The output of the tools run to find the includes is written to errStream.
+func SketchIncludes(ctx context.Context, req *rpc.SketchIncludesReq, errStream io.Writer) (*rpc.SketchIncludesResp, error) {
+	res := &rpc.SketchIncludesResp{}
+	_, err := compile(ctx, &rpc.CompileReq{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+		Libraries:       req.GetLibraries(),
+		Library:         req.GetLibrary(),
+		Profile:         req.GetProfile(),
+	}, errStream, errStream, false, nil, func(builderCtx *types.Context) {
+		for header, result := range builderCtx.LibrariesResolutionResults {
+			res.Libraries = append(res.Libraries, libraryResolutionToRPC(header, result))
+		}
+		sort.Slice(res.Libraries, func(i, j int) bool {
+			return res.Libraries[i].Header < res.Libraries[j].Header
+		})
+
+		var index *librariesindex.Index
+		if lm := commands.GetLibraryManager(req.GetInstance().GetId()); lm != nil {
+			index = lm.Index
+		}
+		for _, unresolved := range builderCtx.UnresolvedIncludes {
+			res.UnresolvedIncludes = append(res.UnresolvedIncludes, &rpc.UnresolvedInclude{
+				Header:             unresolved.Header,
+				File:               unresolved.SourceFile.String(),
+				SuggestedLibraries: librariesProvidingHeader(index, unresolved.Header),
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// librariesProvidingHeader returns the names of the libraries of the index
+// whose latest release provides header, sorted by name
+func librariesProvidingHeader(index *librariesindex.Index, header string) []string {
+	res := []string{}
+	if index == nil {
+		return res
+	}
+	for name, lib := range index.Libraries {
+		if lib.Latest == nil {
+			continue
+		}
+		for _, provided := range lib.Latest.ProvidesIncludes {
+			if strings.EqualFold(provided, header) {
+				res = append(res, name)
+				break
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,37 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibrariesProvidingHeader(t *testing.T) {
+	index := &librariesindex.Index{Libraries: map[string]*librariesindex.Library{
+		"Servo":     {Name: "Servo", Latest: &librariesindex.Release{ProvidesIncludes: []string{"Servo.h"}}},
+		"ServoEasy": {Name: "ServoEasy", Latest: &librariesindex.Release{ProvidesIncludes: []string{"ServoEasy.h", "servo.h"}}},
+		"WiFiNINA":  {Name: "WiFiNINA", Latest: &librariesindex.Release{ProvidesIncludes: []string{"WiFiNINA.h"}}},
+		"NoRelease": {Name: "NoRelease"},
+	}}
+
+	require.Equal(t, []string{"Servo", "ServoEasy"}, librariesProvidingHeader(index, "Servo.h"))
+	require.Equal(t, []string{"WiFiNINA"}, librariesProvidingHeader(index, "WiFiNINA.h"))
+	require.Empty(t, librariesProvidingHeader(index, "Missing.h"))
+	require.Empty(t, librariesProvidingHeader(nil, "Servo.h"))
+}
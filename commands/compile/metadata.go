@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/cli/globals"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultBuildMetadata are the macros defined with --build-metadata when the
+// build.metadata setting is empty
+var defaultBuildMetadata = []string{
+	"ARDUINO_CLI_BUILD_TIMESTAMP={timestamp}",
+	"ARDUINO_CLI_BUILD_GIT={git}",
+	"ARDUINO_CLI_BUILD_PROFILE={profile}",
+	"ARDUINO_CLI_VERSION={version}",
+}
+
+var macroNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// buildMetadataFlags returns the compiler flags defining the macros that
+// describe the build. Each template is in the NAME=value form, the value is
+// defined as a string literal after expanding the placeholders {sketch},
+// {fqbn}, {board}, {git}, {timestamp} (as in the artifacts name), {profile}
+// (the name of the sketch profile used, if any) and {version} (the version
+// of the CLI). {git} is empty if the sketch is not in a git repository.
+func buildMetadataFlags(ctx context.Context, templates []string, sketchName string, sketchPath *paths.Path, fqbn *cores.FQBN, profileName string) ([]string, error) {
+	if len(templates) == 0 {
+		templates = defaultBuildMetadata
+	}
+	flags := []string{}
+	for _, template := range templates {
+		split := strings.SplitN(template, "=", 2)
+		if len(split) != 2 || !macroNameRegexp.MatchString(split[0]) {
+			return nil, fmt.Errorf("invalid build metadata '%s', it must be in the NAME=value form with NAME a valid C identifier", template)
+		}
+		value, err := expandPlaceholders(split[1], func(placeholder string) (string, error) {
+			switch placeholder {
+			case "{git}":
+				describe, err := gitDescribe(ctx, sketchPath)
+				if err != nil {
+					logrus.WithError(err).Warn("Cannot describe the git commit of the sketch")
+					return "", nil
+				}
+				return describe, nil
+			case "{profile}":
+				return profileName, nil
+			case "{version}":
+				return globals.VersionInfo.VersionString, nil
+			default:
+				return placeholderValue(ctx, placeholder, sketchName, sketchPath, fqbn)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		// The flags are split on spaces and quotes when the command line is
+		// built, so the quotes and backslashes can't be escaped
+		if strings.ContainsAny(value, "\"'\\\n") {
+			return nil, fmt.Errorf("invalid value of build metadata %s: quotes, backslashes and newlines are not allowed", split[0])
+		}
+		flags = append(flags, fmt.Sprintf(`'-D%s="%s"'`, split[0], value))
+	}
+	return flags, nil
+}
@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMetadataFlags(t *testing.T) {
+	fqbn, err := cores.ParseFQBN("arduino:avr:nano:cpu=atmega328old")
+	require.NoError(t, err)
+	sketchPath := paths.New("testdata", "Blink")
+
+	os.Setenv("SOURCE_DATE_EPOCH", "1615818301")
+	defer os.Unsetenv("SOURCE_DATE_EPOCH")
+
+	flags, err := buildMetadataFlags(context.Background(), []string{
+		"FIRMWARE_NAME={sketch} for {board}",
+		"FIRMWARE_BUILT={timestamp}",
+		"FIRMWARE_PROFILE={profile}",
+		"FIRMWARE_VENDOR=ACME",
+	}, "Blink", sketchPath, fqbn, "release")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`'-DFIRMWARE_NAME="Blink for nano"'`,
+		`'-DFIRMWARE_BUILT="20210315-142501"'`,
+		`'-DFIRMWARE_PROFILE="release"'`,
+		`'-DFIRMWARE_VENDOR="ACME"'`,
+	}, flags)
+
+	flags, err = buildMetadataFlags(context.Background(), nil, "Blink", sketchPath, fqbn, "")
+	require.NoError(t, err)
+	require.Len(t, flags, len(defaultBuildMetadata))
+	require.Contains(t, flags, `'-DARDUINO_CLI_BUILD_TIMESTAMP="20210315-142501"'`)
+	require.Contains(t, flags, `'-DARDUINO_CLI_BUILD_PROFILE=""'`)
+
+	for _, template := range []string{"FIRMWARE", "1FIRMWARE=x", "FIRMWARE={unknown}", `FIRMWARE=it's "mine"`} {
+		_, err = buildMetadataFlags(context.Background(), []string{template}, "Blink", sketchPath, fqbn, "")
+		require.Error(t, err, template)
+	}
+}
@@ -0,0 +1,50 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"io"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// Preprocess runs only the preprocessing stage of the build: the .ino files of
+// the sketch are merged, the missing prototypes are generated and the #line
+// directives are added. The resulting C++ source, the same that would be
+// compiled, is returned instead of being compiled.
+// The output of the tools run to preprocess the sketch is written to errStream.
+func Preprocess(ctx context.Context, req *rpc.PreprocessReq, errStream io.Writer) (*rpc.PreprocessResp, error) {
+	res := &rpc.PreprocessResp{}
+	_, err := compile(ctx, &rpc.CompileReq{
+		Instance:        req.GetInstance(),
+		Fqbn:            req.GetFqbn(),
+		SketchPath:      req.GetSketchPath(),
+		BuildPath:       req.GetBuildPath(),
+		BuildProperties: req.GetBuildProperties(),
+		Libraries:       req.GetLibraries(),
+		Library:         req.GetLibrary(),
+		Profile:         req.GetProfile(),
+		Preprocess:      true,
+	}, errStream, errStream, false, func(fileName, source string) {
+		res.FileName = fileName
+		res.Source = source
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,145 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// loadProfile returns the name and the profile of the sketch requested by the
+// client, or the default one of the sketch.yaml file. It returns nil if the
+// sketch doesn't use profiles.
+func loadProfile(sketch *sketches.Sketch, name string) (string, *sketches.Profile, error) {
+	project, err := sketches.LoadProjectFile(sketch.FullPath)
+	if err != nil {
+		return "", nil, err
+	}
+	if project == nil {
+		if name != "" {
+			return "", nil, commands.NotFoundError(commands.CodeProfileNotFound, nil, "profile '%s' not found: sketch.yaml is missing", name)
+		}
+		return "", nil, nil
+	}
+	if name == "" {
+		name = project.DefaultProfile
+	}
+	if name == "" {
+		return "", nil, nil
+	}
+	profile, err := project.GetProfile(name)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, profile, nil
+}
+
+// installProfile checks that the platform and the libraries pinned in the
+// profile are installed with the exact version required, installing them
+// if install is true
+func installProfile(ctx context.Context, instance *rpc.Instance, profile *sketches.Profile, install bool, outStream io.Writer) error {
+	taskCB := func(p *rpc.TaskProgress) {
+		if p.GetName() != "" {
+			fmt.Fprintln(outStream, p.GetName())
+		}
+	}
+	downloadCB := func(*rpc.DownloadProgress) {}
+
+	if profile.Platform != "" {
+		ref, version, err := sketches.ParsePinnedReference(profile.Platform)
+		if err != nil {
+			return err
+		}
+		split := strings.Split(ref, ":")
+		if len(split) != 2 {
+			return commands.InvalidArgumentError(commands.CodeInvalidSketch, nil, "invalid platform '%s': must be in the PACKAGER:ARCH@VERSION form", profile.Platform)
+		}
+		if !profilePlatformInstalled(commands.GetPackageManager(instance.GetId()), split[0], split[1], version) {
+			if !install {
+				return commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform %s required by the profile is not installed", profile.Platform)
+			}
+			_, err := core.PlatformInstall(ctx, &rpc.PlatformInstallReq{
+				Instance:        instance,
+				PlatformPackage: split[0],
+				Architecture:    split[1],
+				Version:         version,
+			}, downloadCB, taskCB)
+			if err != nil {
+				return fmt.Errorf("installing platform %s: %w", profile.Platform, err)
+			}
+			// Another version of the platform may still take precedence
+			if !profilePlatformInstalled(commands.GetPackageManager(instance.GetId()), split[0], split[1], version) {
+				return commands.FailedPreconditionError(commands.CodePlatformInUse, nil, "platform %s required by the profile is not the one in use, uninstall the other versions", profile.Platform)
+			}
+		}
+	}
+
+	for _, libRef := range profile.Libraries {
+		name, version, err := sketches.ParsePinnedReference(libRef)
+		if err != nil {
+			return err
+		}
+		parsedVersion, err := semver.Parse(version)
+		if err != nil {
+			return commands.InvalidArgumentError(commands.CodeInvalidVersion, err, "invalid version of library %s", libRef)
+		}
+		lm := commands.GetLibraryManager(instance.GetId())
+		if lm.FindByReference(&librariesindex.Reference{Name: name, Version: parsedVersion}) != nil {
+			continue
+		}
+		if !install {
+			return commands.FailedPreconditionError(commands.CodeLibraryNotInstalled, nil, "library %s required by the profile is not installed", libRef)
+		}
+		err = lib.LibraryInstall(ctx, &rpc.LibraryInstallReq{
+			Instance: instance,
+			Name:     name,
+			Version:  version,
+			NoDeps:   true,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return fmt.Errorf("installing library %s: %w", libRef, err)
+		}
+	}
+	return nil
+}
+
+// profilePlatformInstalled returns true if the platform in use is the
+// given version
+func profilePlatformInstalled(pm *packagemanager.PackageManager, packager, arch, version string) bool {
+	if pm == nil {
+		return false
+	}
+	platform := pm.FindPlatform(&packagemanager.PlatformReference{
+		Package:              packager,
+		PlatformArchitecture: arch,
+	})
+	if platform == nil {
+		return false
+	}
+	installed := pm.GetInstalledPlatformRelease(platform)
+	return installed != nil && installed.Version.String() == version
+}
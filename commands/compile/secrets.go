@@ -0,0 +1,41 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package compile
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/commands"
+)
+
+// loadSecrets returns the content of the arduino_secrets.h generated from the
+// secrets declared in the sketch.yaml file, or nil if there are none. The
+// values are read from the environment and from the encrypted file.
+func loadSecrets(sketch *sketches.Sketch) ([]byte, error) {
+	project, err := sketches.LoadProjectFile(sketch.FullPath)
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidSketch, err, "loading sketch project file")
+	}
+	if project == nil || project.Secrets == nil {
+		return nil, nil
+	}
+	values, err := project.Secrets.Values(sketch.FullPath, os.LookupEnv)
+	if err != nil {
+		return nil, commands.FailedPreconditionError(commands.CodeMissingSecrets, err, "loading the secrets of the sketch")
+	}
+	return sketches.SecretsHeader(values), nil
+}
@@ -0,0 +1,62 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// PlatformCleanCache removes the platform and tool archives from the downloads
+// directory
+func PlatformCleanCache(ctx context.Context, req *rpc.PlatformCleanCacheReq) (*rpc.PlatformCleanCacheResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	referenced := map[string]bool{}
+	addResource := func(resource *resources.DownloadResource) {
+		if resource != nil {
+			referenced[pm.DownloadDir.Join(resource.CachePath, resource.ArchiveFileName).String()] = true
+		}
+	}
+	for _, targetPackage := range pm.Packages {
+		for _, platform := range targetPackage.Platforms {
+			for _, platformRelease := range platform.Releases {
+				addResource(platformRelease.Resource)
+			}
+		}
+		for _, tool := range targetPackage.Tools {
+			for _, toolRelease := range tool.Releases {
+				for _, flavor := range toolRelease.Flavors {
+					addResource(flavor.Resource)
+				}
+			}
+		}
+	}
+
+	dirs := paths.PathList{pm.DownloadDir.Join("packages"), pm.DownloadDir.Join("tools")}
+	archives, size, err := commands.CleanDownloadsCache(dirs, referenced, req.GetOlderThanDays(), req.GetOnlyOrphaned(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.PlatformCleanCacheResp{Archives: archives, Size: size}, nil
+}
@@ -0,0 +1,52 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// PlatformCleanTools uninstalls the tools not required by any installed
+// platform and removes the unreferenced entries of the tools store.
+func PlatformCleanTools(ctx context.Context, req *rpc.PlatformCleanToolsReq, taskCB commands.TaskProgressCB) (*rpc.PlatformCleanToolsResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	for _, tool := range pm.UnusedToolReleases() {
+		if err := uninstallToolReleasse(pm, tool, taskCB); err != nil {
+			return nil, err
+		}
+	}
+
+	taskCB(&rpc.TaskProgress{Name: tr("Cleaning tools store")})
+	removed, err := pm.CleanToolsStore()
+	for _, entry := range removed {
+		taskCB(&rpc.TaskProgress{Message: tr("Removed %s", entry.Base()), Completed: true})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := commands.Rescan(req.GetInstance().GetId()); err != nil {
+		return nil, err
+	}
+	return &rpc.PlatformCleanToolsResp{}, nil
+}
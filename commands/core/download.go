@@ -17,25 +17,25 @@ package core
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"go.bug.st/downloader/v2"
 )
 
 // PlatformDownload FIXMEDOC
 func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadReq, downloadCB commands.DownloadProgressCB) (*rpc.PlatformDownloadResp, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	version, err := commands.ParseVersion(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid version: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidVersion, err, "invalid version")
 	}
 
 	platform, tools, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{
@@ -44,42 +44,52 @@ func PlatformDownload(ctx context.Context, req *rpc.PlatformDownloadReq, downloa
 		PlatformVersion:      version,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("find platform dependencies: %s", err)
+		return nil, commands.NotFoundError(commands.CodePlatformNotFound, err, "find platform dependencies")
 	}
 
-	err = downloadPlatform(pm, platform, downloadCB)
-	if err != nil {
+	if err := downloadPlatformAndTools(ctx, pm, platform, tools, downloadCB); err != nil {
 		return nil, err
 	}
 
-	for _, tool := range tools {
-		err := downloadTool(pm, tool, downloadCB)
-		if err != nil {
-			return nil, fmt.Errorf("downloading tool %s: %s", tool, err)
-		}
-	}
-
 	return &rpc.PlatformDownloadResp{}, nil
 }
 
-func downloadPlatform(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, downloadCB commands.DownloadProgressCB) error {
-	// Download platform
+// downloadPlatformAndTools downloads the platform release and the required tools
+// concurrently
+func downloadPlatformAndTools(ctx context.Context, pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease,
+	tools []*cores.ToolRelease, downloadCB commands.DownloadProgressCB) error {
 	config, err := commands.GetDownloaderConfig()
 	if err != nil {
 		return err
 	}
-	resp, err := pm.DownloadPlatformRelease(platformRelease, config)
-	if err != nil {
-		return err
-	}
-	return commands.Download(resp, platformRelease.String(), downloadCB)
-}
 
-func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, downloadCB commands.DownloadProgressCB) error {
-	// Check if tool has a flavor available for the current OS
-	if tool.GetCompatibleFlavour() == nil {
-		return fmt.Errorf("tool %s not available for the current OS", tool)
+	tasks := []*commands.DownloadTask{}
+	for _, tool := range tools {
+		flavour := tool.GetCompatibleFlavour()
+		if flavour == nil {
+			return commands.NotFoundError(commands.CodeToolNotFound, nil, "tool %s not available for the current OS", tool)
+		}
+		tool := tool
+		tasks = append(tasks, &commands.DownloadTask{
+			Label: tool.String(),
+			Size:  flavour.Size,
+			Start: func() (*downloader.Downloader, error) {
+				d, err := pm.DownloadToolRelease(tool, config)
+				if err != nil {
+					return nil, fmt.Errorf("downloading tool %s: %w", tool, err)
+				}
+				return d, nil
+			},
+			Verify: func() error { return flavour.VerifyLocalArchive(pm.DownloadDir) },
+		})
 	}
-
-	return commands.DownloadToolRelease(pm, tool, downloadCB)
+	tasks = append(tasks, &commands.DownloadTask{
+		Label: platformRelease.String(),
+		Size:  platformRelease.Resource.Size,
+		Start: func() (*downloader.Downloader, error) {
+			return pm.DownloadPlatformRelease(platformRelease, config)
+		},
+		Verify: func() error { return platformRelease.Resource.VerifyLocalArchive(pm.DownloadDir) },
+	})
+	return commands.DownloadAll(ctx, tasks, "Downloading "+platformRelease.String(), downloadCB)
 }
@@ -18,26 +18,64 @@ package core
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"runtime"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
-	"github.com/pkg/errors"
+	paths "github.com/arduino/go-paths-helper"
+	"go.bug.st/downloader/v2"
+	semver "go.bug.st/relaxed-semver"
 )
 
+var tr = i18n.Tr
+
 // PlatformInstall FIXMEDOC
 func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallReq,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) (*rpc.PlatformInstallResp, error) {
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	version, err := commands.ParseVersion(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid version: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidVersion, err, "invalid version")
+	}
+
+	if req.GetFromDir() != "" {
+		if req.GetArchive() != "" {
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "a platform can't be installed from both an archive and a directory")
+		}
+		taskCB(&rpc.TaskProgress{Name: tr("Linking %s", req.GetFromDir())})
+		platformRelease, err := pm.InstallPlatformFromDir(paths.New(req.GetFromDir()), req.PlatformPackage, req.Architecture, version)
+		if err != nil {
+			return nil, commands.FailedPreconditionError(commands.CodeInstallFailed, err, "installing platform from %s", req.GetFromDir())
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("%s linked to %s", platformRelease.String(), req.GetFromDir()), Completed: true})
+		if _, err := commands.Rescan(req.GetInstance().GetId()); err != nil {
+			return nil, err
+		}
+		return &rpc.PlatformInstallResp{}, nil
+	}
+
+	if req.GetArchive() != "" {
+		postInstall, err := installPlatformFromArchive(ctx, pm, req.GetArchive(), req.PlatformPackage, req.Architecture, version,
+			downloadCB, taskCB, req.GetSkipPostInstall())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := commands.Rescan(req.GetInstance().GetId()); err != nil {
+			return nil, err
+		}
+		return &rpc.PlatformInstallResp{PostInstallScript: postInstallScriptToRPC(postInstall)}, nil
 	}
 
 	platform, tools, err := pm.FindPlatformReleaseDependencies(&packagemanager.PlatformReference{
@@ -46,10 +84,10 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallReq,
 		PlatformVersion:      version,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("finding platform dependencies: %s", err)
+		return nil, commands.NotFoundError(commands.CodePlatformNotFound, err, "finding platform dependencies")
 	}
 
-	err = installPlatform(pm, platform, tools, downloadCB, taskCB, req.GetSkipPostInstall())
+	postInstall, err := installPlatform(ctx, pm, platform, tools, downloadCB, taskCB, req.GetSkipPostInstall())
 	if err != nil {
 		return nil, err
 	}
@@ -59,65 +97,135 @@ func PlatformInstall(ctx context.Context, req *rpc.PlatformInstallReq,
 		return nil, err
 	}
 
-	return &rpc.PlatformInstallResp{}, nil
+	return &rpc.PlatformInstallResp{PostInstallScript: postInstallScriptToRPC(postInstall)}, nil
+}
+
+func installPlatformFromArchive(ctx context.Context, pm *packagemanager.PackageManager,
+	archive, packageName, architecture string, version *semver.Version,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB,
+	skipPostInstall bool) (*paths.Path, error) {
+
+	// Download the archive first if an URL has been specified
+	var archivePath *paths.Path
+	archiveURL := ""
+	if u, err := url.Parse(archive); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		archiveURL = archive
+		archivePath = pm.DownloadDir.Join("packages", path.Base(u.Path))
+		if err := archivePath.Parent().MkdirAll(); err != nil {
+			return nil, fmt.Errorf("creating download dir: %s", err)
+		}
+		config, err := commands.GetDownloaderConfig()
+		if err != nil {
+			return nil, err
+		}
+		d, err := downloader.DownloadWithConfig(archivePath.String(), archiveURL, *config, downloader.NoResume)
+		if err != nil {
+			return nil, commands.NetworkError(commands.CodeDownloadFailed, err, "downloading %s", archiveURL)
+		}
+		if err := commands.Download(ctx, d, archivePath.Base(), downloadCB); err != nil {
+			return nil, fmt.Errorf("downloading %s: %w", archiveURL, err)
+		}
+	} else {
+		archivePath = paths.New(archive)
+		if !archivePath.Exist() {
+			return nil, commands.NotFoundError(commands.CodeArchiveNotFound, nil, "archive not found: %s", archive)
+		}
+	}
+
+	taskCB(&rpc.TaskProgress{Name: tr("Installing %s", archivePath.Base())})
+	platformRelease, err := pm.InstallPlatformFromArchive(archivePath, archiveURL, packageName, architecture, version)
+	if err != nil {
+		return nil, commands.FailedPreconditionError(commands.CodeInstallFailed, err, "installing platform from %s", archivePath.Base())
+	}
+
+	postInstall, err := commands.RunPlatformPostInstall(ctx, pm, platformRelease, skipPostInstall, taskCB)
+	if err != nil {
+		return nil, err
+	}
+
+	taskCB(&rpc.TaskProgress{Message: tr("%s installed", platformRelease.String()), Completed: true})
+	return postInstall, nil
 }
 
-func installPlatform(pm *packagemanager.PackageManager,
+func installPlatform(ctx context.Context, pm *packagemanager.PackageManager,
 	platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB,
-	skipPostInstall bool) error {
+	skipPostInstall bool) (*paths.Path, error) {
 	log := pm.Log.WithField("platform", platformRelease)
 
 	// Prerequisite checks before install
 	if platformRelease.IsInstalled() {
 		log.Warn("Platform already installed")
-		taskCB(&rpc.TaskProgress{Name: "Platform " + platformRelease.String() + " already installed", Completed: true})
-		return nil
+		taskCB(&rpc.TaskProgress{Name: tr("Platform %s already installed", platformRelease.String()), Completed: true})
+		return nil, nil
 	}
 	toolsToInstall := []*cores.ToolRelease{}
+	missingTools := []string{}
 	for _, tool := range requiredTools {
 		if tool.IsInstalled() {
 			log.WithField("tool", tool).Warn("Tool already installed")
-			taskCB(&rpc.TaskProgress{Name: "Tool " + tool.String() + " already installed", Completed: true})
+			taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", tool.String()), Completed: true})
+		} else if !tool.IsCompatibleWithCurrentMachine() {
+			missingTools = append(missingTools, tool.String())
 		} else {
 			toolsToInstall = append(toolsToInstall, tool)
 		}
 	}
+	if len(missingTools) > 0 {
+		return nil, commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "platform %s is not compatible with %s/%s, no suitable version available for tools: %s",
+			platformRelease, runtime.GOOS, runtime.GOARCH, strings.Join(missingTools, ", "))
+	}
+	if platformRelease.Platform.Deprecated {
+		taskCB(&rpc.TaskProgress{Message: tr("WARNING: platform %s is deprecated", platformRelease.Platform)})
+	}
 
-	// Package download
-	taskCB(&rpc.TaskProgress{Name: "Downloading packages"})
+	// In offline mode everything must be already downloaded
+	archives := map[string]*resources.DownloadResource{platformRelease.String(): platformRelease.Resource}
 	for _, tool := range toolsToInstall {
-		if err := downloadTool(pm, tool, downloadCB); err != nil {
-			return err
+		if flavour := tool.GetCompatibleFlavour(); flavour != nil {
+			archives[tool.String()] = flavour
 		}
 	}
-	downloadPlatform(pm, platformRelease, downloadCB)
+	if err := commands.CheckDownloadsCache(pm.DownloadDir, archives); err != nil {
+		return nil, err
+	}
+
+	// Package download
+	taskCB(&rpc.TaskProgress{Name: tr("Downloading packages")})
+	if err := downloadPlatformAndTools(ctx, pm, platformRelease, toolsToInstall, downloadCB); err != nil {
+		return nil, err
+	}
 	taskCB(&rpc.TaskProgress{Completed: true})
 
 	// Install tools first
 	for _, tool := range toolsToInstall {
-		err := commands.InstallToolRelease(pm, tool, taskCB)
-		if err != nil {
-			// TODO: handle error
+		if err := commands.InstallToolRelease(pm, tool, taskCB); err != nil {
+			return nil, err
 		}
 	}
 
 	// Are we installing or upgrading?
 	platform := platformRelease.Platform
-	installed := pm.GetInstalledPlatformRelease(platform)
+	installed := pm.GetInstalledManagedPlatformRelease(platform)
 	if installed == nil {
 		log.Info("Installing platform")
-		taskCB(&rpc.TaskProgress{Name: "Installing " + platformRelease.String()})
+		taskCB(&rpc.TaskProgress{Name: tr("Installing %s", platformRelease.String())})
 	} else {
 		log.Info("Updating platform " + installed.String())
-		taskCB(&rpc.TaskProgress{Name: "Updating " + installed.String() + " with " + platformRelease.String()})
+		taskCB(&rpc.TaskProgress{Name: tr("Updating %s with %s", installed.String(), platformRelease.String())})
 	}
 
 	// Install
-	err := pm.InstallPlatform(platformRelease)
+	err := pm.InstallPlatform(platformRelease, commands.NewExtractProgressCB(tr("Installing %s", platformRelease.String()), taskCB))
 	if err != nil {
 		log.WithError(err).Error("Cannot install platform")
-		return err
+		return nil, err
+	}
+
+	// Perform post install, the new release is removed if it fails
+	postInstall, err := commands.RunPlatformPostInstall(ctx, pm, platformRelease, skipPostInstall, taskCB)
+	if err != nil {
+		return nil, err
 	}
 
 	// If upgrading remove previous release
@@ -127,31 +235,23 @@ func installPlatform(pm *packagemanager.PackageManager,
 		// In case of error try to rollback
 		if errUn != nil {
 			log.WithError(errUn).Error("Error updating platform.")
-			taskCB(&rpc.TaskProgress{Message: "Error updating platform: " + err.Error()})
+			taskCB(&rpc.TaskProgress{Message: tr("Error updating platform: %s", errUn.Error())})
 
 			// Rollback
 			if err := pm.UninstallPlatform(platformRelease); err != nil {
 				log.WithError(err).Error("Error rolling-back changes.")
-				taskCB(&rpc.TaskProgress{Message: "Error rolling-back changes: " + err.Error()})
+				taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", err.Error())})
 			}
 
-			return fmt.Errorf("updating platform: %s", errUn)
+			return nil, fmt.Errorf("updating platform: %w", errUn)
 		}
 	}
 
-	// Perform post install
-	if !skipPostInstall {
-		log.Info("Running post_install script")
-		taskCB(&rpc.TaskProgress{Message: "Configuring platform"})
-		if err := pm.RunPostInstallScript(platformRelease); err != nil {
-			taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("WARNING: cannot run post install: %s", err)})
-		}
-	} else {
-		log.Info("Skipping platform configuration (post_install run).")
-		taskCB(&rpc.TaskProgress{Message: "Skipping platform configuration"})
+	if err := commands.EnforceDownloadsMaxSize(); err != nil {
+		log.WithError(err).Warn("Cannot trim downloads directory")
 	}
 
 	log.Info("Platform installed")
-	taskCB(&rpc.TaskProgress{Message: platformRelease.String() + " installed", Completed: true})
-	return nil
+	taskCB(&rpc.TaskProgress{Message: tr("%s installed", platformRelease.String()), Completed: true})
+	return postInstall, nil
 }
@@ -18,20 +18,18 @@ package core
 import (
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands"
-	"github.com/pkg/errors"
 )
 
 // GetPlatforms returns a list of installed platforms, optionally filtered by
 // those requiring an update.
 func GetPlatforms(instanceID int32, updatableOnly bool) ([]*cores.PlatformRelease, error) {
-	i := commands.GetInstance(instanceID)
-	if i == nil {
-		return nil, errors.Errorf("unable to find an instance with ID: %d", instanceID)
+	if commands.GetInstance(instanceID) == nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidInstance, nil, "unable to find an instance with ID: %d", instanceID)
 	}
 
-	packageManager := i.PackageManager
+	packageManager := commands.GetPackageManager(instanceID)
 	if packageManager == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	res := []*cores.PlatformRelease{}
@@ -0,0 +1,71 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package core
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/executils"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// PlatformPostInstall runs the post-install script of an installed platform,
+// e.g. after the user agreed to run the script skipped during the install.
+func PlatformPostInstall(ctx context.Context, req *rpc.PlatformPostInstallReq, taskCB commands.TaskProgressCB) (*rpc.PlatformPostInstallResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	ref := &packagemanager.PlatformReference{
+		Package:              req.GetPlatformPackage(),
+		PlatformArchitecture: req.GetArchitecture(),
+	}
+	platform := pm.FindPlatform(ref)
+	if platform == nil {
+		return nil, commands.NotFoundError(commands.CodePlatformNotFound, nil, "platform %s not found", ref)
+	}
+	platformRelease := pm.GetInstalledPlatformRelease(platform)
+	if platformRelease == nil {
+		return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform %s is not installed", ref)
+	}
+	if pm.PostInstallScript(platformRelease) == nil {
+		taskCB(&rpc.TaskProgress{Name: tr("Platform %s has no post-install script", platformRelease), Completed: true})
+		return &rpc.PlatformPostInstallResp{}, nil
+	}
+
+	taskCB(&rpc.TaskProgress{Name: tr("Configuring platform %s", platformRelease)})
+	err := pm.RunPostInstallScript(ctx, platformRelease, func(line *executils.OutputLine) {
+		taskCB(&rpc.TaskProgress{Output: line.Text})
+	})
+	if err != nil {
+		return nil, commands.ToolFailureError(commands.CodeInstallFailed, err, "configuring platform %s", platformRelease)
+	}
+	taskCB(&rpc.TaskProgress{Message: tr("%s configured", platformRelease), Completed: true})
+	return &rpc.PlatformPostInstallResp{}, nil
+}
+
+// postInstallScriptToRPC returns the path of the post-install script that
+// has not been run, if any
+func postInstallScriptToRPC(postInstall *paths.Path) string {
+	if postInstall == nil {
+		return ""
+	}
+	return postInstall.String()
+}
@@ -16,11 +16,11 @@
 package core
 
 import (
-	"errors"
 	"regexp"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 )
@@ -37,7 +37,7 @@ func exactMatch(line, searchArgs string) bool {
 func PlatformSearch(instanceID int32, searchArgs string, allVersions bool) (*rpc.PlatformSearchResp, error) {
 	pm := commands.GetPackageManager(instanceID)
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	res := []*cores.PlatformRelease{}
@@ -45,6 +45,7 @@ func PlatformSearch(instanceID int32, searchArgs string, allVersions bool) (*rpc
 		vid, pid := searchArgs[:4], searchArgs[5:]
 		res = pm.FindPlatformReleaseProvidingBoardsWithVidPid(vid, pid)
 	} else {
+		terms := strings.Fields(searchArgs)
 		for _, targetPackage := range pm.Packages {
 			for _, platform := range targetPackage.Platforms {
 				// discard invalid platforms
@@ -59,27 +60,13 @@ func PlatformSearch(instanceID int32, searchArgs string, allVersions bool) (*rpc
 				}
 
 				// platform has a valid release, check if it matches the search arguments
-				if match(platform.Name, searchArgs) || match(platform.Architecture, searchArgs) ||
-					exactMatch(platform.String(), searchArgs) || match(targetPackage.Name, searchArgs) ||
-					match(targetPackage.Maintainer, searchArgs) || match(targetPackage.WebsiteURL, searchArgs) {
-					if allVersions {
-						res = append(res, platform.GetAllReleases()...)
-					} else {
-						res = append(res, platformRelease)
-					}
+				if !exactMatch(platform.String(), searchArgs) && !platformMatches(pm, platformRelease, terms) {
+					continue
+				}
+				if allVersions {
+					res = append(res, platform.GetAllReleases()...)
 				} else {
-					// if we didn't find a match in the platform data, search for
-					// a match in the boards manifest
-					for _, board := range platformRelease.BoardsManifest {
-						if match(board.Name, searchArgs) {
-							if allVersions {
-								res = append(res, platform.GetAllReleases()...)
-							} else {
-								res = append(res, platformRelease)
-							}
-							break
-						}
-					}
+					res = append(res, platformRelease)
 				}
 			}
 		}
@@ -88,6 +75,46 @@ func PlatformSearch(instanceID int32, searchArgs string, allVersions bool) (*rpc
 	out := make([]*rpc.Platform, len(res))
 	for i, platformRelease := range res {
 		out[i] = commands.PlatformReleaseToRPC(platformRelease)
+		if installed := pm.GetInstalledPlatformRelease(platformRelease.Platform); installed != nil {
+			out[i].Installed = installed.Version.String()
+		}
 	}
 	return &rpc.PlatformSearchResp{SearchOutput: out}, nil
 }
+
+// platformMatches returns true if each one of the search terms is found in
+// the platform data or in the name of one of its boards, either the boards
+// listed in the index or the ones of the installed release
+func platformMatches(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, terms []string) bool {
+	platform := platformRelease.Platform
+	targetPackage := platform.Package
+	fields := []string{
+		platform.Name,
+		platform.Architecture,
+		targetPackage.Name,
+		targetPackage.Maintainer,
+		targetPackage.WebsiteURL,
+	}
+	for _, board := range platformRelease.BoardsManifest {
+		fields = append(fields, board.Name)
+	}
+	if installed := pm.GetInstalledPlatformRelease(platform); installed != nil {
+		for _, board := range installed.Boards {
+			fields = append(fields, board.Name(), board.BoardID)
+		}
+	}
+
+	for _, term := range terms {
+		found := false
+		for _, field := range fields {
+			if match(field, term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
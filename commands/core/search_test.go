@@ -213,4 +213,15 @@ func TestPlatformSearch(t *testing.T) {
 			{Name: "Linino One"},
 		},
 	})
+	// Search using several keywords, all of them must match
+	res, err = PlatformSearch(inst.GetId(), "leonardo ethernet avr", false)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.SearchOutput, 1)
+	require.Equal(t, "arduino:avr", res.SearchOutput[0].ID)
+
+	res, err = PlatformSearch(inst.GetId(), "rk002 leonardo", false)
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	require.Len(t, res.SearchOutput, 0)
 }
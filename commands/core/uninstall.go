@@ -17,8 +17,7 @@ package core
 
 import (
 	"context"
-	"errors"
-	"fmt"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
@@ -30,7 +29,7 @@ import (
 func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallReq, taskCB commands.TaskProgressCB) (*rpc.PlatformUninstallResp, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	ref := &packagemanager.PlatformReference{
@@ -40,12 +39,12 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallReq, taskC
 	if ref.PlatformVersion == nil {
 		platform := pm.FindPlatform(ref)
 		if platform == nil {
-			return nil, fmt.Errorf("platform not found: %s", ref)
+			return nil, commands.NotFoundError(commands.CodePlatformNotFound, nil, "platform not found: %s", ref)
 
 		}
-		platformRelease := pm.GetInstalledPlatformRelease(platform)
+		platformRelease := pm.GetInstalledManagedPlatformRelease(platform)
 		if platformRelease == nil {
-			return nil, fmt.Errorf("platform not installed: %s", ref)
+			return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform not installed: %s", ref)
 
 		}
 		ref.PlatformVersion = platformRelease.Version
@@ -53,7 +52,15 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallReq, taskC
 
 	platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
 	if err != nil {
-		return nil, fmt.Errorf("finding platform dependencies: %s", err)
+		return nil, commands.NotFoundError(commands.CodePlatformNotFound, err, "finding platform dependencies")
+	}
+
+	if dependants := pm.PlatformReleaseDependants(platform); len(dependants) > 0 {
+		if !req.GetForce() {
+			return nil, commands.FailedPreconditionError(commands.CodePlatformInUse, nil, "%s is required by %s, uninstalling it would break them (force the uninstall to proceed anyway)",
+				platform, platformReleasesList(dependants))
+		}
+		taskCB(&rpc.TaskProgress{Message: tr("Warning: uninstalling %s breaks %s", platform.String(), platformReleasesList(dependants))})
 	}
 
 	err = uninstallPlatformRelease(pm, platform, taskCB)
@@ -62,9 +69,11 @@ func PlatformUninstall(ctx context.Context, req *rpc.PlatformUninstallReq, taskC
 	}
 
 	for _, tool := range tools {
-		if !pm.IsToolRequired(tool) {
-			uninstallToolReleasse(pm, tool, taskCB)
+		if dependants := pm.ToolReleaseDependants(tool); len(dependants) > 0 {
+			taskCB(&rpc.TaskProgress{Message: tr("Keeping %s, tool is still required by %s", tool.String(), platformReleasesList(dependants)), Completed: true})
+			continue
 		}
+		uninstallToolReleasse(pm, tool, taskCB)
 	}
 
 	_, err = commands.Rescan(req.GetInstance().GetId())
@@ -78,7 +87,7 @@ func uninstallPlatformRelease(pm *packagemanager.PackageManager, platformRelease
 	log := pm.Log.WithField("platform", platformRelease)
 
 	log.Info("Uninstalling platform")
-	taskCB(&rpc.TaskProgress{Name: "Uninstalling " + platformRelease.String()})
+	taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s", platformRelease.String())})
 
 	if err := pm.UninstallPlatform(platformRelease); err != nil {
 		log.WithError(err).Error("Error uninstalling")
@@ -86,7 +95,7 @@ func uninstallPlatformRelease(pm *packagemanager.PackageManager, platformRelease
 	}
 
 	log.Info("Platform uninstalled")
-	taskCB(&rpc.TaskProgress{Message: platformRelease.String() + " uninstalled", Completed: true})
+	taskCB(&rpc.TaskProgress{Message: tr("%s uninstalled", platformRelease.String()), Completed: true})
 	return nil
 }
 
@@ -94,7 +103,7 @@ func uninstallToolReleasse(pm *packagemanager.PackageManager, toolRelease *cores
 	log := pm.Log.WithField("Tool", toolRelease)
 
 	log.Info("Uninstalling tool")
-	taskCB(&rpc.TaskProgress{Name: "Uninstalling " + toolRelease.String() + ", tool is no more required"})
+	taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s, tool is no more required", toolRelease.String())})
 
 	if err := pm.UninstallTool(toolRelease); err != nil {
 		log.WithError(err).Error("Error uninstalling")
@@ -102,6 +111,14 @@ func uninstallToolReleasse(pm *packagemanager.PackageManager, toolRelease *cores
 	}
 
 	log.Info("Tool uninstalled")
-	taskCB(&rpc.TaskProgress{Message: toolRelease.String() + " uninstalled", Completed: true})
+	taskCB(&rpc.TaskProgress{Message: tr("%s uninstalled", toolRelease.String()), Completed: true})
 	return nil
 }
+
+func platformReleasesList(releases []*cores.PlatformRelease) string {
+	names := []string{}
+	for _, release := range releases {
+		names = append(names, release.String())
+	}
+	return strings.Join(names, ", ")
+}
@@ -18,11 +18,11 @@ package core
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
 )
 
 var (
@@ -37,7 +37,7 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeReq,
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	// Extract all PlatformReference to platforms that have updates
@@ -45,7 +45,8 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeReq,
 		Package:              req.PlatformPackage,
 		PlatformArchitecture: req.Architecture,
 	}
-	if err := upgradePlatform(pm, ref, downloadCB, taskCB, req.GetSkipPostInstall()); err != nil {
+	postInstall, err := upgradePlatform(ctx, pm, ref, downloadCB, taskCB, req.GetSkipPostInstall())
+	if err != nil {
 		return nil, err
 	}
 
@@ -53,42 +54,43 @@ func PlatformUpgrade(ctx context.Context, req *rpc.PlatformUpgradeReq,
 		return nil, err
 	}
 
-	return &rpc.PlatformUpgradeResp{}, nil
+	return &rpc.PlatformUpgradeResp{PostInstallScript: postInstallScriptToRPC(postInstall)}, nil
 }
 
-func upgradePlatform(pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference,
+func upgradePlatform(ctx context.Context, pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB,
-	skipPostInstall bool) error {
+	skipPostInstall bool) (*paths.Path, error) {
 	if platformRef.PlatformVersion != nil {
-		return fmt.Errorf("upgrade doesn't accept parameters with version")
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidVersion, nil, "upgrade doesn't accept parameters with version")
 	}
 
 	// Search the latest version for all specified platforms
 	toInstallRefs := []*packagemanager.PlatformReference{}
 	platform := pm.FindPlatform(platformRef)
 	if platform == nil {
-		return fmt.Errorf("platform %s not found", platformRef)
+		return nil, commands.NotFoundError(commands.CodePlatformNotFound, nil, "platform %s not found", platformRef)
 	}
-	installed := pm.GetInstalledPlatformRelease(platform)
+	installed := pm.GetInstalledManagedPlatformRelease(platform)
 	if installed == nil {
-		return fmt.Errorf("platform %s is not installed", platformRef)
+		return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform %s is not installed", platformRef)
 	}
 	latest := platform.GetLatestRelease()
 	if !latest.Version.GreaterThan(installed.Version) {
-		return ErrAlreadyLatest
+		return nil, ErrAlreadyLatest
 	}
 	platformRef.PlatformVersion = latest.Version
 	toInstallRefs = append(toInstallRefs, platformRef)
 
+	var postInstall *paths.Path
 	for _, platformRef := range toInstallRefs {
 		platform, tools, err := pm.FindPlatformReleaseDependencies(platformRef)
 		if err != nil {
-			return fmt.Errorf("platform %s is not installed", platformRef)
+			return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "platform %s is not installed", platformRef)
 		}
-		err = installPlatform(pm, platform, tools, downloadCB, taskCB, skipPostInstall)
+		postInstall, err = installPlatform(ctx, pm, platform, tools, downloadCB, taskCB, skipPostInstall)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return postInstall, nil
 }
@@ -0,0 +1,49 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTokenAuth(t *testing.T) {
+	auth := &TokenAuth{Token: "s3cret"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(ctx context.Context) (interface{}, error) {
+		return auth.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	}
+
+	_, err := call(context.Background())
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer wrong"))
+	_, err = call(ctx)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer s3cret"))
+	res, err := call(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
@@ -19,6 +19,9 @@ package daemon
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/commands"
@@ -27,13 +30,19 @@ import (
 	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/commands/lib"
 	"github.com/arduino/arduino-cli/commands/sketch"
+	"github.com/arduino/arduino-cli/commands/updater"
 	"github.com/arduino/arduino-cli/commands/upload"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ArduinoCoreServerImpl FIXMEDOC
 type ArduinoCoreServerImpl struct {
 	VersionString string
+	// OnShutdown is called when a client asks the daemon to stop, the calls
+	// in progress must be canceled after the given timeout
+	OnShutdown func(timeout time.Duration)
 }
 
 // BoardDetails FIXMEDOC
@@ -41,9 +50,21 @@ func (s *ArduinoCoreServerImpl) BoardDetails(ctx context.Context, req *rpc.Board
 	return board.Details(ctx, req)
 }
 
+// BoardNormalizeFQBN validates the configuration of an FQBN and returns it
+// with all the board options set
+func (s *ArduinoCoreServerImpl) BoardNormalizeFQBN(ctx context.Context, req *rpc.BoardNormalizeFQBNReq) (*rpc.BoardNormalizeFQBNResp, error) {
+	return board.NormalizeFQBN(ctx, req)
+}
+
+// BoardSetup installs the udev rules or points to the drivers needed to use
+// a board
+func (s *ArduinoCoreServerImpl) BoardSetup(ctx context.Context, req *rpc.BoardSetupReq) (*rpc.BoardSetupResp, error) {
+	return board.Setup(ctx, req)
+}
+
 // BoardList FIXMEDOC
 func (s *ArduinoCoreServerImpl) BoardList(ctx context.Context, req *rpc.BoardListReq) (*rpc.BoardListResp, error) {
-	ports, err := board.List(req.GetInstance().GetId())
+	ports, err := board.List(ctx, req.GetInstance().GetId())
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +101,13 @@ func (s *ArduinoCoreServerImpl) Rescan(ctx context.Context, req *rpc.RescanReq)
 	return commands.Rescan(req.GetInstance().GetId())
 }
 
+// Watch streams an event every time libraries or platforms change on disk
+func (s *ArduinoCoreServerImpl) Watch(req *rpc.WatchReq, stream rpc.ArduinoCore_WatchServer) error {
+	return commands.Watch(stream.Context(), req,
+		func(r *rpc.WatchResp) { stream.Send(r) },
+	)
+}
+
 // UpdateIndex FIXMEDOC
 func (s *ArduinoCoreServerImpl) UpdateIndex(req *rpc.UpdateIndexReq, stream rpc.ArduinoCore_UpdateIndexServer) error {
 	resp, err := commands.UpdateIndex(stream.Context(), req,
@@ -150,9 +178,74 @@ func (s *ArduinoCoreServerImpl) Init(req *rpc.InitReq, stream rpc.ArduinoCore_In
 	return stream.Send(resp)
 }
 
-// Version FIXMEDOC
+// APIVersion is the version of the gRPC API exposed by the daemon
+const APIVersion = "1.1.0"
+
+// Features lists the optional features supported by the daemon, so that
+// clients can check for them instead of guessing from the CLI version
+var Features = []string{
+	"compilation_database",
+	"compiler_diagnostics",
+	"debug",
+	"debug_sessions",
+	"monitor",
+	"settings",
+	"shared_instance",
+	"watch",
+	"sketch_templates",
+	"health",
+	"self_update",
+	"shutdown",
+}
+
+// Version returns the CLI version together with the API version and the
+// features supported by the daemon
 func (s *ArduinoCoreServerImpl) Version(ctx context.Context, req *rpc.VersionReq) (*rpc.VersionResp, error) {
-	return &rpc.VersionResp{Version: s.VersionString}, nil
+	return &rpc.VersionResp{
+		Version:    s.VersionString,
+		ApiVersion: APIVersion,
+		Features:   Features,
+	}, nil
+}
+
+// CheckForUpdates looks for a newer release of the CLI
+func (s *ArduinoCoreServerImpl) CheckForUpdates(ctx context.Context, req *rpc.CheckForUpdatesReq) (*rpc.CheckForUpdatesResp, error) {
+	return updater.CheckForUpdates(ctx, req, s.VersionString)
+}
+
+// SelfUpdate installs the latest release of the CLI
+func (s *ArduinoCoreServerImpl) SelfUpdate(req *rpc.SelfUpdateReq, stream rpc.ArduinoCore_SelfUpdateServer) error {
+	resp, err := updater.SelfUpdate(stream.Context(), req, s.VersionString,
+		func(p *rpc.DownloadProgress) { stream.Send(&rpc.SelfUpdateResp{Progress: p}) },
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.SelfUpdateResp{TaskProgress: p}) },
+	)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
+// DefaultShutdownTimeout is how long Shutdown waits for the calls in
+// progress to end when the request doesn't specify it
+const DefaultShutdownTimeout = 5 * time.Second
+
+// Shutdown stops the daemon once the response has been sent
+func (s *ArduinoCoreServerImpl) Shutdown(ctx context.Context, req *rpc.ShutdownReq) (*rpc.ShutdownResp, error) {
+	if s.OnShutdown == nil {
+		return nil, status.Error(codes.Unimplemented, "shutdown not supported")
+	}
+	timeout := DefaultShutdownTimeout
+	if req.GetTimeout() != "" {
+		t, err := time.ParseDuration(req.GetTimeout())
+		if err != nil || t < 0 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid timeout: %s", req.GetTimeout()))
+		}
+		timeout = t
+	}
+	// The server waits for this call too before stopping, so it must be
+	// stopped asynchronously
+	go s.OnShutdown(timeout)
+	return &rpc.ShutdownResp{}, nil
 }
 
 // LoadSketch FIXMEDOC
@@ -168,11 +261,25 @@ func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileReq, stream rpc.ArduinoC
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.CompileResp{ErrStream: data}) }),
 		false) // Set debug to false
 	if err != nil {
+		if resp != nil {
+			stream.Send(resp)
+		}
 		return err
 	}
 	return stream.Send(resp)
 }
 
+// Preprocess FIXMEDOC
+func (s *ArduinoCoreServerImpl) Preprocess(ctx context.Context, req *rpc.PreprocessReq) (*rpc.PreprocessResp, error) {
+	return compile.Preprocess(ctx, req, ioutil.Discard)
+}
+
+// SketchIncludes returns the libraries used by a sketch and the includes that
+// can't be resolved
+func (s *ArduinoCoreServerImpl) SketchIncludes(ctx context.Context, req *rpc.SketchIncludesReq) (*rpc.SketchIncludesResp, error) {
+	return compile.SketchIncludes(ctx, req, ioutil.Discard)
+}
+
 // PlatformInstall FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallReq, stream rpc.ArduinoCore_PlatformInstallServer) error {
 	resp, err := core.PlatformInstall(
@@ -186,6 +293,18 @@ func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallReq, str
 	return stream.Send(resp)
 }
 
+// PlatformPostInstall runs the post-install script of an installed platform
+func (s *ArduinoCoreServerImpl) PlatformPostInstall(req *rpc.PlatformPostInstallReq, stream rpc.ArduinoCore_PlatformPostInstallServer) error {
+	resp, err := core.PlatformPostInstall(
+		stream.Context(), req,
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.PlatformPostInstallResp{TaskProgress: p}) },
+	)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
 // PlatformDownload FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformDownload(req *rpc.PlatformDownloadReq, stream rpc.ArduinoCore_PlatformDownloadServer) error {
 	resp, err := core.PlatformDownload(
@@ -210,6 +329,24 @@ func (s *ArduinoCoreServerImpl) PlatformUninstall(req *rpc.PlatformUninstallReq,
 	return stream.Send(resp)
 }
 
+// PlatformCleanTools removes the tools no longer used
+func (s *ArduinoCoreServerImpl) PlatformCleanTools(req *rpc.PlatformCleanToolsReq, stream rpc.ArduinoCore_PlatformCleanToolsServer) error {
+	resp, err := core.PlatformCleanTools(
+		stream.Context(), req,
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.PlatformCleanToolsResp{TaskProgress: p}) },
+	)
+	if err != nil {
+		return err
+	}
+	return stream.Send(resp)
+}
+
+// PlatformCleanCache removes the platform and tool archives from the downloads
+// directory
+func (s *ArduinoCoreServerImpl) PlatformCleanCache(ctx context.Context, req *rpc.PlatformCleanCacheReq) (*rpc.PlatformCleanCacheResp, error) {
+	return core.PlatformCleanCache(ctx, req)
+}
+
 // PlatformUpgrade FIXMEDOC
 func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeReq, stream rpc.ArduinoCore_PlatformUpgradeServer) error {
 	resp, err := core.PlatformUpgrade(
@@ -251,6 +388,7 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadReq, stream rpc.ArduinoCor
 		stream.Context(), req,
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadResp{OutStream: data}) }),
 		utils.FeedStreamTo(func(data []byte) { stream.Send(&rpc.UploadResp{ErrStream: data}) }),
+		func(p *rpc.TaskProgress) { stream.Send(&rpc.UploadResp{TaskProgress: p}) },
 	)
 	if err != nil {
 		return err
@@ -258,6 +396,11 @@ func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadReq, stream rpc.ArduinoCor
 	return stream.Send(resp)
 }
 
+// UploadMultiple uploads the same binary to many boards at the same time
+func (s *ArduinoCoreServerImpl) UploadMultiple(req *rpc.UploadMultipleReq, stream rpc.ArduinoCore_UploadMultipleServer) error {
+	return upload.UploadMultiple(stream.Context(), req, func(resp *rpc.UploadMultipleResp) { stream.Send(resp) })
+}
+
 // BurnBootloader FIXMEDOC
 func (s *ArduinoCoreServerImpl) BurnBootloader(req *rpc.BurnBootloaderReq, stream rpc.ArduinoCore_BurnBootloaderServer) error {
 	resp, err := upload.BurnBootloader(
@@ -314,7 +457,7 @@ func (s *ArduinoCoreServerImpl) LibraryUninstall(req *rpc.LibraryUninstallReq, s
 
 // LibraryUpgradeAll FIXMEDOC
 func (s *ArduinoCoreServerImpl) LibraryUpgradeAll(req *rpc.LibraryUpgradeAllReq, stream rpc.ArduinoCore_LibraryUpgradeAllServer) error {
-	err := lib.LibraryUpgradeAll(req.GetInstance().GetId(),
+	err := lib.LibraryUpgradeAll(stream.Context(), req.GetInstance().GetId(),
 		func(p *rpc.DownloadProgress) { stream.Send(&rpc.LibraryUpgradeAllResp{Progress: p}) },
 		func(p *rpc.TaskProgress) { stream.Send(&rpc.LibraryUpgradeAllResp{TaskProgress: p}) },
 	)
@@ -339,7 +482,22 @@ func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.Librar
 	return lib.LibraryList(ctx, req)
 }
 
+// LibraryCleanCache removes the library archives from the downloads directory
+func (s *ArduinoCoreServerImpl) LibraryCleanCache(ctx context.Context, req *rpc.LibraryCleanCacheReq) (*rpc.LibraryCleanCacheResp, error) {
+	return lib.LibraryCleanCache(ctx, req)
+}
+
+// LibraryExamples FIXMEDOC
+func (s *ArduinoCoreServerImpl) LibraryExamples(ctx context.Context, req *rpc.LibraryExamplesReq) (*rpc.LibraryExamplesResp, error) {
+	return lib.LibraryExamples(ctx, req)
+}
+
 // ArchiveSketch FIXMEDOC
 func (s *ArduinoCoreServerImpl) ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchReq) (*rpc.ArchiveSketchResp, error) {
 	return sketch.ArchiveSketch(ctx, req)
 }
+
+// NewSketch creates a new Sketch from a template
+func (s *ArduinoCoreServerImpl) NewSketch(ctx context.Context, req *rpc.NewSketchReq) (*rpc.NewSketchResp, error) {
+	return sketch.NewSketch(ctx, req)
+}
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVersion(t *testing.T) {
+	s := &ArduinoCoreServerImpl{VersionString: "1.2.3"}
+	resp, err := s.Version(context.Background(), &rpc.VersionReq{})
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", resp.GetVersion())
+	require.Equal(t, APIVersion, resp.GetApiVersion())
+	require.Contains(t, resp.GetFeatures(), "debug")
+	require.Contains(t, resp.GetFeatures(), "monitor")
+}
+
+func TestShutdown(t *testing.T) {
+	s := &ArduinoCoreServerImpl{}
+	_, err := s.Shutdown(context.Background(), &rpc.ShutdownReq{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	timeouts := make(chan time.Duration, 1)
+	s.OnShutdown = func(timeout time.Duration) { timeouts <- timeout }
+	_, err = s.Shutdown(context.Background(), &rpc.ShutdownReq{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultShutdownTimeout, <-timeouts)
+	_, err = s.Shutdown(context.Background(), &rpc.ShutdownReq{Timeout: "10s"})
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, <-timeouts)
+
+	_, err = s.Shutdown(context.Background(), &rpc.ShutdownReq{Timeout: "soon"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -16,7 +16,8 @@
 package daemon
 
 import (
-	"os"
+	"context"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/utils"
 	cmd "github.com/arduino/arduino-cli/commands/debug"
@@ -44,23 +45,86 @@ func (s *DebugService) Debug(stream dbg.Debug_DebugServer) error {
 		return errors.Errorf("First message must contain debug request, not data")
 	}
 
+	// Send the configuration of the session as the first message
+	config, err := cmd.GetDebugConfig(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	// The session is terminated if the stream is dropped
+	session := cmd.NewSession(stream.Context(), req)
+	defer session.Close()
+	if err := stream.Send(&dbg.DebugResp{DebugConfig: config, SessionId: session.ID}); err != nil {
+		return err
+	}
+
 	// Launch debug recipe attaching stdin and out to grpc streaming
-	signalChan := make(chan os.Signal)
-	defer close(signalChan)
-	resp, err := cmd.Debug(stream.Context(), req,
-		utils.ConsumeStreamFrom(func() ([]byte, error) {
-			command, err := stream.Recv()
-			if command.GetSendInterrupt() {
-				signalChan <- os.Interrupt
+	commandsChan := make(chan *dbg.SendCommand)
+	// Output and command results are sent from different goroutines
+	var sendLock sync.Mutex
+	in := utils.ConsumeStreamFrom(func() ([]byte, error) {
+		command, err := stream.Recv()
+		if command.GetSendInterrupt() {
+			session.Interrupt()
+		}
+		if sendCommand := command.GetSendCommand(); sendCommand != nil {
+			select {
+			case commandsChan <- sendCommand:
+			case <-session.Context().Done():
 			}
-			return command.GetData(), err
-		}),
+		}
+		return command.GetData(), err
+	})
+	// Once the debugger exits the data still coming from the client is dropped
+	defer in.Close()
+	resp, err := cmd.Debug(session.Context(), req, in,
 		utils.FeedStreamTo(func(data []byte) {
+			sendLock.Lock()
+			defer sendLock.Unlock()
 			stream.Send(&dbg.DebugResp{Data: data})
 		}),
-		signalChan)
+		session.Interrupts(),
+		commandsChan,
+		func(id, result string) {
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			stream.Send(&dbg.DebugResp{CommandResult: &dbg.CommandResult{Id: id, Result: result}})
+		})
 	if err != nil {
 		return (err)
 	}
 	return stream.Send(resp)
 }
+
+// GetDebugConfig return metadata about a debug session
+func (s *DebugService) GetDebugConfig(ctx context.Context, req *dbg.DebugConfigReq) (*dbg.GetDebugConfigResp, error) {
+	return cmd.GetDebugConfig(ctx, req)
+}
+
+// GetPeripherals streams the peripherals described by the SVD file of the board
+func (s *DebugService) GetPeripherals(req *dbg.DebugConfigReq, stream dbg.Debug_GetPeripheralsServer) error {
+	return cmd.GetPeripherals(stream.Context(), req, func(peripheral *dbg.Peripheral) {
+		stream.Send(&dbg.GetPeripheralsResp{Peripheral: peripheral})
+	})
+}
+
+// ListDebugSessions returns the running debug sessions
+func (s *DebugService) ListDebugSessions(ctx context.Context, req *dbg.ListDebugSessionsReq) (*dbg.ListDebugSessionsResp, error) {
+	return &dbg.ListDebugSessionsResp{Sessions: cmd.ListSessions()}, nil
+}
+
+// SignalDebugSession sends an interrupt to the debugger of a running session
+func (s *DebugService) SignalDebugSession(ctx context.Context, req *dbg.SignalDebugSessionReq) (*dbg.SignalDebugSessionResp, error) {
+	if err := cmd.SignalSession(req.GetSessionId()); err != nil {
+		return nil, err
+	}
+	return &dbg.SignalDebugSessionResp{}, nil
+}
+
+// TerminateDebugSession terminates a running debug session
+func (s *DebugService) TerminateDebugSession(ctx context.Context, req *dbg.TerminateDebugSessionReq) (*dbg.TerminateDebugSessionResp, error) {
+	if err := cmd.TerminateSession(req.GetSessionId()); err != nil {
+		return nil, err
+	}
+	return &dbg.TerminateDebugSessionResp{}, nil
+}
@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/commands"
+	"google.golang.org/grpc"
+)
+
+// ErrorsUnaryInterceptor converts the errors returned by the unary calls to
+// gRPC statuses, with the code and the category of the error attached as
+// ErrorDetails
+func ErrorsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, commands.ErrorToRPCStatus(err).Err()
+	}
+	return resp, nil
+}
+
+// ErrorsStreamInterceptor converts the errors returned by the streaming calls
+// to gRPC statuses, with the code and the category of the error attached as
+// ErrorDetails
+func ErrorsStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, stream); err != nil {
+		return commands.ErrorToRPCStatus(err).Err()
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorsUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/cc.arduino.cli.commands.ArduinoCore/PlatformInstall"}
+	failed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("installing: %w", commands.NotFoundError(commands.CodePlatformNotFound, nil, "platform not found"))
+	}
+	_, err := ErrorsUnaryInterceptor(context.Background(), nil, info, failed)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "installing: platform not found", st.Message())
+	require.Len(t, st.Details(), 1)
+
+	succeeded := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "done", nil
+	}
+	res, err := ErrorsUnaryInterceptor(context.Background(), nil, info, succeeded)
+	require.NoError(t, err)
+	require.Equal(t, "done", res)
+}
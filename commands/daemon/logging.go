@@ -0,0 +1,97 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/arduino/arduino-cli/tracing"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDHeader is the metadata key carrying the ID of a request, the
+// client may set it to correlate its own logs with the ones of the daemon
+const requestIDHeader = "x-request-id"
+
+// requestID returns the ID of the request set by the client, if any, or a
+// new random one
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	return tracing.NewRequestID()
+}
+
+// requestLogger returns the logger for a call, its entries are tagged with
+// the request ID and the method
+func requestLogger(ctx context.Context, method string) *logrus.Entry {
+	return tracing.Logger(ctx).WithField("method", method)
+}
+
+// tracedServerStream is a grpc.ServerStream whose context carries the
+// request ID
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func logRequestEnd(log *logrus.Entry, start time.Time, err error) {
+	log = log.WithField("duration", time.Since(start).String())
+	if err != nil {
+		log.WithError(err).Warn("Request failed")
+	} else {
+		log.Info("Request completed")
+	}
+}
+
+// LoggingUnaryInterceptor logs the start and the end of the unary calls, the
+// request ID is passed to the handler through its context and it's sent back
+// to the client in the `x-request-id` header.
+func LoggingUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := requestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+	ctx = tracing.WithRequestID(ctx, id)
+	log := requestLogger(ctx, info.FullMethod)
+	log.Info("Request started")
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRequestEnd(log, start, err)
+	return resp, err
+}
+
+// LoggingStreamInterceptor logs the start and the end of the streaming calls,
+// the request ID is passed to the handler through the context of the stream
+// and it's sent back to the client in the `x-request-id` header.
+func LoggingStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	id := requestID(stream.Context())
+	stream.SetHeader(metadata.Pairs(requestIDHeader, id))
+	ctx := tracing.WithRequestID(stream.Context(), id)
+	log := requestLogger(ctx, info.FullMethod)
+	log.Info("Request started")
+	start := time.Now()
+	err := handler(srv, &tracedServerStream{ServerStream: stream, ctx: ctx})
+	logRequestEnd(log, start, err)
+	return err
+}
@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arduino/arduino-cli/tracing"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLoggingUnaryInterceptor(t *testing.T) {
+	hook := test.NewGlobal()
+	defer hook.Reset()
+	logrus.SetLevel(logrus.InfoLevel)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/cc.arduino.cli.commands.ArduinoCore/Version"}
+	// the handler gets the request ID through its context
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return tracing.RequestID(ctx), nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "client-id"))
+	res, err := LoggingUnaryInterceptor(ctx, nil, info, ok)
+	require.NoError(t, err)
+	require.Equal(t, "client-id", res)
+	require.Len(t, hook.AllEntries(), 2)
+	for _, entry := range hook.AllEntries() {
+		require.Equal(t, "client-id", entry.Data["request_id"])
+		require.Equal(t, info.FullMethod, entry.Data["method"])
+	}
+	require.Equal(t, "Request completed", hook.LastEntry().Message)
+
+	hook.Reset()
+	failed := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("failure")
+	}
+	_, err = LoggingUnaryInterceptor(context.Background(), nil, info, failed)
+	require.Error(t, err)
+	require.Len(t, hook.AllEntries(), 2)
+	// a new ID is generated if the client doesn't send one
+	id := hook.AllEntries()[0].Data["request_id"]
+	require.Len(t, id, 16)
+	require.Equal(t, id, hook.LastEntry().Data["request_id"])
+	require.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+}
@@ -0,0 +1,73 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/arduino/arduino-cli/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// MetricsUnaryInterceptor records the latency and the status code of the
+// unary calls
+func MetricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	telemetry.RPCDone(info.FullMethod, status.Code(err).String(), time.Since(start))
+	return resp, err
+}
+
+// MetricsStreamInterceptor records the duration and the status code of the
+// streaming calls
+func MetricsStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	telemetry.RPCDone(info.FullMethod, status.Code(err).String(), time.Since(start))
+	return err
+}
+
+// ChainUnaryInterceptors returns an interceptor that runs the given ones in
+// order, the first one being the outermost.
+func ChainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// ChainStreamInterceptors returns an interceptor that runs the given ones in
+// order, the first one being the outermost.
+func ChainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, stream grpc.ServerStream) error {
+				return interceptor(srv, stream, info, next)
+			}
+		}
+		return chained(srv, stream)
+	}
+}
@@ -19,7 +19,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/settings"
 	"github.com/spf13/viper"
 )
@@ -50,6 +53,9 @@ func (s *SettingsService) Merge(ctx context.Context, req *rpc.RawData) (*rpc.Mer
 	if err := viper.MergeConfigMap(toMerge); err != nil {
 		return nil, err
 	}
+	if _, ok := toMerge["locale"]; ok {
+		i18n.Init()
+	}
 
 	return &rpc.MergeResponse{}, nil
 }
@@ -82,7 +88,27 @@ func (s *SettingsService) SetValue(ctx context.Context, val *rpc.Value) (*rpc.Se
 	err := json.Unmarshal([]byte(val.GetJsonData()), &value)
 	if err == nil {
 		viper.Set(key, value)
+		// The messages sent to the clients follow the new locale
+		if strings.EqualFold(key, "locale") {
+			i18n.Init()
+		}
 	}
 
 	return &rpc.SetValueResponse{}, err
 }
+
+// Write dumps the settings currently in use to the file specified in the
+// request, or to the configuration file that was loaded if none is given.
+func (s *SettingsService) Write(ctx context.Context, req *rpc.WriteRequest) (*rpc.WriteResponse, error) {
+	filePath := req.GetFilePath()
+	if filePath == "" {
+		filePath = viper.ConfigFileUsed()
+	}
+	if filePath == "" {
+		return nil, errors.New("no configuration file in use, a file path must be specified")
+	}
+	if err := viper.WriteConfigAs(filePath); err != nil {
+		return nil, fmt.Errorf("writing settings to %s: %v", filePath, err)
+	}
+	return &rpc.WriteResponse{}, nil
+}
@@ -18,6 +18,9 @@ package daemon
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -82,3 +85,21 @@ func TestSetValue(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "bar", viper.GetString("foo"))
 }
+
+func TestWrite(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "settings_write")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	filePath := filepath.Join(tmp, "arduino-cli.yaml")
+
+	_, err = svc.SetValue(context.Background(), &rpc.Value{Key: "daemon.port", JsonData: `"4242"`})
+	require.NoError(t, err)
+	_, err = svc.Write(context.Background(), &rpc.WriteRequest{FilePath: filePath})
+	require.NoError(t, err)
+
+	content, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "port: \"4242\"")
+
+	reset()
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -30,23 +31,33 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/executils"
 	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/arduino/arduino-cli/tracing"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 // Debug command launches a debug tool for a sketch.
 // It also implements streams routing:
 // gRPC In -> tool stdIn
+// gRPC injected commands -> tool stdIn
 // grpc Out <- tool stdOut
 // grpc Out <- tool stdErr
-// It also implements tool process lifecycle management
-func Debug(ctx context.Context, req *dbg.DebugConfigReq, inStream io.Reader, out io.Writer, interrupt <-chan os.Signal) (*dbg.DebugResp, error) {
+// It also implements tool process lifecycle management.
+// The results of the injected commands are passed to commandResultCB, this
+// is supported only by the MI interpreters.
+func Debug(ctx context.Context, req *dbg.DebugConfigReq, inStream io.Reader, out io.Writer, interrupt <-chan os.Signal,
+	injectedCommands <-chan *dbg.SendCommand, commandResultCB CommandResultCB) (*dbg.DebugResp, error) {
 
 	// Get tool commandLine from core recipe
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
-	commandLine, err := getCommandLine(req, pm)
+	toolProperties, err := getDebugProperties(req, pm)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get debug properties")
+	}
+	commandLine, err := getCommandLine(req, toolProperties)
 	if err != nil {
 		return nil, errors.Wrap(err, "Cannot get command line for tool")
 	}
@@ -57,16 +68,24 @@ func Debug(ctx context.Context, req *dbg.DebugConfigReq, inStream io.Reader, out
 		commandLine[i] = filepath.ToSlash(param)
 	}
 
+	shutdownTimeout, err := time.ParseDuration(viper.GetString("debug.shutdown_timeout"))
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "invalid debug.shutdown_timeout '%s': %s", viper.GetString("debug.shutdown_timeout"), err)
+	}
+
 	// Run Tool
-	entry := logrus.NewEntry(logrus.StandardLogger())
+	log := tracing.Logger(ctx)
+	entry := log
 	for i, param := range commandLine {
 		entry = entry.WithField(fmt.Sprintf("param%d", i), param)
 	}
 	entry.Debug("Executing debugger")
 
-	cmd, err := executils.NewProcess(commandLine...)
+	// The debugger must not be killed as soon as ctx is canceled, it's asked
+	// to quit first (see shutdown below)
+	cmd, err := executils.NewProcessWithContext(tracing.Detach(ctx), commandLine...)
 	if err != nil {
-		return nil, errors.Wrap(err, "Cannot execute debug tool")
+		return nil, commands.ToolFailureError(commands.CodeDebugFailed, err, "Cannot execute debug tool")
 	}
 
 	// Get stdIn pipe from tool
@@ -75,8 +94,12 @@ func Debug(ctx context.Context, req *dbg.DebugConfigReq, inStream io.Reader, out
 		return &dbg.DebugResp{Error: err.Error()}, nil
 	}
 	defer in.Close()
+	input := newGdbInput(in, req.GetInterpreter())
 
 	// Merge tool StdOut and StdErr to stream them in the io.Writer passed stream
+	if commandResultCB != nil && input.mi {
+		out = newMiResultsFilter(out, input, commandResultCB)
+	}
 	cmd.RedirectStdoutTo(out)
 	cmd.RedirectStderrTo(out)
 
@@ -97,37 +120,139 @@ func Debug(ctx context.Context, req *dbg.DebugConfigReq, inStream io.Reader, out
 		}()
 	}
 
+	// When the client closes the input stream or the context is canceled the
+	// debugger is asked to quit, if it doesn't terminate within the shutdown
+	// timeout it's killed to avoid leaving zombie processes around (including
+	// the GDB server that it may have launched).
+	terminated := make(chan struct{})
+	var shutdownOnce sync.Once
+	shutdown := func() {
+		shutdownOnce.Do(func() {
+			log.Info("Terminating debug session")
+			// Interrupt the target, if it's running, so the debugger can process the quit command
+			cmd.Signal(os.Interrupt)
+			if err := input.quit(); err != nil {
+				log.WithError(err).Warn("Error sending quit command to the debugger")
+			}
+			in.Close()
+			select {
+			case <-terminated:
+			case <-time.After(shutdownTimeout):
+				log.Warn("Debugger didn't quit in time, killing it")
+				cmd.Kill()
+			}
+		})
+	}
+
 	go func() {
-		// Copy data from passed inStream into command stdIn
-		io.Copy(in, inStream)
-		// In any case, try process termination after a second to avoid leaving
-		// zombie process.
-		time.Sleep(time.Second)
-		cmd.Kill()
+		// Copy data from passed inStream into command stdIn, the data received
+		// after ctx is canceled or the debugger terminated is discarded
+		io.Copy(input, &contextReader{ctx: ctx, terminated: terminated, r: inStream})
+		shutdown()
+	}()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			shutdown()
+		case <-terminated:
+		}
 	}()
 
+	if injectedCommands != nil {
+		go func() {
+			for {
+				select {
+				case command, ok := <-injectedCommands:
+					if !ok {
+						return
+					}
+					if err := input.sendCommand(command); err != nil {
+						log.WithError(err).Warn("Error sending command to the debugger")
+					}
+				case <-terminated:
+					return
+				}
+			}
+		}()
+	}
+
 	// Wait for process to finish
-	if err := cmd.Wait(); err != nil {
+	err = cmd.Wait()
+	close(terminated)
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("debug session interrupted: %w", ctx.Err())
+	}
+	if err != nil {
 		return &dbg.DebugResp{Error: err.Error()}, nil
 	}
 	return &dbg.DebugResp{}, nil
 }
 
-// getCommandLine compose a debug command represented by a core recipe
-func getCommandLine(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager) ([]string, error) {
+// contextReader reads from r until ctx is canceled or terminated is closed
+type contextReader struct {
+	ctx        context.Context
+	terminated <-chan struct{}
+	r          io.Reader
+}
+
+func (r *contextReader) Read(data []byte) (int, error) {
+	if err := r.done(); err != nil {
+		return 0, err
+	}
+	n, err := r.r.Read(data)
+	if err := r.done(); err != nil {
+		return 0, err
+	}
+	return n, err
+}
+
+func (r *contextReader) done() error {
+	select {
+	case <-r.ctx.Done():
+		return r.ctx.Err()
+	case <-r.terminated:
+		return io.EOF
+	default:
+		return nil
+	}
+}
+
+// getCommandLine compose a debug command represented by a core recipe, using
+// the properties returned by getDebugProperties
+func getCommandLine(req *dbg.DebugConfigReq, toolProperties *properties.Map) ([]string, error) {
+	// Build recipe for tool
+	recipe := toolProperties.Get("debug.pattern")
+
+	// REMOVEME: hotfix for samd core 1.8.5/1.8.6
+	if recipe == `"{path}/{cmd}" --interpreter=mi2 -ex "set pagination off" -ex 'target extended-remote | {tools.openocd.path}/{tools.openocd.cmd} -s "{tools.openocd.path}/share/openocd/scripts/" --file "{runtime.platform.path}/variants/{build.variant}/{build.openocdscript}" -c "gdb_port pipe" -c "telnet_port 0"' {build.path}/{build.project_name}.elf` {
+		recipe = `"{path}/{cmd}" --interpreter={interpreter} -ex "set remotetimeout 5" -ex "set pagination off" -ex 'target extended-remote | "{tools.openocd.path}/{tools.openocd.cmd}" -s "{tools.openocd.path}/share/openocd/scripts/" --file "{runtime.platform.path}/variants/{build.variant}/{build.openocdscript}" -c "gdb_port pipe" -c "telnet_port 0"' "{build.path}/{build.project_name}.elf"`
+	}
+
+	cmdLine := toolProperties.ExpandPropsInString(recipe)
+	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
+	if err != nil {
+		return nil, commands.FailedPreconditionError(commands.CodeToolNotFound, err, "invalid recipe '%s'", recipe)
+	}
+	return getDebugOptions(req, toolProperties).injectIntoCommandLine(cmdArgs), nil
+}
+
+// getDebugProperties resolves the board, platform and tool properties needed
+// to run a debug session for the given request
+func getDebugProperties(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager) (*properties.Map, error) {
 	if req.GetImportFile() != "" {
-		return nil, errors.New("the ImportFile parameter has been deprecated, use ImportDir instead")
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "the ImportFile parameter has been deprecated, use ImportDir instead")
 	}
 
 	// TODO: make a generic function to extract sketch from request
 	// and remove duplication in commands/compile.go
 	if req.GetSketchPath() == "" {
-		return nil, fmt.Errorf("missing sketchPath")
+		return nil, commands.InvalidArgumentError(commands.CodeMissingSketchPath, nil, "missing sketchPath")
 	}
 	sketchPath := paths.New(req.GetSketchPath())
 	sketch, err := sketches.NewSketchFromPath(sketchPath)
 	if err != nil {
-		return nil, errors.Wrap(err, "opening sketch")
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidSketch, err, "opening sketch")
 	}
 
 	fqbnIn := req.GetFqbn()
@@ -135,37 +260,59 @@ func getCommandLine(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager)
 		fqbnIn = sketch.Metadata.CPU.Fqbn
 	}
 	if fqbnIn == "" {
-		return nil, fmt.Errorf("no Fully Qualified Board Name provided")
+		fqbnIn = viper.GetString("build.default_fqbn")
+	}
+	if fqbnIn == "" {
+		return nil, commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "no Fully Qualified Board Name provided")
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return nil, errors.Wrap(err, "error parsing FQBN")
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "error parsing FQBN")
 	}
 
 	// Find target board and board properties
-	_, _, board, boardProperties, _, err := pm.ResolveFQBN(fqbn)
+	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
-		return nil, errors.Wrap(err, "error resolving FQBN")
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "error resolving FQBN")
+	}
+
+	// Find the programmer selected by the user, if any
+	var programmer *cores.Programmer
+	if programmerID := req.GetProgrammer(); programmerID != "" {
+		programmer = boardPlatform.Programmers[programmerID]
+		if programmer == nil && buildPlatform != nil {
+			// Try to find the programmer in the referenced build platform
+			programmer = buildPlatform.Programmers[programmerID]
+		}
+		if programmer == nil {
+			return nil, commands.NotFoundError(commands.CodeProgrammerNotFound, nil, "programmer '%s' not available", programmerID)
+		}
 	}
 
 	// Load programmer tool
 	toolName, have := boardProperties.GetOk("debug.tool")
+	if programmer != nil {
+		// The programmer may require a different debug tool than the board
+		if programmerToolName, ok := programmer.Properties.GetOk("debug.tool"); ok {
+			toolName, have = programmerToolName, true
+		}
+	}
 	if !have || toolName == "" {
-		return nil, fmt.Errorf("cannot get programmer tool: undefined 'debug.tool' property")
+		return nil, commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "cannot get programmer tool: undefined 'debug.tool' property")
 	}
 
 	var referencedPlatformRelease *cores.PlatformRelease
 	if split := strings.Split(toolName, ":"); len(split) > 2 {
-		return nil, fmt.Errorf("invalid 'debug.tool' property: %s", toolName)
+		return nil, commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "invalid 'debug.tool' property: %s", toolName)
 	} else if len(split) == 2 {
 		referencedPackageName := split[0]
 		toolName = split[1]
 		architecture := board.PlatformRelease.Platform.Architecture
 
 		if referencedPackage := pm.Packages[referencedPackageName]; referencedPackage == nil {
-			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
+			return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "required platform %s:%s not installed", referencedPackageName, architecture)
 		} else if referencedPlatform := referencedPackage.Platforms[architecture]; referencedPlatform == nil {
-			return nil, fmt.Errorf("required platform %s:%s not installed", referencedPackageName, architecture)
+			return nil, commands.FailedPreconditionError(commands.CodePlatformNotInstalled, nil, "required platform %s:%s not installed", referencedPackageName, architecture)
 		} else {
 			referencedPlatformRelease = pm.GetInstalledPlatformRelease(referencedPlatform)
 		}
@@ -182,6 +329,9 @@ func getCommandLine(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager)
 
 	requestedToolProperties := toolProperties.SubTree("tools." + toolName)
 	toolProperties.Merge(requestedToolProperties)
+	if programmer != nil {
+		toolProperties.Merge(programmer.Properties)
+	}
 	if requiredTools, err := pm.FindToolsRequiredForBoard(board); err == nil {
 		for _, requiredTool := range requiredTools {
 			logrus.WithField("tool", requiredTool).Info("Tool required for debug")
@@ -200,16 +350,24 @@ func getCommandLine(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager)
 		importPath = importPath.Join("build").Join(fqbnSuffix)
 	}
 	if !importPath.Exist() {
-		return nil, fmt.Errorf("compiled sketch not found in %s", importPath)
+		return nil, commands.NotFoundError(commands.CodeBuildArtifactMissing, nil, "compiled sketch not found in %s", importPath)
 	}
 	if !importPath.IsDir() {
-		return nil, fmt.Errorf("expected compiled sketch in directory %s, but is a file instead", importPath)
+		return nil, commands.InvalidArgumentError(commands.CodeBuildArtifactMissing, nil, "expected compiled sketch in directory %s, but is a file instead", importPath)
 	}
 	toolProperties.SetPath("build.path", importPath)
 	toolProperties.Set("build.project_name", sketch.Name+".ino")
 
 	// Set debug port property
 	port := req.GetPort()
+	if port == "" && sketch.Metadata != nil {
+		if port, err = sketch.Metadata.CPU.PortSelector(); err != nil {
+			return nil, err
+		}
+	}
+	if port, err = commands.ResolvePort(context.Background(), pm, port); err != nil {
+		return nil, err
+	}
 	if port != "" {
 		toolProperties.Set("debug.port", port)
 		if strings.HasPrefix(port, "/dev/") {
@@ -227,18 +385,5 @@ func getCommandLine(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager)
 		toolProperties.Set("interpreter", "console")
 	}
 
-	// Build recipe for tool
-	recipe := toolProperties.Get("debug.pattern")
-
-	// REMOVEME: hotfix for samd core 1.8.5/1.8.6
-	if recipe == `"{path}/{cmd}" --interpreter=mi2 -ex "set pagination off" -ex 'target extended-remote | {tools.openocd.path}/{tools.openocd.cmd} -s "{tools.openocd.path}/share/openocd/scripts/" --file "{runtime.platform.path}/variants/{build.variant}/{build.openocdscript}" -c "gdb_port pipe" -c "telnet_port 0"' {build.path}/{build.project_name}.elf` {
-		recipe = `"{path}/{cmd}" --interpreter={interpreter} -ex "set remotetimeout 5" -ex "set pagination off" -ex 'target extended-remote | "{tools.openocd.path}/{tools.openocd.cmd}" -s "{tools.openocd.path}/share/openocd/scripts/" --file "{runtime.platform.path}/variants/{build.variant}/{build.openocdscript}" -c "gdb_port pipe" -c "telnet_port 0"' "{build.path}/{build.project_name}.elf"`
-	}
-
-	cmdLine := toolProperties.ExpandPropsInString(recipe)
-	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
-	if err != nil {
-		return nil, fmt.Errorf("invalid recipe '%s': %s", recipe, err)
-	}
-	return cmdArgs, nil
+	return toolProperties, nil
 }
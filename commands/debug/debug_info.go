@@ -0,0 +1,88 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/commands"
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+)
+
+// GetDebugConfig returns metadata to start debugging with the specified board
+// without launching the debugger.
+func GetDebugConfig(ctx context.Context, req *dbg.DebugConfigReq) (*dbg.GetDebugConfigResp, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+	return getDebugConfig(req, pm)
+}
+
+func getDebugConfig(req *dbg.DebugConfigReq, pm *packagemanager.PackageManager) (*dbg.GetDebugConfigResp, error) {
+	toolProperties, err := getDebugProperties(req, pm)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get debug properties")
+	}
+	commandLine, err := getCommandLine(req, toolProperties)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot get command line for tool")
+	}
+	for i, param := range commandLine {
+		commandLine[i] = filepath.ToSlash(param)
+	}
+
+	expand := func(key string) string {
+		return filepath.ToSlash(toolProperties.ExpandPropsInString(toolProperties.Get(key)))
+	}
+
+	options := getDebugOptions(req, toolProperties)
+	server := toolProperties.Get("debug.server")
+	var serverPath string
+	var serverArgs []string
+	if server != "" {
+		serverPath = expand("debug.server." + server + ".path")
+		args := toolProperties.ExpandPropsInString(toolProperties.Get("debug.server." + server + ".args"))
+		serverArgs, err = properties.SplitQuotedString(args, `"'`, false)
+		if err != nil {
+			return nil, commands.FailedPreconditionError(commands.CodeToolNotFound, err, "invalid arguments for debug server '%s'", server)
+		}
+		serverArgs = options.injectIntoServerArgs(server, serverArgs)
+	}
+
+	svdFile := ""
+	if path := getSvdFile(toolProperties); path != nil {
+		svdFile = filepath.ToSlash(path.String())
+	}
+
+	return &dbg.GetDebugConfigResp{
+		Executable:      filepath.ToSlash(toolProperties.ExpandPropsInString("{build.path}/{build.project_name}.elf")),
+		Tool:            toolProperties.Get("debug.tool"),
+		GdbPath:         filepath.ToSlash(toolProperties.ExpandPropsInString("{path}/{cmd}")),
+		Server:          server,
+		ServerPath:      serverPath,
+		ServerArgs:      serverArgs,
+		SvdFile:         svdFile,
+		CommandLine:     commandLine,
+		Programmer:      req.GetProgrammer(),
+		Rtos:            options.rtos,
+		GdbInitCommands: options.gdbInitCommands,
+	}, nil
+}
@@ -15,7 +15,10 @@
 package debug
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -60,7 +63,9 @@ func TestGetCommandLine(t *testing.T) {
 		fmt.Sprintf(" --file \"%s/arduino-test/samd/variants/arduino_zero/openocd_scripts/arduino_zero.cfg\"", customHardware) +
 		fmt.Sprintf(" -c \"gdb_port pipe\" -c \"telnet_port 0\" -c init -c halt %s/build/arduino-test.samd.arduino_zero_edbg/hello.ino.elf", sketchPath)
 
-	command, err := getCommandLine(req, pm)
+	toolProperties, err := getDebugProperties(req, pm)
+	require.Nil(t, err)
+	command, err := getCommandLine(req, toolProperties)
 	require.Nil(t, err)
 	commandToTest := strings.Join(command[:], " ")
 	require.Equal(t, filepath.FromSlash(goldCommand), filepath.FromSlash(commandToTest))
@@ -81,8 +86,278 @@ func TestGetCommandLine(t *testing.T) {
 		fmt.Sprintf(" --file \"%s/arduino-test/samd/variants/mkr1000/openocd_scripts/arduino_zero.cfg\"", customHardware) +
 		fmt.Sprintf(" -c \"gdb_port pipe\" -c \"telnet_port 0\" -c init -c halt %s/build/arduino-test.samd.mkr1000/hello.ino.elf", sketchPath)
 
-	command2, err := getCommandLine(req2, pm)
+	toolProperties, err = getDebugProperties(req2, pm)
+	assert.Nil(t, err)
+	command2, err := getCommandLine(req2, toolProperties)
 	assert.Nil(t, err)
 	commandToTest2 := strings.Join(command2[:], " ")
 	assert.Equal(t, filepath.FromSlash(goldCommand2), filepath.FromSlash(commandToTest2))
 }
+
+func TestGetDebugConfig(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+	sketchPath := paths.New("testdata", "hello")
+	require.NoError(t, sketchPath.ToAbs())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	var toolExtension = ""
+	if runtime.GOOS == "windows" {
+		toolExtension = ".exe"
+	}
+
+	req := &dbg.DebugConfigReq{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:arduino_zero_edbg",
+		SketchPath: sketchPath.String(),
+	}
+	info, err := getDebugConfig(req, pm)
+	require.NoError(t, err)
+
+	toolProperties, err := getDebugProperties(req, pm)
+	require.NoError(t, err)
+	command, err := getCommandLine(req, toolProperties)
+	require.NoError(t, err)
+	require.Len(t, info.GetCommandLine(), len(command))
+
+	require.Equal(t, "gdb-openocd", info.GetTool())
+	require.Equal(t,
+		filepath.ToSlash(fmt.Sprintf("%s/arduino-test/tools/arm-none-eabi-gcc/7-2017q4/bin//arm-none-eabi-gdb%s", dataDir, toolExtension)),
+		info.GetGdbPath())
+	require.Equal(t,
+		filepath.ToSlash(fmt.Sprintf("%s/build/arduino-test.samd.arduino_zero_edbg/hello.ino.elf", sketchPath)),
+		info.GetExecutable())
+	require.Equal(t, "openocd", info.GetServer())
+	require.Equal(t,
+		filepath.ToSlash(fmt.Sprintf("%s/arduino-test/tools/openocd/0.10.0-arduino7/bin/openocd%s", dataDir, toolExtension)),
+		info.GetServerPath())
+	require.Equal(t, []string{
+		"-s", filepath.ToSlash(fmt.Sprintf("%s/arduino-test/tools/openocd/0.10.0-arduino7/share/openocd/scripts/", dataDir)),
+		"--file", filepath.ToSlash(fmt.Sprintf("%s/arduino-test/samd/variants/arduino_zero/openocd_scripts/arduino_zero.cfg", customHardware)),
+	}, info.GetServerArgs())
+	require.Empty(t, info.GetSvdFile())
+}
+
+func TestGetCommandLineWithProgrammer(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+	sketchPath := paths.New("testdata", "hello")
+	require.NoError(t, sketchPath.ToAbs())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	// The programmer properties override the board properties
+	req := &dbg.DebugConfigReq{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:mkr1000",
+		SketchPath: sketchPath.String(),
+		Programmer: "atmel_ice",
+	}
+	toolProperties, err := getDebugProperties(req, pm)
+	require.NoError(t, err)
+	command, err := getCommandLine(req, toolProperties)
+	require.NoError(t, err)
+	require.Contains(t,
+		filepath.ToSlash(strings.Join(command, " ")),
+		filepath.ToSlash(fmt.Sprintf(`--file "%s/arduino-test/samd/variants/mkr1000/openocd_scripts/atmel_ice.cfg"`, customHardware)))
+
+	info, err := getDebugConfig(req, pm)
+	require.NoError(t, err)
+	require.Equal(t, "atmel_ice", info.GetProgrammer())
+
+	// Unknown programmers are rejected
+	req.Programmer = "not-existent"
+	_, err = getDebugProperties(req, pm)
+	require.Error(t, err)
+}
+
+func TestSvdPeripherals(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+	sketchPath := paths.New("testdata", "hello")
+	require.NoError(t, sketchPath.ToAbs())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	req := &dbg.DebugConfigReq{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:mkr1000",
+		SketchPath: sketchPath.String(),
+	}
+	info, err := getDebugConfig(req, pm)
+	require.NoError(t, err)
+	svdFile := customHardware.Join("arduino-test", "samd", "svd", "ATSAMD21G18A.svd")
+	require.Equal(t, filepath.ToSlash(svdFile.String()), info.GetSvdFile())
+
+	peripherals, err := loadSvdPeripherals(svdFile)
+	require.NoError(t, err)
+	require.Len(t, peripherals, 3)
+
+	sercom0 := peripherals[0]
+	require.Equal(t, "SERCOM0", sercom0.GetName())
+	require.Equal(t, "Serial Communication Interface 0", sercom0.GetDescription())
+	require.Equal(t, "SERCOM", sercom0.GetGroupName())
+	require.Equal(t, uint64(0x42000800), sercom0.GetBaseAddress())
+	require.Len(t, sercom0.GetRegisters(), 2)
+	ctrla := sercom0.GetRegisters()[0]
+	require.Equal(t, "USART.CTRLA", ctrla.GetName())
+	require.Equal(t, uint32(32), ctrla.GetSize())
+	require.Equal(t, "read-write", ctrla.GetAccess())
+	require.Len(t, ctrla.GetFields(), 3)
+	require.Equal(t, uint32(0), ctrla.GetFields()[0].GetBitOffset())
+	require.Equal(t, uint32(1), ctrla.GetFields()[0].GetBitWidth())
+	require.Equal(t, uint32(2), ctrla.GetFields()[1].GetBitOffset())
+	require.Equal(t, uint32(3), ctrla.GetFields()[1].GetBitWidth())
+	require.Equal(t, uint32(30), ctrla.GetFields()[2].GetBitOffset())
+	require.Equal(t, uint32(1), ctrla.GetFields()[2].GetBitWidth())
+	require.Equal(t, "read-write", ctrla.GetFields()[2].GetAccess())
+	baud := sercom0.GetRegisters()[1]
+	require.Equal(t, uint64(0x0C), baud.GetAddressOffset())
+	require.Equal(t, uint32(16), baud.GetSize())
+
+	// Derived peripherals inherit the registers of the original one
+	sercom1 := peripherals[1]
+	require.Equal(t, "SERCOM1", sercom1.GetName())
+	require.Equal(t, uint64(0x42000C00), sercom1.GetBaseAddress())
+	require.Equal(t, "SERCOM", sercom1.GetGroupName())
+	require.Len(t, sercom1.GetRegisters(), 2)
+
+	wdt := peripherals[2]
+	require.Len(t, wdt.GetRegisters(), 2)
+	require.Equal(t, uint32(8), wdt.GetRegisters()[0].GetSize())
+	require.Equal(t, uint64(0x04), wdt.GetRegisters()[0].GetResetValue())
+	require.Equal(t, uint64(0x80), wdt.GetRegisters()[1].GetResetValue())
+	require.Equal(t, "read-only", wdt.GetRegisters()[1].GetAccess())
+
+	// Boards without SVD files don't report it
+	req.Fqbn = "arduino-test:samd:arduino_zero_edbg"
+	info, err = getDebugConfig(req, pm)
+	require.NoError(t, err)
+	require.Empty(t, info.GetSvdFile())
+}
+
+func TestInjectedCommands(t *testing.T) {
+	stdin := &bytes.Buffer{}
+	input := newGdbInput(stdin, "mi2")
+	require.NoError(t, input.sendCommand(&dbg.SendCommand{Id: "bp", Command: "break main"}))
+	require.NoError(t, input.sendCommand(&dbg.SendCommand{Id: "mem", Command: "-data-read-memory-bytes 0x0 4"}))
+	require.NoError(t, input.sendCommand(&dbg.SendCommand{Command: "continue"}))
+	require.Equal(t,
+		"1-interpreter-exec console \"break main\"\n"+
+			"2-data-read-memory-bytes 0x0 4\n"+
+			"continue\n",
+		stdin.String())
+
+	// Result records may be split across writes
+	out := &bytes.Buffer{}
+	results := map[string]string{}
+	filter := newMiResultsFilter(out, input, func(id, result string) { results[id] = result })
+	for _, chunk := range []string{"~\"Breakpoint 1\"\n1^do", "ne\n(gdb) \n2^done,memory=[]\n", "3^done\n*stopped\n"} {
+		n, err := filter.Write([]byte(chunk))
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+	}
+	require.Equal(t, map[string]string{"bp": "^done", "mem": "^done,memory=[]"}, results)
+	require.Equal(t, "~\"Breakpoint 1\"\n(gdb) \n3^done\n*stopped\n", out.String())
+
+	// Commands are sent as they are to the console interpreter
+	stdin.Reset()
+	input = newGdbInput(stdin, "console")
+	require.NoError(t, input.sendCommand(&dbg.SendCommand{Id: "bp", Command: "break main"}))
+	require.Equal(t, "break main\n", stdin.String())
+}
+
+func TestSessions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req1 := &dbg.DebugConfigReq{Fqbn: "arduino:samd:mkr1000", SketchPath: "/tmp/sketch1", Port: "/dev/ttyACM0"}
+	req2 := &dbg.DebugConfigReq{Fqbn: "arduino:samd:mkrzero", SketchPath: "/tmp/sketch2", Port: "/dev/ttyACM1"}
+	session1 := NewSession(ctx, req1)
+	session2 := NewSession(ctx, req2)
+	list := ListSessions()
+	require.Len(t, list, 2)
+	require.Equal(t, session1.ID, list[0].GetId())
+	require.Equal(t, "arduino:samd:mkr1000", list[0].GetFqbn())
+	require.Equal(t, session2.ID, list[1].GetId())
+	require.Equal(t, "/dev/ttyACM1", list[1].GetPort())
+
+	require.NoError(t, SignalSession(session1.ID))
+	require.Equal(t, os.Interrupt, <-session1.Interrupts())
+
+	require.NoError(t, TerminateSession(session2.ID))
+	require.Error(t, session2.Context().Err())
+	require.NoError(t, session1.Context().Err())
+
+	session2.Close()
+	require.Len(t, ListSessions(), 1)
+	require.Error(t, SignalSession(session2.ID))
+	require.Error(t, TerminateSession(session2.ID))
+
+	// Sessions are terminated when the parent context is canceled
+	cancel()
+	require.Error(t, session1.Context().Err())
+	session1.Close()
+	require.Empty(t, ListSessions())
+}
+
+func TestGetCommandLineWithRTOS(t *testing.T) {
+	customHardware := paths.New("testdata", "custom_hardware")
+	dataDir := paths.New("testdata", "data_dir", "packages")
+	sketchPath := paths.New("testdata", "hello")
+	require.NoError(t, sketchPath.ToAbs())
+
+	pm := packagemanager.NewPackageManager(nil, nil, nil, nil)
+	pm.LoadHardwareFromDirectory(customHardware)
+	pm.LoadHardwareFromDirectory(dataDir)
+
+	req := &dbg.DebugConfigReq{
+		Instance:   &rpc.Instance{Id: 1},
+		Fqbn:       "arduino-test:samd:arduino_zero_rtos",
+		SketchPath: sketchPath.String(),
+	}
+	rtosScript := filepath.ToSlash(fmt.Sprintf("%s/arduino-test/samd/variants/arduino_zero/openocd_scripts/rtos.cfg", customHardware))
+
+	// The OpenOCD options go before "init", the GDB commands before the executable
+	toolProperties, err := getDebugProperties(req, pm)
+	require.NoError(t, err)
+	command, err := getCommandLine(req, toolProperties)
+	require.NoError(t, err)
+	require.Contains(t, filepath.ToSlash(command[3]),
+		fmt.Sprintf(`-c "telnet_port 0" "--file" "%s" "-c" "[target current] configure -rtos FreeRTOS" -c init -c halt`, rtosScript))
+	require.Equal(t, []string{"-ex", "set remotetimeout 10", "-ex", "monitor reset halt"}, command[4:8])
+	require.True(t, strings.HasSuffix(command[8], "hello.ino.elf"))
+
+	info, err := getDebugConfig(req, pm)
+	require.NoError(t, err)
+	require.Equal(t, "FreeRTOS", info.GetRtos())
+	require.Equal(t, []string{"set remotetimeout 10", "monitor reset halt"}, info.GetGdbInitCommands())
+	serverArgs := info.GetServerArgs()
+	require.Equal(t, []string{"--file", rtosScript, "-c", "[target current] configure -rtos FreeRTOS"}, serverArgs[4:])
+
+	// The request selects the RTOS and adds GDB commands
+	req.Rtos = "auto"
+	req.GdbInitCommands = []string{"break loop"}
+	info, err = getDebugConfig(req, pm)
+	require.NoError(t, err)
+	require.Equal(t, "auto", info.GetRtos())
+	require.Equal(t, []string{"set remotetimeout 10", "monitor reset halt", "break loop"}, info.GetGdbInitCommands())
+	require.Contains(t, info.GetServerArgs(), "[target current] configure -rtos auto")
+
+	// Nothing is added for the boards without the options
+	req.Fqbn = "arduino-test:samd:arduino_zero_edbg"
+	req.Rtos = ""
+	req.GdbInitCommands = nil
+	info, err = getDebugConfig(req, pm)
+	require.NoError(t, err)
+	require.Empty(t, info.GetRtos())
+	require.Empty(t, info.GetGdbInitCommands())
+	require.Len(t, info.GetServerArgs(), 4)
+}
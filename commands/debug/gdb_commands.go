@@ -0,0 +1,170 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+)
+
+// CommandResultCB is called with the result record of a command injected in
+// the debug session through a SendCommand message.
+type CommandResultCB func(id, result string)
+
+// gdbInput serializes the writes to the stdin of the debugger, so that the
+// injected commands are not mixed with the raw data sent by the client.
+// When the MI interpreter is used the injected commands are prefixed with a
+// numeric token, GDB will use the same token in the result record.
+type gdbInput struct {
+	in        io.Writer
+	inLock    sync.Mutex
+	mi        bool
+	lastToken int
+	pending   map[string]string
+	pendLock  sync.Mutex
+}
+
+func newGdbInput(in io.Writer, interpreter string) *gdbInput {
+	return &gdbInput{
+		in:      in,
+		mi:      strings.HasPrefix(interpreter, "mi"),
+		pending: map[string]string{},
+	}
+}
+
+func (g *gdbInput) Write(data []byte) (int, error) {
+	g.inLock.Lock()
+	defer g.inLock.Unlock()
+	return g.in.Write(data)
+}
+
+// sendCommand writes the command into the stdin of the debugger. Console
+// commands are wrapped in an `-interpreter-exec` MI command when the MI
+// interpreter is in use so that their result can be tracked too.
+func (g *gdbInput) sendCommand(command *dbg.SendCommand) error {
+	line := command.GetCommand()
+	if g.mi && command.GetId() != "" {
+		if !strings.HasPrefix(line, "-") {
+			line = "-interpreter-exec console " + strconv.Quote(line)
+		}
+		g.pendLock.Lock()
+		g.lastToken++
+		token := strconv.Itoa(g.lastToken)
+		g.pending[token] = command.GetId()
+		g.pendLock.Unlock()
+		line = token + line
+	}
+	_, err := g.Write([]byte(line + "\n"))
+	return err
+}
+
+// quit asks the debugger to terminate the session
+func (g *gdbInput) quit() error {
+	command := "quit"
+	if g.mi {
+		command = "-gdb-exit"
+	}
+	_, err := g.Write([]byte(command + "\n"))
+	return err
+}
+
+// takeCommandID returns the id of the command tagged with token, if any.
+func (g *gdbInput) takeCommandID(token string) (string, bool) {
+	g.pendLock.Lock()
+	defer g.pendLock.Unlock()
+	id, ok := g.pending[token]
+	delete(g.pending, token)
+	return id, ok
+}
+
+// miResultsFilter forwards the output of the debugger to out, except for the
+// result records of the injected commands that are passed to resultCB.
+// MI output lines starting with a digit are token-prefixed records, they are
+// buffered until complete, all the other output is forwarded immediately.
+type miResultsFilter struct {
+	out         io.Writer
+	input       *gdbInput
+	resultCB    CommandResultCB
+	line        []byte
+	buffering   bool
+	atLineStart bool
+}
+
+func newMiResultsFilter(out io.Writer, input *gdbInput, resultCB CommandResultCB) *miResultsFilter {
+	return &miResultsFilter{
+		out:         out,
+		input:       input,
+		resultCB:    resultCB,
+		atLineStart: true,
+	}
+}
+
+func (f *miResultsFilter) Write(data []byte) (int, error) {
+	for i := 0; i < len(data); {
+		if f.buffering {
+			nl := bytes.IndexByte(data[i:], '\n')
+			if nl == -1 {
+				f.line = append(f.line, data[i:]...)
+				return len(data), nil
+			}
+			f.line = append(f.line, data[i:i+nl+1]...)
+			i += nl + 1
+			f.buffering = false
+			f.atLineStart = true
+			if err := f.flushLine(); err != nil {
+				return i, err
+			}
+			continue
+		}
+		if f.atLineStart && data[i] >= '0' && data[i] <= '9' {
+			f.buffering = true
+			continue
+		}
+		end := len(data)
+		nl := bytes.IndexByte(data[i:], '\n')
+		if nl != -1 {
+			end = i + nl + 1
+		}
+		if _, err := f.out.Write(data[i:end]); err != nil {
+			return i, err
+		}
+		f.atLineStart = nl != -1
+		i = end
+	}
+	return len(data), nil
+}
+
+func (f *miResultsFilter) flushLine() error {
+	line := f.line
+	f.line = nil
+	tokenLen := 0
+	for tokenLen < len(line) && line[tokenLen] >= '0' && line[tokenLen] <= '9' {
+		tokenLen++
+	}
+	if tokenLen < len(line) && line[tokenLen] == '^' {
+		if id, ok := f.input.takeCommandID(string(line[:tokenLen])); ok {
+			f.resultCB(id, strings.TrimRight(string(line[tokenLen:]), "\r\n"))
+			return nil
+		}
+	}
+	_, err := f.out.Write(line)
+	return err
+}
@@ -0,0 +1,245 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+)
+
+// LaunchConfigFormats are the IDEs a launch configuration can be generated for
+var LaunchConfigFormats = []string{"vscode", "eclipse"}
+
+// LaunchConfigFileName returns the path, relative to the sketch folder, of
+// the launch configuration for the given IDE
+func LaunchConfigFileName(format string, sketchName string) (string, error) {
+	switch format {
+	case "vscode":
+		return ".vscode/launch.json", nil
+	case "eclipse":
+		return sketchName + ".launch", nil
+	}
+	return "", invalidLaunchConfigFormatError(format)
+}
+
+func invalidLaunchConfigFormatError(format string) error {
+	return fmt.Errorf("invalid launch configuration format '%s', valid formats are: %s", format, strings.Join(LaunchConfigFormats, ", "))
+}
+
+// LaunchConfig returns the launch configuration of the debug session described
+// by info for the given IDE: "vscode" gives a launch.json for the cortex-debug
+// extension, "eclipse" a launch file for Eclipse Embedded CDT. For vscode the
+// configuration is added to the content of an existing launch.json, replacing
+// the configuration with the same name if present.
+func LaunchConfig(format string, info *dbg.GetDebugConfigResp, sketchName string, existing []byte) ([]byte, error) {
+	if info.GetServer() == "" {
+		return nil, fmt.Errorf("the board doesn't declare a GDB server, the debug.server property is missing")
+	}
+	switch format {
+	case "vscode":
+		return vscodeLaunchConfig(info, sketchName, existing)
+	case "eclipse":
+		return eclipseLaunchConfig(info, sketchName)
+	}
+	return nil, invalidLaunchConfigFormatError(format)
+}
+
+// openocdArgs are the arguments of the OpenOCD GDB server: the configuration
+// files, the scripts directories and the commands. The commands that set the
+// ports are dropped since the IDEs connect to the server on their own.
+type openocdArgs struct {
+	configFiles []string
+	searchDirs  []string
+	commands    []string
+	others      []string
+}
+
+func parseOpenocdArgs(args []string) *openocdArgs {
+	res := &openocdArgs{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		hasValue := i+1 < len(args)
+		switch {
+		case (arg == "-f" || arg == "--file") && hasValue:
+			i++
+			res.configFiles = append(res.configFiles, args[i])
+		case (arg == "-s" || arg == "--search") && hasValue:
+			i++
+			res.searchDirs = append(res.searchDirs, args[i])
+		case (arg == "-c" || arg == "--command") && hasValue:
+			i++
+			command := args[i]
+			if strings.HasPrefix(command, "gdb_port") || strings.HasPrefix(command, "telnet_port") || strings.HasPrefix(command, "tcl_port") {
+				continue
+			}
+			res.commands = append(res.commands, command)
+		default:
+			res.others = append(res.others, arg)
+		}
+	}
+	return res
+}
+
+func vscodeLaunchConfig(info *dbg.GetDebugConfigResp, sketchName string, existing []byte) ([]byte, error) {
+	name := "Arduino: " + sketchName
+	config := map[string]interface{}{
+		"name":            name,
+		"type":            "cortex-debug",
+		"request":         "launch",
+		"cwd":             "${workspaceFolder}",
+		"executable":      info.GetExecutable(),
+		"gdbPath":         info.GetGdbPath(),
+		"runToEntryPoint": "setup",
+	}
+	if svd := info.GetSvdFile(); svd != "" {
+		config["svdFile"] = svd
+	}
+	if commands := info.GetGdbInitCommands(); len(commands) > 0 {
+		config["postLaunchCommands"] = commands
+	}
+	switch server := info.GetServer(); server {
+	case "openocd":
+		args := parseOpenocdArgs(info.GetServerArgs())
+		config["servertype"] = "openocd"
+		config["serverpath"] = info.GetServerPath()
+		config["configFiles"] = args.configFiles
+		if len(args.searchDirs) > 0 {
+			config["searchDir"] = args.searchDirs
+		}
+		// "init" and "halt" are sent by cortex-debug itself
+		launchCommands := []string{}
+		for _, command := range args.commands {
+			if command != "init" && command != "halt" {
+				launchCommands = append(launchCommands, command)
+			}
+		}
+		if len(launchCommands) > 0 {
+			config["openOCDLaunchCommands"] = launchCommands
+		}
+		if len(args.others) > 0 {
+			config["serverArgs"] = args.others
+		}
+	default:
+		config["servertype"] = server
+		config["serverpath"] = info.GetServerPath()
+		config["serverArgs"] = info.GetServerArgs()
+	}
+
+	launch := map[string]interface{}{}
+	if len(bytes.TrimSpace(existing)) > 0 {
+		if err := json.Unmarshal(existing, &launch); err != nil {
+			return nil, fmt.Errorf("the existing launch.json can't be updated, it's not valid JSON (comments are not supported): %s", err)
+		}
+	}
+	if _, ok := launch["version"]; !ok {
+		launch["version"] = "0.2.0"
+	}
+	configurations, _ := launch["configurations"].([]interface{})
+	replaced := false
+	for i, c := range configurations {
+		if c, ok := c.(map[string]interface{}); ok && c["name"] == name {
+			configurations[i] = config
+			replaced = true
+		}
+	}
+	if !replaced {
+		configurations = append(configurations, config)
+	}
+	launch["configurations"] = configurations
+
+	data, err := json.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type eclipseLaunch struct {
+	XMLName    xml.Name             `xml:"launchConfiguration"`
+	Type       string               `xml:"type,attr"`
+	Attributes []eclipseLaunchValue `xml:",any"`
+}
+
+type eclipseLaunchValue struct {
+	XMLName xml.Name
+	Key     string `xml:"key,attr"`
+	Value   string `xml:"value,attr"`
+}
+
+func eclipseStringAttribute(key, value string) eclipseLaunchValue {
+	return eclipseLaunchValue{XMLName: xml.Name{Local: "stringAttribute"}, Key: key, Value: value}
+}
+
+func eclipseBooleanAttribute(key string, value bool) eclipseLaunchValue {
+	return eclipseLaunchValue{XMLName: xml.Name{Local: "booleanAttribute"}, Key: key, Value: fmt.Sprint(value)}
+}
+
+func eclipseLaunchConfig(info *dbg.GetDebugConfigResp, sketchName string) ([]byte, error) {
+	if server := info.GetServer(); server != "openocd" {
+		return nil, fmt.Errorf("launch configurations for Eclipse are supported only for the openocd GDB server, the board uses '%s'", server)
+	}
+	args := parseOpenocdArgs(info.GetServerArgs())
+	serverArgs := []string{}
+	for _, dir := range args.searchDirs {
+		serverArgs = append(serverArgs, "-s", quoteIfNeeded(dir))
+	}
+	for _, file := range args.configFiles {
+		serverArgs = append(serverArgs, "-f", quoteIfNeeded(file))
+	}
+	for _, command := range args.commands {
+		// "init" and "halt" are sent by the launch configuration itself
+		if command != "init" && command != "halt" {
+			serverArgs = append(serverArgs, "-c", quoteIfNeeded(command))
+		}
+	}
+	serverArgs = append(serverArgs, args.others...)
+
+	const openocd = "ilg.gnumcueclipse.debug.gdbjtag.openocd."
+	launch := &eclipseLaunch{
+		Type: openocd + "launchConfigurationType",
+		Attributes: []eclipseLaunchValue{
+			eclipseBooleanAttribute(openocd+"doStartGdbServer", true),
+			eclipseStringAttribute(openocd+"gdbServerExecutable", info.GetServerPath()),
+			eclipseStringAttribute(openocd+"gdbServerOther", strings.Join(serverArgs, " ")),
+			eclipseStringAttribute(openocd+"gdbClientExecutable", info.GetGdbPath()),
+			eclipseBooleanAttribute(openocd+"doContinue", true),
+			eclipseStringAttribute(openocd+"otherInitCommands", strings.Join(info.GetGdbInitCommands(), "\n")),
+			eclipseStringAttribute("ilg.gnumcueclipse.debug.gdbjtag.svdPath", info.GetSvdFile()),
+			eclipseStringAttribute("org.eclipse.cdt.dsf.gdb.DEBUG_NAME", info.GetGdbPath()),
+			eclipseStringAttribute("org.eclipse.cdt.launch.PROGRAM_NAME", info.GetExecutable()),
+			eclipseStringAttribute("org.eclipse.cdt.launch.PROJECT_ATTR", sketchName),
+			eclipseBooleanAttribute("org.eclipse.cdt.debug.gdbjtag.core.setStopAt", true),
+			eclipseStringAttribute("org.eclipse.cdt.debug.gdbjtag.core.stopAt", "setup"),
+		},
+	}
+	data, err := xml.MarshalIndent(launch, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func quoteIfNeeded(arg string) string {
+	if strings.ContainsAny(arg, " \t") {
+		return `"` + arg + `"`
+	}
+	return arg
+}
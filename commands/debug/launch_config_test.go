@@ -0,0 +1,99 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/stretchr/testify/require"
+)
+
+var launchConfigTestInfo = &dbg.GetDebugConfigResp{
+	Executable: "/sketch/build/Blink.ino.elf",
+	Tool:       "gdb",
+	GdbPath:    "/tools/bin/arm-none-eabi-gdb",
+	Server:     "openocd",
+	ServerPath: "/tools/openocd/bin/openocd",
+	ServerArgs: []string{"-d2", "-s", "/tools/openocd/share/openocd/scripts/", "--file", "/hw/variants/zero/openocd scripts/zero.cfg", "-c", "gdb_port pipe", "-c", "telnet_port 0", "-c", "init", "-c", "halt"},
+	SvdFile:    "/hw/svd/ATSAMD21G18A.svd",
+}
+
+func TestVSCodeLaunchConfig(t *testing.T) {
+	data, err := LaunchConfig("vscode", launchConfigTestInfo, "Blink", nil)
+	require.NoError(t, err)
+	launch := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &launch))
+	require.Equal(t, "0.2.0", launch["version"])
+	configurations := launch["configurations"].([]interface{})
+	require.Len(t, configurations, 1)
+	config := configurations[0].(map[string]interface{})
+	require.Equal(t, "Arduino: Blink", config["name"])
+	require.Equal(t, "cortex-debug", config["type"])
+	require.Equal(t, "/sketch/build/Blink.ino.elf", config["executable"])
+	require.Equal(t, "/tools/bin/arm-none-eabi-gdb", config["gdbPath"])
+	require.Equal(t, "openocd", config["servertype"])
+	require.Equal(t, "/tools/openocd/bin/openocd", config["serverpath"])
+	require.Equal(t, []interface{}{"/hw/variants/zero/openocd scripts/zero.cfg"}, config["configFiles"])
+	require.Equal(t, []interface{}{"/tools/openocd/share/openocd/scripts/"}, config["searchDir"])
+	require.Equal(t, []interface{}{"-d2"}, config["serverArgs"])
+	require.NotContains(t, config, "openOCDLaunchCommands")
+	require.Equal(t, "/hw/svd/ATSAMD21G18A.svd", config["svdFile"])
+
+	// The other configurations of an existing launch.json are kept, the
+	// configuration of the sketch is replaced
+	existing := []byte(`{"version": "0.2.0", "configurations": [{"name": "Other"}, {"name": "Arduino: Blink", "type": "old"}]}`)
+	data, err = LaunchConfig("vscode", launchConfigTestInfo, "Blink", existing)
+	require.NoError(t, err)
+	launch = map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &launch))
+	configurations = launch["configurations"].([]interface{})
+	require.Len(t, configurations, 2)
+	require.Equal(t, "Other", configurations[0].(map[string]interface{})["name"])
+	require.Equal(t, "cortex-debug", configurations[1].(map[string]interface{})["type"])
+
+	_, err = LaunchConfig("vscode", launchConfigTestInfo, "Blink", []byte("// comment\n{}"))
+	require.Error(t, err)
+}
+
+func TestEclipseLaunchConfig(t *testing.T) {
+	data, err := LaunchConfig("eclipse", launchConfigTestInfo, "Blink", nil)
+	require.NoError(t, err)
+	launch := string(data)
+	require.True(t, strings.HasPrefix(launch, "<?xml"))
+	require.Contains(t, launch, `<launchConfiguration type="ilg.gnumcueclipse.debug.gdbjtag.openocd.launchConfigurationType">`)
+	require.Contains(t, launch, `<stringAttribute key="ilg.gnumcueclipse.debug.gdbjtag.openocd.gdbServerOther" value="-s /tools/openocd/share/openocd/scripts/ -f &#34;/hw/variants/zero/openocd scripts/zero.cfg&#34; -d2"></stringAttribute>`)
+	require.Contains(t, launch, `<stringAttribute key="org.eclipse.cdt.launch.PROGRAM_NAME" value="/sketch/build/Blink.ino.elf"></stringAttribute>`)
+	require.Contains(t, launch, `<booleanAttribute key="ilg.gnumcueclipse.debug.gdbjtag.openocd.doStartGdbServer" value="true"></booleanAttribute>`)
+
+	jlink := &dbg.GetDebugConfigResp{Server: "jlink"}
+	_, err = LaunchConfig("eclipse", jlink, "Blink", nil)
+	require.Error(t, err)
+}
+
+func TestLaunchConfigErrors(t *testing.T) {
+	_, err := LaunchConfig("atom", launchConfigTestInfo, "Blink", nil)
+	require.EqualError(t, err, "invalid launch configuration format 'atom', valid formats are: vscode, eclipse")
+
+	_, err = LaunchConfig("vscode", &dbg.GetDebugConfigResp{}, "Blink", nil)
+	require.Error(t, err)
+
+	file, err := LaunchConfigFileName("eclipse", "Blink")
+	require.NoError(t, err)
+	require.Equal(t, "Blink.launch", file)
+}
@@ -0,0 +1,141 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/arduino/go-properties-orderedmap"
+)
+
+// debugOptions are the settings of the debug session added to the command
+// line of the platform recipe: the additional OpenOCD scripts, the RTOS for
+// thread-aware debugging and the GDB commands run at startup.
+type debugOptions struct {
+	rtos            string
+	openocdArgs     []string
+	gdbInitCommands []string
+}
+
+// getDebugOptions collects the debug options from the debug.rtos,
+// debug.server.openocd.scripts and debug.gdb_init properties, the latter two
+// may be lists (e.g. debug.gdb_init.0, debug.gdb_init.1...). The RTOS given
+// in the request replaces the one of the board, the GDB commands given in
+// the request are run after the ones of the board.
+func getDebugOptions(req *dbg.DebugConfigReq, toolProperties *properties.Map) *debugOptions {
+	res := &debugOptions{
+		rtos:            toolProperties.Get("debug.rtos"),
+		openocdArgs:     []string{},
+		gdbInitCommands: []string{},
+	}
+	if rtos := req.GetRtos(); rtos != "" {
+		res.rtos = rtos
+	}
+	for _, script := range propertiesList(toolProperties, "debug.server.openocd.scripts") {
+		res.openocdArgs = append(res.openocdArgs, "--file", script)
+	}
+	if res.rtos != "" {
+		res.openocdArgs = append(res.openocdArgs, "-c", "[target current] configure -rtos "+res.rtos)
+	}
+	res.gdbInitCommands = append(res.gdbInitCommands, propertiesList(toolProperties, "debug.gdb_init")...)
+	res.gdbInitCommands = append(res.gdbInitCommands, req.GetGdbInitCommands()...)
+	return res
+}
+
+// propertiesList returns the expanded value of key, or the values of key.N
+// in numerical order if key is a list
+func propertiesList(props *properties.Map, key string) []string {
+	res := []string{}
+	if value, ok := props.GetOk(key); ok && value != "" {
+		res = append(res, props.ExpandPropsInString(value))
+	}
+	list := props.SubTree(key)
+	indexes := []int{}
+	for _, k := range list.FirstLevelKeys() {
+		if i, err := strconv.Atoi(k); err == nil {
+			indexes = append(indexes, i)
+		}
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		if value := list.Get(strconv.Itoa(i)); value != "" {
+			res = append(res, props.ExpandPropsInString(value))
+		}
+	}
+	return res
+}
+
+// openocdInitCommand matches the OpenOCD "init" command: the options that
+// configure the targets must come before it
+var openocdInitCommand = regexp.MustCompile(`\s(-c|--command)\s+("init"|'init'|init)(\s|$)`)
+
+// injectIntoCommandLine adds the debug options to the command line of GDB:
+// the OpenOCD options are added to the OpenOCD started by GDB through a pipe,
+// the init commands are added as -ex options.
+func (o *debugOptions) injectIntoCommandLine(cmdArgs []string) []string {
+	res := []string{}
+	for _, arg := range cmdArgs {
+		if len(o.openocdArgs) > 0 && isOpenocdPipe(arg) {
+			quoted := []string{}
+			for _, openocdArg := range o.openocdArgs {
+				quoted = append(quoted, fmt.Sprintf(`"%s"`, openocdArg))
+			}
+			extra := " " + strings.Join(quoted, " ")
+			if loc := openocdInitCommand.FindStringIndex(arg); loc != nil {
+				arg = arg[:loc[0]] + extra + arg[loc[0]:]
+			} else {
+				arg += extra
+			}
+		}
+		res = append(res, arg)
+	}
+
+	initCommands := []string{}
+	for _, command := range o.gdbInitCommands {
+		initCommands = append(initCommands, "-ex", command)
+	}
+	// The init commands go before the executable, usually the last argument
+	if n := len(res); n > 0 && strings.HasSuffix(res[n-1], ".elf") {
+		return append(res[:n-1], append(initCommands, res[n-1])...)
+	}
+	return append(res, initCommands...)
+}
+
+func isOpenocdPipe(arg string) bool {
+	return (strings.HasPrefix(arg, "target extended-remote |") || strings.HasPrefix(arg, "target remote |")) &&
+		strings.Contains(arg, "openocd")
+}
+
+// injectIntoServerArgs adds the OpenOCD options to the arguments of the GDB
+// server declared by the platform
+func (o *debugOptions) injectIntoServerArgs(server string, serverArgs []string) []string {
+	if server != "openocd" || len(o.openocdArgs) == 0 {
+		return serverArgs
+	}
+	for i := 0; i+1 < len(serverArgs); i++ {
+		if (serverArgs[i] == "-c" || serverArgs[i] == "--command") && serverArgs[i+1] == "init" {
+			res := append([]string{}, serverArgs[:i]...)
+			res = append(res, o.openocdArgs...)
+			return append(res, serverArgs[i:]...)
+		}
+	}
+	return append(serverArgs, o.openocdArgs...)
+}
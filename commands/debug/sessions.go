@@ -0,0 +1,158 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands"
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+)
+
+// Session tracks a running debug session, so that it can be listed,
+// interrupted or terminated independently from the client that started it.
+type Session struct {
+	ID        string
+	Req       *dbg.DebugConfigReq
+	StartedAt time.Time
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	interrupt chan os.Signal
+	lock      sync.Mutex
+	closed    bool
+}
+
+var sessions = map[string]*Session{}
+var sessionsLock sync.Mutex
+var lastSessionID int
+
+// NewSession registers a new debug session for the given request. The session
+// is terminated when ctx is canceled or when TerminateSession is called,
+// Close must be called when the debugger exits.
+func NewSession(ctx context.Context, req *dbg.DebugConfigReq) *Session {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+	lastSessionID++
+	session := &Session{
+		ID:        strconv.Itoa(lastSessionID),
+		Req:       req,
+		StartedAt: time.Now(),
+		interrupt: make(chan os.Signal, 1),
+	}
+	session.ctx, session.cancel = context.WithCancel(ctx)
+	sessions[session.ID] = session
+	return session
+}
+
+// Context returns the context to pass to Debug, it's canceled when the
+// session is terminated.
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+// Interrupts returns the channel of the signals to pass to Debug.
+func (s *Session) Interrupts() <-chan os.Signal {
+	return s.interrupt
+}
+
+// Interrupt sends an interrupt signal to the debugger.
+func (s *Session) Interrupt() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.interrupt <- os.Interrupt:
+	default:
+		// an interrupt is already pending
+	}
+}
+
+// Close unregisters the session and releases its resources.
+func (s *Session) Close() {
+	sessionsLock.Lock()
+	delete(sessions, s.ID)
+	sessionsLock.Unlock()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.interrupt)
+		s.cancel()
+	}
+}
+
+// ListSessions returns the running debug sessions sorted by ID.
+func ListSessions() []*dbg.DebugSession {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+	res := []*dbg.DebugSession{}
+	for _, session := range sessions {
+		res = append(res, &dbg.DebugSession{
+			Id:         session.ID,
+			Fqbn:       session.Req.GetFqbn(),
+			SketchPath: session.Req.GetSketchPath(),
+			Port:       session.Req.GetPort(),
+			StartedAt:  session.StartedAt.Unix(),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, _ := strconv.Atoi(res[i].Id)
+		b, _ := strconv.Atoi(res[j].Id)
+		return a < b
+	})
+	return res
+}
+
+func findSession(id string) (*Session, error) {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+	session, ok := sessions[id]
+	if !ok {
+		return nil, commands.NotFoundError(commands.CodeDebugSessionNotFound, nil, "debug session %s not found", id)
+	}
+	return session, nil
+}
+
+// SignalSession sends an interrupt signal to the debugger of the session
+// with the given ID.
+func SignalSession(id string) error {
+	session, err := findSession(id)
+	if err != nil {
+		return err
+	}
+	session.Interrupt()
+	return nil
+}
+
+// TerminateSession asks the debugger of the session with the given ID to quit.
+// The debugger is killed if it doesn't quit within `debug.shutdown_timeout`.
+func TerminateSession(id string) error {
+	session, err := findSession(id)
+	if err != nil {
+		return err
+	}
+	session.cancel()
+	return nil
+}
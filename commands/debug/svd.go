@@ -0,0 +1,348 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package debug
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands"
+	dbg "github.com/arduino/arduino-cli/rpc/debug"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+)
+
+// PeripheralCB is a callback receiving the peripherals described by an SVD file.
+type PeripheralCB func(peripheral *dbg.Peripheral)
+
+// GetPeripherals parses the SVD file provided by the board for the debug
+// session described by req and calls peripheralCB for each peripheral found.
+func GetPeripherals(ctx context.Context, req *dbg.DebugConfigReq, peripheralCB PeripheralCB) error {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return commands.ErrInvalidInstance
+	}
+	toolProperties, err := getDebugProperties(req, pm)
+	if err != nil {
+		return errors.Wrap(err, "Cannot get debug properties")
+	}
+	svdFile := getSvdFile(toolProperties)
+	if svdFile == nil {
+		return commands.NotFoundError(commands.CodeSVDNotFound, nil, "the board doesn't provide an SVD file")
+	}
+	peripherals, err := loadSvdPeripherals(svdFile)
+	if err != nil {
+		return err
+	}
+	for _, peripheral := range peripherals {
+		peripheralCB(peripheral)
+	}
+	return nil
+}
+
+// getSvdFile returns the path of the SVD file set in the `debug.svd_file`
+// property, or nil if the property is missing or the file doesn't exist.
+func getSvdFile(toolProperties *properties.Map) *paths.Path {
+	svdFile := toolProperties.ExpandPropsInString(toolProperties.Get("debug.svd_file"))
+	if svdFile == "" {
+		return nil
+	}
+	if path := paths.New(svdFile); path.Exist() {
+		return path
+	}
+	return nil
+}
+
+type svdDevice struct {
+	Size        string          `xml:"size"`
+	Access      string          `xml:"access"`
+	ResetValue  string          `xml:"resetValue"`
+	Peripherals []svdPeripheral `xml:"peripherals>peripheral"`
+}
+
+type svdPeripheral struct {
+	DerivedFrom string        `xml:"derivedFrom,attr"`
+	Name        string        `xml:"name"`
+	Description string        `xml:"description"`
+	GroupName   string        `xml:"groupName"`
+	BaseAddress string        `xml:"baseAddress"`
+	Size        string        `xml:"size"`
+	Access      string        `xml:"access"`
+	ResetValue  string        `xml:"resetValue"`
+	Registers   *svdRegisters `xml:"registers"`
+}
+
+type svdRegisters struct {
+	Clusters  []svdCluster  `xml:"cluster"`
+	Registers []svdRegister `xml:"register"`
+}
+
+type svdCluster struct {
+	Name          string        `xml:"name"`
+	AddressOffset string        `xml:"addressOffset"`
+	Clusters      []svdCluster  `xml:"cluster"`
+	Registers     []svdRegister `xml:"register"`
+}
+
+type svdRegister struct {
+	Name          string     `xml:"name"`
+	Description   string     `xml:"description"`
+	AddressOffset string     `xml:"addressOffset"`
+	Size          string     `xml:"size"`
+	Access        string     `xml:"access"`
+	ResetValue    string     `xml:"resetValue"`
+	Fields        []svdField `xml:"fields>field"`
+}
+
+type svdField struct {
+	Name        string `xml:"name"`
+	Description string `xml:"description"`
+	BitOffset   string `xml:"bitOffset"`
+	BitWidth    string `xml:"bitWidth"`
+	Lsb         string `xml:"lsb"`
+	Msb         string `xml:"msb"`
+	BitRange    string `xml:"bitRange"`
+	Access      string `xml:"access"`
+}
+
+// svdDefaults holds the register properties that are inherited from the
+// enclosing device or peripheral when not specified.
+type svdDefaults struct {
+	size       string
+	access     string
+	resetValue string
+}
+
+func (d svdDefaults) override(size, access, resetValue string) svdDefaults {
+	if size != "" {
+		d.size = size
+	}
+	if access != "" {
+		d.access = access
+	}
+	if resetValue != "" {
+		d.resetValue = resetValue
+	}
+	return d
+}
+
+// loadSvdPeripherals parses the SVD file and returns the peripherals it describes
+func loadSvdPeripherals(svdFile *paths.Path) ([]*dbg.Peripheral, error) {
+	data, err := svdFile.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading SVD file: %s", err)
+	}
+	var device svdDevice
+	if err := xml.Unmarshal(data, &device); err != nil {
+		return nil, fmt.Errorf("parsing SVD file %s: %s", svdFile, err)
+	}
+
+	byName := map[string]*svdPeripheral{}
+	for i := range device.Peripherals {
+		byName[device.Peripherals[i].Name] = &device.Peripherals[i]
+	}
+
+	deviceDefaults := svdDefaults{}.override(device.Size, device.Access, device.ResetValue)
+	res := []*dbg.Peripheral{}
+	for _, peripheral := range device.Peripherals {
+		// A derived peripheral inherits everything that it doesn't redefine
+		if peripheral.DerivedFrom != "" {
+			parent, ok := byName[peripheral.DerivedFrom]
+			if !ok {
+				return nil, fmt.Errorf("peripheral %s derived from unknown peripheral %s", peripheral.Name, peripheral.DerivedFrom)
+			}
+			if peripheral.Description == "" {
+				peripheral.Description = parent.Description
+			}
+			if peripheral.GroupName == "" {
+				peripheral.GroupName = parent.GroupName
+			}
+			if peripheral.Registers == nil {
+				peripheral.Registers = parent.Registers
+			}
+			if peripheral.Size == "" {
+				peripheral.Size = parent.Size
+			}
+			if peripheral.Access == "" {
+				peripheral.Access = parent.Access
+			}
+			if peripheral.ResetValue == "" {
+				peripheral.ResetValue = parent.ResetValue
+			}
+		}
+
+		baseAddress, err := parseSvdNumber(peripheral.BaseAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base address for peripheral %s: %s", peripheral.Name, err)
+		}
+		out := &dbg.Peripheral{
+			Name:        peripheral.Name,
+			Description: cleanSvdText(peripheral.Description),
+			GroupName:   peripheral.GroupName,
+			BaseAddress: baseAddress,
+			Registers:   []*dbg.Register{},
+		}
+		if peripheral.Registers != nil {
+			defaults := deviceDefaults.override(peripheral.Size, peripheral.Access, peripheral.ResetValue)
+			registers, err := convertSvdRegisters("", 0, peripheral.Registers.Clusters, peripheral.Registers.Registers, defaults)
+			if err != nil {
+				return nil, fmt.Errorf("peripheral %s: %s", peripheral.Name, err)
+			}
+			out.Registers = registers
+		}
+		res = append(res, out)
+	}
+	return res, nil
+}
+
+// convertSvdRegisters flattens the registers and the clusters of registers,
+// prefixing the name of the registers with the name of the enclosing cluster.
+func convertSvdRegisters(prefix string, offset uint64, clusters []svdCluster, registers []svdRegister, defaults svdDefaults) ([]*dbg.Register, error) {
+	res := []*dbg.Register{}
+	for _, cluster := range clusters {
+		clusterOffset, err := parseSvdNumber(cluster.AddressOffset)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address offset for cluster %s: %s", cluster.Name, err)
+		}
+		regs, err := convertSvdRegisters(prefix+cluster.Name+".", offset+clusterOffset, cluster.Clusters, cluster.Registers, defaults)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, regs...)
+	}
+	for _, register := range registers {
+		out, err := convertSvdRegister(register, defaults)
+		if err != nil {
+			return nil, fmt.Errorf("register %s: %s", prefix+register.Name, err)
+		}
+		out.Name = prefix + out.Name
+		out.AddressOffset += offset
+		res = append(res, out)
+	}
+	return res, nil
+}
+
+func convertSvdRegister(register svdRegister, defaults svdDefaults) (*dbg.Register, error) {
+	defaults = defaults.override(register.Size, register.Access, register.ResetValue)
+	addressOffset, err := parseSvdNumber(register.AddressOffset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address offset: %s", err)
+	}
+	size, err := parseSvdNumber(defaults.size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size: %s", err)
+	}
+	resetValue, err := parseSvdNumber(defaults.resetValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reset value: %s", err)
+	}
+	out := &dbg.Register{
+		Name:          register.Name,
+		Description:   cleanSvdText(register.Description),
+		AddressOffset: addressOffset,
+		Size:          uint32(size),
+		Access:        defaults.access,
+		ResetValue:    resetValue,
+		Fields:        []*dbg.RegisterField{},
+	}
+	for _, field := range register.Fields {
+		offset, width, err := field.bits()
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %s", field.Name, err)
+		}
+		access := field.Access
+		if access == "" {
+			access = out.Access
+		}
+		out.Fields = append(out.Fields, &dbg.RegisterField{
+			Name:        field.Name,
+			Description: cleanSvdText(field.Description),
+			BitOffset:   offset,
+			BitWidth:    width,
+			Access:      access,
+		})
+	}
+	return out, nil
+}
+
+// bits returns the offset and the width of the field, that may be specified
+// in any of the three formats allowed by the SVD specification.
+func (field svdField) bits() (uint32, uint32, error) {
+	var lsb, msb uint64
+	var err error
+	switch {
+	case field.BitRange != "":
+		bitRange := strings.TrimSuffix(strings.TrimPrefix(field.BitRange, "["), "]")
+		split := strings.Split(bitRange, ":")
+		if len(split) != 2 {
+			return 0, 0, fmt.Errorf("invalid bit range %s", field.BitRange)
+		}
+		if msb, err = parseSvdNumber(split[0]); err != nil {
+			return 0, 0, fmt.Errorf("invalid bit range %s", field.BitRange)
+		}
+		if lsb, err = parseSvdNumber(split[1]); err != nil {
+			return 0, 0, fmt.Errorf("invalid bit range %s", field.BitRange)
+		}
+	case field.Lsb != "" || field.Msb != "":
+		if lsb, err = parseSvdNumber(field.Lsb); err != nil {
+			return 0, 0, fmt.Errorf("invalid lsb: %s", err)
+		}
+		if msb, err = parseSvdNumber(field.Msb); err != nil {
+			return 0, 0, fmt.Errorf("invalid msb: %s", err)
+		}
+	default:
+		if lsb, err = parseSvdNumber(field.BitOffset); err != nil {
+			return 0, 0, fmt.Errorf("invalid bit offset: %s", err)
+		}
+		width := uint64(1)
+		if field.BitWidth != "" {
+			if width, err = parseSvdNumber(field.BitWidth); err != nil {
+				return 0, 0, fmt.Errorf("invalid bit width: %s", err)
+			}
+		}
+		msb = lsb + width - 1
+	}
+	if msb < lsb {
+		return 0, 0, fmt.Errorf("invalid bits position: msb %d is lower than lsb %d", msb, lsb)
+	}
+	return uint32(lsb), uint32(msb - lsb + 1), nil
+}
+
+// parseSvdNumber parses a number in any of the formats allowed by the SVD
+// specification (decimal, 0x hexadecimal or # binary). Empty strings are
+// parsed as zero.
+func parseSvdNumber(s string) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		return strconv.ParseUint(s[1:], 2, 64)
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return strconv.ParseUint(s[2:], 16, 64)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// cleanSvdText collapses the whitespace used to indent multi-line descriptions
+func cleanSvdText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -16,10 +16,15 @@
 package commands
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	"github.com/arduino/arduino-cli/httpclient"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/arduino/arduino-cli/telemetry"
+	"github.com/arduino/arduino-cli/tracing"
+	"github.com/spf13/viper"
 	"go.bug.st/downloader/v2"
 )
 
@@ -40,7 +45,8 @@ func GetDownloaderConfig() (*downloader.Config, error) {
 
 // Download performs a download loop using the provided downloader.Downloader.
 // Messages are passed back to the DownloadProgressCB using label as text for the File field.
-func Download(d *downloader.Downloader, label string, downloadCB DownloadProgressCB) error {
+// The download is interrupted if ctx is canceled.
+func Download(ctx context.Context, d *downloader.Downloader, label string, downloadCB DownloadProgressCB) error {
 	if d == nil {
 		// This signal means that the file is already downloaded
 		downloadCB(&rpc.DownloadProgress{
@@ -54,11 +60,190 @@ func Download(d *downloader.Downloader, label string, downloadCB DownloadProgres
 		Url:       d.URL,
 		TotalSize: d.Size(),
 	})
-	d.RunAndPoll(func(downloaded int64) {
+	if err := runDownload(ctx, d, func(downloaded int64) {
 		downloadCB(&rpc.DownloadProgress{Downloaded: downloaded})
-	}, 250*time.Millisecond)
-	if d.Error() != nil {
-		return d.Error()
+	}); err != nil {
+		return err
+	}
+	downloadCB(&rpc.DownloadProgress{Completed: true})
+	return nil
+}
+
+// runDownload runs the download until it completes or ctx is canceled, poll
+// is called periodically with the downloaded size. The start and the end of
+// the download are logged together with the ID of the request carried by ctx.
+func runDownload(ctx context.Context, d *downloader.Downloader, poll func(int64)) error {
+	log := tracing.Logger(ctx).WithField("url", d.URL)
+	log.Info("Download started")
+	start := time.Now()
+
+	finished := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			// Closing the downloader makes the pending read fail
+			d.Close()
+		case <-finished:
+		}
+	}()
+	d.RunAndPoll(poll, 250*time.Millisecond)
+	close(finished)
+
+	err := d.Error()
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	log = log.WithField("duration", time.Since(start).String())
+	if err != nil {
+		log.WithError(err).Warn("Download failed")
+		if ctx.Err() != nil {
+			return err
+		}
+		return NetworkError(CodeDownloadFailed, err, "downloading %s", d.URL)
+	}
+	log.WithField("size", d.Completed()).Info("Download completed")
+	telemetry.Downloaded(d.Completed())
+	return nil
+}
+
+// DownloadTask is a download to be performed by DownloadAll.
+type DownloadTask struct {
+	// Label is the text used to report the progress of this download alone
+	Label string
+	// Size is the expected size of the file, used to compute the total progress
+	Size int64
+	// Start begins the download, a nil Downloader means that the file is already downloaded
+	Start func() (*downloader.Downloader, error)
+	// Verify, if not nil, checks the integrity of the file once downloaded
+	Verify func() error
+}
+
+// DownloadAll performs the download tasks concurrently, running at most as many
+// downloads as set in network.connections at the same time. The aggregated
+// progress of all the downloads is passed back to the DownloadProgressCB using
+// label as text for the File field. A single task is reported with its own label.
+// Each file is verified as soon as its download completes. The downloads are
+// interrupted if ctx is canceled.
+func DownloadAll(ctx context.Context, tasks []*DownloadTask, label string, downloadCB DownloadProgressCB) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	if len(tasks) == 1 {
+		d, err := tasks[0].Start()
+		if err != nil {
+			return err
+		}
+		if err := Download(ctx, d, tasks[0].Label, downloadCB); err != nil {
+			return err
+		}
+		if d != nil && tasks[0].Verify != nil {
+			return tasks[0].Verify()
+		}
+		return nil
+	}
+
+	connections := viper.GetInt("network.connections")
+	if connections < 1 {
+		connections = 1
+	}
+
+	totalSize := int64(0)
+	for _, task := range tasks {
+		totalSize += task.Size
+	}
+
+	var mux sync.Mutex
+	var firstErr error
+	downloaded := make([]int64, len(tasks))
+	started := false
+
+	var wg sync.WaitGroup
+	slots := make(chan bool, connections)
+	for i, task := range tasks {
+		wg.Add(1)
+		go func(i int, task *DownloadTask) {
+			defer wg.Done()
+			slots <- true
+			defer func() { <-slots }()
+
+			if err := ctx.Err(); err != nil {
+				mux.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mux.Unlock()
+				return
+			}
+			d, err := task.Start()
+			if err == nil && d != nil {
+				mux.Lock()
+				started = true
+				mux.Unlock()
+				err = runDownload(ctx, d, func(n int64) {
+					mux.Lock()
+					downloaded[i] = n
+					mux.Unlock()
+				})
+				if err == nil && task.Verify != nil {
+					err = task.Verify()
+				}
+			}
+			mux.Lock()
+			defer mux.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			downloaded[i] = task.Size
+		}(i, task)
+	}
+
+	done := make(chan bool)
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	// Report the aggregated progress from this goroutine only, so that the
+	// callback is never invoked concurrently
+	reported := false
+	report := func() {
+		mux.Lock()
+		defer mux.Unlock()
+		if !started {
+			return
+		}
+		if !reported {
+			downloadCB(&rpc.DownloadProgress{File: label, TotalSize: totalSize})
+			reported = true
+		}
+		sum := int64(0)
+		for _, n := range downloaded {
+			sum += n
+		}
+		downloadCB(&rpc.DownloadProgress{Downloaded: sum})
+	}
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		case <-ticker.C:
+			report()
+		}
+	}
+
+	if firstErr != nil {
+		return firstErr
+	}
+	report()
+	if !reported {
+		// This signal means that all the files are already downloaded
+		downloadCB(&rpc.DownloadProgress{File: label, Completed: true})
+		return nil
 	}
 	downloadCB(&rpc.DownloadProgress{Completed: true})
 	return nil
@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/downloader/v2"
+)
+
+func TestDownloadAll(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "content of "+r.URL.Path)
+	}))
+	defer ts.Close()
+
+	tmp, err := paths.MkTempDir("", "test_download_all")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	tasks := []*DownloadTask{}
+	for _, name := range []string{"a", "b", "c"} {
+		target := tmp.Join(name)
+		url := ts.URL + "/" + name
+		tasks = append(tasks, &DownloadTask{
+			Label: name,
+			Size:  int64(len("content of /" + name)),
+			Start: func() (*downloader.Downloader, error) {
+				return downloader.Download(target.String(), url)
+			},
+		})
+	}
+	// An already downloaded file
+	tasks = append(tasks, &DownloadTask{
+		Label: "cached",
+		Size:  10,
+		Start: func() (*downloader.Downloader, error) { return nil, nil },
+	})
+
+	progress := []*rpc.DownloadProgress{}
+	err = DownloadAll(context.Background(), tasks, "all", func(p *rpc.DownloadProgress) { progress = append(progress, p) })
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b", "c"} {
+		data, err := tmp.Join(name).ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, "content of /"+name, string(data))
+	}
+
+	require.Equal(t, "all", progress[0].GetFile())
+	require.Equal(t, int64(3*len("content of /a")+10), progress[0].GetTotalSize())
+	require.True(t, progress[len(progress)-1].GetCompleted())
+	require.Equal(t, progress[0].GetTotalSize(), progress[len(progress)-2].GetDownloaded())
+
+	// Errors are reported
+	tasks = append(tasks, &DownloadTask{
+		Label: "error",
+		Start: func() (*downloader.Downloader, error) { return nil, fmt.Errorf("error") },
+	})
+	require.Error(t, DownloadAll(context.Background(), tasks, "all", func(p *rpc.DownloadProgress) {}))
+
+	// Nothing is downloaded once the context is canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = DownloadAll(ctx, tasks[:3], "all", func(p *rpc.DownloadProgress) {})
+	require.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,160 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+)
+
+// cachedArchive is a file found in the downloads directory
+type cachedArchive struct {
+	path     *paths.Path
+	size     int64
+	modified time.Time
+}
+
+// listCachedArchives returns the files found in dirs, least recently
+// downloaded first
+func listCachedArchives(dirs paths.PathList) ([]*cachedArchive, error) {
+	archives := []*cachedArchive{}
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		err := filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.Mode().IsRegular() {
+				archives = append(archives, &cachedArchive{path: paths.New(path), size: info.Size(), modified: info.ModTime()})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading downloads directory: %w", err)
+		}
+	}
+	sort.SliceStable(archives, func(i, j int) bool { return archives[i].modified.Before(archives[j].modified) })
+	return archives, nil
+}
+
+// CleanDownloadsCache removes the archives found in dirs. If olderThanDays is
+// not zero only the archives downloaded more than olderThanDays days ago are
+// removed, if onlyOrphaned is set only the ones whose path is not in
+// referenced. With dryRun the archives are listed without removing them. It
+// returns the archives removed and their total size.
+func CleanDownloadsCache(dirs paths.PathList, referenced map[string]bool, olderThanDays int32, onlyOrphaned, dryRun bool) ([]*rpc.CachedArchive, int64, error) {
+	if olderThanDays < 0 {
+		return nil, 0, InvalidArgumentError(CodeInvalidArgument, nil, "invalid number of days: %d", olderThanDays)
+	}
+	archives, err := listCachedArchives(dirs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := time.Now().AddDate(0, 0, -int(olderThanDays))
+	removed := []*rpc.CachedArchive{}
+	size := int64(0)
+	for _, archive := range archives {
+		if olderThanDays > 0 && archive.modified.After(limit) {
+			continue
+		}
+		orphaned := !referenced[archive.path.String()]
+		if onlyOrphaned && !orphaned {
+			continue
+		}
+		if !dryRun {
+			if err := archive.path.Remove(); err != nil {
+				return removed, size, fmt.Errorf("removing %s: %w", archive.path, err)
+			}
+		}
+		removed = append(removed, &rpc.CachedArchive{
+			Path:     archive.path.String(),
+			Size:     archive.size,
+			Modified: archive.modified.Unix(),
+			Orphaned: orphaned,
+		})
+		size += archive.size
+	}
+	return removed, size, nil
+}
+
+// EnforceDownloadsMaxSize removes the least recently downloaded archives until
+// the size of the downloads directory fits directories.DownloadsMaxSize, if set.
+func EnforceDownloadsMaxSize() error {
+	setting := viper.GetString("directories.DownloadsMaxSize")
+	if setting == "" {
+		return nil
+	}
+	maxSize, err := parseByteSize(setting)
+	if err != nil {
+		return fmt.Errorf("invalid directories.DownloadsMaxSize: %w", err)
+	}
+	if maxSize == 0 {
+		return nil
+	}
+
+	archives, err := listCachedArchives(paths.PathList{paths.New(viper.GetString("directories.Downloads"))})
+	if err != nil {
+		return err
+	}
+	size := int64(0)
+	for _, archive := range archives {
+		size += archive.size
+	}
+	for _, archive := range archives {
+		if size <= maxSize {
+			break
+		}
+		if err := archive.path.Remove(); err != nil {
+			return fmt.Errorf("removing %s: %w", archive.path, err)
+		}
+		size -= archive.size
+	}
+	return nil
+}
+
+// parseByteSize parses a size like `500MB` or `2GB` into bytes. The `KB`, `MB`
+// and `GB` units are powers of 1024, a number without unit is in bytes.
+func parseByteSize(s string) (int64, error) {
+	value := strings.ToUpper(strings.TrimSpace(s))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix     string
+		multiplier int64
+	}{{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(value, unit.suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size: %s", s)
+	}
+	return int64(n * float64(multiplier)), nil
+}
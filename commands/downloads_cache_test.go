@@ -0,0 +1,87 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteSize(t *testing.T) {
+	for s, expected := range map[string]int64{
+		"100":    100,
+		"100B":   100,
+		"2KB":    2048,
+		"1.5 MB": 1536 * 1024,
+		"2gb":    2 << 30,
+	} {
+		size, err := parseByteSize(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expected, size, s)
+	}
+	_, err := parseByteSize("lots")
+	require.Error(t, err)
+	_, err = parseByteSize("-1MB")
+	require.Error(t, err)
+}
+
+func TestCleanDownloadsCache(t *testing.T) {
+	downloadsDir, err := paths.MkTempDir("", "test_downloads_cache")
+	require.NoError(t, err)
+	defer downloadsDir.RemoveAll()
+
+	packagesDir := downloadsDir.Join("packages")
+	require.NoError(t, packagesDir.MkdirAll())
+	old := packagesDir.Join("old.tar.bz2")
+	recent := packagesDir.Join("recent.tar.bz2")
+	orphaned := packagesDir.Join("orphaned.tar.bz2")
+	for _, archive := range []*paths.Path{old, recent, orphaned} {
+		require.NoError(t, archive.WriteFile([]byte("archive")))
+	}
+	require.NoError(t, old.Chtimes(time.Now(), time.Now().AddDate(0, 0, -40)))
+	referenced := map[string]bool{old.String(): true, recent.String(): true}
+	dirs := paths.PathList{packagesDir}
+
+	removed, size, err := CleanDownloadsCache(dirs, referenced, 30, false, true)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	require.Equal(t, old.String(), removed[0].GetPath())
+	require.False(t, removed[0].GetOrphaned())
+	require.Equal(t, int64(7), size)
+	require.True(t, old.Exist())
+
+	removed, _, err = CleanDownloadsCache(dirs, referenced, 0, true, false)
+	require.NoError(t, err)
+	require.Len(t, removed, 1)
+	require.Equal(t, orphaned.String(), removed[0].GetPath())
+	require.True(t, removed[0].GetOrphaned())
+	require.False(t, orphaned.Exist())
+
+	_, _, err = CleanDownloadsCache(dirs, referenced, -1, false, false)
+	require.Error(t, err)
+
+	// The least recently downloaded archives are removed first
+	viper.Set("directories.Downloads", downloadsDir.String())
+	viper.Set("directories.DownloadsMaxSize", "10")
+	defer viper.Set("directories.DownloadsMaxSize", "")
+	require.NoError(t, EnforceDownloadsMaxSize())
+	require.False(t, old.Exist())
+	require.True(t, recent.Exist())
+}
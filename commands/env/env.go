@@ -0,0 +1,228 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/lib"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	semver "go.bug.st/relaxed-semver"
+	"gopkg.in/yaml.v3"
+)
+
+// Lockfile describes the complete state of an installation: the additional
+// package index URLs, the installed platforms, tools and libraries, each one
+// pinned to an exact version. Platforms and tools are in the
+// PACKAGER:NAME@VERSION form, libraries in the NAME@VERSION form, so that a
+// lockfile can also be used as a manifest for `core download` and
+// `lib download`. When a lockfile is written by hand the version of a library
+// may be a range, e.g. `ArduinoJson@>=6.0 <7.0`: the newest version in the
+// range is installed.
+type Lockfile struct {
+	AdditionalURLs []string `yaml:"additional_urls" json:"additional_urls"`
+	Platforms      []string `yaml:"platforms" json:"platforms"`
+	Tools          []string `yaml:"tools" json:"tools"`
+	Libraries      []string `yaml:"libraries" json:"libraries"`
+}
+
+// LoadLockfile reads a Lockfile from a YAML file and checks that every item
+// is pinned to a version.
+func LoadLockfile(file *paths.Path) (*Lockfile, error) {
+	content, err := file.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading lockfile: %s", err)
+	}
+	lockfile := &Lockfile{}
+	if err := yaml.Unmarshal(content, lockfile); err != nil {
+		return nil, fmt.Errorf("decoding lockfile %s: %s", file, err)
+	}
+	for _, platform := range lockfile.Platforms {
+		if _, _, _, err := parseItem(platform, true); err != nil {
+			return nil, fmt.Errorf("invalid platform in lockfile: %s", err)
+		}
+	}
+	for _, tool := range lockfile.Tools {
+		if _, _, _, err := parseItem(tool, true); err != nil {
+			return nil, fmt.Errorf("invalid tool in lockfile: %s", err)
+		}
+	}
+	for _, library := range lockfile.Libraries {
+		if _, _, _, err := parseItem(library, false); err != nil {
+			return nil, fmt.Errorf("invalid library in lockfile: %s", err)
+		}
+	}
+	return lockfile, nil
+}
+
+// Encode returns the YAML representation of the Lockfile.
+func (l *Lockfile) Encode() ([]byte, error) {
+	return yaml.Marshal(l)
+}
+
+// parseItem splits an item of the lockfile in its packager, name and version,
+// the packager is required only if withPackager is true.
+func parseItem(item string, withPackager bool) (string, string, string, error) {
+	split := strings.LastIndex(item, "@")
+	if split == -1 || split == len(item)-1 {
+		return "", "", "", fmt.Errorf("missing version in %s", item)
+	}
+	name, version := item[:split], item[split+1:]
+	if !withPackager {
+		if name == "" {
+			return "", "", "", fmt.Errorf("missing name in %s", item)
+		}
+		return "", name, version, nil
+	}
+	packager := strings.Split(name, ":")
+	if len(packager) != 2 || packager[0] == "" || packager[1] == "" {
+		return "", "", "", fmt.Errorf("%s must be in the PACKAGER:NAME@VERSION form", item)
+	}
+	return packager[0], packager[1], version, nil
+}
+
+// Export returns a Lockfile describing the platforms, tools and libraries
+// installed in the given instance. Libraries without a version or installed
+// outside the sketchbook are not included, since they can't be reinstalled
+// from the libraries index.
+func Export(ctx context.Context, instance *rpc.Instance) (*Lockfile, error) {
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return nil, fmt.Errorf("invalid instance")
+	}
+	lm := commands.GetLibraryManager(instance.GetId())
+	if lm == nil {
+		return nil, fmt.Errorf("invalid instance")
+	}
+
+	lockfile := &Lockfile{
+		AdditionalURLs: viper.GetStringSlice("board_manager.additional_urls"),
+		Platforms:      []string{},
+		Tools:          []string{},
+		Libraries:      []string{},
+	}
+	for _, platform := range pm.InstalledPlatformReleases() {
+		lockfile.Platforms = append(lockfile.Platforms, platform.String())
+	}
+	for _, tool := range pm.GetAllInstalledToolsReleases() {
+		lockfile.Tools = append(lockfile.Tools, tool.String())
+	}
+	for _, alternatives := range lm.Libraries {
+		for _, library := range alternatives.Alternatives {
+			if library.Location != libraries.User || library.Version == nil || library.Version.String() == "" {
+				continue
+			}
+			lockfile.Libraries = append(lockfile.Libraries, library.Name+"@"+library.Version.String())
+		}
+	}
+	sort.Strings(lockfile.Platforms)
+	sort.Strings(lockfile.Tools)
+	sort.Strings(lockfile.Libraries)
+	return lockfile, nil
+}
+
+// Apply installs in the given instance exactly the versions of the platforms,
+// tools and libraries listed in the Lockfile. The indexes of the instance must
+// already include the additional URLs of the Lockfile.
+func Apply(ctx context.Context, instance *rpc.Instance, lockfile *Lockfile,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+
+	pm := commands.GetPackageManager(instance.GetId())
+	if pm == nil {
+		return fmt.Errorf("invalid instance")
+	}
+
+	for _, platform := range lockfile.Platforms {
+		packager, arch, version, err := parseItem(platform, true)
+		if err != nil {
+			return fmt.Errorf("invalid platform: %s", err)
+		}
+		_, err = core.PlatformInstall(ctx, &rpc.PlatformInstallReq{
+			Instance:        instance,
+			PlatformPackage: packager,
+			Architecture:    arch,
+			Version:         version,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return fmt.Errorf("installing platform %s: %s", platform, err)
+		}
+	}
+
+	// Tools are installed as dependencies of the platforms, install the ones
+	// that are still missing (e.g. other versions that were used by previous
+	// platforms releases)
+	toolsInstalled := false
+	for _, tool := range lockfile.Tools {
+		packager, name, version, err := parseItem(tool, true)
+		if err != nil {
+			return fmt.Errorf("invalid tool: %s", err)
+		}
+		targetPackage, ok := pm.Packages[packager]
+		if !ok {
+			return fmt.Errorf("installing tool %s: package %s not found", tool, packager)
+		}
+		t, ok := targetPackage.Tools[name]
+		if !ok {
+			return fmt.Errorf("installing tool %s: tool not found", tool)
+		}
+		toolRelease := t.FindReleaseWithRelaxedVersion(semver.ParseRelaxed(version))
+		if toolRelease == nil {
+			return fmt.Errorf("installing tool %s: version not found", tool)
+		}
+		if toolRelease.IsInstalled() {
+			continue
+		}
+		if err := commands.DownloadToolRelease(ctx, pm, toolRelease, downloadCB); err != nil {
+			return fmt.Errorf("downloading tool %s: %s", tool, err)
+		}
+		if err := commands.InstallToolRelease(pm, toolRelease, taskCB); err != nil {
+			return fmt.Errorf("installing tool %s: %s", tool, err)
+		}
+		toolsInstalled = true
+	}
+	if toolsInstalled {
+		if _, err := commands.Rescan(instance.GetId()); err != nil {
+			return err
+		}
+	}
+
+	// Dependencies are not resolved: the lockfile already lists all the
+	// libraries, each one with the exact version to install
+	for _, library := range lockfile.Libraries {
+		_, name, version, err := parseItem(library, false)
+		if err != nil {
+			return fmt.Errorf("invalid library: %s", err)
+		}
+		err = lib.LibraryInstall(ctx, &rpc.LibraryInstallReq{
+			Instance: instance,
+			Name:     name,
+			Version:  version,
+			NoDeps:   true,
+		}, downloadCB, taskCB)
+		if err != nil {
+			return fmt.Errorf("installing library %s: %s", library, err)
+		}
+	}
+	return nil
+}
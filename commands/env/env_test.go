@@ -0,0 +1,64 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package env
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLockfile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_lockfile")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	lockfile := &Lockfile{
+		AdditionalURLs: []string{"https://example.com/package_example_index.json"},
+		Platforms:      []string{"arduino:samd@1.8.9"},
+		Tools:          []string{"arduino:bossac@1.7.0-arduino3"},
+		Libraries:      []string{"Audio Zero@1.0.0"},
+	}
+	data, err := lockfile.Encode()
+	require.NoError(t, err)
+	file := tmp.Join("arduino-lock.yaml")
+	require.NoError(t, file.WriteFile(data))
+
+	loaded, err := LoadLockfile(file)
+	require.NoError(t, err)
+	require.Equal(t, lockfile, loaded)
+
+	// a library version may be a range
+	require.NoError(t, file.WriteFile([]byte("libraries:\n  - \"ArduinoJson@>=6.0 <7.0\"\n")))
+	loaded, err = LoadLockfile(file)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ArduinoJson@>=6.0 <7.0"}, loaded.Libraries)
+
+	invalid := map[string]string{
+		"platforms:\n  - arduino:samd\n":  "missing version",
+		"tools:\n  - bossac@1.7.0\n":      "PACKAGER:NAME@VERSION",
+		"libraries:\n  - AudioZero@\n":    "missing version",
+		"libraries:\n  - \"@1.0.0\"\n":    "missing name",
+		"platforms: arduino:samd@1.8.9\n": "decoding lockfile",
+	}
+	for content, msg := range invalid {
+		require.NoError(t, file.WriteFile([]byte(content)))
+		_, err := LoadLockfile(file)
+		require.Error(t, err, content)
+		require.Contains(t, err.Error(), msg, content)
+	}
+}
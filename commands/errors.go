@@ -0,0 +1,211 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorCategory groups the errors by the way a client is expected to react to
+// them.
+type ErrorCategory string
+
+// The error categories, each one maps to a gRPC status code and to an exit
+// code of the CLI.
+const (
+	CategoryGeneric            ErrorCategory = "generic"
+	CategoryInvalidArgument    ErrorCategory = "invalid_argument"
+	CategoryNotFound           ErrorCategory = "not_found"
+	CategoryFailedPrecondition ErrorCategory = "failed_precondition"
+	CategoryNetwork            ErrorCategory = "network"
+	CategoryToolFailure        ErrorCategory = "tool_failure"
+	CategoryCanceled           ErrorCategory = "canceled"
+)
+
+// Stable identifiers of the errors, they are sent to the gRPC clients in the
+// ErrorDetails of the status and must never be changed.
+const (
+	CodeInvalidInstance      = "INVALID_INSTANCE"
+	CodeInvalidArgument      = "INVALID_ARGUMENT"
+	CodeInvalidFQBN          = "INVALID_FQBN"
+	CodeMissingFQBN          = "MISSING_FQBN"
+	CodeInvalidVersion       = "INVALID_VERSION"
+	CodeMissingSketchPath    = "MISSING_SKETCH_PATH"
+	CodeMissingSecrets       = "MISSING_SECRETS"
+	CodeInvalidSketch        = "INVALID_SKETCH"
+	CodeMissingPort          = "MISSING_PORT"
+	CodePortNotFound         = "PORT_NOT_FOUND"
+	CodeBoardNotFound        = "BOARD_NOT_FOUND"
+	CodePlatformNotFound     = "PLATFORM_NOT_FOUND"
+	CodePlatformNotInstalled = "PLATFORM_NOT_INSTALLED"
+	CodePlatformInUse        = "PLATFORM_IN_USE"
+	CodeLibraryNotFound      = "LIBRARY_NOT_FOUND"
+	CodeLibraryNotInstalled  = "LIBRARY_NOT_INSTALLED"
+	CodeLibraryDependency    = "LIBRARY_DEPENDENCY_ERROR"
+	CodeToolNotFound         = "TOOL_NOT_FOUND"
+	CodeProgrammerNotFound   = "PROGRAMMER_NOT_FOUND"
+	CodeBuildArtifactMissing = "BUILD_ARTIFACT_NOT_FOUND"
+	CodeArchiveNotFound      = "ARCHIVE_NOT_FOUND"
+	CodeProfileNotFound      = "PROFILE_NOT_FOUND"
+	CodeSVDNotFound          = "SVD_NOT_FOUND"
+	CodeDebugSessionNotFound = "DEBUG_SESSION_NOT_FOUND"
+	CodeDownloadFailed       = "DOWNLOAD_FAILED"
+	CodeCompileFailed        = "COMPILE_FAILED"
+	CodeUploadFailed         = "UPLOAD_FAILED"
+	CodeDebugFailed          = "DEBUG_FAILED"
+	CodeInstallFailed        = "INSTALL_FAILED"
+	CodePermissionDenied     = "PERMISSION_DENIED"
+)
+
+// Error is an error with a stable code and a category, it's returned by the
+// commands to let the CLI and the gRPC clients react to the failures without
+// parsing the messages.
+type Error struct {
+	Code     string
+	Category ErrorCategory
+	Message  string
+	Cause    error
+}
+
+func (e *Error) Error() string {
+	if e.Cause == nil {
+		return e.Message
+	}
+	return e.Message + ": " + e.Cause.Error()
+}
+
+// Unwrap returns the error that caused e, if any.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// GRPCStatus converts the error to a gRPC status, with the code and the
+// category of the error attached as ErrorDetails.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.rpcStatus(e.Error())
+}
+
+func (e *Error) rpcStatus(message string) *status.Status {
+	st := status.New(e.Category.RPCCode(), message)
+	if withDetails, err := st.WithDetails(&rpc.ErrorDetails{Code: e.Code, Category: string(e.Category)}); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// RPCCode returns the gRPC status code matching the category.
+func (c ErrorCategory) RPCCode() codes.Code {
+	switch c {
+	case CategoryInvalidArgument:
+		return codes.InvalidArgument
+	case CategoryNotFound:
+		return codes.NotFound
+	case CategoryFailedPrecondition:
+		return codes.FailedPrecondition
+	case CategoryNetwork:
+		return codes.Unavailable
+	case CategoryToolFailure:
+		return codes.Aborted
+	case CategoryCanceled:
+		return codes.Canceled
+	default:
+		return codes.Unknown
+	}
+}
+
+func newError(category ErrorCategory, code string, cause error, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:     code,
+		Category: category,
+		Message:  fmt.Sprintf(format, args...),
+		Cause:    cause,
+	}
+}
+
+// InvalidArgumentError returns an error for a request that can't be fulfilled
+// as it is, cause may be nil.
+func InvalidArgumentError(code string, cause error, format string, args ...interface{}) *Error {
+	return newError(CategoryInvalidArgument, code, cause, format, args...)
+}
+
+// NotFoundError returns an error for a resource (platform, library, board...)
+// that doesn't exist, cause may be nil.
+func NotFoundError(code string, cause error, format string, args ...interface{}) *Error {
+	return newError(CategoryNotFound, code, cause, format, args...)
+}
+
+// FailedPreconditionError returns an error for a request that is valid but
+// can't be fulfilled in the current state, for example because a platform is
+// not installed, cause may be nil.
+func FailedPreconditionError(code string, cause error, format string, args ...interface{}) *Error {
+	return newError(CategoryFailedPrecondition, code, cause, format, args...)
+}
+
+// NetworkError returns an error for a failed download, cause may be nil.
+func NetworkError(code string, cause error, format string, args ...interface{}) *Error {
+	return newError(CategoryNetwork, code, cause, format, args...)
+}
+
+// ToolFailureError returns an error for an external tool (compiler, uploader,
+// debugger...) that failed, cause may be nil.
+func ToolFailureError(code string, cause error, format string, args ...interface{}) *Error {
+	return newError(CategoryToolFailure, code, cause, format, args...)
+}
+
+// ErrInvalidInstance is returned when the instance of the request doesn't
+// exist.
+var ErrInvalidInstance = InvalidArgumentError(CodeInvalidInstance, nil, "invalid instance")
+
+// GetErrorCategory returns the category of err, errors not created through
+// this package belong to the generic category.
+func GetErrorCategory(err error) ErrorCategory {
+	var e *Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &e):
+		return e.Category
+	case errors.Is(err, context.Canceled):
+		return CategoryCanceled
+	default:
+		return CategoryGeneric
+	}
+}
+
+// ErrorToRPCStatus converts err to a gRPC status: errors created through this
+// package keep their details, errors that are already a status are returned
+// unchanged and the others become an Unknown status.
+func ErrorToRPCStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		// keep the message of the whole chain, the error may have been
+		// wrapped with more context
+		return e.rpcStatus(err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.New(codes.Canceled, err.Error())
+	}
+	return status.New(codes.Unknown, err.Error())
+}
@@ -0,0 +1,59 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError(t *testing.T) {
+	cause := errors.New("no such file")
+	err := NotFoundError(CodeLibraryNotFound, cause, "library %s not found", "Foo")
+	require.Equal(t, "library Foo not found: no such file", err.Error())
+	require.True(t, errors.Is(err, cause))
+	require.Equal(t, "library Foo not found", NotFoundError(CodeLibraryNotFound, nil, "library %s not found", "Foo").Error())
+
+	wrapped := fmt.Errorf("installing: %w", err)
+	require.Equal(t, CategoryNotFound, GetErrorCategory(wrapped))
+	require.Equal(t, CategoryGeneric, GetErrorCategory(errors.New("generic")))
+	require.Equal(t, CategoryCanceled, GetErrorCategory(fmt.Errorf("downloading: %w", context.Canceled)))
+	require.Equal(t, ErrorCategory(""), GetErrorCategory(nil))
+}
+
+func TestErrorToRPCStatus(t *testing.T) {
+	err := fmt.Errorf("installing: %w", FailedPreconditionError(CodePlatformNotInstalled, nil, "platform not installed"))
+	st := ErrorToRPCStatus(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Equal(t, "installing: platform not installed", st.Message())
+	require.Len(t, st.Details(), 1)
+	details, ok := st.Details()[0].(*rpc.ErrorDetails)
+	require.True(t, ok)
+	require.Equal(t, CodePlatformNotInstalled, details.GetCode())
+	require.Equal(t, "failed_precondition", details.GetCategory())
+
+	require.Equal(t, codes.Unknown, ErrorToRPCStatus(errors.New("generic")).Code())
+	require.Equal(t, codes.Canceled, ErrorToRPCStatus(context.Canceled).Code())
+	// statuses are returned unchanged
+	require.Equal(t, codes.Unauthenticated, ErrorToRPCStatus(status.Error(codes.Unauthenticated, "no token")).Code())
+}
@@ -0,0 +1,138 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/arduino/arduino-cli/tracing"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// indexCache holds the validators sent by the server together with an index,
+// it's stored next to the index to avoid downloading it again when unchanged
+type indexCache struct {
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	CheckedAt    time.Time `json:"checked_at"`
+}
+
+// indexCachePath returns the path of the cache file of the given index
+func indexCachePath(index *paths.Path) *paths.Path {
+	return index.Parent().Join(index.Base() + ".cache")
+}
+
+// loadIndexCache returns the cache of the given index, an empty one if it's
+// missing or invalid
+func loadIndexCache(index *paths.Path) *indexCache {
+	cache := &indexCache{}
+	if data, err := indexCachePath(index).ReadFile(); err == nil {
+		if err := json.Unmarshal(data, cache); err != nil {
+			return &indexCache{}
+		}
+	}
+	return cache
+}
+
+// save stores the cache of the given index, marking it as checked now
+func (c *indexCache) save(index *paths.Path) error {
+	c.CheckedAt = time.Now()
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return indexCachePath(index).WriteFile(data)
+}
+
+// isFresh returns true if the index exists and has been checked less than
+// maxAge ago
+func (c *indexCache) isFresh(index *paths.Path, maxAge time.Duration, now time.Time) bool {
+	if maxAge <= 0 || !index.Exist() {
+		return false
+	}
+	return !now.Before(c.CheckedAt) && now.Sub(c.CheckedAt) < maxAge
+}
+
+// isModified asks the server, with a conditional HEAD request, if the index
+// at url has been modified since it has been downloaded. If the server can't
+// tell, the index is considered modified. The validators of the remote index
+// are stored in the cache, to be saved once the index is downloaded.
+func (c *indexCache) isModified(ctx context.Context, client *http.Client, url string, index *paths.Path) bool {
+	log := tracing.Logger(ctx).WithField("url", url)
+	req, err := http.NewRequest("HEAD", url, nil)
+	if err != nil {
+		return true
+	}
+	req = req.WithContext(ctx)
+	if index.Exist() {
+		if c.ETag != "" {
+			req.Header.Set("If-None-Match", c.ETag)
+		}
+		if c.LastModified != "" {
+			req.Header.Set("If-Modified-Since", c.LastModified)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.WithError(err).Info("Cannot check if the index has been modified")
+		return true
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		log.Info("Index not modified")
+		return false
+	}
+	if resp.StatusCode == http.StatusOK {
+		c.ETag = resp.Header.Get("ETag")
+		c.LastModified = resp.Header.Get("Last-Modified")
+	}
+	return true
+}
+
+// indexNeedsUpdate returns true if the index at url must be downloaded again:
+// indexes checked less than maxAge ago and indexes that the server reports as
+// not modified are kept. The returned cache must be saved once the index has
+// been downloaded.
+func indexNeedsUpdate(ctx context.Context, client *http.Client, url string, index *paths.Path, maxAge time.Duration) (bool, *indexCache) {
+	cache := loadIndexCache(index)
+	if cache.isFresh(index, maxAge, time.Now()) {
+		tracing.Logger(ctx).WithField("url", url).Info("Index recently checked, skipping update")
+		return false, cache
+	}
+	if cache.isModified(ctx, client, url, index) {
+		return true, cache
+	}
+	if err := cache.save(index); err != nil {
+		tracing.Logger(ctx).WithError(err).Warn("Cannot save index cache")
+	}
+	return false, cache
+}
+
+// parseIndexMaxAge parses the if_older_than field of the index update
+// requests, an empty value means that the indexes are always checked
+func parseIndexMaxAge(ifOlderThan string) (time.Duration, error) {
+	if ifOlderThan == "" {
+		return 0, nil
+	}
+	maxAge, err := time.ParseDuration(ifOlderThan)
+	if err != nil || maxAge < 0 {
+		return 0, InvalidArgumentError(CodeInvalidArgument, err, "invalid index age '%s'", ifOlderThan)
+	}
+	return maxAge, nil
+}
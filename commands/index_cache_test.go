@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexNeedsUpdate(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+	}))
+	defer ts.Close()
+
+	tmp, err := paths.MkTempDir("", "test_index_cache")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	index := tmp.Join("package_index.json")
+
+	// the index is missing
+	needsUpdate, cache := indexNeedsUpdate(context.Background(), http.DefaultClient, ts.URL, index, time.Hour)
+	require.True(t, needsUpdate)
+	require.Equal(t, `"v1"`, cache.ETag)
+	require.Equal(t, "Mon, 02 Jan 2006 15:04:05 GMT", cache.LastModified)
+	require.NoError(t, index.WriteFile([]byte("{}")))
+	require.NoError(t, cache.save(index))
+	require.Equal(t, 1, requests)
+
+	// the index has just been checked
+	needsUpdate, _ = indexNeedsUpdate(context.Background(), http.DefaultClient, ts.URL, index, time.Hour)
+	require.False(t, needsUpdate)
+	require.Equal(t, 1, requests)
+
+	// the server reports that the index is not modified
+	needsUpdate, _ = indexNeedsUpdate(context.Background(), http.DefaultClient, ts.URL, index, 0)
+	require.False(t, needsUpdate)
+	require.Equal(t, 2, requests)
+
+	// the index changed on the server
+	cache = loadIndexCache(index)
+	cache.ETag = `"v0"`
+	require.NoError(t, cache.save(index))
+	needsUpdate, _ = indexNeedsUpdate(context.Background(), http.DefaultClient, ts.URL, index, 0)
+	require.True(t, needsUpdate)
+	require.Equal(t, 3, requests)
+}
+
+func TestParseIndexMaxAge(t *testing.T) {
+	maxAge, err := parseIndexMaxAge("")
+	require.NoError(t, err)
+	require.Zero(t, maxAge)
+	maxAge, err = parseIndexMaxAge("2h")
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, maxAge)
+	_, err = parseIndexMaxAge("soon")
+	require.Error(t, err)
+	require.Equal(t, CategoryInvalidArgument, GetErrorCategory(err))
+}
@@ -19,9 +19,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
 	"net/url"
+	"os"
 	"path"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
@@ -33,17 +39,27 @@ import (
 	"github.com/arduino/arduino-cli/arduino/security"
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/arduino/arduino-cli/configuration"
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"go.bug.st/downloader/v2"
+	"golang.org/x/crypto/openpgp"
 )
 
+var tr = i18n.Tr
+
 // this map contains all the running Arduino Core Services instances
 // referenced by an int32 handle
 var instances = map[int32]*CoreInstance{}
 var instancesCount int32 = 1
+var instancesMux sync.RWMutex
+
+// SharedInstanceID is the handle of the instance shared by all the clients
+// that don't create their own with Init. It is created on first use and
+// kept up to date by the Rescan and UpdateIndex calls.
+const SharedInstanceID int32 = 0
 
 // CoreInstance is an instance of the Arduino Core Services. The user can
 // instantiate as many as needed by providing a different configuration
@@ -54,6 +70,10 @@ type CoreInstance struct {
 	getLibOnly     bool
 }
 
+// sharedInstanceMux serializes the creation of the shared instance, so that
+// concurrent requests don't scan platforms and libraries more than once
+var sharedInstanceMux sync.Mutex
+
 // InstanceContainer FIXMEDOC
 type InstanceContainer interface {
 	GetInstance() *rpc.Instance
@@ -69,34 +89,81 @@ type createInstanceResult struct {
 // GetInstance returns a CoreInstance for the given ID, or nil if ID
 // doesn't exist
 func GetInstance(id int32) *CoreInstance {
-	return instances[id]
+	instancesMux.RLock()
+	i, ok := instances[id]
+	instancesMux.RUnlock()
+	if !ok && id == SharedInstanceID {
+		var err error
+		if i, err = getSharedInstance(); err != nil {
+			logrus.Errorf("Error initializing shared instance: %s", err)
+			return nil
+		}
+	}
+	return i
+}
+
+// getSharedInstance returns the shared instance, creating it if needed
+func getSharedInstance() (*CoreInstance, error) {
+	sharedInstanceMux.Lock()
+	defer sharedInstanceMux.Unlock()
+
+	instancesMux.RLock()
+	i, ok := instances[SharedInstanceID]
+	instancesMux.RUnlock()
+	if ok {
+		return i, nil
+	}
+
+	logrus.Info("Initializing shared instance")
+	res, err := createInstance(context.Background(), false)
+	if err != nil {
+		return nil, err
+	}
+	i = &CoreInstance{
+		PackageManager: res.Pm,
+		lm:             res.Lm,
+	}
+	noDownloadCB := func(*rpc.DownloadProgress) {}
+	noTaskCB := func(*rpc.TaskProgress) {}
+	if err := i.checkForBuiltinTools(context.Background(), noDownloadCB, noTaskCB); err != nil {
+		return nil, err
+	}
+
+	instancesMux.Lock()
+	instances[SharedInstanceID] = i
+	instancesMux.Unlock()
+	return i, nil
 }
 
 // GetPackageManager returns a PackageManager for the given ID, or nil if
 // ID doesn't exist
 func GetPackageManager(id int32) *packagemanager.PackageManager {
-	i, ok := instances[id]
-	if !ok {
+	i := GetInstance(id)
+	if i == nil {
 		return nil
 	}
+	instancesMux.RLock()
+	defer instancesMux.RUnlock()
 	return i.PackageManager
 }
 
 // GetLibraryManager returns the library manager for the given instance ID
 func GetLibraryManager(instanceID int32) *librariesmanager.LibrariesManager {
-	i, ok := instances[instanceID]
-	if !ok {
+	i := GetInstance(instanceID)
+	if i == nil {
 		return nil
 	}
+	instancesMux.RLock()
+	defer instancesMux.RUnlock()
 	return i.lm
 }
 
-func (instance *CoreInstance) installToolIfMissing(tool *cores.ToolRelease, downloadCB DownloadProgressCB, taskCB TaskProgressCB) (bool, error) {
+func (instance *CoreInstance) installToolIfMissing(ctx context.Context, tool *cores.ToolRelease, downloadCB DownloadProgressCB, taskCB TaskProgressCB) (bool, error) {
 	if tool.IsInstalled() {
 		return false, nil
 	}
-	taskCB(&rpc.TaskProgress{Name: "Downloading missing tool " + tool.String()})
-	if err := DownloadToolRelease(instance.PackageManager, tool, downloadCB); err != nil {
+	taskCB(&rpc.TaskProgress{Name: tr("Downloading missing tool %s", tool.String())})
+	if err := DownloadToolRelease(ctx, instance.PackageManager, tool, downloadCB); err != nil {
 		return false, fmt.Errorf("downloading %s tool: %s", tool, err)
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
@@ -106,17 +173,17 @@ func (instance *CoreInstance) installToolIfMissing(tool *cores.ToolRelease, down
 	return true, nil
 }
 
-func (instance *CoreInstance) checkForBuiltinTools(downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+func (instance *CoreInstance) checkForBuiltinTools(ctx context.Context, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
 	// Check for ctags tool
 	ctags, _ := getBuiltinCtagsTool(instance.PackageManager)
-	ctagsInstalled, err := instance.installToolIfMissing(ctags, downloadCB, taskCB)
+	ctagsInstalled, err := instance.installToolIfMissing(ctx, ctags, downloadCB, taskCB)
 	if err != nil {
 		return err
 	}
 
 	// Check for bultin serial-discovery tool
 	serialDiscoveryTool, _ := getBuiltinSerialDiscoveryTool(instance.PackageManager)
-	serialDiscoveryInstalled, err := instance.installToolIfMissing(serialDiscoveryTool, downloadCB, taskCB)
+	serialDiscoveryInstalled, err := instance.installToolIfMissing(ctx, serialDiscoveryTool, downloadCB, taskCB)
 	if err != nil {
 		return err
 	}
@@ -141,11 +208,13 @@ func Init(ctx context.Context, req *rpc.InitReq, downloadCB DownloadProgressCB,
 		lm:             res.Lm,
 		getLibOnly:     req.GetLibraryManagerOnly(),
 	}
+	instancesMux.Lock()
 	handle := instancesCount
 	instancesCount++
 	instances[handle] = instance
+	instancesMux.Unlock()
 
-	if err := instance.checkForBuiltinTools(downloadCB, taskCB); err != nil {
+	if err := instance.checkForBuiltinTools(ctx, downloadCB, taskCB); err != nil {
 		return nil, err
 	}
 
@@ -159,10 +228,15 @@ func Init(ctx context.Context, req *rpc.InitReq, downloadCB DownloadProgressCB,
 // Destroy FIXMEDOC
 func Destroy(ctx context.Context, req *rpc.DestroyReq) (*rpc.DestroyResp, error) {
 	id := req.GetInstance().GetId()
+	if id == SharedInstanceID {
+		return nil, fmt.Errorf("the shared instance can't be destroyed")
+	}
+
+	instancesMux.Lock()
+	defer instancesMux.Unlock()
 	if _, ok := instances[id]; !ok {
 		return nil, fmt.Errorf("invalid handle")
 	}
-
 	delete(instances, id)
 	return &rpc.DestroyResp{}, nil
 }
@@ -174,17 +248,16 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexReq,
 	if lm == nil {
 		return fmt.Errorf("invalid handle")
 	}
-	config, err := GetDownloaderConfig()
+	maxAge, err := parseIndexMaxAge(req.GetIfOlderThan())
 	if err != nil {
 		return err
 	}
-	d, err := lm.UpdateIndex(config)
-	if err != nil {
-		return err
+	if IsOffline() {
+		return errors.New("can't update the libraries index in offline mode")
 	}
-	Download(d, "Updating index: library_index.json", downloadCB)
-	if d.Error() != nil {
-		return d.Error()
+	updated, err := updateLibrariesIndex(ctx, lm, maxAge, downloadCB)
+	if err != nil || !updated {
+		return err
 	}
 	if _, err := Rescan(req.GetInstance().GetId()); err != nil {
 		return fmt.Errorf("rescanning filesystem: %s", err)
@@ -192,15 +265,110 @@ func UpdateLibrariesIndex(ctx context.Context, req *rpc.UpdateLibrariesIndexReq,
 	return nil
 }
 
+// updateLibrariesIndex downloads the library_index.json unless it's fresher
+// than maxAge or unchanged on the server, it returns true if the index has
+// been downloaded
+func updateLibrariesIndex(ctx context.Context, lm *librariesmanager.LibrariesManager, maxAge time.Duration, downloadCB DownloadProgressCB) (bool, error) {
+	config, err := GetDownloaderConfig()
+	if err != nil {
+		return false, err
+	}
+	updated, err := updateLibrariesIndexFile(ctx, config, librariesmanager.LibraryIndexURL, lm.IndexFile, maxAge, downloadCB,
+		func() (*downloader.Downloader, error) { return lm.UpdateIndex(config) })
+	if err != nil {
+		return false, err
+	}
+	for _, URL := range lm.AdditionalIndexURLs {
+		if URL.Scheme == "file" {
+			// Local indexes are always read from their path
+			continue
+		}
+		URL := URL
+		additionalUpdated, err := updateLibrariesIndexFile(ctx, config, URL, lm.AdditionalIndexFile(URL), maxAge, downloadCB,
+			func() (*downloader.Downloader, error) { return lm.UpdateAdditionalIndex(URL, config) })
+		if err != nil {
+			return false, fmt.Errorf("updating library index %s: %w", URL, err)
+		}
+		updated = updated || additionalUpdated
+	}
+	return updated, nil
+}
+
+// updateLibrariesIndexFile runs download to update the library index at URL
+// saved in indexFile, unless it's fresher than maxAge or unchanged on the
+// server. It returns true if the index has been downloaded.
+func updateLibrariesIndexFile(ctx context.Context, config *downloader.Config, URL *url.URL, indexFile *paths.Path, maxAge time.Duration,
+	downloadCB DownloadProgressCB, download func() (*downloader.Downloader, error)) (bool, error) {
+	label := "Updating index: " + indexFile.Base()
+	needsUpdate, cache := indexNeedsUpdate(ctx, &config.HttpClient, URL.String(), indexFile, maxAge)
+	if !needsUpdate {
+		return false, Download(ctx, nil, label, downloadCB)
+	}
+	lock, err := lockDataDir()
+	if err != nil {
+		return false, err
+	}
+	defer lock.Release()
+	d, err := download()
+	if err != nil {
+		return false, err
+	}
+	if err := Download(ctx, d, label, downloadCB); err != nil {
+		return false, err
+	}
+	if err := cache.save(indexFile); err != nil {
+		logrus.WithError(err).Warn("Cannot save index cache")
+	}
+	return true, nil
+}
+
+// additionalLibraryIndexURLs returns the URLs of the library indexes set in
+// library_manager.additional_urls
+func additionalLibraryIndexURLs() []*url.URL {
+	urls := []*url.URL{}
+	for _, u := range viper.GetStringSlice("library_manager.additional_urls") {
+		URL, err := url.Parse(u)
+		if err != nil {
+			logrus.Warnf("unable to parse additional library index URL: %s", u)
+			continue
+		}
+		urls = append(urls, URL)
+	}
+	return urls
+}
+
 // UpdateIndex FIXMEDOC
 func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexReq, downloadCB DownloadProgressCB) (*rpc.UpdateIndexResp, error) {
 	id := req.GetInstance().GetId()
-	_, ok := instances[id]
-	if !ok {
+	if GetInstance(id) == nil {
 		return nil, fmt.Errorf("invalid handle")
 	}
+	maxAge, err := parseIndexMaxAge(req.GetIfOlderThan())
+	if err != nil {
+		return nil, err
+	}
+	if IsOffline() {
+		return nil, errors.New("can't update the platforms index in offline mode")
+	}
 
+	signatures, updated, err := updatePlatformIndexes(ctx, maxAge, downloadCB)
+	if err != nil {
+		return nil, err
+	}
+	if updated {
+		if _, err := Rescan(id); err != nil {
+			return nil, fmt.Errorf("rescanning filesystem: %s", err)
+		}
+	}
+	return &rpc.UpdateIndexResp{Signatures: signatures}, nil
+}
+
+// updatePlatformIndexes updates the default platforms index and the
+// additional ones, it returns true if any index has been downloaded
+func updatePlatformIndexes(ctx context.Context, maxAge time.Duration, downloadCB DownloadProgressCB) ([]*rpc.IndexSignature, bool, error) {
 	indexpath := paths.New(viper.GetString("directories.Data"))
+	signatures := []*rpc.IndexSignature{}
+	updated := false
 	urls := []string{globals.DefaultIndexURL}
 	urls = append(urls, viper.GetStringSlice("board_manager.additional_urls")...)
 	for _, u := range urls {
@@ -209,93 +377,185 @@ func UpdateIndex(ctx context.Context, req *rpc.UpdateIndexReq, downloadCB Downlo
 			logrus.Warnf("unable to parse additional URL: %s", u)
 			continue
 		}
+		signature, downloaded, err := updatePlatformIndex(ctx, URL, indexpath, maxAge, downloadCB)
+		if err != nil {
+			return nil, false, err
+		}
+		signatures = append(signatures, signature)
+		updated = updated || downloaded
+	}
+	return signatures, updated, nil
+}
 
-		logrus.WithField("url", URL).Print("Updating index")
+// updatePlatformIndex downloads the index at URL in indexpath, verifying its
+// signature, unless it's fresher than maxAge or unchanged on the server. It
+// returns true if the index has been downloaded.
+func updatePlatformIndex(ctx context.Context, URL *url.URL, indexpath *paths.Path, maxAge time.Duration, downloadCB DownloadProgressCB) (*rpc.IndexSignature, bool, error) {
+	logrus.WithField("url", URL).Print("Updating index")
 
-		var tmp *paths.Path
-		if tmpFile, err := ioutil.TempFile("", ""); err != nil {
-			return nil, fmt.Errorf("creating temp file for index download: %s", err)
-		} else if err := tmpFile.Close(); err != nil {
-			return nil, fmt.Errorf("creating temp file for index download: %s", err)
-		} else {
-			tmp = paths.New(tmpFile.Name())
-		}
-		defer tmp.Remove()
+	config, err := GetDownloaderConfig()
+	if err != nil {
+		return nil, false, fmt.Errorf("downloading index %s: %s", URL, err)
+	}
 
-		config, err := GetDownloaderConfig()
-		if err != nil {
-			return nil, fmt.Errorf("downloading index %s: %s", URL, err)
-		}
-		d, err := downloader.DownloadWithConfig(tmp.String(), URL.String(), *config)
-		if err != nil {
-			return nil, fmt.Errorf("downloading index %s: %s", URL, err)
+	// Check for signature: indexes hosted by Arduino must be signed, for the
+	// others the signature is verified only if available
+	signatureRequired := URL.Hostname() == "downloads.arduino.cc"
+	URLSig, err := url.Parse(URL.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing url for index signature check: %s", err)
+	}
+	URLSig.Path += ".sig"
+	coreIndexPath := indexpath.Join(path.Base(URL.Path))
+	coreIndexSigPath := indexpath.Join(path.Base(URLSig.Path))
+	trustedKeys := paths.NewPathList(viper.GetStringSlice("board_manager.trusted_keys")...)
+
+	needsUpdate, cache := indexNeedsUpdate(ctx, &config.HttpClient, URL.String(), coreIndexPath, maxAge)
+	if !needsUpdate {
+		// The signature of the index already downloaded is still reported
+		signature := &rpc.IndexSignature{Url: URL.String()}
+		if coreIndexSigPath.Exist() {
+			if valid, signer, err := security.VerifyDetachedSignature(coreIndexPath, coreIndexSigPath, trustedKeys); err == nil && valid {
+				signature.Verified = true
+				signature.Signer = signerIdentity(signer)
+			}
 		}
-		coreIndexPath := indexpath.Join(path.Base(URL.Path))
-		Download(d, "Updating index: "+coreIndexPath.Base(), downloadCB)
-		if d.Error() != nil {
-			return nil, fmt.Errorf("downloading index %s: %s", URL, d.Error())
+		if signature.Verified || !signatureRequired {
+			return signature, false, Download(ctx, nil, "Updating index: "+coreIndexPath.Base(), downloadCB)
 		}
+		logrus.WithField("url", URL).Info("Index not signed, downloading it again")
+	}
 
-		// Check for signature
-		var tmpSig *paths.Path
-		var coreIndexSigPath *paths.Path
-		if URL.Hostname() == "downloads.arduino.cc" {
-			URLSig, err := url.Parse(URL.String())
-			if err != nil {
-				return nil, fmt.Errorf("parsing url for index signature check: %s", err)
-			}
-			URLSig.Path += ".sig"
-
-			if t, err := ioutil.TempFile("", ""); err != nil {
-				return nil, fmt.Errorf("creating temp file for index signature download: %s", err)
-			} else if err := t.Close(); err != nil {
-				return nil, fmt.Errorf("creating temp file for index signature download: %s", err)
-			} else {
-				tmpSig = paths.New(t.Name())
-			}
-			defer tmpSig.Remove()
+	var tmp *paths.Path
+	if tmpFile, err := ioutil.TempFile("", ""); err != nil {
+		return nil, false, fmt.Errorf("creating temp file for index download: %s", err)
+	} else if err := tmpFile.Close(); err != nil {
+		return nil, false, fmt.Errorf("creating temp file for index download: %s", err)
+	} else {
+		tmp = paths.New(tmpFile.Name())
+	}
+	defer tmp.Remove()
 
-			d, err := downloader.DownloadWithConfig(tmpSig.String(), URLSig.String(), *config)
-			if err != nil {
-				return nil, fmt.Errorf("downloading index signature %s: %s", URLSig, err)
-			}
+	d, err := downloader.DownloadWithConfig(tmp.String(), URL.String(), *config)
+	if err != nil {
+		return nil, false, fmt.Errorf("downloading index %s: %s", URL, err)
+	}
+	if err := Download(ctx, d, "Updating index: "+coreIndexPath.Base(), downloadCB); err != nil {
+		return nil, false, fmt.Errorf("downloading index %s: %w", URL, err)
+	}
 
-			coreIndexSigPath = indexpath.Join(path.Base(URLSig.Path))
-			Download(d, "Updating index: "+coreIndexSigPath.Base(), downloadCB)
-			if d.Error() != nil {
-				return nil, fmt.Errorf("downloading index signature %s: %s", URL, d.Error())
-			}
+	var tmpSig *paths.Path
+	if t, err := ioutil.TempFile("", ""); err != nil {
+		return nil, false, fmt.Errorf("creating temp file for index signature download: %s", err)
+	} else if err := t.Close(); err != nil {
+		return nil, false, fmt.Errorf("creating temp file for index signature download: %s", err)
+	} else {
+		tmpSig = paths.New(t.Name())
+	}
+	defer tmpSig.Remove()
 
-			valid, _, err := security.VerifyArduinoDetachedSignature(tmp, tmpSig)
-			if err != nil {
-				return nil, fmt.Errorf("signature verification error: %s", err)
-			}
-			if !valid {
-				return nil, fmt.Errorf("index has an invalid signature")
-			}
+	signature := &rpc.IndexSignature{Url: URL.String()}
+	if err := downloadIndexSignature(ctx, &config.HttpClient, URLSig.String(), tmpSig, "Updating index: "+coreIndexSigPath.Base(), downloadCB); err != nil {
+		if signatureRequired || ctx.Err() != nil {
+			return nil, false, fmt.Errorf("downloading index signature %s: %w", URLSig, err)
 		}
-
-		if _, err := packageindex.LoadIndex(tmp); err != nil {
-			return nil, fmt.Errorf("invalid package index in %s: %s", URL, err)
+		logrus.WithField("url", URLSig).WithError(err).Info("Index signature not available")
+		tmpSig = nil
+	} else {
+		valid, signer, err := security.VerifyDetachedSignature(tmp, tmpSig, trustedKeys)
+		if err != nil {
+			return nil, false, fmt.Errorf("signature verification error: %s", err)
 		}
-
-		if err := indexpath.MkdirAll(); err != nil {
-			return nil, fmt.Errorf("can't create data directory %s: %s", indexpath, err)
+		if !valid {
+			return nil, false, fmt.Errorf("index has an invalid signature")
 		}
+		signature.Verified = true
+		signature.Signer = signerIdentity(signer)
+	}
 
-		if err := tmp.CopyTo(coreIndexPath); err != nil {
-			return nil, fmt.Errorf("saving downloaded index %s: %s", URL, err)
+	if _, err := packageindex.LoadIndex(tmp); err != nil {
+		return nil, false, fmt.Errorf("invalid package index in %s: %s", URL, err)
+	}
+
+	if err := indexpath.MkdirAll(); err != nil {
+		return nil, false, fmt.Errorf("can't create data directory %s: %s", indexpath, err)
+	}
+	lock, err := lockDataDir()
+	if err != nil {
+		return nil, false, err
+	}
+	defer lock.Release()
+
+	if err := tmp.CopyTo(coreIndexPath); err != nil {
+		return nil, false, fmt.Errorf("saving downloaded index %s: %s", URL, err)
+	}
+	if tmpSig != nil {
+		if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
+			return nil, false, fmt.Errorf("saving downloaded index signature: %s", err)
 		}
-		if tmpSig != nil {
-			if err := tmpSig.CopyTo(coreIndexSigPath); err != nil {
-				return nil, fmt.Errorf("saving downloaded index signature: %s", err)
-			}
+	} else if coreIndexSigPath.Exist() {
+		// Remove the stale signature of a previous download
+		if err := coreIndexSigPath.Remove(); err != nil {
+			return nil, false, fmt.Errorf("removing stale index signature: %s", err)
 		}
 	}
-	if _, err := Rescan(id); err != nil {
-		return nil, fmt.Errorf("rescanning filesystem: %s", err)
+	if err := cache.save(coreIndexPath); err != nil {
+		logrus.WithError(err).Warn("Cannot save index cache")
 	}
-	return &rpc.UpdateIndexResp{}, nil
+	return signature, true, nil
+}
+
+// downloadIndexSignature downloads the index signature at url in dest. The
+// downloader saves whatever the server replies, so the signature is fetched
+// with a plain GET to tell a missing signature from the error page of the
+// server: any status other than 2xx is an error.
+func downloadIndexSignature(ctx context.Context, client *http.Client, url string, dest *paths.Path, label string, downloadCB DownloadProgressCB) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("server replied %s", resp.Status)
+	}
+
+	downloadCB(&rpc.DownloadProgress{
+		File:      label,
+		Url:       url,
+		TotalSize: resp.ContentLength,
+	})
+	out, err := os.Create(dest.String())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	downloaded, err := io.Copy(out, resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	downloadCB(&rpc.DownloadProgress{Downloaded: downloaded})
+	downloadCB(&rpc.DownloadProgress{Completed: true})
+	return nil
+}
+
+// signerIdentity returns a human readable identity of the PGP entity
+func signerIdentity(signer *openpgp.Entity) string {
+	names := []string{}
+	for name := range signer.Identities {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return signer.PrimaryKey.KeyIdString()
+	}
+	sort.Strings(names)
+	return names[0]
 }
 
 // UpdateCoreLibrariesIndex updates both Cores and Libraries indexes
@@ -317,6 +577,44 @@ func UpdateCoreLibrariesIndex(ctx context.Context, req *rpc.UpdateCoreLibrariesI
 	return nil
 }
 
+// RefreshIndexes updates the platforms and libraries indexes checked more
+// than maxAge ago, rescanning all the instances if any index changed. It's
+// used by the daemon to keep the indexes up to date.
+func RefreshIndexes(ctx context.Context, maxAge time.Duration) error {
+	if IsOffline() {
+		return nil
+	}
+	ignoreProgress := func(*rpc.DownloadProgress) {}
+	_, platformsUpdated, err := updatePlatformIndexes(ctx, maxAge, ignoreProgress)
+	if err != nil {
+		return err
+	}
+	lm := librariesmanager.NewLibraryManager(
+		paths.New(viper.GetString("directories.Data")),
+		paths.New(viper.GetString("directories.Downloads")))
+	lm.AdditionalIndexURLs = additionalLibraryIndexURLs()
+	librariesUpdated, err := updateLibrariesIndex(ctx, lm, maxAge, ignoreProgress)
+	if err != nil {
+		return err
+	}
+	if !platformsUpdated && !librariesUpdated {
+		return nil
+	}
+
+	instancesMux.RLock()
+	ids := []int32{}
+	for id := range instances {
+		ids = append(ids, id)
+	}
+	instancesMux.RUnlock()
+	for _, id := range ids {
+		if _, err := Rescan(id); err != nil {
+			logrus.WithError(err).WithField("instance", id).Warn("Error rescanning instance after index update")
+		}
+	}
+	return nil
+}
+
 // Outdated returns a list struct containing both Core and Libraries that can be updated
 func Outdated(ctx context.Context, req *rpc.OutdatedReq) (*rpc.OutdatedResp, error) {
 	id := req.GetInstance().GetId()
@@ -352,7 +650,7 @@ func Outdated(ctx context.Context, req *rpc.OutdatedReq) (*rpc.OutdatedResp, err
 	outdatedPlatforms := []*rpc.Platform{}
 	for _, targetPackage := range packageManager.Packages {
 		for _, installed := range targetPackage.Platforms {
-			if installedRelease := packageManager.GetInstalledPlatformRelease(installed); installedRelease != nil {
+			if installedRelease := packageManager.GetInstalledManagedPlatformRelease(installed); installedRelease != nil {
 				latest := installed.GetLatestRelease()
 				if latest == nil || latest == installedRelease {
 					continue
@@ -458,32 +756,32 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 			}
 
 			// Downloads latest library release
-			taskCB(&rpc.TaskProgress{Name: "Downloading " + available.String()})
+			taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", available.String())})
 			if d, err := available.Resource.Download(lm.DownloadsDir, downloaderConfig); err != nil {
 				return err
-			} else if err := Download(d, available.String(), downloadCB); err != nil {
+			} else if err := Download(ctx, d, available.String(), downloadCB); err != nil {
 				return err
 			}
 
 			// Installs downloaded library
-			taskCB(&rpc.TaskProgress{Name: "Installing " + available.String()})
+			taskCB(&rpc.TaskProgress{Name: tr("Installing %s", available.String())})
 			libPath, libReplaced, err := lm.InstallPrerequisiteCheck(available)
 			if err == librariesmanager.ErrAlreadyInstalled {
-				taskCB(&rpc.TaskProgress{Message: "Already installed " + available.String(), Completed: true})
+				taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", available.String()), Completed: true})
 				continue
 			} else if err != nil {
 				return fmt.Errorf("checking lib install prerequisites: %s", err)
 			}
 
 			if libReplaced != nil {
-				taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("Replacing %s with %s", libReplaced, available)})
+				taskCB(&rpc.TaskProgress{Message: tr("Replacing %s with %s", libReplaced, available)})
 			}
 
-			if err := lm.Install(available, libPath); err != nil {
+			if err := lm.Install(available, libPath, nil); err != nil {
 				return err
 			}
 
-			taskCB(&rpc.TaskProgress{Message: "Installed " + available.String(), Completed: true})
+			taskCB(&rpc.TaskProgress{Message: tr("Installed %s", available.String()), Completed: true})
 		}
 	}
 
@@ -494,7 +792,7 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 
 	for _, targetPackage := range pm.Packages {
 		for _, installed := range targetPackage.Platforms {
-			if installedRelease := pm.GetInstalledPlatformRelease(installed); installedRelease != nil {
+			if installedRelease := pm.GetInstalledManagedPlatformRelease(installed); installedRelease != nil {
 				latest := installed.GetLatestRelease()
 				if latest == nil || latest == installedRelease {
 					continue
@@ -506,7 +804,7 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 					PlatformVersion:      latest.Version,
 				}
 
-				taskCB(&rpc.TaskProgress{Name: "Downloading " + latest.String()})
+				taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", latest.String())})
 				_, tools, err := pm.FindPlatformReleaseDependencies(ref)
 				if err != nil {
 					return fmt.Errorf("platform %s is not installed", ref)
@@ -516,7 +814,7 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 				for _, tool := range tools {
 					if tool.IsInstalled() {
 						logrus.WithField("tool", tool).Warn("Tool already installed")
-						taskCB(&rpc.TaskProgress{Name: "Tool " + tool.String() + " already installed", Completed: true})
+						taskCB(&rpc.TaskProgress{Name: tr("Tool %s already installed", tool.String()), Completed: true})
 					} else {
 						toolsToInstall = append(toolsToInstall, tool)
 					}
@@ -524,8 +822,8 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 
 				// Downloads platform tools
 				for _, tool := range toolsToInstall {
-					if err := DownloadToolRelease(pm, tool, downloadCB); err != nil {
-						taskCB(&rpc.TaskProgress{Message: "Error downloading tool " + tool.String()})
+					if err := DownloadToolRelease(ctx, pm, tool, downloadCB); err != nil {
+						taskCB(&rpc.TaskProgress{Message: tr("Error downloading tool %s", tool.String())})
 						return err
 					}
 				}
@@ -533,26 +831,31 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 				// Downloads platform
 				if d, err := pm.DownloadPlatformRelease(latest, downloaderConfig); err != nil {
 					return err
-				} else if err := Download(d, latest.String(), downloadCB); err != nil {
+				} else if err := Download(ctx, d, latest.String(), downloadCB); err != nil {
 					return err
 				}
 
 				logrus.Info("Updating platform " + installed.String())
-				taskCB(&rpc.TaskProgress{Name: "Updating " + latest.String()})
+				taskCB(&rpc.TaskProgress{Name: tr("Updating %s", latest.String())})
 
 				// Installs tools
 				for _, tool := range toolsToInstall {
 					if err := InstallToolRelease(pm, tool, taskCB); err != nil {
-						taskCB(&rpc.TaskProgress{Message: "Error installing tool " + tool.String()})
+						taskCB(&rpc.TaskProgress{Message: tr("Error installing tool %s", tool.String())})
 						return err
 					}
 				}
 
 				// Installs platform
-				err = pm.InstallPlatform(latest)
+				err = pm.InstallPlatform(latest, NewExtractProgressCB(tr("Updating %s", latest.String()), taskCB))
 				if err != nil {
 					logrus.WithError(err).Error("Cannot install platform")
-					taskCB(&rpc.TaskProgress{Message: "Error installing " + latest.String()})
+					taskCB(&rpc.TaskProgress{Message: tr("Error installing %s", latest.String())})
+					return err
+				}
+
+				// Perform post install, the new release is removed if it fails
+				if _, err := RunPlatformPostInstall(ctx, pm, latest, req.SkipPostInstall, taskCB); err != nil {
 					return err
 				}
 
@@ -562,27 +865,15 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 				// In case uninstall fails tries to rollback
 				if err != nil {
 					logrus.WithError(err).Error("Error updating platform.")
-					taskCB(&rpc.TaskProgress{Message: "Error upgrading platform: " + err.Error()})
+					taskCB(&rpc.TaskProgress{Message: tr("Error upgrading platform: %s", err.Error())})
 
 					// Rollback
 					if err := pm.UninstallPlatform(latest); err != nil {
 						logrus.WithError(err).Error("Error rolling-back changes.")
-						taskCB(&rpc.TaskProgress{Message: "Error rolling-back changes: " + err.Error()})
+						taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", err.Error())})
 						return err
 					}
 				}
-
-				// Perform post install
-				if !req.SkipPostInstall {
-					logrus.Info("Running post_install script")
-					taskCB(&rpc.TaskProgress{Message: "Configuring platform"})
-					if err := pm.RunPostInstallScript(latest); err != nil {
-						taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("WARNING: cannot run post install: %s", err)})
-					}
-				} else {
-					logrus.Info("Skipping platform configuration (post_install run).")
-					taskCB(&rpc.TaskProgress{Message: "Skipping platform configuration"})
-				}
 			}
 		}
 	}
@@ -592,8 +883,8 @@ func Upgrade(ctx context.Context, req *rpc.UpgradeReq, downloadCB DownloadProgre
 
 // Rescan restart discoveries for the given instance
 func Rescan(instanceID int32) (*rpc.RescanResp, error) {
-	coreInstance, ok := instances[instanceID]
-	if !ok {
+	coreInstance := GetInstance(instanceID)
+	if coreInstance == nil {
 		return nil, fmt.Errorf("invalid handle")
 	}
 
@@ -601,8 +892,11 @@ func Rescan(instanceID int32) (*rpc.RescanResp, error) {
 	if err != nil {
 		return nil, fmt.Errorf("rescanning filesystem: %s", err)
 	}
+	// Requests already running keep using the previous managers
+	instancesMux.Lock()
 	coreInstance.PackageManager = res.Pm
 	coreInstance.lm = res.Lm
+	instancesMux.Unlock()
 
 	return &rpc.RescanResp{
 		PlatformsIndexErrors: res.PlatformIndexErrors,
@@ -613,6 +907,11 @@ func Rescan(instanceID int32) (*rpc.RescanResp, error) {
 func createInstance(ctx context.Context, getLibOnly bool) (*createInstanceResult, error) {
 	res := &createInstanceResult{}
 
+	lockTimeout, err := LockTimeout()
+	if err != nil {
+		return nil, err
+	}
+
 	// setup downloads directory
 	downloadsDir := paths.New(viper.GetString("directories.Downloads"))
 	if downloadsDir.NotExist() {
@@ -635,6 +934,7 @@ func createInstance(ctx context.Context, getLibOnly bool) (*createInstanceResult
 	if !getLibOnly {
 		res.Pm = packagemanager.NewPackageManager(dataDir, configuration.PackagesDir(),
 			downloadsDir, dataDir.Join("tmp"))
+		res.Pm.LockTimeout = lockTimeout
 
 		urls := []string{globals.DefaultIndexURL}
 		urls = append(urls, viper.GetStringSlice("board_manager.additional_urls")...)
@@ -662,6 +962,8 @@ func createInstance(ctx context.Context, getLibOnly bool) (*createInstanceResult
 	// Initialize library manager
 	// --------------------------
 	res.Lm = librariesmanager.NewLibraryManager(dataDir, downloadsDir)
+	res.Lm.AdditionalIndexURLs = additionalLibraryIndexURLs()
+	res.Lm.LockTimeout = lockTimeout
 
 	// Add IDE builtin libraries dir
 	if bundledLibsDir := configuration.IDEBundledLibrariesDir(); bundledLibsDir != nil {
@@ -672,6 +974,11 @@ func createInstance(ctx context.Context, getLibOnly bool) (*createInstanceResult
 	libDir := configuration.LibrariesDir()
 	res.Lm.AddLibrariesDir(libDir, libraries.User)
 
+	// Add shared libraries dirs, after the user one that has precedence
+	for _, dir := range configuration.AdditionalLibrariesDirs() {
+		res.Lm.AddReadOnlyLibrariesDir(dir, libraries.User)
+	}
+
 	// Add libraries dirs from installed platforms
 	if res.Pm != nil {
 		for _, targetPackage := range res.Pm.Packages {
@@ -709,14 +1016,18 @@ func LoadSketch(ctx context.Context, req *rpc.LoadSketchReq) (*rpc.LoadSketchRes
 	}
 
 	additionalFiles := make([]string, len(sketch.AdditionalFiles))
+	additionalFilesRelative := make([]string, len(sketch.AdditionalFiles))
 	for i, file := range sketch.AdditionalFiles {
 		additionalFiles[i] = file.Path
+		additionalFilesRelative[i] = sketch.RelativePath(file)
 	}
 
 	return &rpc.LoadSketchResp{
-		MainFile:         sketch.MainFile.Path,
-		LocationPath:     sketch.LocationPath,
-		OtherSketchFiles: otherSketchFiles,
-		AdditionalFiles:  additionalFiles,
+		MainFile:                sketch.MainFile.Path,
+		LocationPath:            sketch.LocationPath,
+		OtherSketchFiles:        otherSketchFiles,
+		AdditionalFiles:         additionalFiles,
+		Layout:                  sketch.Layout(),
+		AdditionalFilesRelative: additionalFilesRelative,
 	}, nil
 }
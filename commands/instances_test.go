@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadIndexSignature(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/package_index.json.sig" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("signature"))
+	}))
+	defer ts.Close()
+
+	tmp, err := paths.MkTempDir("", "test_index_signature")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	sig := tmp.Join("package_index.json.sig")
+
+	progress := []*rpc.DownloadProgress{}
+	downloadCB := func(p *rpc.DownloadProgress) { progress = append(progress, p) }
+	err = downloadIndexSignature(context.Background(), http.DefaultClient, ts.URL+"/package_index.json.sig", sig, "Updating index: package_index.json.sig", downloadCB)
+	require.NoError(t, err)
+	data, err := sig.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "signature", string(data))
+	require.Equal(t, "Updating index: package_index.json.sig", progress[0].GetFile())
+	require.True(t, progress[len(progress)-1].GetCompleted())
+
+	// The error page of a missing signature must not be taken as the signature
+	progress = progress[:0]
+	require.NoError(t, sig.Remove())
+	err = downloadIndexSignature(context.Background(), http.DefaultClient, ts.URL+"/other_index.json.sig", sig, "Updating index: other_index.json.sig", downloadCB)
+	require.EqualError(t, err, "server replied 404 Not Found")
+	require.False(t, sig.Exist())
+	require.Empty(t, progress)
+}
@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// LibraryCleanCache removes the library archives from the downloads directory
+func LibraryCleanCache(ctx context.Context, req *rpc.LibraryCleanCacheReq) (*rpc.LibraryCleanCacheResp, error) {
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
+
+	referenced := map[string]bool{}
+	for _, library := range lm.Index.Libraries {
+		for _, release := range library.Releases {
+			if release.Resource != nil {
+				referenced[lm.DownloadsDir.Join(release.Resource.CachePath, release.Resource.ArchiveFileName).String()] = true
+			}
+		}
+	}
+
+	dirs := paths.PathList{lm.DownloadsDir.Join("libraries")}
+	archives, size, err := commands.CleanDownloadsCache(dirs, referenced, req.GetOlderThanDays(), req.GetOnlyOrphaned(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.LibraryCleanCacheResp{Archives: archives, Size: size}, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/sirupsen/logrus"
+	"go.bug.st/downloader/v2"
 )
 
 // LibraryDownload FIXMEDOC
@@ -36,30 +37,65 @@ func LibraryDownload(ctx context.Context, req *rpc.LibraryDownloadReq, downloadC
 
 	lib, err := findLibraryIndexRelease(lm, req)
 	if err != nil {
-		return nil, fmt.Errorf("looking for library: %s", err)
+		return nil, fmt.Errorf("looking for library: %w", err)
 	}
 
-	if err := downloadLibrary(lm, lib, downloadCB, func(*rpc.TaskProgress) {}); err != nil {
+	if err := downloadLibrary(ctx, lm, lib, downloadCB, func(*rpc.TaskProgress) {}); err != nil {
 		return nil, err
 	}
 
 	return &rpc.LibraryDownloadResp{}, nil
 }
 
-func downloadLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release,
+func downloadLibrary(ctx context.Context, lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
 
-	taskCB(&rpc.TaskProgress{Name: "Downloading " + libRelease.String()})
+	taskCB(&rpc.TaskProgress{Name: tr("Downloading %s", libRelease.String())})
 	config, err := commands.GetDownloaderConfig()
 	if err != nil {
 		return err
 	}
 	if d, err := libRelease.Resource.Download(lm.DownloadsDir, config); err != nil {
 		return err
-	} else if err := commands.Download(d, libRelease.String(), downloadCB); err != nil {
+	} else if err := commands.Download(ctx, d, libRelease.String(), downloadCB); err != nil {
 		return err
+	} else if d != nil {
+		if err := libRelease.Resource.VerifyLocalArchive(lm.DownloadsDir); err != nil {
+			return err
+		}
 	}
 	taskCB(&rpc.TaskProgress{Completed: true})
 
 	return nil
 }
+
+// downloadLibraries downloads the library releases concurrently
+func downloadLibraries(ctx context.Context, lm *librariesmanager.LibrariesManager, libReleases []*librariesindex.Release,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
+	if len(libReleases) == 1 {
+		return downloadLibrary(ctx, lm, libReleases[0], downloadCB, taskCB)
+	}
+
+	taskCB(&rpc.TaskProgress{Name: tr("Downloading libraries")})
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return err
+	}
+	tasks := []*commands.DownloadTask{}
+	for _, libRelease := range libReleases {
+		libRelease := libRelease
+		tasks = append(tasks, &commands.DownloadTask{
+			Label: libRelease.String(),
+			Size:  libRelease.Resource.Size,
+			Start: func() (*downloader.Downloader, error) {
+				return libRelease.Resource.Download(lm.DownloadsDir, config)
+			},
+			Verify: func() error { return libRelease.Resource.VerifyLocalArchive(lm.DownloadsDir) },
+		})
+	}
+	if err := commands.DownloadAll(ctx, tasks, fmt.Sprintf("%d libraries", len(libReleases)), downloadCB); err != nil {
+		return err
+	}
+	taskCB(&rpc.TaskProgress{Completed: true})
+	return nil
+}
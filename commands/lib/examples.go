@@ -0,0 +1,86 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package lib
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// LibraryExamples returns the examples of the installed libraries, including
+// the ones bundled with the platforms. If an FQBN is given only the libraries
+// that would be used to compile for the board are returned.
+func LibraryExamples(ctx context.Context, req *rpc.LibraryExamplesReq) (*rpc.LibraryExamplesResp, error) {
+	list, err := LibraryList(ctx, &rpc.LibraryListReq{
+		Instance: req.GetInstance(),
+		All:      true,
+		Name:     req.GetName(),
+		Fqbn:     req.GetFqbn(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &rpc.LibraryExamplesResp{Libraries: []*rpc.LibraryExamples{}}
+	for _, installed := range list.GetInstalledLibrary() {
+		lib := installed.GetLibrary()
+		if len(lib.GetExamples()) == 0 {
+			continue
+		}
+		compatible := true
+		if fqbn := req.GetFqbn(); fqbn != "" {
+			compatible = lib.GetCompatibleWith()[fqbn]
+		}
+		if req.GetCompatibleOnly() && !compatible {
+			continue
+		}
+
+		examples := []*rpc.LibraryExample{}
+		for _, examplePath := range lib.GetExamples() {
+			name := filepath.Base(examplePath)
+			relativePath := examplePath
+			if rel, err := filepath.Rel(lib.GetInstallDir(), examplePath); err == nil {
+				relativePath = filepath.ToSlash(rel)
+			}
+			examples = append(examples, &rpc.LibraryExample{
+				Name:         name,
+				Path:         examplePath,
+				MainFile:     filepath.Join(examplePath, name+".ino"),
+				RelativePath: relativePath,
+			})
+		}
+		sort.Slice(examples, func(i, j int) bool {
+			return examples[i].Path < examples[j].Path
+		})
+
+		res.Libraries = append(res.Libraries, &rpc.LibraryExamples{
+			Library:    lib,
+			Examples:   examples,
+			Compatible: compatible,
+		})
+	}
+	sort.Slice(res.Libraries, func(i, j int) bool {
+		a, b := res.Libraries[i].Library, res.Libraries[j].Library
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.InstallDir < b.InstallDir
+	})
+	return res, nil
+}
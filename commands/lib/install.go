@@ -18,15 +18,21 @@ package lib
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/i18n"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	"github.com/sirupsen/logrus"
 )
 
-// LibraryInstall FIXMEDOC
+var tr = i18n.Tr
+
+// LibraryInstall installs a library and, unless NoDeps is set in the request,
+// all the libraries it depends on.
 func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallReq,
 	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) error {
 
@@ -34,17 +40,67 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallReq,
 
 	libRelease, err := findLibraryIndexRelease(lm, req)
 	if err != nil {
-		return fmt.Errorf("looking for library: %s", err)
+		return fmt.Errorf("looking for library: %w", err)
 	}
 
-	if err := downloadLibrary(lm, libRelease, downloadCB, taskCB); err != nil {
-		return fmt.Errorf("downloading library: %s", err)
+	toInstall := []*librariesindex.Release{libRelease}
+	if !req.GetNoDeps() {
+		deps, err := LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesReq{
+			Instance: req.GetInstance(),
+			Name:     req.GetName(),
+			Version:  req.GetVersion(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error resolving dependencies for %s: %w", libRelease, err)
+		}
+
+		// The resolved dependencies include the requested library too
+		toInstall = []*librariesindex.Release{}
+		for _, dep := range deps.GetDependencies() {
+			if dep.GetName() != libRelease.GetName() && dep.GetVersionInstalled() == dep.GetVersionRequired() {
+				continue
+			}
+			depRelease, err := findLibraryIndexRelease(lm, &rpc.LibraryInstallReq{
+				Name:    dep.GetName(),
+				Version: dep.GetVersionRequired(),
+			})
+			if err != nil {
+				return fmt.Errorf("looking for library: %w", err)
+			}
+			toInstall = append(toInstall, depRelease)
+		}
+		if len(toInstall) > 1 {
+			names := []string{}
+			for _, release := range toInstall {
+				names = append(names, release.String())
+			}
+			taskCB(&rpc.TaskProgress{Message: tr("Libraries to install: %s", strings.Join(names, ", "))})
+		}
 	}
 
-	if err := installLibrary(lm, libRelease, taskCB); err != nil {
+	// In offline mode everything must be already downloaded
+	archives := map[string]*resources.DownloadResource{}
+	for _, release := range toInstall {
+		archives[release.String()] = release.Resource
+	}
+	if err := commands.CheckDownloadsCache(lm.DownloadsDir, archives); err != nil {
 		return err
 	}
 
+	// Download everything before installing, so that a failed download
+	// doesn't leave dependencies half installed
+	if err := downloadLibraries(ctx, lm, toInstall, downloadCB, taskCB); err != nil {
+		return fmt.Errorf("downloading library: %w", err)
+	}
+	for _, release := range toInstall {
+		if err := installLibrary(lm, release, taskCB); err != nil {
+			return err
+		}
+	}
+	if err := commands.EnforceDownloadsMaxSize(); err != nil {
+		logrus.WithError(err).Warn("Cannot trim downloads directory")
+	}
+
 	if _, err := commands.Rescan(req.GetInstance().GetId()); err != nil {
 		return fmt.Errorf("rescanning libraries: %s", err)
 	}
@@ -52,11 +108,11 @@ func LibraryInstall(ctx context.Context, req *rpc.LibraryInstallReq,
 }
 
 func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, taskCB commands.TaskProgressCB) error {
-	taskCB(&rpc.TaskProgress{Name: "Installing " + libRelease.String()})
+	taskCB(&rpc.TaskProgress{Name: tr("Installing %s", libRelease.String())})
 	logrus.WithField("library", libRelease).Info("Installing library")
 	libPath, libReplaced, err := lm.InstallPrerequisiteCheck(libRelease)
 	if err == librariesmanager.ErrAlreadyInstalled {
-		taskCB(&rpc.TaskProgress{Message: "Already installed " + libRelease.String(), Completed: true})
+		taskCB(&rpc.TaskProgress{Message: tr("Already installed %s", libRelease.String()), Completed: true})
 		return nil
 	}
 
@@ -65,13 +121,13 @@ func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *libraries
 	}
 
 	if libReplaced != nil {
-		taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("Replacing %s with %s", libReplaced, libRelease)})
+		taskCB(&rpc.TaskProgress{Message: tr("Replacing %s with %s", libReplaced, libRelease)})
 	}
 
-	if err := lm.Install(libRelease, libPath); err != nil {
+	if err := lm.Install(libRelease, libPath, commands.NewExtractProgressCB(tr("Installing %s", libRelease.String()), taskCB)); err != nil {
 		return err
 	}
 
-	taskCB(&rpc.TaskProgress{Message: "Installed " + libRelease.String(), Completed: true})
+	taskCB(&rpc.TaskProgress{Message: tr("Installed %s", libRelease.String()), Completed: true})
 	return nil
 }
@@ -17,33 +17,34 @@ package lib
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
-	"github.com/pkg/errors"
 )
 
 type installedLib struct {
-	Library   *libraries.Library
-	Available *librariesindex.Release
+	Library       *libraries.Library
+	Available     *librariesindex.Release
+	UnknownOrigin bool
+	ShadowedBy    *libraries.Library
 }
 
 // LibraryList FIXMEDOC
 func LibraryList(ctx context.Context, req *rpc.LibraryListReq) (*rpc.LibraryListResp, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
 	if pm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
 	if lm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	nameFilter := strings.ToLower(req.GetName())
@@ -53,11 +54,11 @@ func LibraryList(ctx context.Context, req *rpc.LibraryListReq) (*rpc.LibraryList
 	if f := req.GetFqbn(); f != "" {
 		fqbn, err := cores.ParseFQBN(req.GetFqbn())
 		if err != nil {
-			return nil, fmt.Errorf("parsing fqbn: %s", err)
+			return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "parsing fqbn")
 		}
 		_, boardPlatform, _, _, refBoardPlatform, err := pm.ResolveFQBN(fqbn)
 		if err != nil {
-			return nil, fmt.Errorf("loading board data: %s", err)
+			return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "loading board data")
 		}
 
 		filteredRes := map[string]*installedLib{}
@@ -104,9 +105,15 @@ func LibraryList(ctx context.Context, req *rpc.LibraryListReq) (*rpc.LibraryList
 			return nil, err
 		}
 		release := GetOutputRelease(lib.Available)
+		shadowedBy := ""
+		if lib.ShadowedBy != nil {
+			shadowedBy = lib.ShadowedBy.InstallDir.String()
+		}
 		instaledLib = append(instaledLib, &rpc.InstalledLibrary{
-			Library: libtmp,
-			Release: release,
+			Library:       libtmp,
+			Release:       release,
+			UnknownOrigin: lib.UnknownOrigin,
+			ShadowedBy:    shadowedBy,
 		})
 	}
 
@@ -129,14 +136,40 @@ func listLibraries(lm *librariesmanager.LibrariesManager, updatable bool, all bo
 				continue
 			}
 			res = append(res, &installedLib{
-				Library:   lib,
-				Available: available,
+				Library:       lib,
+				Available:     available,
+				UnknownOrigin: lib.Location == libraries.User && lm.Index.FindIndexedLibrary(lib) == nil,
+				ShadowedBy:    findShadowingLibrary(lib, libAlternatives.Alternatives),
 			})
 		}
 	}
 	return res
 }
 
+// findShadowingLibrary returns the library in the user directory, among the
+// alternatives, that the resolver prefers to lib for at least one of the
+// architectures lib is meant for, or nil if lib is never shadowed
+func findShadowingLibrary(lib *libraries.Library, alternatives libraries.List) *libraries.Library {
+	if lib.Location == libraries.User {
+		return nil
+	}
+	archs := lib.Architectures
+	if lib.ContainerPlatform != nil {
+		archs = []string{lib.ContainerPlatform.Platform.Architecture}
+	}
+	for _, alternative := range alternatives {
+		if alternative.Location != libraries.User {
+			continue
+		}
+		for _, arch := range archs {
+			if librariesresolver.Shadows(alternative, lib, arch) {
+				return alternative
+			}
+		}
+	}
+	return nil
+}
+
 // GetOutputLibrary FIXMEDOC
 func GetOutputLibrary(lib *libraries.Library) (*rpc.Library, error) {
 	insdir := ""
@@ -155,6 +188,14 @@ func GetOutputLibrary(lib *libraries.Library) (*rpc.Library, error) {
 	if lib.ContainerPlatform != nil {
 		cntplat = lib.ContainerPlatform.String()
 	}
+	version := lib.Version.String()
+	if version == "" && lib.Properties != nil {
+		// Keep the version declared in library.properties even if it's not
+		// semver compliant, "-" is the placeholder for a missing version
+		if declared := strings.TrimSpace(lib.Properties.Get("version")); declared != "-" {
+			version = declared
+		}
+	}
 
 	return &rpc.Library{
 		Name:              lib.Name,
@@ -177,7 +218,7 @@ func GetOutputLibrary(lib *libraries.Library) (*rpc.Library, error) {
 		Precompiled:       lib.Precompiled,
 		LdFlags:           lib.LDflags,
 		IsLegacy:          lib.IsLegacy,
-		Version:           lib.Version.String(),
+		Version:           version,
 		License:           lib.License,
 		Examples:          lib.Examples.AsStrings(),
 		ProvidesIncludes:  lib.DeclaredHeaders(),
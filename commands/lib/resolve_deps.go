@@ -20,18 +20,21 @@ import (
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 )
 
-// LibraryResolveDependencies FIXMEDOC
+// LibraryResolveDependencies returns the dependencies of a library, together
+// with their installation status.
 func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDependenciesReq) (*rpc.LibraryResolveDependenciesResp, error) {
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
 
-	// Search the requested lib
-	reqLibRelease, err := findLibraryIndexRelease(lm, req)
+	// Search the requested lib, for a range of versions all the releases in
+	// the range are candidates
+	candidates, err := findLibraryIndexReleases(lm, req)
 	if err != nil {
-		return nil, fmt.Errorf("looking for library: %s", err)
+		return nil, fmt.Errorf("looking for library: %w", err)
 	}
 
 	// Extract all installed libraries
@@ -40,33 +43,61 @@ func LibraryResolveDependencies(ctx context.Context, req *rpc.LibraryResolveDepe
 		installedLibs[lib.Library.Name] = lib.Library
 	}
 
-	// Resolve all dependencies...
-	deps := lm.Index.ResolveDependencies(reqLibRelease)
+	// Resolve all dependencies, using the newest candidate that has a solution
+	reqLibRelease := candidates[0]
+	var deps []*librariesindex.Release
+	for _, candidate := range candidates {
+		if deps = lm.Index.ResolveDependencies(candidate); len(deps) > 0 {
+			break
+		}
+	}
 
 	// If no solution has been found
 	if len(deps) == 0 {
 		// Check if there is a problem with the first level deps
 		for _, directDep := range reqLibRelease.GetDependencies() {
 			if _, ok := lm.Index.Libraries[directDep.GetName()]; !ok {
-				return nil, fmt.Errorf("dependency '%s' is not available", directDep.GetName())
+				return nil, commands.FailedPreconditionError(commands.CodeLibraryDependency, nil, "dependency '%s' is not available", directDep.GetName())
 			}
 		}
 
 		// Otherwise there is no possible solution, the depends field has an invalid formula
-		return nil, fmt.Errorf("no valid solution found")
+		return nil, commands.FailedPreconditionError(commands.CodeLibraryDependency, nil, "no valid solution found")
+	}
+
+	resolved := map[string]bool{}
+	for _, dep := range deps {
+		resolved[dep.GetName()] = true
 	}
 
 	res := []*rpc.LibraryDependencyStatus{}
 	for _, dep := range deps {
 		// ...and add information on currently installed versions of the libraries
 		installed := ""
+		status := rpc.LibraryDependencyInstallStatus_missing
 		if installedLib, has := installedLibs[dep.GetName()]; has {
 			installed = installedLib.Version.String()
+			if installedLib.Version.Equal(dep.GetVersion()) {
+				status = rpc.LibraryDependencyInstallStatus_installed
+			} else {
+				status = rpc.LibraryDependencyInstallStatus_version_mismatch
+			}
 		}
+
+		// ...and the direct dependencies, to allow building the dependency tree
+		directDeps := []string{}
+		for _, directDep := range dep.GetDependencies() {
+			if resolved[directDep.GetName()] {
+				directDeps = append(directDeps, directDep.GetName())
+			}
+		}
+
 		res = append(res, &rpc.LibraryDependencyStatus{
 			Name:             dep.GetName(),
 			VersionRequired:  dep.GetVersion().String(),
 			VersionInstalled: installed,
+			Dependencies:     directDeps,
+			Status:           status,
 		})
 	}
 	return &rpc.LibraryResolveDependenciesResp{Dependencies: res}, nil
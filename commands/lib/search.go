@@ -17,7 +17,7 @@ package lib
 
 import (
 	"context"
-	"errors"
+	"sort"
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
@@ -34,7 +34,7 @@ var similarityThreshold = 0.7
 func LibrarySearch(ctx context.Context, req *rpc.LibrarySearchReq) (*rpc.LibrarySearchResp, error) {
 	lm := commands.GetLibraryManager(req.GetInstance().GetId())
 	if lm == nil {
-		return nil, errors.New("invalid instance")
+		return nil, commands.ErrInvalidInstance
 	}
 
 	return searchLibrary(req, lm)
@@ -44,26 +44,36 @@ func searchLibrary(req *rpc.LibrarySearchReq, lm *librariesmanager.LibrariesMana
 	res := []*rpc.SearchedLibrary{}
 	status := rpc.LibrarySearchStatus_success
 
+	query := parseSearchQuery(req.GetQuery())
+	scores := map[string]int{}
 	for _, lib := range lm.Index.Libraries {
-		qry := strings.ToLower(req.GetQuery())
-		if strings.Contains(strings.ToLower(lib.Name), qry) ||
-			strings.Contains(strings.ToLower(lib.Latest.Paragraph), qry) ||
-			strings.Contains(strings.ToLower(lib.Latest.Sentence), qry) {
-			releases := map[string]*rpc.LibraryRelease{}
-			for str, rel := range lib.Releases {
-				releases[str] = GetLibraryParameters(rel)
-			}
-			latest := GetLibraryParameters(lib.Latest)
+		score, ok := query.match(lib)
+		if !ok {
+			continue
+		}
+		releases := map[string]*rpc.LibraryRelease{}
+		for str, rel := range lib.Releases {
+			releases[str] = GetLibraryParameters(rel)
+		}
+		latest := GetLibraryParameters(lib.Latest)
 
-			searchedLib := &rpc.SearchedLibrary{
-				Name:     lib.Name,
-				Releases: releases,
-				Latest:   latest,
-			}
-			res = append(res, searchedLib)
+		searchedLib := &rpc.SearchedLibrary{
+			Name:     lib.Name,
+			Releases: releases,
+			Latest:   latest,
 		}
+		res = append(res, searchedLib)
+		scores[lib.Name] = score
 	}
 
+	// Most relevant libraries first
+	sort.SliceStable(res, func(i, j int) bool {
+		if scores[res[i].Name] != scores[res[j].Name] {
+			return scores[res[i].Name] > scores[res[j].Name]
+		}
+		return strings.ToLower(res[i].Name) < strings.ToLower(res[j].Name)
+	})
+
 	if len(res) == 0 {
 		status = rpc.LibrarySearchStatus_failed
 		for _, lib := range lm.Index.Libraries {
@@ -78,6 +88,120 @@ func searchLibrary(req *rpc.LibrarySearchReq, lm *librariesmanager.LibrariesMana
 	return &rpc.LibrarySearchResp{Libraries: res, Status: status}, nil
 }
 
+// searchQuery is a parsed library search query: free text and
+// `qualifier:value` filters
+type searchQuery struct {
+	text    string
+	authors []string
+	archs   []string
+	headers []string
+	topics  []string
+}
+
+func parseSearchQuery(query string) *searchQuery {
+	res := &searchQuery{}
+	text := []string{}
+	for _, token := range strings.Fields(strings.ToLower(query)) {
+		split := strings.SplitN(token, ":", 2)
+		if len(split) == 2 && split[1] != "" {
+			switch split[0] {
+			case "author":
+				res.authors = append(res.authors, split[1])
+				continue
+			case "arch":
+				res.archs = append(res.archs, split[1])
+				continue
+			case "header":
+				res.headers = append(res.headers, split[1])
+				continue
+			case "topic":
+				res.topics = append(res.topics, split[1])
+				continue
+			}
+		}
+		text = append(text, token)
+	}
+	res.text = strings.Join(text, " ")
+	return res
+}
+
+// match returns true if the latest release of lib satisfies all the filters
+// and contains the free text of the query, together with a relevance score
+// depending on where the text has been found
+func (q *searchQuery) match(lib *librariesindex.Library) (int, bool) {
+	rel := lib.Latest
+	name := strings.ToLower(lib.Name)
+	sentence := strings.ToLower(rel.Sentence)
+	paragraph := strings.ToLower(rel.Paragraph)
+	archs := lowerAll(rel.Architectures)
+	headers := lowerAll(rel.ProvidesIncludes)
+
+	for _, author := range q.authors {
+		if !strings.Contains(strings.ToLower(rel.Author), author) &&
+			!strings.Contains(strings.ToLower(rel.Maintainer), author) {
+			return 0, false
+		}
+	}
+	for _, arch := range q.archs {
+		if !contains(archs, arch) && !contains(archs, "*") {
+			return 0, false
+		}
+	}
+	for _, header := range q.headers {
+		if !contains(headers, header) && !contains(headers, header+".h") {
+			return 0, false
+		}
+	}
+	for _, topic := range q.topics {
+		if !strings.Contains(strings.ToLower(rel.Category), topic) {
+			return 0, false
+		}
+	}
+
+	if q.text == "" {
+		return 0, true
+	}
+	score := 0
+	switch {
+	case name == q.text:
+		score += 100
+	case strings.HasPrefix(name, q.text):
+		score += 50
+	case strings.Contains(name, q.text):
+		score += 30
+	}
+	if contains(headers, q.text) || contains(headers, q.text+".h") {
+		score += 20
+	}
+	if strings.Contains(sentence, q.text) {
+		score += 10
+	}
+	if strings.Contains(paragraph, q.text) {
+		score += 5
+	}
+	if contains(archs, q.text) {
+		score += 2
+	}
+	return score, score > 0
+}
+
+func lowerAll(list []string) []string {
+	res := make([]string, len(list))
+	for i, s := range list {
+		res[i] = strings.ToLower(s)
+	}
+	return res
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
 // GetLibraryParameters FIXMEDOC
 func GetLibraryParameters(rel *librariesindex.Release) *rpc.LibraryRelease {
 	return &rpc.LibraryRelease{
@@ -93,6 +217,7 @@ func GetLibraryParameters(rel *librariesindex.Release) *rpc.LibraryRelease {
 		License:          rel.License,
 		ProvidesIncludes: rel.ProvidesIncludes,
 		Dependencies:     getLibraryDependenciesParameter(rel.GetDependencies()),
+		IndexUrl:         rel.IndexURL,
 		Resources: &rpc.DownloadResource{
 			Url:             rel.Resource.URL,
 			Archivefilename: rel.Resource.ArchiveFileName,
@@ -8,6 +8,7 @@ import (
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var customIndexPath = paths.New("testdata")
@@ -52,3 +53,29 @@ func TestSearchLibrarySimilar(t *testing.T) {
 	assert.Equal(len(resp.GetLibraries()), 1)
 	assert.Equal(resp.GetLibraries()[0].Name, "Arduino")
 }
+
+func TestSearchLibraryRanking(t *testing.T) {
+	lm := librariesmanager.NewLibraryManager(paths.New("testdata", "search"), nil)
+	require.NoError(t, lm.LoadIndex())
+
+	search := func(query string) []string {
+		resp, err := searchLibrary(&rpc.LibrarySearchReq{Query: query}, lm)
+		require.NoError(t, err)
+		names := []string{}
+		for _, lib := range resp.GetLibraries() {
+			names = append(names, lib.GetName())
+		}
+		return names
+	}
+
+	// Exact name first, then names starting with the query, then the others
+	require.Equal(t, []string{"Servo", "ServoEasing", "Motor Shield"}, search("servo"))
+	require.Equal(t, []string{"ArduinoJson"}, search("simple and efficient"))
+	require.Equal(t, []string{"ArduinoJson", "Motor Shield", "Servo", "ServoEasing"}, search(""))
+
+	require.Equal(t, []string{"Servo"}, search("author:margolis"))
+	require.Equal(t, []string{"Servo", "ServoEasing"}, search("servo arch:samd"))
+	require.Equal(t, []string{"Motor Shield"}, search("header:MotorShield"))
+	require.Equal(t, []string{"ArduinoJson"}, search("topic:data"))
+	require.Equal(t, []string{"ServoEasing"}, search("author:armin servo"))
+}
@@ -17,7 +17,6 @@ package lib
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/commands"
@@ -34,10 +33,12 @@ func LibraryUninstall(ctx context.Context, req *rpc.LibraryUninstallReq, taskCB
 	lib := lm.FindByReference(ref)
 
 	if lib == nil {
-		taskCB(&rpc.TaskProgress{Message: fmt.Sprintf("Library %s is not installed", req.Name), Completed: true})
+		taskCB(&rpc.TaskProgress{Message: tr("Library %s is not installed", req.Name), Completed: true})
 	} else {
-		taskCB(&rpc.TaskProgress{Name: "Uninstalling " + lib.String()})
-		lm.Uninstall(lib)
+		taskCB(&rpc.TaskProgress{Name: tr("Uninstalling %s", lib.String())})
+		if err := lm.Uninstall(lib); err != nil {
+			return err
+		}
 		taskCB(&rpc.TaskProgress{Completed: true})
 	}
 
@@ -16,19 +16,21 @@
 package lib
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/sirupsen/logrus"
 )
 
 // LibraryUpgradeAll upgrades all the available libraries
-func LibraryUpgradeAll(instanceID int32, downloadCB commands.DownloadProgressCB,
+func LibraryUpgradeAll(ctx context.Context, instanceID int32, downloadCB commands.DownloadProgressCB,
 	taskCB commands.TaskProgressCB) error {
 	// get the library manager
 	lm := commands.GetLibraryManager(instanceID)
 
-	if err := upgrade(lm, listLibraries(lm, true, true), downloadCB, taskCB); err != nil {
+	if err := upgrade(ctx, lm, listLibraries(lm, true, true), downloadCB, taskCB); err != nil {
 		return err
 	}
 
@@ -40,7 +42,7 @@ func LibraryUpgradeAll(instanceID int32, downloadCB commands.DownloadProgressCB,
 }
 
 // LibraryUpgrade upgrades only the given libraries
-func LibraryUpgrade(instanceID int32, libraryNames []string, downloadCB commands.DownloadProgressCB,
+func LibraryUpgrade(ctx context.Context, instanceID int32, libraryNames []string, downloadCB commands.DownloadProgressCB,
 	taskCB commands.TaskProgressCB) error {
 	// get the library manager
 	lm := commands.GetLibraryManager(instanceID)
@@ -49,16 +51,24 @@ func LibraryUpgrade(instanceID int32, libraryNames []string, downloadCB commands
 	libs := filterByName(listLibraries(lm, true, true), libraryNames)
 
 	// do it
-	return upgrade(lm, libs, downloadCB, taskCB)
+	if err := upgrade(ctx, lm, libs, downloadCB, taskCB); err != nil {
+		return err
+	}
+
+	if _, err := commands.Rescan(instanceID); err != nil {
+		return fmt.Errorf("rescanning libraries: %s", err)
+	}
+
+	return nil
 }
 
-func upgrade(lm *librariesmanager.LibrariesManager, libs []*installedLib, downloadCB commands.DownloadProgressCB,
+func upgrade(ctx context.Context, lm *librariesmanager.LibrariesManager, libs []*installedLib, downloadCB commands.DownloadProgressCB,
 	taskCB commands.TaskProgressCB) error {
 
 	// Go through the list and download them
 
 	for _, lib := range libs {
-		if err := downloadLibrary(lm, lib.Available, downloadCB, taskCB); err != nil {
+		if err := downloadLibrary(ctx, lm, lib.Available, downloadCB, taskCB); err != nil {
 			return err
 		}
 	}
@@ -69,6 +79,9 @@ func upgrade(lm *librariesmanager.LibrariesManager, libs []*installedLib, downlo
 			return err
 		}
 	}
+	if err := commands.EnforceDownloadsMaxSize(); err != nil {
+		logrus.WithError(err).Warn("Cannot trim downloads directory")
+	}
 
 	return nil
 }
@@ -16,8 +16,6 @@
 package lib
 
 import (
-	"fmt"
-
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
@@ -31,20 +29,46 @@ type libraryReferencer interface {
 func createLibIndexReference(lm *librariesmanager.LibrariesManager, req libraryReferencer) (*librariesindex.Reference, error) {
 	version, err := commands.ParseVersion(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid version: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidVersion, err, "invalid version")
 	}
 
 	return &librariesindex.Reference{Name: req.GetName(), Version: version}, nil
 }
 
 func findLibraryIndexRelease(lm *librariesmanager.LibrariesManager, req libraryReferencer) (*librariesindex.Release, error) {
-	ref, err := createLibIndexReference(lm, req)
+	releases, err := findLibraryIndexReleases(lm, req)
 	if err != nil {
 		return nil, err
 	}
-	lib := lm.Index.FindRelease(ref)
-	if lib == nil {
-		return nil, fmt.Errorf("library %s not found", ref)
+	return releases[0], nil
+}
+
+// findLibraryIndexReleases returns the releases of the library index matching
+// the request: the requested version, or the latest one if not given, or all
+// the versions in the requested range (e.g. ">=1.2 <2.0"), the newest first.
+func findLibraryIndexReleases(lm *librariesmanager.LibrariesManager, req libraryReferencer) ([]*librariesindex.Release, error) {
+	if !librariesindex.IsVersionRange(req.GetVersion()) {
+		ref, err := createLibIndexReference(lm, req)
+		if err != nil {
+			return nil, err
+		}
+		lib := lm.Index.FindRelease(ref)
+		if lib == nil {
+			return nil, commands.NotFoundError(commands.CodeLibraryNotFound, nil, "library %s not found", ref)
+		}
+		return []*librariesindex.Release{lib}, nil
+	}
+
+	versionRange, err := librariesindex.ParseVersionRange(req.GetVersion())
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidVersion, err, "invalid version")
+	}
+	if _, exists := lm.Index.Libraries[req.GetName()]; !exists {
+		return nil, commands.NotFoundError(commands.CodeLibraryNotFound, nil, "library %s not found", req.GetName())
+	}
+	releases := lm.Index.FindReleasesInRange(req.GetName(), versionRange)
+	if len(releases) == 0 {
+		return nil, commands.NotFoundError(commands.CodeLibraryNotFound, nil, "no version of library %s satisfies %s", req.GetName(), req.GetVersion())
 	}
-	return lib, nil
+	return releases, nil
 }
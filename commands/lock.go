@@ -0,0 +1,47 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/filelock"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+)
+
+// LockTimeout returns how long the installations wait for the data directory
+// or the user libraries directory locked by another process, as set in
+// directories.LockTimeout.
+func LockTimeout() (time.Duration, error) {
+	setting := viper.GetString("directories.LockTimeout")
+	timeout, err := time.ParseDuration(setting)
+	if err != nil {
+		return 0, fmt.Errorf("invalid directories.LockTimeout '%s': %s", setting, err)
+	}
+	return timeout, nil
+}
+
+// lockDataDir locks the data directory, waiting at most LockTimeout for the
+// other processes to release it.
+func lockDataDir() (*filelock.Lock, error) {
+	timeout, err := LockTimeout()
+	if err != nil {
+		return nil, err
+	}
+	return filelock.Acquire(paths.New(viper.GetString("directories.Data")), timeout)
+}
@@ -0,0 +1,55 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+)
+
+// IsOffline returns true if network access has been disabled through the
+// network.offline setting.
+func IsOffline() bool {
+	return viper.GetBool("network.offline")
+}
+
+// CheckDownloadsCache returns an error listing the resources that are not
+// available in the downloads cache, if offline mode is enabled. Resources are
+// labeled with the key of the map.
+func CheckDownloadsCache(downloadDir *paths.Path, res map[string]*resources.DownloadResource) error {
+	if !IsOffline() {
+		return nil
+	}
+	missing := []string{}
+	for label, r := range res {
+		if r == nil {
+			continue
+		}
+		if cached, err := r.TestLocalArchiveIntegrity(downloadDir); err != nil || !cached {
+			missing = append(missing, label)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return NetworkError(CodeDownloadFailed, nil, "offline mode enabled, the following archives are missing from the downloads cache: %s",
+		strings.Join(missing, ", "))
+}
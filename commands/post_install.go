@@ -0,0 +1,56 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/executils"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// RunPlatformPostInstall runs the post-install script of platformRelease, if
+// any, sending its output to taskCB. If the script fails platformRelease is
+// uninstalled, so it must be run before removing the release it replaces,
+// and the error is returned. When skip is true the script is not run and its
+// path is returned, so that it can be run later with the consent of the user.
+func RunPlatformPostInstall(ctx context.Context, pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, skip bool, taskCB TaskProgressCB) (*paths.Path, error) {
+	log := pm.Log.WithField("platform", platformRelease)
+	if skip {
+		log.Info("Skipping platform configuration (post_install run).")
+		taskCB(&rpc.TaskProgress{Message: tr("Skipping platform configuration")})
+		return pm.PostInstallScript(platformRelease), nil
+	}
+
+	log.Info("Running post_install script")
+	taskCB(&rpc.TaskProgress{Message: tr("Configuring platform")})
+	err := pm.RunPostInstallScript(ctx, platformRelease, func(line *executils.OutputLine) {
+		taskCB(&rpc.TaskProgress{Output: line.Text})
+	})
+	if err != nil {
+		log.WithError(err).Error("Post install failed")
+		taskCB(&rpc.TaskProgress{Message: tr("Error configuring platform, rolling back the installation of %s", platformRelease.String())})
+		if errUn := pm.UninstallPlatform(platformRelease); errUn != nil {
+			log.WithError(errUn).Error("Error rolling-back changes.")
+			taskCB(&rpc.TaskProgress{Message: tr("Error rolling-back changes: %s", errUn.Error())})
+		}
+		return nil, ToolFailureError(CodeInstallFailed, err, "configuring platform %s", platformRelease)
+	}
+	return nil, nil
+}
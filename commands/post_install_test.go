@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPlatformPostInstallRollback(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("post_install.sh is not run on Windows")
+	}
+	dataDir, err := paths.MkTempDir("", "test_post_install_rollback")
+	require.NoError(t, err)
+	defer dataDir.RemoveAll()
+	pm := packagemanager.NewPackageManager(dataDir, dataDir.Join("packages"), dataDir.Join("staging"), dataDir.Join("tmp"))
+
+	sourcesDir := dataDir.Join("src", "myarch")
+	require.NoError(t, sourcesDir.MkdirAll())
+	require.NoError(t, sourcesDir.Join("boards.txt").WriteFile([]byte("myboard.name=My board\n")))
+	require.NoError(t, sourcesDir.Join("platform.txt").WriteFile([]byte("name=My Architecture\nversion=1.0.0\n")))
+	require.NoError(t, sourcesDir.Join("post_install.sh").WriteFile([]byte("#!/bin/sh\necho failure\nexit 1\n")))
+	require.NoError(t, os.Chmod(sourcesDir.Join("post_install.sh").String(), 0755))
+	release, err := pm.InstallPlatformFromDir(sourcesDir, "mypackage", "myarch", nil)
+	require.NoError(t, err)
+	installDir := release.InstallDir
+	taskCB := func(*rpc.TaskProgress) {}
+
+	// A skipped script is returned to be run later
+	script, err := RunPlatformPostInstall(context.Background(), pm, release, true, taskCB)
+	require.NoError(t, err)
+	require.Equal(t, "post_install.sh", script.Base())
+	require.True(t, release.IsInstalled())
+
+	// A failed script rolls back the installation
+	output := []string{}
+	_, err = RunPlatformPostInstall(context.Background(), pm, release, false, func(progress *rpc.TaskProgress) {
+		if progress.GetOutput() != "" {
+			output = append(output, progress.GetOutput())
+		}
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "configuring platform mypackage:myarch@1.0.0")
+	require.Equal(t, []string{"failure"}, output)
+	require.False(t, release.IsInstalled())
+	require.False(t, installDir.Exist())
+	require.True(t, sourcesDir.Join("boards.txt").Exist())
+}
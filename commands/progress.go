@@ -15,10 +15,21 @@
 
 package commands
 
-import rpc "github.com/arduino/arduino-cli/rpc/commands"
+import (
+	"github.com/arduino/arduino-cli/arduino/resources"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
 
 // DownloadProgressCB is a callback to get updates on download progress
 type DownloadProgressCB func(curr *rpc.DownloadProgress)
 
 // TaskProgressCB is a callback to receive progress messages
 type TaskProgressCB func(msg *rpc.TaskProgress)
+
+// NewExtractProgressCB returns a callback that reports the progress of the
+// extraction of an archive as the progress of the task name
+func NewExtractProgressCB(name string, taskCB TaskProgressCB) resources.ExtractProgressCB {
+	return func(percent float32) {
+		taskCB(&rpc.TaskProgress{Name: name, Percent: percent})
+	}
+}
@@ -82,6 +82,21 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchReq) (*rpc.Archive
 	}
 	filesToZip.FilterOutDirs()
 
+	// Exclude the files matching the patterns in .gitignore and in the request
+	ignored, err := loadIgnoreFile(sketchPath.Parent().Join(".gitignore"))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading .gitignore: %v", err)
+	}
+	ignored = append(ignored, newIgnoreList(req.GetExclude())...)
+
+	var manifest []byte
+	if req.GetIncludeManifest() {
+		manifest, err = createArchiveManifest(req, sketchPath.Parent())
+		if err != nil {
+			return nil, fmt.Errorf("Error creating archive manifest: %v", err)
+		}
+	}
+
 	archive, err := archivePath.Create()
 	if err != nil {
 		return nil, fmt.Errorf("Error creating archive: %v", err)
@@ -105,6 +120,18 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchReq) (*rpc.Archive
 			}
 		}
 
+		relPath, err := sketchPath.Parent().RelTo(f)
+		if err != nil {
+			return nil, fmt.Errorf("Error calculating relative file path: %v", err)
+		}
+		if ignored.Match(filepath.ToSlash(relPath.String()), false) {
+			continue
+		}
+		// The manifest is generated, an existing one would be stale
+		if manifest != nil && relPath.String() == manifestFileName {
+			continue
+		}
+
 		// We get the parent path since we want the archive to unpack as a folder.
 		// If we don't do this the archive would contain all the sketch files as top level.
 		err = addFileToSketchArchive(zipWriter, f, sketchPath.Parent().Parent())
@@ -113,6 +140,16 @@ func ArchiveSketch(ctx context.Context, req *rpc.ArchiveSketchReq) (*rpc.Archive
 		}
 	}
 
+	if manifest != nil {
+		writer, err := zipWriter.Create(sketchName + "/" + manifestFileName)
+		if err != nil {
+			return nil, fmt.Errorf("Error adding manifest to archive: %v", err)
+		}
+		if _, err := writer.Write(manifest); err != nil {
+			return nil, fmt.Errorf("Error adding manifest to archive: %v", err)
+		}
+	}
+
 	return &rpc.ArchiveSketchResp{}, nil
 }
 
@@ -0,0 +1,146 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// ignorePattern is a single .gitignore-style pattern
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// ignoreList is a list of .gitignore-style patterns, later patterns take
+// precedence over the earlier ones
+type ignoreList []*ignorePattern
+
+// loadIgnoreFile reads the patterns contained in a .gitignore-style file. A
+// missing file results in an empty list.
+func loadIgnoreFile(file *paths.Path) (ignoreList, error) {
+	if !file.Exist() {
+		return nil, nil
+	}
+	data, err := file.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{}
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return newIgnoreList(lines), nil
+}
+
+// newIgnoreList parses the given .gitignore-style patterns, skipping blank
+// lines and comments
+func newIgnoreList(patterns []string) ignoreList {
+	res := ignoreList{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		p := &ignorePattern{}
+		if strings.HasPrefix(pattern, "!") {
+			p.negate = true
+			pattern = pattern[1:]
+		}
+		if strings.HasSuffix(pattern, "/") {
+			p.dirOnly = true
+			pattern = strings.TrimSuffix(pattern, "/")
+		}
+		// Patterns without a slash match at any level, the others are
+		// relative to the sketch folder
+		anchored := strings.Contains(pattern, "/")
+		pattern = strings.TrimPrefix(pattern, "/")
+		expr := globToRegexp(pattern)
+		if !anchored {
+			expr = "(.*/)?" + expr
+		}
+		re, err := regexp.Compile("^" + expr + "$")
+		if err != nil {
+			continue
+		}
+		p.re = re
+		res = append(res, p)
+	}
+	return res
+}
+
+// globToRegexp converts a glob pattern, with support for `**`, to a regular expression
+func globToRegexp(glob string) string {
+	var expr strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if strings.HasPrefix(glob[i:], "**/") {
+				expr.WriteString("(.*/)?")
+				i += 2
+			} else if strings.HasPrefix(glob[i:], "**") {
+				expr.WriteString(".*")
+				i++
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		case '[':
+			if end := strings.IndexByte(glob[i:], ']'); end > 0 {
+				expr.WriteString(glob[i : i+end+1])
+				i += end
+			} else {
+				expr.WriteString(`\[`)
+			}
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return expr.String()
+}
+
+// Match returns true if the path, relative to the sketch folder and using
+// forward slashes, is excluded by the patterns. A file is excluded also if
+// any of its parent folders is.
+func (list ignoreList) Match(relPath string, isDir bool) bool {
+	parts := strings.Split(relPath, "/")
+	for i := 1; i < len(parts); i++ {
+		if list.matchSingle(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return list.matchSingle(relPath, isDir)
+}
+
+func (list ignoreList) matchSingle(relPath string, isDir bool) bool {
+	excluded := false
+	for _, p := range list {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.re.MatchString(relPath) {
+			excluded = !p.negate
+		}
+	}
+	return excluded
+}
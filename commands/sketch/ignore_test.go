@@ -0,0 +1,50 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreList(t *testing.T) {
+	list := newIgnoreList([]string{
+		"# comment",
+		"",
+		"*.bak",
+		"secrets/",
+		"/data/*.bin",
+		"!/data/keep.bin",
+		"docs/**/*.pdf",
+	})
+
+	require.True(t, list.Match("sketch.ino.bak", false))
+	require.True(t, list.Match("src/old/file.bak", false))
+	require.False(t, list.Match("sketch.ino", false))
+
+	require.True(t, list.Match("secrets/key.h", false))
+	require.True(t, list.Match("src/secrets/key.h", false))
+	require.False(t, list.Match("secrets", false))
+
+	require.True(t, list.Match("data/image.bin", false))
+	require.False(t, list.Match("data/keep.bin", false))
+	require.False(t, list.Match("src/data/image.bin", false))
+
+	require.True(t, list.Match("docs/manual.pdf", false))
+	require.True(t, list.Match("docs/a/b/manual.pdf", false))
+	require.False(t, list.Match("manual.pdf", false))
+}
@@ -0,0 +1,148 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+)
+
+// manifestFileName is the name of the manifest added to the sketch folder in the archive
+const manifestFileName = "manifest.json"
+
+// archiveManifest describes the platform and the libraries used by an archived sketch
+type archiveManifest struct {
+	Sketch    string                 `json:"sketch"`
+	FQBN      string                 `json:"fqbn,omitempty"`
+	Platform  *archiveManifestItem   `json:"platform,omitempty"`
+	Libraries []*archiveManifestItem `json:"libraries"`
+}
+
+type archiveManifestItem struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+var includeRegexp = regexp.MustCompile(`(?m)^\s*#\s*include\s*[<"](\S+)[">]`)
+
+var sourceExtensions = map[string]bool{
+	".ino": true, ".pde": true, ".h": true, ".hh": true, ".hpp": true,
+	".c": true, ".cpp": true, ".cc": true, ".cxx": true, ".S": true,
+}
+
+// createArchiveManifest returns the JSON encoded manifest of the sketch in sketchDir
+func createArchiveManifest(req *rpc.ArchiveSketchReq, sketchDir *paths.Path) ([]byte, error) {
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, errors.New("invalid instance")
+	}
+	lm := commands.GetLibraryManager(req.GetInstance().GetId())
+	if lm == nil {
+		return nil, errors.New("invalid instance")
+	}
+
+	manifest := &archiveManifest{
+		Sketch:    sketchDir.Base(),
+		Libraries: []*archiveManifestItem{},
+	}
+
+	fqbnIn := req.GetFqbn()
+	if fqbnIn == "" {
+		if sketch, err := sketches.NewSketchFromPath(sketchDir); err == nil && sketch.Metadata != nil {
+			fqbnIn = sketch.Metadata.CPU.Fqbn
+		}
+	}
+	if fqbnIn == "" {
+		fqbnIn = viper.GetString("build.default_fqbn")
+	}
+	architecture := ""
+	if fqbnIn != "" {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		if err != nil {
+			return nil, fmt.Errorf("parsing fqbn: %s", err)
+		}
+		_, platformRelease, _, _, _, err := pm.ResolveFQBN(fqbn)
+		if err != nil {
+			return nil, fmt.Errorf("resolving fqbn: %s", err)
+		}
+		manifest.FQBN = fqbn.String()
+		manifest.Platform = &archiveManifestItem{
+			Name:    platformRelease.Platform.String(),
+			Version: platformRelease.Version.String(),
+		}
+		architecture = fqbn.PlatformArch
+	}
+
+	// Resolve the libraries providing the headers included by the sketch
+	resolver := librariesresolver.NewCppResolver()
+	if err := resolver.ScanFromLibrariesManager(lm); err != nil {
+		return nil, fmt.Errorf("scanning libraries: %s", err)
+	}
+	files, err := sketchDir.ReadDirRecursive()
+	if err != nil {
+		return nil, err
+	}
+	files.FilterOutDirs()
+	localHeaders := map[string]bool{}
+	for _, file := range files {
+		localHeaders[file.Base()] = true
+	}
+	used := map[string]*archiveManifestItem{}
+	for _, file := range files {
+		if !sourceExtensions[file.Ext()] {
+			continue
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			return nil, err
+		}
+		for _, include := range includeRegexp.FindAllStringSubmatch(string(data), -1) {
+			header := include[1]
+			if localHeaders[paths.New(header).Base()] {
+				continue
+			}
+			lib := resolver.ResolveFor(header, architecture)
+			if lib == nil {
+				// provided by the platform or the toolchain
+				continue
+			}
+			version := ""
+			if lib.Version != nil {
+				version = lib.Version.String()
+			}
+			used[lib.Name] = &archiveManifestItem{Name: lib.Name, Version: version}
+		}
+	}
+	for _, item := range used {
+		manifest.Libraries = append(manifest.Libraries, item)
+	}
+	sort.Slice(manifest.Libraries, func(i, j int) bool {
+		return manifest.Libraries[i].Name < manifest.Libraries[j].Name
+	})
+
+	return json.MarshalIndent(manifest, "", "  ")
+}
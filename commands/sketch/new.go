@@ -0,0 +1,199 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/spf13/viper"
+)
+
+var emptySketch = []byte(`
+void setup() {
+}
+
+void loop() {
+}
+`)
+
+// NewSketch creates a new Sketch named req.SketchName inside req.SketchDir,
+// scaffolding it from the built-in template or from the template specified
+// in req.Template
+func NewSketch(ctx context.Context, req *rpc.NewSketchReq) (*rpc.NewSketchResp, error) {
+	// Trim to avoid issues if user creates a sketch adding the .ino extesion to the name
+	sketchName := strings.TrimSuffix(req.GetSketchName(), ".ino")
+	if sketchName == "" {
+		return nil, fmt.Errorf("sketch name cannot be empty")
+	}
+
+	sketchDir := req.GetSketchDir()
+	if sketchDir == "" {
+		sketchDir = viper.GetString("directories.User")
+	}
+	sketchDir, err := filepath.Abs(filepath.Join(sketchDir, sketchName))
+	if err != nil {
+		return nil, fmt.Errorf("getting absolute sketch path: %v", err)
+	}
+	sketchName = filepath.Base(sketchDir)
+
+	mainFile := filepath.Join(sketchDir, sketchName+".ino")
+	if _, err := os.Stat(mainFile); err == nil {
+		return nil, fmt.Errorf("sketch already exists: %s", mainFile)
+	}
+
+	author := req.GetAuthor()
+	if author == "" {
+		author = currentUser()
+	}
+	replacer := strings.NewReplacer(
+		"{{name}}", sketchName,
+		"{{author}}", author,
+		"{{date}}", time.Now().Format("2006-01-02"),
+	)
+
+	if err := os.MkdirAll(sketchDir, os.FileMode(0755)); err != nil {
+		return nil, fmt.Errorf("could not create sketch directory: %v", err)
+	}
+
+	if req.GetTemplate() == "" {
+		if err := ioutil.WriteFile(mainFile, emptySketch, os.FileMode(0644)); err != nil {
+			return nil, fmt.Errorf("creating sketch: %v", err)
+		}
+		return &rpc.NewSketchResp{MainFile: mainFile}, nil
+	}
+
+	templateDir, err := findSketchTemplate(req.GetTemplate())
+	if err != nil {
+		return nil, err
+	}
+	if err := copySketchTemplate(templateDir, sketchDir, sketchName, replacer); err != nil {
+		return nil, fmt.Errorf("creating sketch from template: %v", err)
+	}
+	return &rpc.NewSketchResp{MainFile: mainFile}, nil
+}
+
+// sketchTemplatesDir returns the folder containing the user's Sketch templates
+func sketchTemplatesDir() string {
+	return filepath.Join(viper.GetString("directories.User"), "templates")
+}
+
+// findSketchTemplate returns the folder of the template with the given name,
+// the name can be either a template in the user's templates folder or a path
+// to a template folder
+func findSketchTemplate(name string) (string, error) {
+	if info, err := os.Stat(name); err == nil && info.IsDir() {
+		return name, nil
+	}
+	templatesDir := sketchTemplatesDir()
+	dir := filepath.Join(templatesDir, name)
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		return dir, nil
+	}
+
+	available := []string{}
+	if entries, err := ioutil.ReadDir(templatesDir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				available = append(available, entry.Name())
+			}
+		}
+	}
+	sort.Strings(available)
+	if len(available) == 0 {
+		return "", fmt.Errorf("template '%s' not found, no templates available in %s", name, templatesDir)
+	}
+	return "", fmt.Errorf("template '%s' not found, available templates: %s", name, strings.Join(available, ", "))
+}
+
+// copySketchTemplate copies all the files in templateDir into sketchDir,
+// the .ino file in the root of the template is renamed after the Sketch and
+// placeholders are replaced both in file names and in text files contents
+func copySketchTemplate(templateDir, sketchDir, sketchName string, replacer *strings.Replacer) error {
+	rootInos, err := filepath.Glob(filepath.Join(templateDir, "*.ino"))
+	if err != nil {
+		return err
+	}
+	if len(rootInos) > 1 {
+		return fmt.Errorf("template %s contains more than one .ino file", templateDir)
+	}
+	mainTemplate := ""
+	if len(rootInos) == 1 {
+		mainTemplate = rootInos[0]
+	}
+
+	copied := false
+	err = filepath.Walk(templateDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(templateDir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		target := filepath.Join(sketchDir, replacer.Replace(rel))
+		if path == mainTemplate {
+			target = filepath.Join(sketchDir, sketchName+".ino")
+			copied = true
+		}
+		if info.IsDir() {
+			return os.MkdirAll(target, os.FileMode(0755))
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if utf8.Valid(data) {
+			data = []byte(replacer.Replace(string(data)))
+		}
+		return ioutil.WriteFile(target, data, info.Mode().Perm())
+	})
+	if err != nil {
+		return err
+	}
+
+	if !copied {
+		// Templates without a main file get the empty one
+		return ioutil.WriteFile(filepath.Join(sketchDir, sketchName+".ino"), emptySketch, os.FileMode(0644))
+	}
+	return nil
+}
+
+// currentUser returns the name of the user running the process, or an empty
+// string if it can't be determined
+func currentUser() string {
+	u, err := user.Current()
+	if err != nil {
+		return ""
+	}
+	if u.Name != "" {
+		return u.Name
+	}
+	return u.Username
+}
@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSketch(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "sketch_new")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	viper.Set("directories.User", "testdata")
+	defer viper.Set("directories.User", "")
+
+	// Built-in template
+	resp, err := NewSketch(context.Background(), &rpc.NewSketchReq{SketchName: "Empty", SketchDir: tmp})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(tmp, "Empty", "Empty.ino"), resp.GetMainFile())
+	data, err := ioutil.ReadFile(resp.GetMainFile())
+	require.NoError(t, err)
+	require.Equal(t, emptySketch, data)
+
+	// The main file is never overwritten
+	_, err = NewSketch(context.Background(), &rpc.NewSketchReq{SketchName: "Empty.ino", SketchDir: tmp})
+	require.Error(t, err)
+
+	// User template
+	resp, err = NewSketch(context.Background(), &rpc.NewSketchReq{
+		SketchName: "Blinker",
+		SketchDir:  tmp,
+		Template:   "basic",
+		Author:     "Jane",
+	})
+	require.NoError(t, err)
+	data, err = ioutil.ReadFile(resp.GetMainFile())
+	require.NoError(t, err)
+	require.Contains(t, string(data), "// Blinker by Jane ("+time.Now().Format("2006-01-02")+")")
+	data, err = ioutil.ReadFile(filepath.Join(tmp, "Blinker", "src", "Blinker.cpp"))
+	require.NoError(t, err)
+	require.Equal(t, "#include \"Blinker.h\"\n", string(data))
+	require.NoFileExists(t, filepath.Join(tmp, "Blinker", "template.ino"))
+
+	// Template given as a path
+	_, err = NewSketch(context.Background(), &rpc.NewSketchReq{
+		SketchName: "FromPath",
+		SketchDir:  tmp,
+		Template:   filepath.Join("testdata", "templates", "basic"),
+	})
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(tmp, "FromPath", "FromPath.ino"))
+
+	// Unknown template
+	_, err = NewSketch(context.Background(), &rpc.NewSketchReq{SketchName: "Unknown", SketchDir: tmp, Template: "missing"})
+	require.EqualError(t, err, "template 'missing' not found, available templates: basic")
+}
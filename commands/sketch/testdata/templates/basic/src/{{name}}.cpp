@@ -0,0 +1 @@
+#include "{{name}}.h"
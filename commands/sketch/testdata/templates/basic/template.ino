@@ -0,0 +1,6 @@
+// {{name}} by {{author}} ({{date}})
+void setup() {
+}
+
+void loop() {
+}
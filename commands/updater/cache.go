@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package updater
+
+import (
+	"encoding/json"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// CacheDuration is how long the result of an update check is reused before
+// checking again
+const CacheDuration = 24 * time.Hour
+
+// cacheFileName is the name of the file, in the data directory, where the
+// result of the last update check is stored
+const cacheFileName = "updater_cache.json"
+
+// latestRelease is the latest release found in a release channel
+type latestRelease struct {
+	Channel   string    `json:"channel"`
+	Version   string    `json:"version"`
+	URL       string    `json:"url"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// loadCachedRelease returns the latest release of the channel stored in the
+// cache file, or nil if it's missing, invalid or older than CacheDuration
+func loadCachedRelease(cacheFile *paths.Path, channel string, now time.Time) *latestRelease {
+	data, err := cacheFile.ReadFile()
+	if err != nil {
+		return nil
+	}
+	var release latestRelease
+	if err := json.Unmarshal(data, &release); err != nil {
+		return nil
+	}
+	if release.Channel != channel || release.Version == "" || now.Sub(release.CheckedAt) > CacheDuration || now.Before(release.CheckedAt) {
+		return nil
+	}
+	return &release
+}
+
+// save stores the release in the cache file, marking it as checked now
+func (r *latestRelease) save(cacheFile *paths.Path) error {
+	r.CheckedAt = time.Now()
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if err := cacheFile.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return cacheFile.WriteFile(data)
+}
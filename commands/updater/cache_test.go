@@ -0,0 +1,48 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package updater
+
+import (
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedRelease(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "updater_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	cacheFile := tmp.Join("cache", cacheFileName)
+
+	require.Nil(t, loadCachedRelease(cacheFile, "stable", time.Now()))
+
+	release := &latestRelease{Channel: "stable", Version: "0.13.0", URL: "https://example.com/arduino-cli_0.13.0_Linux_64bit.tar.gz"}
+	require.NoError(t, release.save(cacheFile))
+	cached := loadCachedRelease(cacheFile, "stable", time.Now())
+	require.NotNil(t, cached)
+	require.Equal(t, "0.13.0", cached.Version)
+	require.Equal(t, release.URL, cached.URL)
+
+	// The cache is valid only for the same channel and for a day
+	require.Nil(t, loadCachedRelease(cacheFile, "nightly", time.Now()))
+	require.NotNil(t, loadCachedRelease(cacheFile, "stable", time.Now().Add(23*time.Hour)))
+	require.Nil(t, loadCachedRelease(cacheFile, "stable", time.Now().Add(25*time.Hour)))
+
+	require.NoError(t, cacheFile.WriteFile([]byte("not json")))
+	require.Nil(t, loadCachedRelease(cacheFile, "stable", time.Now()))
+}
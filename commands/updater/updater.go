@@ -0,0 +1,349 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package updater
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/httpclient"
+	"github.com/arduino/arduino-cli/i18n"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"go.bug.st/downloader/v2"
+	semver "go.bug.st/relaxed-semver"
+)
+
+var tr = i18n.Tr
+
+// DownloadURL is the base URL of the Arduino CLI releases
+var DownloadURL = "https://downloads.arduino.cc/arduino-cli/"
+
+// LatestReleaseURL is the URL of the JSON describing the latest stable
+// release of Arduino CLI
+var LatestReleaseURL = "https://api.github.com/repos/arduino/arduino-cli/releases/latest"
+
+// CheckForUpdates looks for the latest release of Arduino CLI available in
+// the requested channel and compares it with currentVersion. The result is
+// cached for CacheDuration, unless req.Force is set. The check can be
+// disabled with the updater.enable_check setting.
+func CheckForUpdates(ctx context.Context, req *rpc.CheckForUpdatesReq, currentVersion string) (*rpc.CheckForUpdatesResp, error) {
+	if !viper.GetBool("updater.enable_check") {
+		return nil, errors.New("the update check is disabled by the updater.enable_check setting")
+	}
+	channel, err := getChannel(req.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	cacheFile := paths.New(viper.GetString("directories.Data")).Join(cacheFileName)
+	var latest *latestRelease
+	if !req.GetForce() {
+		latest = loadCachedRelease(cacheFile, channel, time.Now())
+	}
+	if latest == nil {
+		if latest, err = findLatestRelease(ctx, channel); err != nil {
+			return nil, fmt.Errorf("checking for updates: %s", err)
+		}
+		if err := latest.save(cacheFile); err != nil {
+			logrus.WithError(err).Warn("Error caching the latest release")
+		}
+	}
+	return &rpc.CheckForUpdatesResp{
+		CurrentVersion:  currentVersion,
+		LatestVersion:   latest.Version,
+		UpdateAvailable: isNewer(latest.Version, currentVersion),
+		Url:             latest.URL,
+	}, nil
+}
+
+// SelfUpdate downloads the latest release of Arduino CLI available in the
+// requested channel, verifies its signature and replaces the running
+// executable with it. The replaced executable is kept with the `.old` suffix.
+func SelfUpdate(ctx context.Context, req *rpc.SelfUpdateReq, currentVersion string,
+	downloadCB commands.DownloadProgressCB, taskCB commands.TaskProgressCB) (*rpc.SelfUpdateResp, error) {
+
+	channel, err := getChannel(req.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	latest, err := findLatestRelease(ctx, channel)
+	if err != nil {
+		return nil, fmt.Errorf("checking for updates: %s", err)
+	}
+	if !isNewer(latest.Version, currentVersion) {
+		taskCB(&rpc.TaskProgress{Message: tr("Arduino CLI is already up to date"), Completed: true})
+		return &rpc.SelfUpdateResp{}, nil
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("finding the executable to update: %s", err)
+	}
+	executablePath := paths.New(executable)
+	if err := executablePath.FollowSymLink(); err != nil {
+		return nil, fmt.Errorf("finding the executable to update: %s", err)
+	}
+
+	tmp, err := paths.MkTempDir("", "arduino-cli-update")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir for the update: %s", err)
+	}
+	defer tmp.RemoveAll()
+
+	config, err := commands.GetDownloaderConfig()
+	if err != nil {
+		return nil, err
+	}
+	archiveName := path.Base(latest.URL)
+	archive := tmp.Join(archiveName)
+	if d, err := downloader.DownloadWithConfig(archive.String(), latest.URL, *config); err != nil {
+		return nil, fmt.Errorf("downloading %s: %s", latest.URL, err)
+	} else if err := commands.Download(ctx, d, archiveName, downloadCB); err != nil {
+		return nil, fmt.Errorf("downloading %s: %s", latest.URL, err)
+	}
+	// Releases must be signed, the update is refused if the signature is not available
+	signature := tmp.Join(archiveName + ".sig")
+	if d, err := downloader.DownloadWithConfig(signature.String(), latest.URL+".sig", *config); err != nil {
+		return nil, fmt.Errorf("downloading signature of %s: %s", latest.URL, err)
+	} else if err := commands.Download(ctx, d, archiveName+".sig", downloadCB); err != nil {
+		return nil, fmt.Errorf("downloading signature of %s: %s", latest.URL, err)
+	}
+
+	taskCB(&rpc.TaskProgress{Name: tr("Installing Arduino CLI %s", latest.Version)})
+	if trusted, _, err := security.VerifyArduinoDetachedSignature(archive, signature); err != nil {
+		return nil, fmt.Errorf("verifying signature of %s: %s", archiveName, err)
+	} else if !trusted {
+		return nil, fmt.Errorf("invalid signature of %s", archiveName)
+	}
+
+	extracted := tmp.Join("extracted")
+	if err := extractArchive(ctx, archive, extracted); err != nil {
+		return nil, fmt.Errorf("extracting %s: %s", archiveName, err)
+	}
+	newExecutable := extracted.Join(executableName())
+	if !newExecutable.Exist() {
+		return nil, fmt.Errorf("%s not found in %s", executableName(), archiveName)
+	}
+	if err := replaceExecutable(executablePath, newExecutable); err != nil {
+		return nil, fmt.Errorf("replacing %s: %s", executablePath, err)
+	}
+	taskCB(&rpc.TaskProgress{Message: tr("Arduino CLI updated to %s", latest.Version), Completed: true})
+	return &rpc.SelfUpdateResp{InstalledVersion: latest.Version}, nil
+}
+
+// getChannel returns the release channel to use, falling back to the
+// updater.channel setting
+func getChannel(channel string) (string, error) {
+	if channel == "" {
+		channel = viper.GetString("updater.channel")
+	}
+	switch channel {
+	case "", "stable":
+		return "stable", nil
+	case "nightly":
+		return "nightly", nil
+	}
+	return "", fmt.Errorf("invalid release channel: %s, must be stable or nightly", channel)
+}
+
+// archiveURL returns the URL of the archive of the given version for the
+// current OS and architecture
+func archiveURL(version string) (string, error) {
+	platform, err := archivePlatform(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+	return DownloadURL + "arduino-cli_" + version + "_" + platform, nil
+}
+
+// archivePlatform returns the suffix used in the name of the release archives
+// for the given OS and architecture, extension included
+func archivePlatform(goos, goarch string) (string, error) {
+	oses := map[string]string{"linux": "Linux", "darwin": "macOS", "windows": "Windows"}
+	archs := map[string]string{"amd64": "64bit", "386": "32bit", "arm": "ARMv7", "arm64": "ARM64"}
+	osName, osOk := oses[goos]
+	arch, archOk := archs[goarch]
+	if !osOk || !archOk || (goos != "linux" && (goarch == "arm" || goarch == "arm64")) {
+		return "", fmt.Errorf("no release available for %s/%s", goos, goarch)
+	}
+	if goos == "windows" {
+		return osName + "_" + arch + ".zip", nil
+	}
+	return osName + "_" + arch + ".tar.gz", nil
+}
+
+// findLatestRelease returns the latest release in the channel
+func findLatestRelease(ctx context.Context, channel string) (*latestRelease, error) {
+	if commands.IsOffline() {
+		return nil, errors.New("can't check for updates in offline mode")
+	}
+	client, err := httpclient.New()
+	if err != nil {
+		return nil, err
+	}
+	if channel == "nightly" {
+		return findLatestNightly(ctx, client)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, LatestReleaseURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from %s: %s", LatestReleaseURL, resp.Status)
+	}
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("decoding %s: %s", LatestReleaseURL, err)
+	}
+	if release.TagName == "" {
+		return nil, fmt.Errorf("decoding %s: missing release version", LatestReleaseURL)
+	}
+	version := strings.TrimPrefix(release.TagName, "v")
+	url, err := archiveURL(version)
+	if err != nil {
+		return nil, err
+	}
+	return &latestRelease{Channel: channel, Version: version, URL: url}, nil
+}
+
+// findLatestNightly returns the latest nightly build. Nightly builds are not
+// published as releases, but the `nightly-latest` archive URL redirects to the
+// archive of the actual build, whose file name contains the version.
+func findLatestNightly(ctx context.Context, client *http.Client) (*latestRelease, error) {
+	platform, err := archivePlatform(runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return nil, err
+	}
+	latestURL := DownloadURL + "nightly/arduino-cli_nightly-latest_" + platform
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, latestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected response from %s: %s", latestURL, resp.Status)
+	}
+	version, err := parseArchiveVersion(path.Base(location.Path), path.Base(latestURL))
+	if err != nil {
+		return nil, err
+	}
+	return &latestRelease{Channel: "nightly", Version: version, URL: location.String()}, nil
+}
+
+// parseArchiveVersion extracts the version from the name of a release
+// archive, e.g. `0.13.0` from `arduino-cli_0.13.0_Linux_64bit.tar.gz`. The
+// name of the `latest` archive is used to find the platform suffix.
+func parseArchiveVersion(archiveName, latestName string) (string, error) {
+	parts := strings.SplitN(latestName, "_", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid archive name: %s", latestName)
+	}
+	prefix, suffix := parts[0]+"_", "_"+parts[2]
+	if !strings.HasPrefix(archiveName, prefix) || !strings.HasSuffix(archiveName, suffix) || len(archiveName) <= len(prefix)+len(suffix) {
+		return "", fmt.Errorf("invalid archive name: %s", archiveName)
+	}
+	return archiveName[len(prefix) : len(archiveName)-len(suffix)], nil
+}
+
+// isNewer returns true if latest is newer than current. Versions that are not
+// comparable, e.g. nightly builds, are newer if they are different.
+func isNewer(latest, current string) bool {
+	latestVersion, err1 := semver.Parse(latest)
+	currentVersion, err2 := semver.Parse(current)
+	if err1 != nil || err2 != nil {
+		return latest != current
+	}
+	return latestVersion.GreaterThan(currentVersion)
+}
+
+func executableName() string {
+	if runtime.GOOS == "windows" {
+		return "arduino-cli.exe"
+	}
+	return "arduino-cli"
+}
+
+func extractArchive(ctx context.Context, archive, destDir *paths.Path) error {
+	file, err := os.Open(archive.String())
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return extract.Archive(ctx, file, destDir.String(), nil)
+}
+
+// replaceExecutable moves newExecutable in place of executable. The running
+// executable can't be overwritten on every OS, but it can be renamed, so it
+// is kept with the `.old` suffix until the next update.
+func replaceExecutable(executable, newExecutable *paths.Path) error {
+	// The new executable is copied next to the old one first, to rename it
+	// atomically even if the temp dir is on a different filesystem
+	staged := executable.Parent().Join(executable.Base() + ".new")
+	data, err := newExecutable.ReadFile()
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(staged.String(), data, 0755); err != nil {
+		return err
+	}
+	old := executable.Parent().Join(executable.Base() + ".old")
+	if old.Exist() {
+		if err := old.Remove(); err != nil {
+			staged.Remove()
+			return err
+		}
+	}
+	if err := executable.Rename(old); err != nil {
+		staged.Remove()
+		return err
+	}
+	if err := staged.Rename(executable); err != nil {
+		// Put the old executable back
+		old.Rename(executable)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package updater
+
+import (
+	"context"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivePlatform(t *testing.T) {
+	platform, err := archivePlatform("linux", "amd64")
+	require.NoError(t, err)
+	require.Equal(t, "Linux_64bit.tar.gz", platform)
+	platform, err = archivePlatform("linux", "arm64")
+	require.NoError(t, err)
+	require.Equal(t, "Linux_ARM64.tar.gz", platform)
+	platform, err = archivePlatform("darwin", "amd64")
+	require.NoError(t, err)
+	require.Equal(t, "macOS_64bit.tar.gz", platform)
+	platform, err = archivePlatform("windows", "386")
+	require.NoError(t, err)
+	require.Equal(t, "Windows_32bit.zip", platform)
+
+	_, err = archivePlatform("windows", "arm")
+	require.Error(t, err)
+	_, err = archivePlatform("freebsd", "amd64")
+	require.Error(t, err)
+}
+
+func TestParseArchiveVersion(t *testing.T) {
+	version, err := parseArchiveVersion("arduino-cli_0.13.0_Linux_64bit.tar.gz", "arduino-cli_latest_Linux_64bit.tar.gz")
+	require.NoError(t, err)
+	require.Equal(t, "0.13.0", version)
+	version, err = parseArchiveVersion("arduino-cli_nightly-20201016_Windows_64bit.zip", "arduino-cli_nightly-latest_Windows_64bit.zip")
+	require.NoError(t, err)
+	require.Equal(t, "nightly-20201016", version)
+
+	_, err = parseArchiveVersion("arduino-cli_0.13.0_Linux_32bit.tar.gz", "arduino-cli_latest_Linux_64bit.tar.gz")
+	require.Error(t, err)
+	_, err = parseArchiveVersion("arduino-cli__Linux_64bit.tar.gz", "arduino-cli_latest_Linux_64bit.tar.gz")
+	require.Error(t, err)
+}
+
+func TestIsNewer(t *testing.T) {
+	require.True(t, isNewer("0.13.0", "0.12.1"))
+	require.False(t, isNewer("0.12.1", "0.12.1"))
+	require.False(t, isNewer("0.12.1", "0.13.0"))
+	require.True(t, isNewer("nightly-20201016", "nightly-20201015"))
+	require.False(t, isNewer("nightly-20201016", "nightly-20201016"))
+}
+
+func TestGetChannel(t *testing.T) {
+	defer viper.Reset()
+
+	channel, err := getChannel("")
+	require.NoError(t, err)
+	require.Equal(t, "stable", channel)
+	viper.Set("updater.channel", "nightly")
+	channel, err = getChannel("")
+	require.NoError(t, err)
+	require.Equal(t, "nightly", channel)
+	channel, err = getChannel("stable")
+	require.NoError(t, err)
+	require.Equal(t, "stable", channel)
+	_, err = getChannel("beta")
+	require.Error(t, err)
+}
+
+func TestCheckForUpdatesDisabled(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("updater.enable_check", false)
+	_, err := CheckForUpdates(context.Background(), &rpc.CheckForUpdatesReq{}, "0.12.1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "updater.enable_check")
+}
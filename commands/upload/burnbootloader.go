@@ -33,8 +33,12 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderReq, outStream i
 		Trace("BurnBootloader started", req.GetFqbn())
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
 
-	err := runProgramAction(
+	_, err := runProgramAction(
+		ctx,
 		pm,
 		nil, // sketch
 		"",  // importFile
@@ -44,9 +48,12 @@ func BurnBootloader(ctx context.Context, req *rpc.BurnBootloaderReq, outStream i
 		req.GetProgrammer(),
 		req.GetVerbose(),
 		req.GetVerify(),
-		true, // burnBootloader
+		true,  // burnBootloader
+		false, // filesystem
+		false, // detectPortAfterUpload
 		outStream,
 		errStream,
+		nil, // taskCB
 	)
 	if err != nil {
 		return nil, err
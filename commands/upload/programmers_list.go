@@ -17,7 +17,7 @@ package upload
 
 import (
 	"context"
-	"fmt"
+	"sort"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/commands"
@@ -27,20 +27,23 @@ import (
 // ListProgrammersAvailableForUpload FIXMEDOC
 func ListProgrammersAvailableForUpload(ctx context.Context, req *rpc.ListProgrammersAvailableForUploadReq) (*rpc.ListProgrammersAvailableForUploadResp, error) {
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
 
 	fqbnIn := req.GetFqbn()
 	if fqbnIn == "" {
-		return nil, fmt.Errorf("no Fully Qualified Board Name provided")
+		return nil, commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "no Fully Qualified Board Name provided")
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "incorrect FQBN")
 	}
 
 	// Find target platforms
 	_, platform, _, _, refPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
-		return nil, fmt.Errorf("incorrect FQBN: %s", err)
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, err, "incorrect FQBN")
 	}
 
 	result := []*rpc.Programmer{}
@@ -49,6 +52,7 @@ func ListProgrammersAvailableForUpload(ctx context.Context, req *rpc.ListProgram
 			Id:       id,
 			Platform: programmer.PlatformRelease.String(),
 			Name:     programmer.Name,
+			Tool:     programmer.Properties.Get("program.tool"),
 		}
 	}
 	if refPlatform != platform {
@@ -59,6 +63,9 @@ func ListProgrammersAvailableForUpload(ctx context.Context, req *rpc.ListProgram
 	for id, programmer := range platform.Programmers {
 		result = append(result, createRPCProgrammer(id, programmer))
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
 
 	return &rpc.ListProgrammersAvailableForUploadResp{
 		Programmers: result,
@@ -0,0 +1,176 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+)
+
+// ProgressParser extracts the progress of an upload from the output of the
+// upload tool. It's called with the current line of the output, that may be
+// still incomplete, and returns the name of the current phase of the upload
+// and its percentage. ok is false if the line doesn't report any progress.
+type ProgressParser func(line string) (phase string, percent float32, ok bool)
+
+var progressParsers = map[string]ProgressParser{
+	"avrdude": parseAvrdudeProgress,
+	"bossac":  parseBossacProgress,
+	"esptool": parseEsptoolProgress,
+	"openocd": parseOpenocdProgress,
+}
+var progressParsersLock sync.Mutex
+
+// RegisterProgressParser sets the ProgressParser for the upload tool with the
+// given executable name, the extension of the executable is ignored.
+func RegisterProgressParser(tool string, parser ProgressParser) {
+	progressParsersLock.Lock()
+	defer progressParsersLock.Unlock()
+	progressParsers[tool] = parser
+}
+
+// findProgressParser returns the ProgressParser for the executable, or nil
+func findProgressParser(executable string) ProgressParser {
+	tool := executable[strings.LastIndexAny(executable, `/\`)+1:]
+	tool = strings.TrimSuffix(tool, filepath.Ext(tool))
+	progressParsersLock.Lock()
+	defer progressParsersLock.Unlock()
+	return progressParsers[tool]
+}
+
+// avrdude prints a bar of 50 hashes, a character at a time when the output is
+// not a terminal, e.g. `Writing | ################################################## | 100% 0.26s`
+var avrdudeProgress = regexp.MustCompile(`^(\w+) \| (#*)`)
+
+func parseAvrdudeProgress(line string) (string, float32, bool) {
+	m := avrdudeProgress.FindStringSubmatch(line)
+	if m == nil {
+		return "", 0, false
+	}
+	return m[1], float32(len(m[2]) * 2), true
+}
+
+// bossac prints `[==============================] 100% (205/205 pages)`
+var bossacProgress = regexp.MustCompile(`^\[[= ]*\] +(\d+)% \(\d+/\d+ pages\)`)
+
+func parseBossacProgress(line string) (string, float32, bool) {
+	m := bossacProgress.FindStringSubmatch(line)
+	if m == nil {
+		return "", 0, false
+	}
+	percent, _ := strconv.Atoi(m[1])
+	return "Writing", float32(percent), true
+}
+
+// esptool prints `Writing at 0x00010000... (14 %)`
+var esptoolProgress = regexp.MustCompile(`^(\w+) at 0x[0-9a-fA-F]+\.\.\. \((\d+) ?%\)`)
+
+func parseEsptoolProgress(line string) (string, float32, bool) {
+	m := esptoolProgress.FindStringSubmatch(line)
+	if m == nil {
+		return "", 0, false
+	}
+	percent, _ := strconv.Atoi(m[2])
+	return m[1], float32(percent), true
+}
+
+// openocd doesn't print the progress, only the start and the end of the
+// programming and of the verification
+func parseOpenocdProgress(line string) (string, float32, bool) {
+	switch strings.TrimSpace(line) {
+	case "** Programming Started **":
+		return "Programming", 0, true
+	case "** Programming Finished **":
+		return "Programming", 100, true
+	case "** Verify Started **":
+		return "Verifying", 0, true
+	case "** Verified OK **":
+		return "Verifying", 100, true
+	}
+	return "", 0, false
+}
+
+// progressReporter passes to taskCB the progress parsed from the output of an
+// upload tool, each change of the progress is reported once.
+type progressReporter struct {
+	parser      ProgressParser
+	taskCB      commands.TaskProgressCB
+	lock        sync.Mutex
+	lastPhase   string
+	lastPercent float32
+	reported    bool
+}
+
+// writer returns an io.Writer to use as the stdout or the stderr of the tool
+func (r *progressReporter) writer() *progressWriter {
+	return &progressWriter{reporter: r}
+}
+
+func (r *progressReporter) parse(line string) {
+	phase, percent, ok := r.parser(line)
+	if !ok {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.reported && phase == r.lastPhase && percent == r.lastPercent {
+		return
+	}
+	r.reported = true
+	r.lastPhase = phase
+	r.lastPercent = percent
+	r.taskCB(&rpc.TaskProgress{Name: phase, Percent: percent})
+}
+
+// completed reports the end of the upload, if any progress has been reported
+func (r *progressReporter) completed() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.reported {
+		r.taskCB(&rpc.TaskProgress{Name: r.lastPhase, Percent: 100, Completed: true})
+	}
+}
+
+// progressWriter splits the output of the tool into lines, the incomplete
+// line is parsed too at the end of each write, since some tools print their
+// progress bar a character at a time.
+type progressWriter struct {
+	reporter *progressReporter
+	line     []byte
+}
+
+func (w *progressWriter) Write(data []byte) (int, error) {
+	for _, b := range data {
+		if b == '\n' || b == '\r' {
+			if len(w.line) > 0 {
+				w.reporter.parse(string(w.line))
+				w.line = w.line[:0]
+			}
+			continue
+		}
+		w.line = append(w.line, b)
+	}
+	if len(w.line) > 0 {
+		w.reporter.parse(string(w.line))
+	}
+	return len(data), nil
+}
@@ -0,0 +1,83 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressParsers(t *testing.T) {
+	require.NotNil(t, findProgressParser("/path/to/avrdude"))
+	require.NotNil(t, findProgressParser(`C:\path\to\bossac.exe`))
+	require.NotNil(t, findProgressParser("/path/to/esptool.py"))
+	require.Nil(t, findProgressParser("/path/to/unknown"))
+
+	type test struct {
+		parser  ProgressParser
+		line    string
+		ok      bool
+		phase   string
+		percent float32
+	}
+	tests := []test{
+		{parseAvrdudeProgress, "Writing | ", true, "Writing", 0},
+		{parseAvrdudeProgress, "Reading | ####################", true, "Reading", 40},
+		{parseAvrdudeProgress, "Writing | ################################################## | 100% 0.26s", true, "Writing", 100},
+		{parseAvrdudeProgress, "avrdude: 924 bytes of flash written", false, "", 0},
+		{parseBossacProgress, "[=====                         ] 18% (36/205 pages)", true, "Writing", 18},
+		{parseBossacProgress, "Write 13104 bytes to flash (205 pages)", false, "", 0},
+		{parseEsptoolProgress, "Writing at 0x00010000... (14 %)", true, "Writing", 14},
+		{parseEsptoolProgress, "Hash of data verified.", false, "", 0},
+		{parseOpenocdProgress, "** Programming Started **", true, "Programming", 0},
+		{parseOpenocdProgress, "** Verified OK **", true, "Verifying", 100},
+		{parseOpenocdProgress, "Info : flash size probed value 256", false, "", 0},
+	}
+	for _, test := range tests {
+		phase, percent, ok := test.parser(test.line)
+		require.Equal(t, test.ok, ok, test.line)
+		require.Equal(t, test.phase, phase, test.line)
+		require.Equal(t, test.percent, percent, test.line)
+	}
+}
+
+func TestProgressReporter(t *testing.T) {
+	progress := []string{}
+	reporter := &progressReporter{
+		parser: parseAvrdudeProgress,
+		taskCB: func(p *rpc.TaskProgress) {
+			progress = append(progress, fmt.Sprintf("%s %v %v", p.GetName(), p.GetPercent(), p.GetCompleted()))
+		},
+	}
+	// avrdude prints the bar a character at a time when the output is not a terminal
+	w := reporter.writer()
+	w.Write([]byte("avrdude: writing flash (924 bytes):\n\nWriting | "))
+	w.Write([]byte("#"))
+	w.Write([]byte("#"))
+	w.Write([]byte(strings.Repeat("#", 48) + " | 100% 0.26s\n\n"))
+	reporter.completed()
+	require.Equal(t, []string{
+		"Writing 0 false",
+		"Writing 2 false",
+		"Writing 4 false",
+		"Writing 100 false",
+		"Writing 100 true",
+	}, progress)
+}
@@ -19,9 +19,9 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"net/url"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
@@ -34,11 +34,14 @@ import (
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"go.bug.st/serial"
 )
 
 // Upload FIXMEDOC
-func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStream io.Writer) (*rpc.UploadResp, error) {
+// The progress parsed from the output of the upload tool is passed to taskCB,
+// if not nil.
+func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStream io.Writer, taskCB commands.TaskProgressCB) (*rpc.UploadResp, error) {
 	logrus.Tracef("Upload %s on %s started", req.GetSketchPath(), req.GetFqbn())
 
 	// TODO: make a generic function to extract sketch from request
@@ -46,12 +49,16 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 	sketchPath := paths.New(req.GetSketchPath())
 	sketch, err := sketches.NewSketchFromPath(sketchPath)
 	if err != nil && req.GetImportDir() == "" && req.GetImportFile() == "" {
-		return nil, fmt.Errorf("opening sketch: %s", err)
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidSketch, err, "opening sketch")
 	}
 
 	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return nil, commands.ErrInvalidInstance
+	}
 
-	err = runProgramAction(
+	boardPort, err := runProgramAction(
+		ctx,
 		pm,
 		sketch,
 		req.GetImportFile(),
@@ -62,35 +69,44 @@ func Upload(ctx context.Context, req *rpc.UploadReq, outStream io.Writer, errStr
 		req.GetVerbose(),
 		req.GetVerify(),
 		false, // burnBootloader
+		req.GetFilesystem(),
+		req.GetDetectPortAfterUpload(),
 		outStream,
 		errStream,
+		taskCB,
 	)
 	if err != nil {
 		return nil, err
 	}
-	return &rpc.UploadResp{}, nil
+	return &rpc.UploadResp{Port: boardPort}, nil
 }
 
-func runProgramAction(pm *packagemanager.PackageManager,
+func runProgramAction(ctx context.Context, pm *packagemanager.PackageManager,
 	sketch *sketches.Sketch,
 	importFile, importDir, fqbnIn, port string,
 	programmerID string,
-	verbose, verify, burnBootloader bool,
-	outStream, errStream io.Writer) error {
+	verbose, verify, burnBootloader, filesystem, detectPortAfterUpload bool,
+	outStream, errStream io.Writer, taskCB commands.TaskProgressCB) (string, error) {
 
 	if burnBootloader && programmerID == "" {
-		return fmt.Errorf("no programmer specified for burning bootloader")
+		return "", commands.InvalidArgumentError(commands.CodeProgrammerNotFound, nil, "no programmer specified for burning bootloader")
+	}
+	if filesystem && programmerID != "" {
+		return "", commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "the filesystem image can't be uploaded with a programmer")
 	}
 
 	// FIXME: make a specification on how a port is specified via command line
 	if port == "" && sketch != nil && sketch.Metadata != nil {
-		deviceURI, err := url.Parse(sketch.Metadata.CPU.Port)
+		attachedPort, err := sketch.Metadata.CPU.PortSelector()
 		if err != nil {
-			return fmt.Errorf("invalid Device URL format: %s", err)
-		}
-		if deviceURI.Scheme == "serial" {
-			port = deviceURI.Host + deviceURI.Path
+			return "", err
 		}
+		port = attachedPort
+	}
+	// Find the port of the "serial://<serial number>" selectors
+	port, err := commands.ResolvePort(ctx, pm, port)
+	if err != nil {
+		return "", err
 	}
 	logrus.WithField("port", port).Tracef("Upload port")
 
@@ -98,18 +114,33 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		fqbnIn = sketch.Metadata.CPU.Fqbn
 	}
 	if fqbnIn == "" {
-		return fmt.Errorf("no Fully Qualified Board Name provided")
+		fqbnIn = viper.GetString("build.default_fqbn")
+	}
+	if fqbnIn == "" && port != "" {
+		ports, err := commands.ListBoards(ctx, pm)
+		if err != nil {
+			return "", fmt.Errorf("detecting board: %w", err)
+		}
+		board, err := identifyBoardOnPort(pm, ports, port)
+		if err != nil {
+			return "", err
+		}
+		fqbnIn = board.FQBN()
+		outStream.Write([]byte(fmt.Sprintf("Detected board %s (%s) on port %s\n", board.Name(), fqbnIn, port)))
+	}
+	if fqbnIn == "" {
+		return "", commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "no Fully Qualified Board Name provided")
 	}
 	fqbn, err := cores.ParseFQBN(fqbnIn)
 	if err != nil {
-		return fmt.Errorf("incorrect FQBN: %s", err)
+		return "", commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "incorrect FQBN")
 	}
 	logrus.WithField("fqbn", fqbn).Tracef("Detected FQBN")
 
 	// Find target board and board properties
 	_, boardPlatform, board, boardProperties, buildPlatform, err := pm.ResolveFQBN(fqbn)
 	if err != nil {
-		return fmt.Errorf("incorrect FQBN: %s", err)
+		return "", commands.NotFoundError(commands.CodeBoardNotFound, err, "incorrect FQBN")
 	}
 	logrus.
 		WithField("boardPlatform", boardPlatform).
@@ -126,7 +157,7 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		uploadToolName = boardProperties.Get("bootloader.tool")
 		uploadToolPlatform = boardPlatform
 		if uploadToolName == "" {
-			return fmt.Errorf("cannot get programmer tool: undefined 'bootloader.tool' in boards.txt")
+			return "", commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "cannot get programmer tool: undefined 'bootloader.tool' in boards.txt")
 		}
 		logrus.
 			WithField("uploadToolName", uploadToolName).
@@ -141,12 +172,12 @@ func runProgramAction(pm *packagemanager.PackageManager,
 			programmer = buildPlatform.Programmers[programmerID]
 		}
 		if programmer == nil {
-			return fmt.Errorf("programmer '%s' not available", programmerID)
+			return "", commands.NotFoundError(commands.CodeProgrammerNotFound, nil, "programmer '%s' not available", programmerID)
 		}
 		uploadToolName = programmer.Properties.Get("program.tool")
 		uploadToolPlatform = programmer.PlatformRelease
 		if uploadToolName == "" {
-			return fmt.Errorf("cannot get programmer tool: undefined 'program.tool' property")
+			return "", commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "cannot get programmer tool: undefined 'program.tool' property")
 		}
 		logrus.
 			WithField("uploadToolName", uploadToolName).
@@ -156,10 +187,10 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		uploadToolName = boardProperties.Get("upload.tool")
 		uploadToolPlatform = boardPlatform
 		if uploadToolName == "" {
-			return fmt.Errorf("cannot get upload tool: undefined 'upload.tool' property")
+			return "", commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "cannot get upload tool: undefined 'upload.tool' property")
 		}
 		if split := strings.Split(uploadToolName, ":"); len(split) > 2 {
-			return fmt.Errorf("invalid 'upload.tool' property: %s", uploadToolName)
+			return "", commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "invalid 'upload.tool' property: %s", uploadToolName)
 		} else if len(split) == 2 {
 			uploadToolName = split[1]
 			uploadToolPlatform = pm.GetInstalledPlatformRelease(
@@ -206,6 +237,9 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if v, ok := uploadProperties.GetOk("upload.params.verbose"); ok {
 			uploadProperties.Set("upload.verbose", v)
 		}
+		if v, ok := uploadProperties.GetOk("upload_fs.params.verbose"); ok {
+			uploadProperties.Set("upload_fs.verbose", v)
+		}
 		if v, ok := uploadProperties.GetOk("program.params.verbose"); ok {
 			uploadProperties.Set("program.verbose", v)
 		}
@@ -219,6 +253,9 @@ func runProgramAction(pm *packagemanager.PackageManager,
 		if v, ok := uploadProperties.GetOk("upload.params.quiet"); ok {
 			uploadProperties.Set("upload.verbose", v)
 		}
+		if v, ok := uploadProperties.GetOk("upload_fs.params.quiet"); ok {
+			uploadProperties.Set("upload_fs.verbose", v)
+		}
 		if v, ok := uploadProperties.GetOk("program.params.quiet"); ok {
 			uploadProperties.Set("program.verbose", v)
 		}
@@ -231,73 +268,67 @@ func runProgramAction(pm *packagemanager.PackageManager,
 	}
 
 	// Set properties for verify
-	if verify {
-		uploadProperties.Set("upload.verify", uploadProperties.Get("upload.params.verify"))
-		uploadProperties.Set("program.verify", uploadProperties.Get("program.params.verify"))
-	} else {
-		uploadProperties.Set("upload.verify", uploadProperties.Get("upload.params.noverify"))
-		uploadProperties.Set("program.verify", uploadProperties.Get("program.params.noverify"))
+	action := "upload"
+	if burnBootloader {
+		action = "bootloader"
+	} else if programmer != nil {
+		action = "program"
+	} else if filesystem {
+		action = "upload_fs"
+	}
+	if !setVerifyProperties(uploadProperties, verify, action) {
+		errStream.Write([]byte(fmt.Sprintf("Warning: the %s tool doesn't support verification, the %s will not be verified\n",
+			uploadToolName, action)))
 	}
 
 	if !burnBootloader {
 		importPath, sketchName, err := determineBuildPathAndSketchName(importFile, importDir, sketch, fqbn)
 		if err != nil {
-			return errors.Errorf("retrieving build artifacts: %s", err)
+			return "", fmt.Errorf("retrieving build artifacts: %w", err)
 		}
 		if !importPath.Exist() {
-			return fmt.Errorf("compiled sketch not found in %s", importPath)
+			return "", commands.NotFoundError(commands.CodeBuildArtifactMissing, nil, "compiled sketch not found in %s", importPath)
 		}
 		if !importPath.IsDir() {
-			return fmt.Errorf("expected compiled sketch in directory %s, but is a file instead", importPath)
+			return "", commands.InvalidArgumentError(commands.CodeBuildArtifactMissing, nil, "expected compiled sketch in directory %s, but is a file instead", importPath)
 		}
 		uploadProperties.SetPath("build.path", importPath)
 		uploadProperties.Set("build.project_name", sketchName)
+
+		if filesystem {
+			if image := importPath.Join(sketchName + ".fs.bin"); image.NotExist() {
+				return "", commands.NotFoundError(commands.CodeBuildArtifactMissing, nil, "filesystem image %s not found, compile the sketch with --build-fs", image)
+			}
+			if !uploadProperties.ContainsKey("upload_fs.pattern") {
+				return "", commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "the %s tool can't upload the filesystem image, upload_fs.pattern is missing", uploadToolName)
+			}
+		}
 	}
 
 	// If not using programmer perform some action required
 	// to set the board in bootloader mode
 	actualPort := port
+	wait := false
 	if programmer == nil && !burnBootloader {
-		// Perform reset via 1200bps touch if requested
-		if uploadProperties.GetBoolean("upload.use_1200bps_touch") {
-			if port == "" {
-				return fmt.Errorf("no upload port provided")
-			}
-
-			ports, err := serial.GetPortsList()
-			if err != nil {
-				return fmt.Errorf("cannot get serial port list: %s", err)
-			}
-			for _, p := range ports {
-				if p == port {
-					if verbose {
-						outStream.Write([]byte(fmt.Sprintf("Performing 1200-bps touch reset on serial port %s", p)))
-						outStream.Write([]byte(fmt.Sprintln()))
-					}
-					logrus.Infof("Touching port %s at 1200bps", port)
-					if err := serialutils.TouchSerialPortAt1200bps(p); err != nil {
-						outStream.Write([]byte(fmt.Sprintf("Cannot perform port reset: %s", err)))
-						outStream.Write([]byte(fmt.Sprintln()))
-					}
-					break
-				}
-			}
+		touch := uploadProperties.GetBoolean("upload.use_1200bps_touch")
+		wait = uploadProperties.GetBoolean("upload.wait_for_upload_port")
+		actualPort, err = resetBoard(port, touch, wait, verbose, outStream)
+		if err != nil {
+			return "", err
 		}
+	}
 
-		// Wait for upload port if requested
-		if uploadProperties.GetBoolean("upload.wait_for_upload_port") {
-			if verbose {
-				outStream.Write([]byte(fmt.Sprintln("Waiting for upload port...")))
-			}
-
-			actualPort, err = serialutils.WaitForNewSerialPortOrDefaultTo(actualPort)
-			if err != nil {
-				return errors.WithMessage(err, "detecting serial port")
-			}
+	// The ports available before running the upload tool are needed to
+	// detect the port that appears when the board restarts after the upload
+	var portsBeforeUpload []string
+	if detectPortAfterUpload && wait {
+		portsBeforeUpload, err = serial.GetPortsList()
+		if err != nil {
+			return "", fmt.Errorf("cannot get serial port list: %s", err)
 		}
 	}
 
-	if port != "" {
+	if actualPort != "" {
 		// Set serial port property
 		uploadProperties.Set("serial.port", actualPort)
 		if strings.HasPrefix(actualPort, "/dev/") {
@@ -309,63 +340,108 @@ func runProgramAction(pm *packagemanager.PackageManager,
 
 	// Build recipe for upload
 	if burnBootloader {
-		if err := runTool("erase.pattern", uploadProperties, outStream, errStream, verbose); err != nil {
-			return fmt.Errorf("chip erase error: %s", err)
+		if err := runTool(ctx, "erase.pattern", uploadProperties, outStream, errStream, verbose, taskCB); err != nil {
+			return "", fmt.Errorf("chip erase error: %w", err)
 		}
-		if err := runTool("bootloader.pattern", uploadProperties, outStream, errStream, verbose); err != nil {
-			return fmt.Errorf("burn bootloader error: %s", err)
+		if err := runTool(ctx, "bootloader.pattern", uploadProperties, outStream, errStream, verbose, taskCB); err != nil {
+			return "", fmt.Errorf("burn bootloader error: %w", err)
 		}
 	} else if programmer != nil {
-		if err := runTool("program.pattern", uploadProperties, outStream, errStream, verbose); err != nil {
-			return fmt.Errorf("programming error: %s", err)
+		if err := runTool(ctx, "program.pattern", uploadProperties, outStream, errStream, verbose, taskCB); err != nil {
+			return "", fmt.Errorf("programming error: %w", err)
+		}
+	} else if filesystem {
+		if err := runTool(ctx, "upload_fs.pattern", uploadProperties, outStream, errStream, verbose, taskCB); err != nil {
+			return "", fmt.Errorf("uploading filesystem image error: %w", err)
 		}
 	} else {
-		if err := runTool("upload.pattern", uploadProperties, outStream, errStream, verbose); err != nil {
-			return fmt.Errorf("uploading error: %s", err)
+		if err := runTool(ctx, "upload.pattern", uploadProperties, outStream, errStream, verbose, taskCB); err != nil {
+			return "", fmt.Errorf("uploading error: %w", err)
 		}
 	}
 
 	logrus.Tracef("Upload successful")
-	return nil
+
+	// Boards that change port when entering the bootloader usually
+	// re-enumerate again when the uploaded sketch starts
+	if detectPortAfterUpload && wait {
+		if verbose {
+			outStream.Write([]byte(fmt.Sprintln("Waiting for board port...")))
+		}
+		boardPort, err := serialutils.WaitForUploadPort(port, portsBeforeUpload)
+		if err != nil {
+			return "", errors.WithMessage(err, "detecting board port after upload")
+		}
+		return boardPort, nil
+	}
+	return actualPort, nil
 }
 
-func runTool(recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool) error {
+// setVerifyProperties sets the "ACTION.verify" property of every upload action
+// to the "ACTION.params.verify" or "ACTION.params.noverify" value given by the
+// platform, like the Arduino IDE does. It returns false if the verification
+// has been requested but the given action doesn't support it.
+func setVerifyProperties(props *properties.Map, verify bool, action string) bool {
+	for _, a := range []string{"upload", "upload_fs", "program", "erase", "bootloader"} {
+		if verify {
+			props.Set(a+".verify", props.Get(a+".params.verify"))
+		} else {
+			props.Set(a+".verify", props.Get(a+".params.noverify"))
+		}
+	}
+	return !verify || props.ContainsKey(action+".params.verify")
+}
+
+func runTool(ctx context.Context, recipeID string, props *properties.Map, outStream, errStream io.Writer, verbose bool, taskCB commands.TaskProgressCB) error {
 	recipe, ok := props.GetOk(recipeID)
 	if !ok {
-		return fmt.Errorf("recipe not found '%s'", recipeID)
+		return commands.FailedPreconditionError(commands.CodeToolNotFound, nil, "recipe not found '%s'", recipeID)
 	}
 	if strings.TrimSpace(recipe) == "" {
 		return nil // Nothing to run
 	}
 	if props.IsPropertyMissingInExpandPropsInString("serial.port", recipe) {
-		return fmt.Errorf("no upload port provided")
+		return commands.InvalidArgumentError(commands.CodeMissingPort, nil, "no upload port provided")
 	}
 	cmdLine := props.ExpandPropsInString(recipe)
 	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
 	if err != nil {
-		return fmt.Errorf("invalid recipe '%s': %s", recipe, err)
+		return commands.FailedPreconditionError(commands.CodeToolNotFound, err, "invalid recipe '%s'", recipe)
 	}
 
 	// Run Tool
 	if verbose {
 		outStream.Write([]byte(fmt.Sprintln(cmdLine)))
 	}
-	cmd, err := executils.NewProcess(cmdArgs...)
+	cmd, err := executils.NewProcessWithContext(ctx, cmdArgs...)
 	if err != nil {
-		return fmt.Errorf("cannot execute upload tool: %s", err)
+		return commands.ToolFailureError(commands.CodeUploadFailed, err, "cannot execute upload tool")
 	}
 
-	cmd.RedirectStdoutTo(outStream)
-	cmd.RedirectStderrTo(errStream)
+	var progress *progressReporter
+	if parser := findProgressParser(cmdArgs[0]); parser != nil && taskCB != nil {
+		progress = &progressReporter{parser: parser, taskCB: taskCB}
+		cmd.RedirectStdoutTo(io.MultiWriter(outStream, progress.writer()))
+		cmd.RedirectStderrTo(io.MultiWriter(errStream, progress.writer()))
+	} else {
+		cmd.RedirectStdoutTo(outStream)
+		cmd.RedirectStderrTo(errStream)
+	}
 
 	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("cannot execute upload tool: %s", err)
+		return commands.ToolFailureError(commands.CodeUploadFailed, err, "cannot execute upload tool")
 	}
 
 	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("uploading error: %s", err)
+		if ctx.Err() != nil {
+			return fmt.Errorf("upload interrupted: %w", ctx.Err())
+		}
+		return commands.ToolFailureError(commands.CodeUploadFailed, err, "uploading error")
 	}
 
+	if progress != nil {
+		progress.completed()
+	}
 	return nil
 }
 
@@ -385,19 +461,29 @@ func determineBuildPathAndSketchName(importFile, importDir string, sketch *sketc
 	// Case 1: importFile flag has been specified
 	if importFile != "" {
 		if importDir != "" {
-			return nil, "", fmt.Errorf("importFile and importDir cannot be used together")
+			return nil, "", commands.InvalidArgumentError(commands.CodeInvalidArgument, nil, "importFile and importDir cannot be used together")
 		}
 
 		// We have a path like "path/to/my/build/SketchName.ino.bin". We are going to
 		// ignore the extension and set:
 		// - "build.path" as "path/to/my/build"
 		// - "build.project_name" as "SketchName.ino"
+		// The same applies to the "SketchName.ino.with_bootloader.bin" variant
+		// and to the filesystem image "SketchName.ino.fs.bin".
 
 		importFilePath := paths.New(importFile)
 		if !importFilePath.Exist() {
-			return nil, "", fmt.Errorf("binary file not found in %s", importFilePath)
+			return nil, "", commands.NotFoundError(commands.CodeBuildArtifactMissing, nil, "binary file not found in %s", importFilePath)
 		}
-		return importFilePath.Parent(), strings.TrimSuffix(importFilePath.Base(), importFilePath.Ext()), nil
+		if !importFilePath.IsDir() {
+			projectName := strings.TrimSuffix(importFilePath.Base(), importFilePath.Ext())
+			projectName = strings.TrimSuffix(projectName, ".with_bootloader")
+			projectName = strings.TrimSuffix(projectName, ".fs")
+			return importFilePath.Parent(), projectName, nil
+		}
+
+		// A directory has been given, treat it like importDir
+		importDir = importFile
 	}
 
 	if importDir != "" {
@@ -410,14 +496,14 @@ func determineBuildPathAndSketchName(importFile, importDir string, sketch *sketc
 		buildPath := paths.New(importDir)
 		sketchName, err := detectSketchNameFromBuildPath(buildPath)
 		if err != nil {
-			return nil, "", errors.Errorf("autodetect build artifact: %s", err)
+			return nil, "", fmt.Errorf("autodetect build artifact: %w", err)
 		}
 		return buildPath, sketchName, nil
 	}
 
 	// Case 3: nothing given...
 	if sketch == nil {
-		return nil, "", fmt.Errorf("no sketch or build directory/file specified")
+		return nil, "", commands.InvalidArgumentError(commands.CodeMissingSketchPath, nil, "no sketch or build directory/file specified")
 	}
 
 	// Case 4: only sketch specified. In this case we use the default sketch build path
@@ -426,7 +512,7 @@ func determineBuildPathAndSketchName(importFile, importDir string, sketch *sketc
 	// TODO: Create a function to obtain importPath from sketch
 	// Add FQBN (without configs part) to export path
 	if fqbn == nil {
-		return nil, "", fmt.Errorf("missing FQBN")
+		return nil, "", commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "missing FQBN")
 	}
 	fqbnSuffix := strings.Replace(fqbn.StringWithoutConfig(), ":", ".", -1)
 	return sketch.FullPath.Join("build").Join(fqbnSuffix), sketch.Name + ".ino", nil
@@ -463,7 +549,96 @@ func detectSketchNameFromBuildPath(buildPath *paths.Path) (string, error) {
 	}
 
 	if candidateName == "" {
-		return "", errors.New("could not find a valid build artifact")
+		return "", commands.NotFoundError(commands.CodeBuildArtifactMissing, nil, "could not find a valid build artifact")
 	}
 	return candidateName, nil
 }
+
+// portsChangeMutex serializes the resets of the boards that change port to
+// enter the bootloader: the new port is detected comparing the ports before
+// and after the reset, so concurrent uploads would mix up the ports.
+var portsChangeMutex sync.Mutex
+
+// resetBoard performs the 1200bps touch of port, if touch is true, and waits
+// for the upload port to appear, if wait is true. The port to use for the
+// upload is returned.
+func resetBoard(port string, touch, wait, verbose bool, outStream io.Writer) (string, error) {
+	if !touch && !wait {
+		return port, nil
+	}
+	portsChangeMutex.Lock()
+	defer portsChangeMutex.Unlock()
+
+	// The ports available before the reset are needed to detect
+	// the upload port that appears after it
+	ports, err := serial.GetPortsList()
+	if err != nil {
+		return "", fmt.Errorf("cannot get serial port list: %s", err)
+	}
+
+	// Perform reset via 1200bps touch if requested
+	if touch {
+		if port == "" {
+			return "", commands.InvalidArgumentError(commands.CodeMissingPort, nil, "no upload port provided")
+		}
+
+		for _, p := range ports {
+			if p == port {
+				if verbose {
+					outStream.Write([]byte(fmt.Sprintf("Performing 1200-bps touch reset on serial port %s", p)))
+					outStream.Write([]byte(fmt.Sprintln()))
+				}
+				logrus.Infof("Touching port %s at 1200bps", port)
+				if err := serialutils.TouchSerialPortAt1200bps(p); err != nil {
+					outStream.Write([]byte(fmt.Sprintf("Cannot perform port reset: %s", err)))
+					outStream.Write([]byte(fmt.Sprintln()))
+				}
+				break
+			}
+		}
+	}
+
+	// Wait for upload port if requested
+	actualPort := port
+	if wait {
+		if verbose {
+			outStream.Write([]byte(fmt.Sprintln("Waiting for upload port...")))
+		}
+
+		actualPort, err = serialutils.WaitForUploadPort(port, ports)
+		if err != nil {
+			return "", errors.WithMessage(err, "detecting serial port")
+		}
+		if verbose && actualPort != port {
+			outStream.Write([]byte(fmt.Sprintf("Upload port changed to %s", actualPort)))
+			outStream.Write([]byte(fmt.Sprintln()))
+		}
+	}
+	return actualPort, nil
+}
+
+// identifyBoardOnPort returns the installed board connected to the given port,
+// matching the identification properties reported by the discovery against
+// the boards.txt of the installed platforms. An error listing the candidates
+// is returned if the match is ambiguous.
+func identifyBoardOnPort(pm *packagemanager.PackageManager, ports []*commands.BoardPort, port string) (*cores.Board, error) {
+	for _, p := range ports {
+		if p.Address != port {
+			continue
+		}
+		boards := pm.IdentifyBoard(p.IdentificationPrefs)
+		switch len(boards) {
+		case 0:
+			return nil, commands.NotFoundError(commands.CodeBoardNotFound, nil, "the board connected to %s is not recognized by the installed platforms, please specify the FQBN", port)
+		case 1:
+			return boards[0], nil
+		}
+		candidates := []string{}
+		for _, board := range boards {
+			candidates = append(candidates, fmt.Sprintf("%s (%s)", board.FQBN(), board.Name()))
+		}
+		return nil, commands.InvalidArgumentError(commands.CodeMissingFQBN, nil, "multiple boards match the one connected to %s, please specify the FQBN, candidates are: %s",
+			port, strings.Join(candidates, ", "))
+	}
+	return nil, commands.NotFoundError(commands.CodeBoardNotFound, nil, "no board found on port %s, please specify the FQBN", port)
+}
@@ -0,0 +1,170 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package upload
+
+import (
+	"context"
+	"sync"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// UploadMultiple uploads the same binary to many boards at the same time.
+// The output, the progress and the result of each upload are passed to
+// respCB tagged with the port of the board, the calls to respCB are
+// serialized. The error returned is about the request, the uploads failures
+// are reported in the results.
+func UploadMultiple(ctx context.Context, req *rpc.UploadMultipleReq, respCB func(*rpc.UploadMultipleResp)) error {
+	logrus.Tracef("Upload %s on %v started", req.GetSketchPath(), req.GetPorts())
+
+	sketchPath := paths.New(req.GetSketchPath())
+	sketch, err := sketches.NewSketchFromPath(sketchPath)
+	if err != nil && req.GetImportDir() == "" && req.GetImportFile() == "" {
+		return commands.InvalidArgumentError(commands.CodeInvalidSketch, err, "opening sketch")
+	}
+
+	pm := commands.GetPackageManager(req.GetInstance().GetId())
+	if pm == nil {
+		return commands.ErrInvalidInstance
+	}
+
+	ports, err := uploadPorts(ctx, pm, req)
+	if err != nil {
+		return err
+	}
+	if len(ports) == 0 {
+		return commands.InvalidArgumentError(commands.CodeMissingPort, nil, "no upload port provided")
+	}
+
+	fqbn := req.GetFqbn()
+	if fqbn == "" {
+		fqbn = req.GetAllMatching()
+	}
+
+	var mutex sync.Mutex
+	send := func(resp *rpc.UploadMultipleResp) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		respCB(resp)
+	}
+
+	jobs := int(req.GetJobs())
+	if jobs <= 0 || jobs > len(ports) {
+		jobs = len(ports)
+	}
+	slots := make(chan bool, jobs)
+	var wg sync.WaitGroup
+	for _, port := range ports {
+		wg.Add(1)
+		go func(port string) {
+			defer wg.Done()
+			slots <- true
+			defer func() { <-slots }()
+
+			outStream := callbackWriter(func(data []byte) { send(&rpc.UploadMultipleResp{Port: port, OutStream: data}) })
+			errStream := callbackWriter(func(data []byte) { send(&rpc.UploadMultipleResp{Port: port, ErrStream: data}) })
+			taskCB := func(p *rpc.TaskProgress) { send(&rpc.UploadMultipleResp{Port: port, TaskProgress: p}) }
+			_, err := runProgramAction(
+				ctx,
+				pm,
+				sketch,
+				req.GetImportFile(),
+				req.GetImportDir(),
+				fqbn,
+				port,
+				req.GetProgrammer(),
+				req.GetVerbose(),
+				req.GetVerify(),
+				false, // burnBootloader
+				req.GetFilesystem(),
+				false, // detectPortAfterUpload
+				outStream,
+				errStream,
+				taskCB,
+			)
+			result := &rpc.UploadPortResult{Success: err == nil}
+			if err != nil {
+				result.Error = err.Error()
+			}
+			send(&rpc.UploadMultipleResp{Port: port, Result: result})
+		}(port)
+	}
+	wg.Wait()
+	return nil
+}
+
+// uploadPorts returns the addresses of the ports requested and, if
+// all_matching is set, of the ports of the connected boards identified by
+// that FQBN, without duplicates.
+func uploadPorts(ctx context.Context, pm *packagemanager.PackageManager, req *rpc.UploadMultipleReq) ([]string, error) {
+	res := []string{}
+	seen := map[string]bool{}
+	add := func(address string) {
+		if !seen[address] {
+			seen[address] = true
+			res = append(res, address)
+		}
+	}
+
+	for _, port := range req.GetPorts() {
+		address, err := commands.ResolvePort(ctx, pm, port)
+		if err != nil {
+			return nil, err
+		}
+		add(address)
+	}
+
+	if req.GetAllMatching() == "" {
+		return res, nil
+	}
+	fqbn, err := cores.ParseFQBN(req.GetAllMatching())
+	if err != nil {
+		return nil, commands.InvalidArgumentError(commands.CodeInvalidFQBN, err, "incorrect FQBN")
+	}
+	connected, err := commands.ListBoards(ctx, pm)
+	if err != nil {
+		return nil, commands.ToolFailureError(commands.CodePortNotFound, err, "detecting the connected boards")
+	}
+	matching := 0
+	for _, port := range connected {
+		for _, board := range pm.IdentifyBoard(port.IdentificationPrefs) {
+			if board.FQBN() == fqbn.StringWithoutConfig() {
+				add(port.Address)
+				matching++
+				break
+			}
+		}
+	}
+	if matching == 0 {
+		return nil, commands.NotFoundError(commands.CodeBoardNotFound, nil, "no connected board matches %s", fqbn.StringWithoutConfig())
+	}
+	return res, nil
+}
+
+// callbackWriter is an io.Writer that passes the data written to the
+// function, before returning
+type callbackWriter func(data []byte)
+
+func (w callbackWriter) Write(data []byte) (int, error) {
+	w(data)
+	return len(data), nil
+}
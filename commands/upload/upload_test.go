@@ -20,8 +20,11 @@ import (
 	"testing"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/commands"
 	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +101,14 @@ func TestDetermineBuildPathAndSketchName(t *testing.T) {
 		{"", "testdata/build_path_2", blonk, fqbn, "testdata/build_path_2", "Blink.ino", false},
 		// 15: error: used both importPath and importFile
 		{"testdata/build_path_2/Blink.ino.hex", "testdata/build_path_2", blonk, fqbn, "<nil>", "", true},
+		// 16: use importFile with bootloader to detect build.path and project_name
+		{"testdata/build_path_3/Blink.ino.with_bootloader.hex", "", nil, nil, "testdata/build_path_3", "Blink.ino", false},
+		// 17: importFile is a directory, use it as importPath
+		{"testdata/build_path_2", "", nil, fqbn, "testdata/build_path_2", "Blink.ino", false},
+		// 18: error: importFile doesn't exist
+		{"testdata/build_path_2/Missing.ino.hex", "", nil, fqbn, "<nil>", "", true},
+		// 19: use the filesystem image to detect build.path and project_name
+		{"testdata/build_path_2/Blink.ino.fs.bin", "", nil, nil, "testdata/build_path_2", "Blink.ino", false},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("SubTest%02d", i), func(t *testing.T) {
@@ -119,3 +130,61 @@ func TestDetermineBuildPathAndSketchName(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentifyBoardOnPort(t *testing.T) {
+	hardware := paths.New("testdata", "hardware")
+	pm := packagemanager.NewPackageManager(hardware, hardware, hardware, hardware)
+	require.NoError(t, pm.LoadHardwareFromDirectory(hardware))
+
+	newPort := func(address, vid, pid string) *commands.BoardPort {
+		return &commands.BoardPort{
+			Address: address,
+			IdentificationPrefs: properties.NewFromHashmap(map[string]string{
+				"vid": vid, "pid": pid,
+			}),
+		}
+	}
+	ports := []*commands.BoardPort{
+		newPort("/dev/ttyACM0", "0x1234", "0x0001"),
+		newPort("/dev/ttyACM1", "0x1234", "0x0002"),
+		newPort("/dev/ttyACM2", "0x5678", "0x0001"),
+	}
+
+	board, err := identifyBoardOnPort(pm, ports, "/dev/ttyACM0")
+	require.NoError(t, err)
+	require.Equal(t, "test:avr:one", board.FQBN())
+
+	_, err = identifyBoardOnPort(pm, ports, "/dev/ttyACM1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "test:avr:two (Board Two)")
+	require.Contains(t, err.Error(), "test:avr:three (Board Three)")
+
+	_, err = identifyBoardOnPort(pm, ports, "/dev/ttyACM2")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not recognized")
+
+	_, err = identifyBoardOnPort(pm, ports, "/dev/ttyACM3")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no board found")
+}
+
+func TestSetVerifyProperties(t *testing.T) {
+	props := properties.NewFromHashmap(map[string]string{
+		"upload.params.verify":       "",
+		"upload.params.noverify":     "-V",
+		"bootloader.params.verify":   "-v",
+		"bootloader.params.noverify": "-n",
+	})
+
+	require.True(t, setVerifyProperties(props, true, "upload"))
+	require.Equal(t, "", props.Get("upload.verify"))
+	require.Equal(t, "-v", props.Get("bootloader.verify"))
+	require.True(t, props.ContainsKey("program.verify"))
+
+	require.True(t, setVerifyProperties(props, false, "upload"))
+	require.Equal(t, "-V", props.Get("upload.verify"))
+	require.Equal(t, "-n", props.Get("bootloader.verify"))
+
+	require.False(t, setVerifyProperties(props, true, "program"))
+	require.True(t, setVerifyProperties(props, false, "program"))
+}
@@ -0,0 +1,138 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/arduino/arduino-cli/configuration"
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// watchDebounce is the time waited after the last filesystem event before
+// rescanning, so that a whole `git clone` or archive extraction triggers a
+// single rescan
+var watchDebounce = time.Second
+
+// Watch monitors the folders containing libraries and platforms, rescanning
+// the given instance and notifying eventCB every time something changes on
+// disk. It returns when ctx is cancelled.
+func Watch(ctx context.Context, req *rpc.WatchReq, eventCB func(*rpc.WatchResp)) error {
+	id := req.GetInstance().GetId()
+	if GetInstance(id) == nil {
+		return fmt.Errorf("invalid handle")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating filesystem watcher: %s", err)
+	}
+	defer watcher.Close()
+	addWatchedDirs(watcher, id)
+
+	changed := map[string]bool{}
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			changed[event.Name] = true
+			debounce = time.After(watchDebounce)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logrus.Warnf("Filesystem watcher: %s", err)
+		case <-debounce:
+			debounce = nil
+			changedPaths := []string{}
+			for path := range changed {
+				changedPaths = append(changedPaths, path)
+			}
+			sort.Strings(changedPaths)
+			changed = map[string]bool{}
+
+			logrus.WithField("paths", changedPaths).Info("Rescanning after filesystem changes")
+			res, err := Rescan(id)
+			if err != nil {
+				return err
+			}
+			// New folders may have been created in the meantime
+			addWatchedDirs(watcher, id)
+			eventCB(&rpc.WatchResp{
+				ChangedPaths:         changedPaths,
+				PlatformsIndexErrors: res.GetPlatformsIndexErrors(),
+				LibrariesIndexError:  res.GetLibrariesIndexError(),
+			})
+		}
+	}
+}
+
+// addWatchedDirs adds to the watcher the libraries folder with the root of
+// every library, the hardware folders down to the platforms releases and the
+// directories of the platforms linked with `core install --from-dir`
+func addWatchedDirs(watcher *fsnotify.Watcher, instanceID int32) {
+	watchTree(watcher, configuration.LibrariesDir().String(), 1)
+	for _, libDir := range configuration.AdditionalLibrariesDirs() {
+		watchTree(watcher, libDir.String(), 1)
+	}
+	for _, hwDir := range configuration.HardwareDirectories() {
+		// packages/PACKAGER/hardware/ARCH/VERSION or hardware/VENDOR/ARCH
+		watchTree(watcher, hwDir.String(), 3)
+	}
+	if pm := GetPackageManager(instanceID); pm != nil {
+		for _, platformRelease := range pm.InstalledPlatformReleases() {
+			if pm.IsLinkedPlatformRelease(platformRelease) {
+				// boards.txt, platform.txt and the other configuration files
+				watchTree(watcher, platformRelease.InstallDir.String(), 0)
+			}
+		}
+	}
+}
+
+// watchTree adds dir and its subfolders, up to the given depth, to watcher
+func watchTree(watcher *fsnotify.Watcher, dir string, depth int) {
+	if err := watcher.Add(dir); err != nil {
+		logrus.Debugf("Not watching %s: %s", dir, err)
+		return
+	}
+	if depth == 0 {
+		return
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			watchTree(watcher, filepath.Join(dir, file.Name()), depth-1)
+		}
+	}
+}
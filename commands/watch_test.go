@@ -0,0 +1,78 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/commands"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "test_watch")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	viper.Set("directories.Data", tmp.Join("data").String())
+	viper.Set("directories.Downloads", tmp.Join("staging").String())
+	viper.Set("directories.User", tmp.Join("user").String())
+	viper.Set("directories.LockTimeout", "1s")
+	defer viper.Reset()
+	librariesDir := tmp.Join("user", "libraries")
+	require.NoError(t, librariesDir.MkdirAll())
+
+	defer func(debounce time.Duration) { watchDebounce = debounce }(watchDebounce)
+	watchDebounce = 100 * time.Millisecond
+
+	const id = 1000
+	instancesMux.Lock()
+	instances[id] = &CoreInstance{getLibOnly: true}
+	instancesMux.Unlock()
+	defer func() {
+		instancesMux.Lock()
+		delete(instances, id)
+		instancesMux.Unlock()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan *rpc.WatchResp, 10)
+	done := make(chan error)
+	go func() {
+		done <- Watch(ctx, &rpc.WatchReq{Instance: &rpc.Instance{Id: id}}, func(resp *rpc.WatchResp) { events <- resp })
+	}()
+	// Give the watcher the time to start
+	time.Sleep(100 * time.Millisecond)
+
+	newLibrary := librariesDir.Join("NewLibrary")
+	start := time.Now()
+	require.NoError(t, newLibrary.Mkdir())
+	select {
+	case resp := <-events:
+		require.GreaterOrEqual(t, int64(time.Since(start)), int64(watchDebounce))
+		require.Equal(t, []string{newLibrary.String()}, resp.GetChangedPaths())
+	case err := <-done:
+		require.FailNow(t, "watch stopped", "%v", err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event after the libraries directory changed")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+}
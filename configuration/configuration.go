@@ -37,13 +37,10 @@ func Init(configPath string) {
 	jww.SetStdoutThreshold(jww.LevelFatal)
 	viper.SetConfigName("arduino-cli")
 
-	// Get default data path if none was provided
-	if configPath == "" {
-		configPath = getDefaultArduinoDataDir()
-	}
-
-	// Add paths where to search for a config file
-	viper.AddConfigPath(configPath)
+	// The global config file is searched in the default data path, a
+	// project config file (if provided) is layered on top of it
+	globalConfigPath := getDefaultArduinoDataDir()
+	viper.AddConfigPath(globalConfigPath)
 
 	// Bind env vars
 	viper.SetEnvPrefix("ARDUINO")
@@ -77,6 +74,116 @@ func Init(configPath string) {
 			feedback.Errorf("Error reading config file: %v", err)
 		}
 	}
+
+	// Merge the project config file, if any
+	if configPath != "" && configPath != globalConfigPath {
+		if err := mergeProjectConfig(configPath, projectSettings); err != nil {
+			feedback.Errorf("Error reading config file: %v", err)
+		}
+	}
+}
+
+// projectSettings are the settings that can be overridden by a project config
+// file, passed with --config-file or found in a sketch directory
+var projectSettings = []string{
+	"directories",
+	"board_manager.additional_urls",
+	"library_manager.additional_urls",
+	"build.default_fqbn",
+	"build.properties",
+	"build.warnings",
+	"build.sketch_include_dirs",
+	"build.metadata",
+}
+
+// projectConfigFile is the project config file merged on top of the global
+// one, if any
+var projectConfigFile string
+
+// ProjectConfigFile returns the path of the project config file merged on top
+// of the global one, or an empty string if there is none. The global config
+// file remains the one returned by viper.ConfigFileUsed, where the settings
+// are changed.
+func ProjectConfigFile() string {
+	return projectConfigFile
+}
+
+// MergeSketchConfig layers the settings of the config file found in the sketch
+// directory, if any, on top of the current configuration. Only the project
+// related settings (directories, additional URLs, default FQBN, build
+// properties, warnings level and sketch include dirs) are taken into account.
+func MergeSketchConfig(sketchPath *paths.Path) error {
+	if sketchPath == nil {
+		return nil
+	}
+	sketchDir, err := filepath.Abs(sketchPath.String())
+	if err != nil {
+		return err
+	}
+	if !sketchPath.IsDir() {
+		sketchDir = filepath.Dir(sketchDir)
+	}
+	if projectConfigFile != "" {
+		if configDir, err := filepath.Abs(filepath.Dir(projectConfigFile)); err == nil && configDir == sketchDir {
+			// already loaded as project config
+			return nil
+		}
+	}
+	return mergeProjectConfig(sketchDir, projectSettings)
+}
+
+// mergeProjectConfig reads the `arduino-cli` config file in the given
+// directory and merges the settings matching the allowed keys on top of the
+// current configuration.
+func mergeProjectConfig(configDir string, allowed []string) error {
+	project := viper.New()
+	project.SetConfigName("arduino-cli")
+	project.AddConfigPath(configDir)
+	if err := project.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+
+	settings := map[string]interface{}{}
+	for _, key := range project.AllKeys() {
+		if !isProjectSetting(key, allowed) {
+			logrus.Warnf("Setting %s is not allowed in project config file %s", key, project.ConfigFileUsed())
+			continue
+		}
+		setNested(settings, strings.Split(key, "."), project.Get(key))
+	}
+	if err := viper.MergeConfigMap(settings); err != nil {
+		return err
+	}
+
+	// The settings not allowed here would be ignored if changed in the
+	// project config file, the global one stays the one in use
+	projectConfigFile = project.ConfigFileUsed()
+	return nil
+}
+
+func isProjectSetting(key string, allowed []string) bool {
+	for _, setting := range allowed {
+		if key == setting || strings.HasPrefix(key, setting+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func setNested(settings map[string]interface{}, path []string, value interface{}) {
+	if len(path) == 1 {
+		settings[path[0]] = value
+		return
+	}
+	sub, ok := settings[path[0]].(map[string]interface{})
+	if !ok {
+		sub = map[string]interface{}{}
+		settings[path[0]] = sub
+	}
+	setNested(sub, path[1:], value)
 }
 
 // getDefaultArduinoDataDir returns the full path to the default arduino folder
@@ -22,6 +22,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,6 +59,73 @@ func TestSearchConfigTreeInParent(t *testing.T) {
 	require.Equal(t, tmp, searchConfigTree(target))
 }
 
+func TestMergeSketchConfig(t *testing.T) {
+	viper.Reset()
+	setDefaults("data", "user")
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+
+	sketchDir := filepath.Join(tmp, "Sketch")
+	require.NoError(t, os.MkdirAll(sketchDir, os.ModePerm))
+	err := ioutil.WriteFile(filepath.Join(sketchDir, "arduino-cli.yaml"), []byte(
+		"board_manager:\n"+
+			"  additional_urls:\n"+
+			"  - https://example.com/package_example_index.json\n"+
+			"build:\n"+
+			"  default_fqbn: arduino:avr:uno\n"+
+			"logging:\n"+
+			"  level: trace\n"), 0644)
+	require.NoError(t, err)
+
+	require.NoError(t, MergeSketchConfig(paths.New(sketchDir, "Sketch.ino")))
+	require.Equal(t, []string{"https://example.com/package_example_index.json"}, viper.GetStringSlice("board_manager.additional_urls"))
+	require.Equal(t, "arduino:avr:uno", viper.GetString("build.default_fqbn"))
+	// Settings not related to the project are ignored
+	require.Equal(t, "info", viper.GetString("logging.level"))
+	require.Equal(t, "data", viper.GetString("directories.Data"))
+
+	// No config file in the sketch directory is not an error
+	require.NoError(t, MergeSketchConfig(paths.New(tmp)))
+}
+
+func TestInitProjectConfig(t *testing.T) {
+	viper.Reset()
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+
+	err := ioutil.WriteFile(filepath.Join(tmp, "arduino-cli.yaml"), []byte(
+		"build:\n"+
+			"  default_fqbn: arduino:avr:uno\n"+
+			"logging:\n"+
+			"  level: trace\n"), 0644)
+	require.NoError(t, err)
+
+	Init(tmp)
+	require.Equal(t, "arduino:avr:uno", viper.GetString("build.default_fqbn"))
+	// The config file passed with --config-file is a project config file too
+	require.Equal(t, "info", viper.GetString("logging.level"))
+	require.Equal(t, filepath.Join(tmp, "arduino-cli.yaml"), ProjectConfigFile())
+	require.NotEqual(t, ProjectConfigFile(), viper.ConfigFileUsed())
+}
+
+func TestAdditionalDirectories(t *testing.T) {
+	tmp := paths.New(tmpDirOrDie())
+	defer tmp.RemoveAll()
+	for _, dir := range []string{"user/hardware", "shared1", "shared2"} {
+		require.NoError(t, tmp.Join(dir).MkdirAll())
+	}
+
+	viper.Reset()
+	viper.Set("IDE.Bundled", false)
+	viper.Set("directories.User", tmp.Join("user").String())
+	viper.Set("directories.additional_hardware", []string{tmp.Join("shared1").String(), tmp.Join("missing").String(), tmp.Join("shared2").String()})
+	viper.Set("directories.additional_libraries", []string{tmp.Join("shared2").String(), tmp.Join("shared1").String()})
+
+	// Platforms loaded later have precedence, missing directories are skipped
+	require.Equal(t, paths.NewPathList(tmp.Join("shared2").String(), tmp.Join("shared1").String(), tmp.Join("user", "hardware").String()), HardwareDirectories())
+	require.Equal(t, paths.NewPathList(tmp.Join("shared2").String(), tmp.Join("shared1").String()), AdditionalLibrariesDirs())
+}
+
 var result string
 
 func BenchmarkSearchConfigTree(b *testing.B) {
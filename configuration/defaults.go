@@ -17,27 +17,79 @@ package configuration
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
+// defaultValues holds the default value of every setting known to the CLI,
+// indexed by lowercase key. It is used as schema to validate settings keys.
+var defaultValues = map[string]interface{}{}
+
+func setDefault(key string, value interface{}) {
+	defaultValues[strings.ToLower(key)] = value
+	viper.SetDefault(key, value)
+}
+
 func setDefaults(dataDir, userDir string) {
+	// locale of the messages, detected from the OS if empty
+	setDefault("locale", "")
+
 	// logging
-	viper.SetDefault("logging.level", "info")
-	viper.SetDefault("logging.format", "text")
+	setDefault("logging.level", "info")
+	setDefault("logging.format", "text")
+	setDefault("logging.max_size", 0)
+	setDefault("logging.max_backups", 3)
 
 	// Boards Manager
-	viper.SetDefault("board_manager.additional_urls", []string{})
+	setDefault("board_manager.additional_urls", []string{})
+	setDefault("board_manager.trusted_keys", []string{})
+	setDefault("library_manager.additional_urls", []string{})
 
 	// arduino directories
-	viper.SetDefault("directories.Data", dataDir)
-	viper.SetDefault("directories.Downloads", filepath.Join(dataDir, "staging"))
-	viper.SetDefault("directories.User", userDir)
+	setDefault("directories.Data", dataDir)
+	setDefault("directories.Downloads", filepath.Join(dataDir, "staging"))
+	setDefault("directories.DownloadsMaxSize", "")
+	setDefault("directories.LockTimeout", "5m")
+	setDefault("directories.User", userDir)
+
+	// build settings
+	setDefault("build.default_fqbn", "")
+	setDefault("build.properties", []string{})
+	setDefault("build.jobs", 0)
+	setDefault("build.warnings", "none")
+	setDefault("build.compiler_launcher", "")
+	setDefault("build.compiler_launcher_env", []string{})
+	setDefault("build.sketch_include_dirs", []string{})
+	setDefault("build.metadata", []string{})
+
+	// debug settings
+	setDefault("debug.shutdown_timeout", "5s")
+
+	// index settings
+	setDefault("index.update_interval", "")
+
+	// network settings
+	setDefault("network.proxy", "")
+	setDefault("network.ca_certs", "")
+	setDefault("network.timeout", "")
+	setDefault("network.user_agent_ext", "")
+	setDefault("network.offline", false)
+	setDefault("network.connections", 4)
 
 	// daemon settings
-	viper.SetDefault("daemon.port", "50051")
+	setDefault("daemon.address", "127.0.0.1")
+	setDefault("daemon.port", "50051")
+	setDefault("daemon.socket", "")
+	setDefault("daemon.ssl_cert", "")
+	setDefault("daemon.ssl_key", "")
+	setDefault("daemon.token", "")
+
+	// updater settings
+	setDefault("updater.channel", "stable")
+	setDefault("updater.enable_check", true)
 
 	//telemetry settings
-	viper.SetDefault("telemetry.enabled", true)
-	viper.SetDefault("telemetry.addr", ":9090")
+	setDefault("telemetry.enabled", false)
+	setDefault("telemetry.addr", ":9090")
 }
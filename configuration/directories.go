@@ -39,6 +39,16 @@ func HardwareDirectories() paths.PathList {
 		}
 	}
 
+	// Platforms loaded later replace the ones with the same version already
+	// loaded, so the additional directories are added in reverse order of
+	// precedence and before the sketchbook
+	additionalDirs := viper.GetStringSlice("directories.additional_hardware")
+	for i := len(additionalDirs) - 1; i >= 0; i-- {
+		if hwDir := paths.New(additionalDirs[i]); hwDir.IsDir() {
+			res.Add(hwDir)
+		}
+	}
+
 	if viper.IsSet("directories.User") {
 		skDir := paths.New(viper.GetString("directories.User"))
 		hwDir := skDir.Join("hardware")
@@ -86,6 +96,17 @@ func LibrariesDir() *paths.Path {
 	return paths.New(viper.GetString("directories.User")).Join("libraries")
 }
 
+// AdditionalLibrariesDirs returns the directories containing shared libraries,
+// in order of precedence. The libraries in these directories are never
+// installed, replaced or removed by the CLI.
+func AdditionalLibrariesDirs() paths.PathList {
+	res := paths.PathList{}
+	for _, dir := range viper.GetStringSlice("directories.additional_libraries") {
+		res.Add(paths.New(dir))
+	}
+	return res
+}
+
 // PackagesDir returns the full path to the packages folder
 func PackagesDir() *paths.Path {
 	return paths.New(viper.GetString("directories.Data")).Join("packages")
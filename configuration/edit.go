@@ -0,0 +1,234 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SettingIsList returns true if the setting identified by key holds a list of
+// values. An error is returned if the key is not a known setting.
+func SettingIsList(key string) (bool, error) {
+	def, err := lookupDefault(key)
+	if err != nil {
+		return false, err
+	}
+	_, isList := def.([]string)
+	return isList, nil
+}
+
+// ValidateKey returns an error if key is not a known setting.
+func ValidateKey(key string) error {
+	_, err := lookupDefault(key)
+	return err
+}
+
+func lookupDefault(key string) (interface{}, error) {
+	def, ok := defaultValues[strings.ToLower(key)]
+	if !ok {
+		return nil, fmt.Errorf("unknown setting: %s", key)
+	}
+	return def, nil
+}
+
+// SetInFile sets the value of the setting identified by key in the given
+// configuration file. The file is created if it doesn't exist, comments and
+// order of the other settings are preserved.
+func SetInFile(configFile, key string, values []string) error {
+	def, err := lookupDefault(key)
+	if err != nil {
+		return err
+	}
+	return editConfigFile(configFile, key, func(node *yaml.Node) error {
+		switch def.(type) {
+		case []string:
+			setSequence(node, values)
+		case bool:
+			if len(values) != 1 {
+				return fmt.Errorf("setting %s requires exactly one value", key)
+			}
+			b, err := strconv.ParseBool(values[0])
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %s", key, values[0])
+			}
+			setScalar(node, "!!bool", strconv.FormatBool(b))
+		default:
+			if len(values) != 1 {
+				return fmt.Errorf("setting %s requires exactly one value", key)
+			}
+			setScalar(node, "!!str", values[0])
+		}
+		return nil
+	})
+}
+
+// AddToFile appends values to the list setting identified by key in the given
+// configuration file. Values already present in the list are not duplicated.
+func AddToFile(configFile, key string, values []string) error {
+	return editListInFile(configFile, key, func(current []string) []string {
+		for _, value := range values {
+			if !contains(current, value) {
+				current = append(current, value)
+			}
+		}
+		return current
+	})
+}
+
+// RemoveFromFile removes values from the list setting identified by key in the
+// given configuration file.
+func RemoveFromFile(configFile, key string, values []string) error {
+	return editListInFile(configFile, key, func(current []string) []string {
+		res := []string{}
+		for _, value := range current {
+			if !contains(values, value) {
+				res = append(res, value)
+			}
+		}
+		return res
+	})
+}
+
+func editListInFile(configFile, key string, edit func(current []string) []string) error {
+	if isList, err := SettingIsList(key); err != nil {
+		return err
+	} else if !isList {
+		return fmt.Errorf("setting %s is not a list", key)
+	}
+	return editConfigFile(configFile, key, func(node *yaml.Node) error {
+		current := []string{}
+		if node.Kind == yaml.SequenceNode {
+			for _, item := range node.Content {
+				current = append(current, item.Value)
+			}
+		}
+		setSequence(node, edit(current))
+		return nil
+	})
+}
+
+// editConfigFile loads the configuration file as a YAML tree, calls edit on the
+// node holding the value of key (creating it if missing) and writes back the
+// file.
+func editConfigFile(configFile, key string, edit func(node *yaml.Node) error) error {
+	if ext := strings.ToLower(filepath.Ext(configFile)); ext != ".yaml" && ext != ".yml" {
+		return fmt.Errorf("only YAML config files can be edited: %s", configFile)
+	}
+
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("reading config file: %s", err)
+	}
+
+	doc := &yaml.Node{}
+	if len(bytes.TrimSpace(data)) > 0 {
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return fmt.Errorf("parsing config file: %s", err)
+		}
+	}
+	if doc.Kind == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("invalid config file: %s", configFile)
+	}
+
+	node := root
+	for _, k := range strings.Split(strings.ToLower(key), ".") {
+		node = mappingValue(node, k)
+	}
+	if err := edit(node); err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("encoding config file: %s", err)
+	}
+	encoder.Close()
+
+	if err := os.MkdirAll(filepath.Dir(configFile), os.FileMode(0755)); err != nil {
+		return fmt.Errorf("creating config file directory: %s", err)
+	}
+	if err := ioutil.WriteFile(configFile, buf.Bytes(), os.FileMode(0644)); err != nil {
+		return fmt.Errorf("writing config file: %s", err)
+	}
+	return nil
+}
+
+// mappingValue returns the value node of the given key in the mapping node,
+// the key is created if missing. Keys are matched case-insensitively, like
+// viper does.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping.Kind != yaml.MappingNode {
+		mapping.Kind = yaml.MappingNode
+		mapping.Tag = "!!map"
+		mapping.Value = ""
+		mapping.Content = nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			return mapping.Content[i+1]
+		}
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+	return value
+}
+
+func setScalar(node *yaml.Node, tag, value string) {
+	node.Kind = yaml.ScalarNode
+	node.Tag = tag
+	node.Value = value
+	node.Style = 0
+	node.Content = nil
+}
+
+func setSequence(node *yaml.Node, values []string) {
+	node.Kind = yaml.SequenceNode
+	node.Tag = "!!seq"
+	node.Value = ""
+	node.Style = 0
+	node.Content = nil
+	if len(values) == 0 {
+		// Keep empty lists on a single line: `key: []`
+		node.Style = yaml.FlowStyle
+	}
+	for _, value := range values {
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
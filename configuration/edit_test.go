@@ -0,0 +1,83 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package configuration
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEditConfigFile(t *testing.T) {
+	setDefaults("data", "user")
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+
+	configFile := filepath.Join(tmp, "arduino-cli.yaml")
+	err := ioutil.WriteFile(configFile, []byte(
+		"board_manager:\n"+
+			"  additional_urls: []\n"+
+			"daemon:\n"+
+			"  port: \"50051\" # the daemon port\n"), 0644)
+	require.NoError(t, err)
+
+	require.NoError(t, AddToFile(configFile, "board_manager.additional_urls", []string{"https://a", "https://b"}))
+	require.NoError(t, AddToFile(configFile, "board_manager.additional_urls", []string{"https://a"}))
+	require.NoError(t, RemoveFromFile(configFile, "board_manager.additional_urls", []string{"https://b"}))
+	require.NoError(t, SetInFile(configFile, "daemon.port", []string{"1234"}))
+	require.NoError(t, SetInFile(configFile, "logging.level", []string{"debug"}))
+	require.NoError(t, SetInFile(configFile, "telemetry.enabled", []string{"false"}))
+
+	data, err := ioutil.ReadFile(configFile)
+	require.NoError(t, err)
+	settings := map[string]map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(data, &settings))
+	require.Equal(t, []interface{}{"https://a"}, settings["board_manager"]["additional_urls"])
+	require.Equal(t, "1234", settings["daemon"]["port"])
+	require.Equal(t, "debug", settings["logging"]["level"])
+	require.Equal(t, false, settings["telemetry"]["enabled"])
+
+	// Comments and order of the existing settings are preserved
+	content := string(data)
+	require.Contains(t, content, "port: \"1234\" # the daemon port\n")
+	require.Less(t, strings.Index(content, "board_manager:"), strings.Index(content, "daemon:"))
+	require.Less(t, strings.Index(content, "daemon:"), strings.Index(content, "logging:"))
+}
+
+func TestEditConfigFileValidation(t *testing.T) {
+	setDefaults("data", "user")
+	tmp := tmpDirOrDie()
+	defer os.RemoveAll(tmp)
+	configFile := filepath.Join(tmp, "arduino-cli.yaml")
+
+	require.Error(t, SetInFile(configFile, "not.existent", []string{"value"}))
+	require.Error(t, SetInFile(configFile, "telemetry.enabled", []string{"maybe"}))
+	require.Error(t, SetInFile(configFile, "logging.level", []string{"debug", "info"}))
+	require.Error(t, AddToFile(configFile, "logging.level", []string{"debug"}))
+	require.Error(t, RemoveFromFile(configFile, "daemon.port", []string{"50051"}))
+	require.NoFileExists(t, configFile)
+
+	// The file is created if missing
+	require.NoError(t, SetInFile(configFile, "directories.Data", []string{"/tmp"}))
+	data, err := ioutil.ReadFile(configFile)
+	require.NoError(t, err)
+	require.Equal(t, "directories:\n  data: /tmp\n", string(data))
+}
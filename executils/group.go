@@ -0,0 +1,72 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package executils
+
+import (
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ProcessGroup is a process together with all the processes it spawns. They
+// are placed in a new process group on Unix and in a job object on Windows,
+// so that they can be killed all together and no orphan is left around.
+type ProcessGroup struct {
+	process  *os.Process
+	lock     sync.Mutex
+	released bool
+	sys      processGroupSys
+}
+
+// StartProcessGroup starts cmd as the leader of a new ProcessGroup. If the
+// group can't be created cmd is started anyway, and only cmd itself will be
+// killed by Kill.
+func StartProcessGroup(cmd *exec.Cmd) (*ProcessGroup, error) {
+	prepareProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	g := &ProcessGroup{process: cmd.Process}
+	if err := g.sys.attach(cmd.Process); err != nil {
+		logrus.WithError(err).WithField("pid", cmd.Process.Pid).Warn("Cannot create process group")
+	}
+	return g, nil
+}
+
+// Kill causes the process and all the processes it spawned to exit
+// immediately. Kill does not wait until the processes have actually exited.
+func (g *ProcessGroup) Kill() error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.released {
+		return g.process.Kill()
+	}
+	return g.sys.kill(g.process)
+}
+
+// Release releases the resources associated with the group, it must be
+// called after the process exited. The processes spawned by the process that
+// are still running are left untouched.
+func (g *ProcessGroup) Release() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if !g.released {
+		g.sys.release()
+		g.released = true
+	}
+}
@@ -0,0 +1,50 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+//go:build !windows
+// +build !windows
+
+package executils
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+type processGroupSys struct{}
+
+func prepareProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+func (s *processGroupSys) attach(_ *os.Process) error {
+	return nil
+}
+
+func (s *processGroupSys) kill(process *os.Process) error {
+	// The ID of the group is the PID of its leader, a negative PID sends the
+	// signal to the whole group
+	if err := syscall.Kill(-process.Pid, syscall.SIGKILL); err != nil {
+		return process.Kill()
+	}
+	return nil
+}
+
+func (s *processGroupSys) release() {
+}
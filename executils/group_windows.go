@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package executils
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+const (
+	processTerminate = 0x0001
+	processSetQuota  = 0x0100
+)
+
+var (
+	kernel32                     = syscall.NewLazyDLL("kernel32.dll")
+	procCreateJobObjectW         = kernel32.NewProc("CreateJobObjectW")
+	procAssignProcessToJobObject = kernel32.NewProc("AssignProcessToJobObject")
+	procTerminateJobObject       = kernel32.NewProc("TerminateJobObject")
+)
+
+type processGroupSys struct {
+	job syscall.Handle
+}
+
+func prepareProcessGroup(_ *exec.Cmd) {
+}
+
+func (s *processGroupSys) attach(process *os.Process) error {
+	// The processes spawned by a process in a job object are part of the
+	// same job, unless they explicitly break away from it
+	job, _, err := procCreateJobObjectW.Call(0, 0)
+	if job == 0 {
+		return err
+	}
+	handle, err := syscall.OpenProcess(processSetQuota|processTerminate, false, uint32(process.Pid))
+	if err != nil {
+		syscall.CloseHandle(syscall.Handle(job))
+		return err
+	}
+	defer syscall.CloseHandle(handle)
+	if res, _, err := procAssignProcessToJobObject.Call(job, uintptr(handle)); res == 0 {
+		syscall.CloseHandle(syscall.Handle(job))
+		return err
+	}
+	s.job = syscall.Handle(job)
+	return nil
+}
+
+func (s *processGroupSys) kill(process *os.Process) error {
+	if s.job == 0 {
+		return process.Kill()
+	}
+	if res, _, err := procTerminateJobObject.Call(uintptr(s.job), 1); res == 0 {
+		return err
+	}
+	return nil
+}
+
+func (s *processGroupSys) release() {
+	if s.job != 0 {
+		syscall.CloseHandle(s.job)
+		s.job = 0
+	}
+}
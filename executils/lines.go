@@ -0,0 +1,81 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package executils
+
+import (
+	"sync"
+	"time"
+)
+
+// OutputLine is a line of the output of a process
+type OutputLine struct {
+	// Time is when the line has been received
+	Time time.Time
+	// Stderr is true if the line has been written to the standard error
+	Stderr bool
+	// Text is the content of the line, without the line terminator
+	Text string
+}
+
+// LineCB is called with each line of the output of a process
+type LineCB func(line *OutputLine)
+
+// lineWriter is an io.Writer that splits the data written into lines and
+// passes them to a LineCB. A carriage return ends a line too, so that the
+// progress printed by the tools rewriting the same line of the terminal
+// (e.g. esptool percentages) is reported as soon as it's updated.
+type lineWriter struct {
+	cb      LineCB
+	stderr  bool
+	lock    *sync.Mutex
+	line    []byte
+	afterCR bool
+}
+
+func (w *lineWriter) Write(data []byte) (int, error) {
+	for _, b := range data {
+		afterCR := w.afterCR
+		w.afterCR = false
+		switch b {
+		case '\r':
+			w.emit()
+			w.afterCR = true
+		case '\n':
+			// "\r\n" is a single line terminator
+			if !afterCR {
+				w.emit()
+			}
+		default:
+			w.line = append(w.line, b)
+		}
+	}
+	return len(data), nil
+}
+
+// flush passes to the callback the last line, if it's not terminated
+func (w *lineWriter) flush() {
+	if len(w.line) > 0 {
+		w.emit()
+	}
+}
+
+func (w *lineWriter) emit() {
+	line := &OutputLine{Time: time.Now(), Stderr: w.stderr, Text: string(w.line)}
+	w.line = w.line[:0]
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.cb(line)
+}
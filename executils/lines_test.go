@@ -0,0 +1,84 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package executils
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperProcess is not a real test, it's the process run by the other tests
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("EXECUTILS_HELPER_PROCESS") != "1" {
+		return
+	}
+	fmt.Print("first\nsecond\r\n")
+	fmt.Fprint(os.Stderr, "error\n")
+	fmt.Print("progress 10%\rprogress 20%\rdone")
+	os.Exit(0)
+}
+
+func TestLineWriter(t *testing.T) {
+	lines := []string{}
+	w := &lineWriter{
+		cb:   func(line *OutputLine) { lines = append(lines, line.Text) },
+		lock: &sync.Mutex{},
+	}
+	w.Write([]byte("a\nb"))
+	require.Equal(t, []string{"a"}, lines)
+	w.Write([]byte("c\r"))
+	w.Write([]byte("\nd\r\re"))
+	require.Equal(t, []string{"a", "bc", "d", ""}, lines)
+	w.flush()
+	require.Equal(t, []string{"a", "bc", "d", "", "e"}, lines)
+	w.flush()
+	require.Len(t, lines, 5)
+}
+
+func TestRedirectLines(t *testing.T) {
+	p, err := NewProcess(os.Args[0], "-test.run=TestHelperProcess")
+	require.NoError(t, err)
+	p.cmd.Env = append(os.Environ(), "EXECUTILS_HELPER_PROCESS=1")
+
+	stdout := &bytes.Buffer{}
+	p.RedirectStdoutTo(stdout)
+	lines := []*OutputLine{}
+	cb := func(line *OutputLine) { lines = append(lines, line) }
+	p.RedirectStdoutLinesTo(cb)
+	p.RedirectStderrLinesTo(cb)
+	require.NoError(t, p.Run())
+
+	// The output is still written to the writer
+	require.Equal(t, "first\nsecond\r\nprogress 10%\rprogress 20%\rdone", stdout.String())
+
+	stdoutLines := []string{}
+	stderrLines := []string{}
+	for _, line := range lines {
+		require.False(t, line.Time.IsZero())
+		if line.Stderr {
+			stderrLines = append(stderrLines, line.Text)
+		} else {
+			stdoutLines = append(stdoutLines, line.Text)
+		}
+	}
+	require.Equal(t, []string{"first", "second", "progress 10%", "progress 20%", "done"}, stdoutLines)
+	require.Equal(t, []string{"error"}, stderrLines)
+}
@@ -16,28 +16,50 @@
 package executils
 
 import (
+	"context"
 	"io"
 	"os"
 	"os/exec"
+	"sync"
+	"time"
 
+	"github.com/arduino/arduino-cli/tracing"
 	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 // Process is representation of an external process run
 type Process struct {
-	cmd *exec.Cmd
+	cmd         *exec.Cmd
+	ctx         context.Context
+	group       *ProcessGroup
+	exited      chan struct{}
+	log         *logrus.Entry
+	start       time.Time
+	lineWriters []*lineWriter
+	linesLock   sync.Mutex
 }
 
 // NewProcess creates a command with the provided command line arguments.
 // The first argument is the path to the executable, the remainder are the
 // arguments to the command.
 func NewProcess(args ...string) (*Process, error) {
+	return NewProcessWithContext(context.Background(), args...)
+}
+
+// NewProcessWithContext creates a command with the provided command line
+// arguments, like NewProcess. The process, together with all the processes it
+// spawned, is killed if ctx is canceled before it exits, its start and its exit
+// are logged together with the ID of the request carried by ctx.
+func NewProcessWithContext(ctx context.Context, args ...string) (*Process, error) {
 	if args == nil || len(args) == 0 {
 		return nil, errors.New("no executable specified")
 	}
 	p := &Process{
 		cmd: exec.Command(args[0], args[1:]...),
+		ctx: ctx,
+		log: tracing.Logger(ctx).WithField("cmd", args[0]),
 	}
 	TellCommandNotToSpawnShell(p.cmd)
 
@@ -56,6 +78,14 @@ func NewProcessFromPath(executable *paths.Path, args ...string) (*Process, error
 	return NewProcess(processArgs...)
 }
 
+// NewProcessFromPathWithContext creates a command from the provided
+// executable path and command line arguments, like NewProcessWithContext.
+func NewProcessFromPathWithContext(ctx context.Context, executable *paths.Path, args ...string) (*Process, error) {
+	processArgs := []string{executable.String()}
+	processArgs = append(processArgs, args...)
+	return NewProcessWithContext(ctx, processArgs...)
+}
+
 // RedirectStdoutTo will redirect the process' stdout to the specified
 // writer. Any previous redirection will be overwritten.
 func (p *Process) RedirectStdoutTo(out io.Writer) {
@@ -68,6 +98,30 @@ func (p *Process) RedirectStderrTo(out io.Writer) {
 	p.cmd.Stderr = out
 }
 
+// RedirectStdoutLinesTo calls cb with each line written by the process to its
+// stdout as soon as it's received. The output is still written to the writer
+// set with RedirectStdoutTo, if any, that must be called first.
+func (p *Process) RedirectStdoutLinesTo(cb LineCB) {
+	p.cmd.Stdout = p.addLineWriter(p.cmd.Stdout, cb, false)
+}
+
+// RedirectStderrLinesTo calls cb with each line written by the process to its
+// stderr as soon as it's received. The output is still written to the writer
+// set with RedirectStderrTo, if any, that must be called first. The callbacks
+// of stdout and stderr are never called concurrently.
+func (p *Process) RedirectStderrLinesTo(cb LineCB) {
+	p.cmd.Stderr = p.addLineWriter(p.cmd.Stderr, cb, true)
+}
+
+func (p *Process) addLineWriter(out io.Writer, cb LineCB, stderr bool) io.Writer {
+	w := &lineWriter{cb: cb, stderr: stderr, lock: &p.linesLock}
+	p.lineWriters = append(p.lineWriters, w)
+	if out == nil {
+		return w
+	}
+	return io.MultiWriter(out, w)
+}
+
 // StdinPipe returns a pipe that will be connected to the command's standard
 // input when the command starts. The pipe will be closed automatically after
 // Wait sees the command exit. A caller need only call Close to force the pipe
@@ -94,14 +148,46 @@ func (p *Process) StderrPipe() (io.ReadCloser, error) {
 
 // Start will start the underliyng process.
 func (p *Process) Start() error {
-	return p.cmd.Start()
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+	group, err := StartProcessGroup(p.cmd)
+	if err != nil {
+		p.log.WithError(err).Warn("Process failed to start")
+		return err
+	}
+	p.group = group
+	p.exited = make(chan struct{})
+	go func() {
+		select {
+		case <-p.ctx.Done():
+			p.log.Debug("Killing process")
+			group.Kill()
+		case <-p.exited:
+		}
+	}()
+	p.start = time.Now()
+	p.log = p.log.WithField("pid", p.cmd.Process.Pid)
+	p.log.Debug("Process started")
+	return nil
 }
 
 // Wait waits for the command to exit and waits for any copying to stdin or copying
 // from stdout or stderr to complete.
 func (p *Process) Wait() error {
 	// TODO: make some helpers to retrieve exit codes out of *ExitError.
-	return p.cmd.Wait()
+	err := p.cmd.Wait()
+	close(p.exited)
+	p.group.Release()
+	for _, w := range p.lineWriters {
+		w.flush()
+	}
+	log := p.log.WithField("duration", time.Since(p.start).String())
+	if err != nil {
+		log = log.WithError(err)
+	}
+	log.Debug("Process exited")
+	return err
 }
 
 // Signal sends a signal to the Process. Sending Interrupt on Windows is not implemented.
@@ -109,11 +195,10 @@ func (p *Process) Signal(sig os.Signal) error {
 	return p.cmd.Process.Signal(sig)
 }
 
-// Kill causes the Process to exit immediately. Kill does not wait until the Process has
-// actually exited. This only kills the Process itself, not any other processes it may
-// have started.
+// Kill causes the Process, and any other processes it may have started, to exit
+// immediately. Kill does not wait until the Process has actually exited.
 func (p *Process) Kill() error {
-	return p.cmd.Process.Kill()
+	return p.group.Kill()
 }
 
 // SetDir sets the working directory of the command. If Dir is the empty string, Run
@@ -132,7 +217,16 @@ func (p *Process) SetDirFromPath(path *paths.Path) {
 	}
 }
 
+// SetEnvironment sets the environment variables of the command, in the
+// KEY=value form, in place of the ones of the calling process.
+func (p *Process) SetEnvironment(values []string) {
+	p.cmd.Env = values
+}
+
 // Run starts the specified command and waits for it to complete.
 func (p *Process) Run() error {
-	return p.cmd.Run()
+	if err := p.Start(); err != nil {
+		return err
+	}
+	return p.Wait()
 }
@@ -0,0 +1,90 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package executils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperProcessGroup is not a real test, it's the process run by
+// TestProcessGroupKilledOnCancel: the parent spawns a child that inherits
+// its stdout and never exits
+func TestHelperProcessGroup(t *testing.T) {
+	switch os.Getenv("EXECUTILS_HELPER_GROUP") {
+	case "parent":
+		child := exec.Command(os.Args[0], "-test.run=TestHelperProcessGroup")
+		child.Env = append(os.Environ(), "EXECUTILS_HELPER_GROUP=child")
+		child.Stdout = os.Stdout
+		if err := child.Start(); err != nil {
+			os.Exit(1)
+		}
+		fmt.Println("started")
+		child.Wait()
+		os.Exit(0)
+	case "child":
+		time.Sleep(time.Minute)
+		os.Exit(0)
+	}
+}
+
+func TestProcessGroupKilledOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p, err := NewProcessWithContext(ctx, os.Args[0], "-test.run=TestHelperProcessGroup")
+	require.NoError(t, err)
+	p.cmd.Env = append(os.Environ(), "EXECUTILS_HELPER_GROUP=parent")
+	started := make(chan struct{})
+	var startedOnce sync.Once
+	p.RedirectStdoutLinesTo(func(line *OutputLine) {
+		if line.Text == "started" {
+			startedOnce.Do(func() { close(started) })
+		}
+	})
+	require.NoError(t, p.Start())
+	select {
+	case <-started:
+	case <-time.After(30 * time.Second):
+		require.FailNow(t, "the helper process didn't start")
+	}
+
+	// The child keeps the stdout of the process open, Wait returns only
+	// after it has been killed too
+	cancel()
+	waited := make(chan error, 1)
+	go func() { waited <- p.Wait() }()
+	select {
+	case err := <-waited:
+		require.Error(t, err)
+	case <-time.After(30 * time.Second):
+		require.FailNow(t, "the child of the killed process is still running")
+	}
+}
+
+func TestProcessNotStartedIfCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, err := NewProcessWithContext(ctx, os.Args[0], "-test.run=TestHelperProcessGroup")
+	require.NoError(t, err)
+	require.Equal(t, context.Canceled, p.Start())
+}
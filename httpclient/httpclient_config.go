@@ -16,10 +16,13 @@
 package httpclient
 
 import (
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"runtime"
+	"time"
 
 	"github.com/arduino/arduino-cli/cli/globals"
 	"github.com/spf13/viper"
@@ -29,6 +32,11 @@ import (
 type Config struct {
 	UserAgent string
 	Proxy     *url.URL
+	CACerts   *x509.CertPool
+	Timeout   time.Duration
+	Offline   bool
+	// Mirrors maps an host to the base URLs of its mirrors
+	Mirrors map[string][]string
 }
 
 // DefaultConfig returns the default http client config
@@ -46,12 +54,47 @@ func DefaultConfig() (*Config, error) {
 		}
 	}
 
+	var caCerts *x509.CertPool
+	if caCertsFile := viper.GetString("network.ca_certs"); caCertsFile != "" {
+		if caCerts, err = loadCACerts(caCertsFile); err != nil {
+			return nil, errors.New("Invalid network.ca_certs '" + caCertsFile + "': " + err.Error())
+		}
+	}
+
+	var timeout time.Duration
+	if timeoutConfig := viper.GetString("network.timeout"); timeoutConfig != "" {
+		if timeout, err = time.ParseDuration(timeoutConfig); err != nil {
+			return nil, errors.New("Invalid network.timeout '" + timeoutConfig + "': " + err.Error())
+		}
+	}
+
 	return &Config{
 		UserAgent: UserAgent(),
 		Proxy:     proxy,
+		CACerts:   caCerts,
+		Timeout:   timeout,
+		Offline:   viper.GetBool("network.offline"),
+		Mirrors:   viper.GetStringMapStringSlice("network.mirrors"),
 	}, nil
 }
 
+// loadCACerts returns the system certificate pool extended with the
+// certificates contained in the PEM bundle at caCertsFile
+func loadCACerts(caCertsFile string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(caCertsFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errors.New("no valid certificate found")
+	}
+	return pool, nil
+}
+
 // UserAgent returns the user agent for the cli http client
 func UserAgent() string {
 	subComponent := viper.GetString("network.user_agent_ext")
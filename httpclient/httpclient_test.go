@@ -16,12 +16,16 @@
 package httpclient
 
 import (
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -68,3 +72,99 @@ func TestProxy(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, http.StatusNoContent, response.StatusCode)
 }
+
+func TestOffline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	client := NewWithConfig(&Config{
+		Offline: true,
+	})
+
+	request, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(request)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrOffline))
+}
+
+func TestCustomCACerts(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	request, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+
+	// The test server certificate is not trusted by default
+	_, err = NewWithConfig(&Config{}).Do(request)
+	require.Error(t, err)
+
+	caCertsFile, err := ioutil.TempFile("", "cacerts")
+	require.NoError(t, err)
+	defer os.Remove(caCertsFile.Name())
+	err = pem.Encode(caCertsFile, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, err)
+	require.NoError(t, caCertsFile.Close())
+
+	caCerts, err := loadCACerts(caCertsFile.Name())
+	require.NoError(t, err)
+	response, err := NewWithConfig(&Config{CACerts: caCerts}).Do(request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	_, err = loadCACerts("testdata/does-not-exist.pem")
+	require.Error(t, err)
+}
+
+func TestTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	request, err := http.NewRequest("GET", ts.URL, nil)
+	require.NoError(t, err)
+
+	_, err = NewWithConfig(&Config{Timeout: 100 * time.Millisecond}).Do(request)
+	require.Error(t, err)
+}
+
+func TestMirrors(t *testing.T) {
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer broken.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, r.URL.Path+" "+r.Header.Get("Range"))
+	}))
+	defer mirror.Close()
+
+	brokenURL, err := url.Parse(broken.URL)
+	require.NoError(t, err)
+	client := NewWithConfig(&Config{
+		Mirrors: map[string][]string{
+			brokenURL.Host: {"http://127.0.0.1:1/unreachable", mirror.URL + "/mirror/"},
+		},
+	})
+
+	request, err := http.NewRequest("GET", broken.URL+"/packages/file.tar.bz2", nil)
+	require.NoError(t, err)
+	request.Header.Set("Range", "bytes=100-")
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	b, err := ioutil.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, "/mirror/packages/file.tar.bz2 bytes=100-", string(b))
+
+	// Without mirrors the error is returned as is
+	response, err = NewWithConfig(&Config{}).Do(request)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+}
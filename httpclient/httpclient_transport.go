@@ -15,7 +15,17 @@
 
 package httpclient
 
-import "net/http"
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrOffline is returned for any request performed while the offline mode is enabled
+var ErrOffline = errors.New("network access is disabled in offline mode")
 
 type httpClientRoundTripper struct {
 	transport http.RoundTripper
@@ -23,11 +33,25 @@ type httpClientRoundTripper struct {
 }
 
 func newHTTPClientTransport(config *Config) http.RoundTripper {
-	proxy := http.ProxyURL(config.Proxy)
+	// Use the proxy from the environment if none has been configured
+	proxy := http.ProxyFromEnvironment
+	if config.Proxy != nil {
+		proxy = http.ProxyURL(config.Proxy)
+	}
 
 	transport := &http.Transport{
 		Proxy: proxy,
 	}
+	if config.CACerts != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: config.CACerts}
+	}
+	if config.Timeout > 0 {
+		// The timeout applies to each step of the connection, not to the whole
+		// transfer, since downloads of big archives may take a long time
+		transport.DialContext = (&net.Dialer{Timeout: config.Timeout}).DialContext
+		transport.TLSHandshakeTimeout = config.Timeout
+		transport.ResponseHeaderTimeout = config.Timeout
+	}
 
 	return &httpClientRoundTripper{
 		transport: transport,
@@ -36,6 +60,42 @@ func newHTTPClientTransport(config *Config) http.RoundTripper {
 }
 
 func (h *httpClientRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if h.config.Offline {
+		return nil, ErrOffline
+	}
 	req.Header.Add("User-Agent", h.config.UserAgent)
-	return h.transport.RoundTrip(req)
+	resp, err := h.transport.RoundTrip(req)
+
+	// Retry on the configured mirrors, if any
+	if req.Method != http.MethodGet || !mustRetry(resp, err) {
+		return resp, err
+	}
+	for _, mirror := range h.config.Mirrors[strings.ToLower(req.URL.Host)] {
+		mirrorURL, parseErr := url.Parse(strings.TrimSuffix(mirror, "/") + req.URL.Path)
+		if parseErr != nil {
+			continue
+		}
+		mirrorURL.RawQuery = req.URL.RawQuery
+		if resp != nil {
+			resp.Body.Close()
+		}
+		mirrorReq := req.Clone(req.Context())
+		mirrorReq.URL = mirrorURL
+		mirrorReq.Host = ""
+		resp, err = h.transport.RoundTrip(mirrorReq)
+		if !mustRetry(resp, err) {
+			break
+		}
+	}
+	return resp, err
+}
+
+// mustRetry returns true if the request failed in a way that may succeed on a
+// different server
+func mustRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	// 416 (range not satisfiable) is an answer about the request itself
+	return resp.StatusCode >= 400 && resp.StatusCode != http.StatusRequestedRangeNotSatisfiable
 }
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/arduino/arduino-cli/i18n/cmd/po"
 )
@@ -49,36 +50,135 @@ func GenerateCatalog(files []string) po.MessageCatalog {
 
 func doFile(fset *token.FileSet, file *ast.File, catalog *po.MessageCatalog) {
 	ast.Inspect(file, func(node ast.Node) bool {
-		funcCall, ok := node.(*ast.CallExpr)
-
-		if !ok {
-			return true
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			doCall(fset, node, catalog)
+		case *ast.CompositeLit:
+			doCommand(fset, node, catalog)
 		}
+		return true
+	})
+}
 
-		if functionName(funcCall) != "i18n.Tr" && functionName(funcCall) != "tr" {
-			return true
+// doCall adds the messages passed to i18n.Tr and feedback.Errorf and the
+// usages of the command line flags
+func doCall(fset *token.FileSet, funcCall *ast.CallExpr, catalog *po.MessageCatalog) {
+	if isFlagDefinition(funcCall) {
+		// The usage is the last argument of every flag definition
+		if msg, ok := stringValue(funcCall.Args[len(funcCall.Args)-1]); ok && msg != "" {
+			addMessage(fset, funcCall, msg, catalog)
 		}
+		return
+	}
+
+	name := functionName(funcCall)
+	if name != "i18n.Tr" && name != "tr" && name != "feedback.Errorf" {
+		return
+	}
+
+	pos := fset.Position(funcCall.Pos())
+	firstArg, ok := funcCall.Args[0].(*ast.BasicLit)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
+		fmt.Fprintf(os.Stderr, "argument to %s must be a literal string\n", name)
+		return
+	}
+
+	msg, err := strconv.Unquote(firstArg.Value)
 
-		pos := fset.Position(funcCall.Pos())
-		firstArg, ok := funcCall.Args[0].(*ast.BasicLit)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
+		fmt.Fprintln(os.Stderr, err.Error())
+		return
+	}
+
+	addMessage(fset, funcCall, msg, catalog)
+}
+
+// doCommand adds the descriptions of the cobra.Command literals, they are
+// translated when the command tree is created
+func doCommand(fset *token.FileSet, lit *ast.CompositeLit, catalog *po.MessageCatalog) {
+	sel, ok := lit.Type.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Command" {
+		return
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "cobra" {
+		return
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
 		if !ok {
-			fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
-			fmt.Fprintln(os.Stderr, "argument to i18n.Tr must be a literal string")
-			return true
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok || (key.Name != "Short" && key.Name != "Long") {
+			continue
 		}
+		if msg, ok := stringValue(kv.Value); ok && msg != "" {
+			addMessage(fset, kv.Value, msg, catalog)
+		}
+	}
+}
 
-		msg, err := strconv.Unquote(firstArg.Value)
+func addMessage(fset *token.FileSet, node ast.Node, msg string, catalog *po.MessageCatalog) {
+	pos := fset.Position(node.Pos())
+	catalog.Add(msg, msg, []string{fmt.Sprintf("#: %s:%d", filepath.ToSlash(pos.Filename), pos.Line)})
+}
 
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "%s:%d\n", pos.Filename, pos.Line)
-			fmt.Fprintln(os.Stderr, err.Error())
-			return true
+// stringValue returns the value of a string literal or of a concatenation
+// of string literals
+func stringValue(expr ast.Expr) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind != token.STRING {
+			return "", false
 		}
+		value, err := strconv.Unquote(expr.Value)
+		return value, err == nil
+	case *ast.BinaryExpr:
+		if expr.Op != token.ADD {
+			return "", false
+		}
+		x, ok := stringValue(expr.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := stringValue(expr.Y)
+		return x + y, ok
+	case *ast.ParenExpr:
+		return stringValue(expr.X)
+	}
+	return "", false
+}
 
-		catalog.Add(msg, msg, []string{fmt.Sprintf("#: %s:%d", filepath.ToSlash(pos.Filename), pos.Line)})
+// flagTypes are the types of the flags that can be defined on a pflag.FlagSet
+var flagTypes = map[string]bool{
+	"Bool": true, "BoolSlice": true, "Count": true, "Duration": true, "Float32": true, "Float64": true,
+	"Int": true, "Int8": true, "Int16": true, "Int32": true, "Int64": true, "IntSlice": true,
+	"String": true, "StringArray": true, "StringSlice": true, "StringToString": true,
+	"Uint": true, "Uint8": true, "Uint16": true, "Uint32": true, "Uint64": true, "": true,
+}
 
-		return true
-	})
+// isFlagDefinition returns true for calls like
+// `cmd.Flags().StringVar(&value, "name", "", "usage")`
+func isFlagDefinition(callExpr *ast.CallExpr) bool {
+	sel, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok || len(callExpr.Args) < 2 {
+		return false
+	}
+	flagSet, ok := sel.X.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	flagSetSel, ok := flagSet.Fun.(*ast.SelectorExpr)
+	if !ok || (flagSetSel.Sel.Name != "Flags" && flagSetSel.Sel.Name != "PersistentFlags") {
+		return false
+	}
+	method := strings.TrimSuffix(sel.Sel.Name, "P")
+	if strings.HasSuffix(method, "Var") {
+		return flagTypes[strings.TrimSuffix(method, "Var")]
+	}
+	return method != "" && flagTypes[method]
 }
 
 func functionName(callExpr *ast.CallExpr) string {
@@ -0,0 +1,60 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package ast
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/arduino/arduino-cli/i18n/cmd/po"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoFile(t *testing.T) {
+	src := `package test
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "test",
+		Short:   "Short description.",
+		Long:    "Long " + "description.",
+		Example: "  " + os.Args[0] + " test",
+	}
+	cmd.Flags().StringVarP(&value, "value", "v", "", "The value")
+	cmd.PersistentFlags().Bool("enabled", false, "Enable " + "it")
+	cmd.Flags().MarkHidden("value")
+	feedback.Errorf("Error: %v", err)
+	tr("Translated")
+	return cmd
+}
+`
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, "test.go", src, parser.AllErrors)
+	require.NoError(t, err)
+	catalog := po.MessageCatalog{}
+	doFile(fset, node, &catalog)
+
+	require.Equal(t, []string{
+		"Enable it",
+		"Error: %v",
+		"Long description.",
+		"Short description.",
+		"The value",
+		"Translated",
+	}, catalog.SortedKeys())
+	require.Equal(t, []string{"#: test.go:6"}, catalog.Messages["Short description."].Comments)
+}
@@ -20,7 +20,7 @@ import (
 )
 
 // Init initializes the i18n module, setting the locale according to this order of preference:
-// 1. Configuration set in arduino-cli.yaml
+// 1. Configuration set in arduino-cli.yaml (the `locale` setting)
 // 2. OS Locale
 // 3. en (default)
 func Init() {
@@ -47,5 +47,5 @@ func Init() {
 // Tr returns msg translated to the selected locale
 // the msg argument must be a literal string
 func Tr(msg string, args ...interface{}) string {
-	return po.Get(msg, args...)
+	return getPo().Get(msg, args...)
 }
@@ -28,6 +28,7 @@ import (
 var (
 	loadOnce sync.Once
 	po       *gotext.Po
+	poMutex  sync.RWMutex
 	box      *rice.Box
 )
 
@@ -79,6 +80,16 @@ func findMatchingLocale(locale string, supportedLocales []string) string {
 
 func setLocale(locale string) {
 	poFile := box.MustBytes(locale + ".po")
-	po = new(gotext.Po)
-	po.Parse(poFile)
+	newPo := new(gotext.Po)
+	newPo.Parse(poFile)
+	// The locale may be changed while the daemon is serving other requests
+	poMutex.Lock()
+	po = newPo
+	poMutex.Unlock()
+}
+
+func getPo() *gotext.Po {
+	poMutex.RLock()
+	defer poMutex.RUnlock()
+	return po
 }
@@ -18,9 +18,9 @@ func init() {
 	}
 	file3 := &embedded.EmbeddedFile{
 		Filename:    "en.po",
-		FileModTime: time.Unix(1598608723, 0),
+		FileModTime: time.Unix(1792157106, 0),
 
-		Content: string("msgid \"\"\nmsgstr \"\"\n\n#: cli/usage.go:31\nmsgid \"Additional help topics:\"\nmsgstr \"Additional help topics:\"\n\n#: cli/usage.go:26\nmsgid \"Aliases:\"\nmsgstr \"Aliases:\"\n\n#: cli/usage.go:28\nmsgid \"Available Commands:\"\nmsgstr \"Available Commands:\"\n\n#: cli/board/details.go:124\nmsgid \"Board name:\"\nmsgstr \"Board name:\"\n\n#: cli/board/details.go:126\nmsgid \"Board version:\"\nmsgstr \"Board version:\"\n\n#: cli/board/details.go:169\nmsgid \"Checksum:\"\nmsgstr \"Checksum:\"\n\n#: cli/board/details.go:60\n#: cli/board/details.go:75\nmsgid \"Error getting board details: %v\"\nmsgstr \"Error getting board details: %v\"\n\n#: cli/usage.go:27\nmsgid \"Examples:\"\nmsgstr \"Examples:\"\n\n#: cli/board/details.go:167\nmsgid \"File:\"\nmsgstr \"File:\"\n\n#: cli/usage.go:29\nmsgid \"Flags:\"\nmsgstr \"Flags:\"\n\n#: cli/usage.go:30\nmsgid \"Global Flags:\"\nmsgstr \"Global Flags:\"\n\n#: cli/board/details.go:97\n#: cli/board/details.go:195\nmsgid \"Id\"\nmsgstr \"Id\"\n\n#: cli/board/details.go:137\nmsgid \"Identification properties:\"\nmsgstr \"Identification properties:\"\n\n#: cli/board/details.go:195\nmsgid \"Name\"\nmsgstr \"Name\"\n\n#: cli/board/details.go:166\nmsgid \"OS:\"\nmsgstr \"OS:\"\n\n#: cli/board/details.go:130\nmsgid \"Official Arduino board:\"\nmsgstr \"Official Arduino board:\"\n\n#: cli/board/details.go:178\nmsgid \"Option:\"\nmsgstr \"Option:\"\n\n#: cli/board/details.go:146\nmsgid \"Package URL:\"\nmsgstr \"Package URL:\"\n\n#: cli/board/details.go:145\nmsgid \"Package maintainer:\"\nmsgstr \"Package maintainer:\"\n\n#: cli/board/details.go:144\nmsgid \"Package name:\"\nmsgstr \"Package name:\"\n\n#: cli/board/details.go:148\nmsgid \"Package online help:\"\nmsgstr \"Package online help:\"\n\n#: cli/board/details.go:147\nmsgid \"Package website:\"\nmsgstr \"Package website:\"\n\n#: cli/board/details.go:154\nmsgid \"Platform URL:\"\nmsgstr \"Platform URL:\"\n\n#: cli/board/details.go:153\nmsgid \"Platform architecture:\"\nmsgstr \"Platform architecture:\"\n\n#: cli/board/details.go:152\nmsgid \"Platform category:\"\nmsgstr \"Platform category:\"\n\n#: cli/board/details.go:159\nmsgid \"Platform checksum:\"\nmsgstr \"Platform checksum:\"\n\n#: cli/board/details.go:155\nmsgid \"Platform file name:\"\nmsgstr \"Platform file name:\"\n\n#: cli/board/details.go:151\nmsgid \"Platform name:\"\nmsgstr \"Platform name:\"\n\n#: cli/board/details.go:157\nmsgid \"Platform size (bytes):\"\nmsgstr \"Platform size (bytes):\"\n\n#: cli/board/details.go:42\nmsgid \"Print details about a board.\"\nmsgstr \"Print details about a board.\"\n\n#: cli/board/details.go:97\nmsgid \"Programmer name\"\nmsgstr \"Programmer name\"\n\n#: cli/board/details.go:195\nmsgid \"Programmers:\"\nmsgstr \"Programmers:\"\n\n#: cli/board/details.go:163\nmsgid \"Required tool:\"\nmsgstr \"Required tool:\"\n\n#: cli/board/details.go:49\nmsgid \"Show full board details\"\nmsgstr \"Show full board details\"\n\n#: cli/board/details.go:43\nmsgid \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\nmsgstr \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\n\n#: cli/board/details.go:51\nmsgid \"Show list of available programmers\"\nmsgstr \"Show list of available programmers\"\n\n#: cli/board/details.go:168\nmsgid \"Size (bytes):\"\nmsgstr \"Size (bytes):\"\n\n#: cli/usage.go:25\nmsgid \"Usage:\"\nmsgstr \"Usage:\"\n\n#: cli/usage.go:32\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"Use %s for more information about a command.\"\n\n"),
+		Content: string("msgid \"\"\nmsgstr \"\"\n\n#: cli/output/cached_archives.go:59\nmsgid \"%d archives removed, %s freed\"\nmsgstr \"%d archives removed, %s freed\"\n\n#: cli/output/cached_archives.go:57\nmsgid \"%d archives, %s would be freed\"\nmsgstr \"%d archives, %s would be freed\"\n\n#: cli/compile/batch.go:279\nmsgid \"%d builds, %d failed\"\nmsgstr \"%d builds, %d failed\"\n\n#: cli/compile/batch.go:218\nmsgid \"%d of %d builds failed\"\nmsgstr \"%d of %d builds failed\"\n\n#: cli/upload/multiple.go:96\nmsgid \"%d of %d uploads failed\"\nmsgstr \"%d of %d uploads failed\"\n\n#: cli/upload/multiple.go:138\nmsgid \"%d uploads, %d failed\"\nmsgstr \"%d uploads, %d failed\"\n\n#: cli/output/rpc_progress.go:63\nmsgid \"%s already downloaded\"\nmsgstr \"%s already downloaded\"\n\n#: commands/core/post_install.go:60\nmsgid \"%s configured\"\nmsgstr \"%s configured\"\n\n#: cli/output/rpc_progress.go:75\nmsgid \"%s downloaded\"\nmsgstr \"%s downloaded\"\n\n#: commands/bundled_tools.go:71\n#: commands/core/install.go:146\n#: commands/core/install.go:255\nmsgid \"%s installed\"\nmsgstr \"%s installed\"\n\n#: commands/core/install.go:62\nmsgid \"%s linked to %s\"\nmsgstr \"%s linked to %s\"\n\n#: commands/core/uninstall.go:98\n#: commands/core/uninstall.go:114\nmsgid \"%s uninstalled\"\nmsgstr \"%s uninstalled\"\n\n#: cli/cli.go:118\nmsgid \"A project config file layered on top of the global one, only its directories, additional URLs and build settings are used.\"\nmsgstr \"A project config file layered on top of the global one, only its directories, additional URLs and build settings are used.\"\n\n#: cli/compile/compile.go:122\nmsgid \"Add flags to the compiler command line for every source file, e.g.: \\\"-DDEBUG_LEVEL=3\\\". Can be used multiple times.\"\nmsgstr \"Add flags to the compiler command line for every source file, e.g.: \\\"-DDEBUG_LEVEL=3\\\". Can be used multiple times.\"\n\n#: cli/usage.go:33\nmsgid \"Additional help topics:\"\nmsgstr \"Additional help topics:\"\n\n#: cli/config/add.go:31\nmsgid \"Adds one or more values to a setting.\"\nmsgstr \"Adds one or more values to a setting.\"\n\n#: cli/config/add.go:32\nmsgid \"Adds one or more values to a setting. Only settings holding a list of values are supported.\"\nmsgstr \"Adds one or more values to a setting. Only settings holding a list of values are supported.\"\n\n#: cli/usage.go:28\nmsgid \"Aliases:\"\nmsgstr \"Aliases:\"\n\n#: commands/instances.go:770\n#: commands/lib/install.go:115\nmsgid \"Already installed %s\"\nmsgstr \"Already installed %s\"\n\n#: cli/output/cached_archives.go:48\nmsgid \"Archive\"\nmsgstr \"Archive\"\n\n#: commands/updater/updater.go:101\nmsgid \"Arduino CLI is already up to date\"\nmsgstr \"Arduino CLI is already up to date\"\n\n#: cli/sketch/sketch.go:28\n#: cli/sketch/sketch.go:29\nmsgid \"Arduino CLI sketch commands.\"\nmsgstr \"Arduino CLI sketch commands.\"\n\n#: commands/updater/updater.go:157\nmsgid \"Arduino CLI updated to %s\"\nmsgstr \"Arduino CLI updated to %s\"\n\n#: cli/cli.go:73\nmsgid \"Arduino CLI.\"\nmsgstr \"Arduino CLI.\"\n\n#: cli/cli.go:74\nmsgid \"Arduino Command Line Interface (arduino-cli).\"\nmsgstr \"Arduino Command Line Interface (arduino-cli).\"\n\n#: cli/board/board.go:28\n#: cli/board/board.go:29\nmsgid \"Arduino board commands.\"\nmsgstr \"Arduino board commands.\"\n\n#: cli/cache/cache.go:28\n#: cli/cache/cache.go:29\nmsgid \"Arduino cache commands.\"\nmsgstr \"Arduino cache commands.\"\n\n#: cli/lib/lib.go:28\n#: cli/lib/lib.go:29\nmsgid \"Arduino commands about libraries.\"\nmsgstr \"Arduino commands about libraries.\"\n\n#: cli/config/config.go:28\nmsgid \"Arduino configuration commands.\"\nmsgstr \"Arduino configuration commands.\"\n\n#: cli/core/core.go:28\n#: cli/core/core.go:29\nmsgid \"Arduino core operations.\"\nmsgstr \"Arduino core operations.\"\n\n#: cli/env/env.go:28\nmsgid \"Arduino environment commands.\"\nmsgstr \"Arduino environment commands.\"\n\n#: cli/lib/check_deps.go:50\n#: cli/lib/install.go:55\nmsgid \"Arguments error: %v\"\nmsgstr \"Arguments error: %v\"\n\n#: cli/board/attach.go:63\n#: cli/board/attach.go:81\nmsgid \"Attach board error: %v\"\nmsgstr \"Attach board error: %v\"\n\n#: cli/board/attach.go:36\nmsgid \"Attaches a sketch to a board.\"\nmsgstr \"Attaches a sketch to a board.\"\n\n#: cli/board/attach.go:37\nmsgid \"Attaches a sketch to a board.\\n\"\n\"The board and the port are saved in the sketch.json file of the sketch, so that\\n\"\n\"the --fqbn and --port flags can be omitted by the compile, upload and debug commands.\\n\"\n\"If a port is given the connected board is detected automatically.\"\nmsgstr \"Attaches a sketch to a board.\\n\"\n\"The board and the port are saved in the sketch.json file of the sketch, so that\\n\"\n\"the --fqbn and --port flags can be omitted by the compile, upload and debug commands.\\n\"\n\"If a port is given the connected board is detected automatically.\"\n\n#: cli/sketch/new.go:50\nmsgid \"Author of the Sketch, defaults to the current user.\"\nmsgstr \"Author of the Sketch, defaults to the current user.\"\n\n#: cli/usage.go:30\nmsgid \"Available Commands:\"\nmsgstr \"Available Commands:\"\n\n#: cli/upload/upload.go:85\nmsgid \"Baud rate of the serial monitor opened with --attach-monitor.\"\nmsgstr \"Baud rate of the serial monitor opened with --attach-monitor.\"\n\n#: cli/upload/upload.go:79\nmsgid \"Binary file to upload (e.g. path/to/Sketch.ino.hex), no sketch is needed.\"\nmsgstr \"Binary file to upload (e.g. path/to/Sketch.ino.hex), no sketch is needed.\"\n\n#: cli/compile/batch.go:256\nmsgid \"Board\"\nmsgstr \"Board\"\n\n#: commands/board/attach.go:108\nmsgid \"Board found: %s\"\nmsgstr \"Board found: %s\"\n\n#: cli/board/details.go:124\nmsgid \"Board name:\"\nmsgstr \"Board name:\"\n\n#: commands/board/attach.go:140\nmsgid \"Board serial number: %s\"\nmsgstr \"Board serial number: %s\"\n\n#: cli/board/details.go:129\nmsgid \"Board version:\"\nmsgstr \"Board version:\"\n\n#: cli/daemon/daemon.go:92\nmsgid \"Both daemon.ssl_cert and daemon.ssl_key must be set to enable TLS.\"\nmsgstr \"Both daemon.ssl_cert and daemon.ssl_key must be set to enable TLS.\"\n\n#: cli/build/build.go:28\nmsgid \"Build diagnostic commands.\"\nmsgstr \"Build diagnostic commands.\"\n\n#: cli/compile/compile.go:140\n#: cli/sketch/includes.go:67\n#: cli/sketch/preprocess.go:67\nmsgid \"Build profile of the sketch.yaml file to use, if omitted the default profile is used.\"\nmsgstr \"Build profile of the sketch.yaml file to use, if omitted the default profile is used.\"\n\n#: cli/compile/compile.go:105\nmsgid \"Build the image of the filesystem of the board (e.g. SPIFFS or LittleFS) with the content of the data folder of the sketch, in <sketch>.ino.fs.bin. Upload it with upload --fs.\"\nmsgstr \"Build the image of the filesystem of the board (e.g. SPIFFS or LittleFS) with the content of the data folder of the sketch, in <sketch>.ino.fs.bin. Upload it with upload --fs.\"\n\n#: cli/compile/compile.go:99\nmsgid \"Builds of 'core.a' are saved into this path to be cached and reused.\"\nmsgstr \"Builds of 'core.a' are saved into this path to be cached and reused.\"\n\n#: cli/compile/compile.go:189\nmsgid \"Can't upload when only the compilation database is produced.\"\nmsgstr \"Can't upload when only the compilation database is produced.\"\n\n#: cli/compile/compile.go:205\nmsgid \"Can't upload when the sketch is not compiled.\"\nmsgstr \"Can't upload when the sketch is not compiled.\"\n\n#: cli/debug/debug.go:80\nmsgid \"Can't use --info and --generate-config together.\"\nmsgstr \"Can't use --info and --generate-config together.\"\n\n#: cli/config/add.go:46\nmsgid \"Cannot add value: %v\"\nmsgstr \"Cannot add value: %v\"\n\n#: cli/config/init.go:63\nmsgid \"Cannot create config file directory: %v\"\nmsgstr \"Cannot create config file directory: %v\"\n\n#: cli/config/init.go:68\nmsgid \"Cannot create config file: %v\"\nmsgstr \"Cannot create config file: %v\"\n\n#: cli/config/init.go:55\nmsgid \"Cannot find absolute path: %v\"\nmsgstr \"Cannot find absolute path: %v\"\n\n#: configuration/configuration.go:252\n#: configuration/configuration.go:258\nmsgid \"Cannot get executable path: %v\"\nmsgstr \"Cannot get executable path: %v\"\n\n#: cli/config/get.go:49\nmsgid \"Cannot get value: %v\"\nmsgstr \"Cannot get value: %v\"\n\n#: cli/config/remove.go:46\nmsgid \"Cannot remove value: %v\"\nmsgstr \"Cannot remove value: %v\"\n\n#: cli/config/set.go:48\nmsgid \"Cannot set value: %v\"\nmsgstr \"Cannot set value: %v\"\n\n#: cli/lib/check_deps.go:36\nmsgid \"Check dependencies status for the specified library, printing the dependency tree annotated with the installation status of each library.\"\nmsgstr \"Check dependencies status for the specified library, printing the dependency tree annotated with the installation status of each library.\"\n\n#: cli/lib/check_deps.go:35\nmsgid \"Check dependencies status for the specified library.\"\nmsgstr \"Check dependencies status for the specified library.\"\n\n#: cli/version/version.go:46\nmsgid \"Check if a newer release of Arduino CLI is available\"\nmsgstr \"Check if a newer release of Arduino CLI is available\"\n\n#: cli/daemon/health.go:36\nmsgid \"Checks if the daemon is serving requests.\"\nmsgstr \"Checks if the daemon is serving requests.\"\n\n#: cli/board/details.go:178\nmsgid \"Checksum:\"\nmsgstr \"Checksum:\"\n\n#: cli/cache/clean.go:31\nmsgid \"Clean arduino cache.\"\nmsgstr \"Clean arduino cache.\"\n\n#: cli/cache/clean.go:32\nmsgid \"Clean the files i.e. `~/arduino15/staging` in Linux.\"\nmsgstr \"Clean the files i.e. `~/arduino15/staging` in Linux.\"\n\n#: commands/core/clean_tools.go:39\nmsgid \"Cleaning tools store\"\nmsgstr \"Cleaning tools store\"\n\n#: cli/cli.go:119\nmsgid \"Comma-separated list of additional URLs for the Boards Manager.\"\nmsgstr \"Comma-separated list of additional URLs for the Boards Manager.\"\n\n#: cli/build/replay.go:73\nmsgid \"Command %d not found in %s\"\nmsgstr \"Command %d not found in %s\"\n\n#: cli/board/setup.go:99\nmsgid \"Command:\"\nmsgstr \"Command:\"\n\n#: cli/build/build.go:29\nmsgid \"Commands to diagnose the builds, using the log recorded with compile --log-build.\"\nmsgstr \"Commands to diagnose the builds, using the log recorded with compile --log-build.\"\n\n#: cli/compile/compile.go:150\nmsgid \"Compile all the examples of the given library for each board, instead of a single sketch.\"\nmsgstr \"Compile all the examples of the given library for each board, instead of a single sketch.\"\n\n#: cli/compile/compile.go:151\nmsgid \"Compile all the sketches found in the given directory and its subfolders for each board, instead of a single sketch.\"\nmsgstr \"Compile all the sketches found in the given directory and its subfolders for each board, instead of a single sketch.\"\n\n#: cli/compile/compile.go:85\n#: cli/compile/compile.go:86\nmsgid \"Compiles Arduino sketches.\"\nmsgstr \"Compiles Arduino sketches.\"\n\n#: cli/board/details.go:127\nmsgid \"Configured FQBN:\"\nmsgstr \"Configured FQBN:\"\n\n#: commands/post_install.go:42\nmsgid \"Configuring platform\"\nmsgstr \"Configuring platform\"\n\n#: commands/core/post_install.go:53\nmsgid \"Configuring platform %s\"\nmsgstr \"Configuring platform %s\"\n\n#: cli/daemon/shutdown.go:38\nmsgid \"Connects to the daemon listening on the configured address, port or socket and asks it to stop. The daemon waits for the calls in progress to end, up to the grace period, before stopping.\"\nmsgstr \"Connects to the daemon listening on the configured address, port or socket and asks it to stop. The daemon waits for the calls in progress to end, up to the grace period, before stopping.\"\n\n#: cli/daemon/health.go:37\nmsgid \"Connects to the daemon listening on the configured address, port or socket and prints its health status. Exits with an error if the daemon can't be reached or is not serving requests.\"\nmsgstr \"Connects to the daemon listening on the configured address, port or socket and prints its health status. Exits with an error if the daemon can't be reached or is not serving requests.\"\n\n#: cli/board/attach.go:94\n#: cli/burnbootloader/burnbootloader.go:112\n#: cli/compile/compile.go:336\n#: cli/debug/debug.go:192\n#: cli/upload/upload.go:231\nmsgid \"Couldn't get current working directory: %v\"\nmsgstr \"Couldn't get current working directory: %v\"\n\n#: cli/sketch/new.go:39\nmsgid \"Create a new Sketch\"\nmsgstr \"Create a new Sketch\"\n\n#: cli/sketch/new.go:40\nmsgid \"Create a new Sketch.\\n\"\n\"A template can be used to scaffold the Sketch: templates are folders in the\\n\"\n\"'templates' subfolder of the user directory, the {{name}}, {{author}} and\\n\"\n\"{{date}} placeholders are replaced in file names and contents.\"\nmsgstr \"Create a new Sketch.\\n\"\n\"A template can be used to scaffold the Sketch: templates are folders in the\\n\"\n\"'templates' subfolder of the user directory, the {{name}}, {{author}} and\\n\"\n\"{{date}} placeholders are replaced in file names and contents.\"\n\n#: cli/sketch/archive.go:42\n#: cli/sketch/archive.go:43\nmsgid \"Creates a zip file containing all sketch files.\"\nmsgstr \"Creates a zip file containing all sketch files.\"\n\n#: cli/config/init.go:37\nmsgid \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\nmsgstr \"Creates or updates the configuration file in the data directory or custom directory with the current configuration settings.\"\n\n#: cli/debug/debug.go:57\nmsgid \"Debug Arduino sketches.\"\nmsgstr \"Debug Arduino sketches.\"\n\n#: cli/debug/debug.go:58\nmsgid \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\nmsgstr \"Debug Arduino sketches. (this command opens an interactive gdb session)\"\n\n#: cli/debug/debug.go:67\nmsgid \"Debug interpreter e.g.: console, mi, mi1, mi2, mi3\"\nmsgstr \"Debug interpreter e.g.: console, mi, mi1, mi2, mi3\"\n\n#: cli/debug/debug.go:65\nmsgid \"Debug port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\nmsgstr \"Debug port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\n\n#: cli/board/details.go:139\nmsgid \"Debugging supported:\"\nmsgstr \"Debugging supported:\"\n\n#: cli/compile/compile.go:148\nmsgid \"Define the macros ARDUINO_CLI_BUILD_TIMESTAMP, ARDUINO_CLI_BUILD_GIT, ARDUINO_CLI_BUILD_PROFILE and ARDUINO_CLI_VERSION describing the build, or the ones of the build.metadata setting.\"\nmsgstr \"Define the macros ARDUINO_CLI_BUILD_TIMESTAMP, ARDUINO_CLI_BUILD_GIT, ARDUINO_CLI_BUILD_PROFILE and ARDUINO_CLI_VERSION describing the build, or the ones of the build.metadata setting.\"\n\n#: cli/board/list.go:39\nmsgid \"Detects and displays a list of boards connected to the current computer.\"\nmsgstr \"Detects and displays a list of boards connected to the current computer.\"\n\n#: cli/debug/debug.go:68\nmsgid \"Directory containing binaries for debug.\"\nmsgstr \"Directory containing binaries for debug.\"\n\n#: cli/upload/upload.go:78\nmsgid \"Directory containing binaries to upload.\"\nmsgstr \"Directory containing binaries to upload.\"\n\n#: cli/generatedocs/generatedocs.go:40\nmsgid \"Directory where to save generated files. Default is './docs', the directory must exist.\"\nmsgstr \"Directory where to save generated files. Default is './docs', the directory must exist.\"\n\n#: cli/completion/completion.go:42\nmsgid \"Disable completion description for shells that support it\"\nmsgstr \"Disable completion description for shells that support it\"\n\n#: cli/cli.go:121\nmsgid \"Disable network access, use only the indexes and archives already downloaded.\"\nmsgstr \"Disable network access, use only the indexes and archives already downloaded.\"\n\n#: cli/lib/install.go:43\nmsgid \"Do not install dependencies.\"\nmsgstr \"Do not install dependencies.\"\n\n#: cli/daemon/daemon.go:66\nmsgid \"Do not terminate daemon process if the parent process dies\"\nmsgstr \"Do not terminate daemon process if the parent process dies\"\n\n#: cli/output/cached_archives.go:48\nmsgid \"Downloaded\"\nmsgstr \"Downloaded\"\n\n#: commands/instances.go:759\n#: commands/instances.go:807\n#: commands/lib/download.go:53\nmsgid \"Downloading %s\"\nmsgstr \"Downloading %s\"\n\n#: commands/lib/download.go:79\nmsgid \"Downloading libraries\"\nmsgstr \"Downloading libraries\"\n\n#: commands/instances.go:165\nmsgid \"Downloading missing tool %s\"\nmsgstr \"Downloading missing tool %s\"\n\n#: commands/core/install.go:194\nmsgid \"Downloading packages\"\nmsgstr \"Downloading packages\"\n\n#: cli/core/download.go:41\nmsgid \"Downloads one or more cores and corresponding tool dependencies.\"\nmsgstr \"Downloads one or more cores and corresponding tool dependencies.\"\n\n#: cli/core/download.go:42\nmsgid \"Downloads one or more cores and corresponding tool dependencies. The archives are verified and kept in the downloads directory, so that the cores can be installed later without internet access.\"\nmsgstr \"Downloads one or more cores and corresponding tool dependencies. The archives are verified and kept in the downloads directory, so that the cores can be installed later without internet access.\"\n\n#: cli/lib/download.go:40\nmsgid \"Downloads one or more libraries without installing them.\"\nmsgstr \"Downloads one or more libraries without installing them.\"\n\n#: cli/lib/download.go:41\nmsgid \"Downloads one or more libraries without installing them. The archives are verified and kept in the downloads directory, so that the libraries can be installed later without internet access.\"\nmsgstr \"Downloads one or more libraries without installing them. The archives are verified and kept in the downloads directory, so that the libraries can be installed later without internet access.\"\n\n#: cli/selfupdate/selfupdate.go:43\nmsgid \"Downloads the latest release of Arduino CLI, verifies its signature and replaces the executable in use. The release channel, stable or nightly, is taken from the updater.channel setting unless specified.\"\nmsgstr \"Downloads the latest release of Arduino CLI, verifies its signature and replaces the executable in use. The release channel, stable or nightly, is taken from the updater.channel setting unless specified.\"\n\n#: cli/daemon/daemon.go:67\nmsgid \"Enable gRPC server reflection, to inspect the services with tools like grpcurl\"\nmsgstr \"Enable gRPC server reflection, to inspect the services with tools like grpcurl\"\n\n#: cli/upload/multiple.go:130\nmsgid \"Error\"\nmsgstr \"Error\"\n\n#: cli/env/apply.go:85\nmsgid \"Error applying lockfile: %v\"\nmsgstr \"Error applying lockfile: %v\"\n\n#: cli/sketch/archive.go:79\n#: cli/sketch/archive.go:96\nmsgid \"Error archiving: %v\"\nmsgstr \"Error archiving: %v\"\n\n#: cli/selfupdate/selfupdate.go:65\n#: cli/version/version.go:59\nmsgid \"Error checking for updates: %v\"\nmsgstr \"Error checking for updates: %v\"\n\n#: cli/daemon/health.go:59\nmsgid \"Error checking the daemon health: %v\"\nmsgstr \"Error checking the daemon health: %v\"\n\n#: cli/core/clean_cache.go:59\n#: cli/core/clean_cache.go:72\n#: cli/lib/clean_cache.go:59\n#: cli/lib/clean_cache.go:72\nmsgid \"Error cleaning cache: %v\"\nmsgstr \"Error cleaning cache: %v\"\n\n#: cli/cache/clean.go:46\nmsgid \"Error cleaning caches: %v\"\nmsgstr \"Error cleaning caches: %v\"\n\n#: cli/core/clean_tools.go:49\n#: cli/core/clean_tools.go:57\nmsgid \"Error cleaning tools: %v\"\nmsgstr \"Error cleaning tools: %v\"\n\n#: cli/compile/batch.go:160\nmsgid \"Error compiling in batch: %v\"\nmsgstr \"Error compiling in batch: %v\"\n\n#: commands/post_install.go:48\nmsgid \"Error configuring platform, rolling back the installation of %s\"\nmsgstr \"Error configuring platform, rolling back the installation of %s\"\n\n#: cli/daemon/health.go:50\n#: cli/daemon/shutdown.go:52\nmsgid \"Error connecting to the daemon: %v\"\nmsgstr \"Error connecting to the daemon: %v\"\n\n#: cli/compile/compile.go:211\n#: cli/sketch/includes.go:86\n#: cli/sketch/preprocess.go:86\nmsgid \"Error creating instance: %v\"\nmsgstr \"Error creating instance: %v\"\n\n#: inventory/inventory.go:76\nmsgid \"Error creating inventory dir: %v\"\nmsgstr \"Error creating inventory dir: %v\"\n\n#: cli/sketch/preprocess.go:109\nmsgid \"Error creating output directory: %v\"\nmsgstr \"Error creating output directory: %v\"\n\n#: cli/sketch/new.go:59\n#: cli/sketch/new.go:70\nmsgid \"Error creating sketch: %v\"\nmsgstr \"Error creating sketch: %v\"\n\n#: cli/board/list.go:65\n#: cli/board/list.go:71\nmsgid \"Error detecting boards: %v\"\nmsgstr \"Error detecting boards: %v\"\n\n#: cli/core/download.go:93\n#: cli/lib/download.go:84\nmsgid \"Error downloading %s: %v\"\nmsgstr \"Error downloading %s: %v\"\n\n#: commands/instances.go:826\nmsgid \"Error downloading tool %s\"\nmsgstr \"Error downloading tool %s\"\n\n#: cli/core/download.go:72\nmsgid \"Error downloading: %v\"\nmsgstr \"Error downloading: %v\"\n\n#: cli/debug/debug.go:96\n#: cli/debug/debug.go:136\nmsgid \"Error during Debug: %v\"\nmsgstr \"Error during Debug: %v\"\n\n#: cli/burnbootloader/burnbootloader.go:68\n#: cli/burnbootloader/burnbootloader.go:98\n#: cli/compile/compile.go:320\n#: cli/upload/multiple.go:90\n#: cli/upload/upload.go:127\n#: cli/upload/upload.go:173\n#: cli/upload/upload.go:179\nmsgid \"Error during Upload: %v\"\nmsgstr \"Error during Upload: %v\"\n\n#: cli/compile/compile.go:297\nmsgid \"Error during build: %v\"\nmsgstr \"Error during build: %v\"\n\n#: cli/core/install.go:169\nmsgid \"Error during install: %v\"\nmsgstr \"Error during install: %v\"\n\n#: arduino/builder/sketch.go:158\nmsgid \"Error during sketch processing: %v\"\nmsgstr \"Error during sketch processing: %v\"\n\n#: cli/core/uninstall.go:82\nmsgid \"Error during uninstall: %v\"\nmsgstr \"Error during uninstall: %v\"\n\n#: cli/core/upgrade.go:128\nmsgid \"Error during upgrade: %v\"\nmsgstr \"Error during upgrade: %v\"\n\n#: cli/env/export.go:74\nmsgid \"Error encoding lockfile: %v\"\nmsgstr \"Error encoding lockfile: %v\"\n\n#: cli/env/export.go:47\n#: cli/env/export.go:55\nmsgid \"Error exporting environment: %v\"\nmsgstr \"Error exporting environment: %v\"\n\n#: cli/sketch/includes.go:101\nmsgid \"Error finding the includes of the sketch: %v\"\nmsgstr \"Error finding the includes of the sketch: %v\"\n\n#: inventory/inventory.go:59\nmsgid \"Error generating installation.id: %v\"\nmsgstr \"Error generating installation.id: %v\"\n\n#: inventory/inventory.go:65\nmsgid \"Error generating installation.secret: %v\"\nmsgstr \"Error generating installation.secret: %v\"\n\n#: cli/debug/debug.go:170\nmsgid \"Error generating the launch configuration: %v\"\nmsgstr \"Error generating the launch configuration: %v\"\n\n#: cli/debug/debug.go:119\n#: cli/debug/debug.go:156\nmsgid \"Error getting Debug info: %v\"\nmsgstr \"Error getting Debug info: %v\"\n\n#: cli/board/details.go:60\n#: cli/board/details.go:75\nmsgid \"Error getting board details: %v\"\nmsgstr \"Error getting board details: %v\"\n\n#: cli/lib/examples.go:77\nmsgid \"Error getting libraries info: %v\"\nmsgstr \"Error getting libraries info: %v\"\n\n#: cli/debug/debug.go:145\nmsgid \"Error getting the sketch path: %v\"\nmsgstr \"Error getting the sketch path: %v\"\n\n#: commands/instances.go:853\nmsgid \"Error installing %s\"\nmsgstr \"Error installing %s\"\n\n#: cli/compile/auto_install.go:81\n#: cli/lib/install.go:68\nmsgid \"Error installing %s: %v\"\nmsgstr \"Error installing %s: %v\"\n\n#: commands/instances.go:844\nmsgid \"Error installing tool %s\"\nmsgstr \"Error installing tool %s\"\n\n#: cli/core/install.go:136\nmsgid \"Error installing: %v\"\nmsgstr \"Error installing: %v\"\n\n#: cli/lib/list.go:81\nmsgid \"Error listing Libraries: %v\"\nmsgstr \"Error listing Libraries: %v\"\n\n#: cli/board/listall.go:63\n#: cli/board/listall.go:75\nmsgid \"Error listing boards: %v\"\nmsgstr \"Error listing boards: %v\"\n\n#: cli/lib/upgrade.go:67\nmsgid \"Error listing libraries: %v\"\nmsgstr \"Error listing libraries: %v\"\n\n#: cli/core/list.go:52\n#: cli/core/list.go:60\nmsgid \"Error listing platforms: %v\"\nmsgstr \"Error listing platforms: %v\"\n\n#: cli/burnbootloader/burnbootloader.go:78\n#: cli/upload/upload.go:137\nmsgid \"Error listing programmers: %v\"\nmsgstr \"Error listing programmers: %v\"\n\n#: cli/daemon/daemon.go:97\nmsgid \"Error loading TLS certificate: %v\"\nmsgstr \"Error loading TLS certificate: %v\"\n\n#: cli/sketch/preprocess.go:101\nmsgid \"Error preprocessing sketch: %v\"\nmsgstr \"Error preprocessing sketch: %v\"\n\n#: cli/debug/debug.go:164\nmsgid \"Error reading %s: %v\"\nmsgstr \"Error reading %s: %v\"\n\n#: cli/build/replay.go:53\nmsgid \"Error reading build log: %v\"\nmsgstr \"Error reading build log: %v\"\n\n#: configuration/configuration.go:74\n#: configuration/configuration.go:81\nmsgid \"Error reading config file: %v\"\nmsgstr \"Error reading config file: %v\"\n\n#: inventory/inventory.go:51\nmsgid \"Error reading inventory file: %v\"\nmsgstr \"Error reading inventory file: %v\"\n\n#: cli/env/apply.go:51\nmsgid \"Error reading lockfile: %v\"\nmsgstr \"Error reading lockfile: %v\"\n\n#: cli/core/download.go:60\n#: cli/lib/download.go:59\nmsgid \"Error reading manifest: %v\"\nmsgstr \"Error reading manifest: %v\"\n\n#: cli/compile/compile.go:176\n#: cli/debug/debug.go:90\n#: cli/sketch/includes.go:80\n#: cli/sketch/preprocess.go:80\n#: cli/upload/upload.go:121\nmsgid \"Error reading sketch config file: %v\"\nmsgstr \"Error reading sketch config file: %v\"\n\n#: cli/lib/check_deps.go:60\nmsgid \"Error resolving dependencies for %s: %s\"\nmsgstr \"Error resolving dependencies for %s: %s\"\n\n#: cli/core/upgrade.go:71\n#: cli/core/upgrade.go:88\nmsgid \"Error retrieving core list: %v\"\nmsgstr \"Error retrieving core list: %v\"\n\n#: cli/outdated/outdated.go:60\n#: cli/update/update.go:69\nmsgid \"Error retrieving outdated cores and libraries: %v\"\nmsgstr \"Error retrieving outdated cores and libraries: %v\"\n\n#: commands/core/install.go:243\n#: commands/instances.go:873\n#: commands/post_install.go:51\nmsgid \"Error rolling-back changes: %s\"\nmsgstr \"Error rolling-back changes: %s\"\n\n#: cli/build/replay.go:87\nmsgid \"Error running command %d: %v\"\nmsgstr \"Error running command %d: %v\"\n\n#: cli/outdated/outdated.go:50\nmsgid \"Error running outdated command: %v\"\nmsgstr \"Error running outdated command: %v\"\n\n#: cli/core/install.go:128\nmsgid \"Error running post-install script: %v\"\nmsgstr \"Error running post-install script: %v\"\n\n#: cli/sketch/preprocess.go:114\nmsgid \"Error saving preprocessed sketch: %v\"\nmsgstr \"Error saving preprocessed sketch: %v\"\n\n#: cli/lib/search.go:69\nmsgid \"Error searching for Library: %v\"\nmsgstr \"Error searching for Library: %v\"\n\n#: cli/core/search.go:57\n#: cli/core/search.go:66\nmsgid \"Error searching for platforms: %v\"\nmsgstr \"Error searching for platforms: %v\"\n\n#: cli/board/setup.go:53\n#: cli/board/setup.go:63\nmsgid \"Error setting up the board: %v\"\nmsgstr \"Error setting up the board: %v\"\n\n#: cli/daemon/shutdown.go:63\nmsgid \"Error shutting down the daemon: %v\"\nmsgstr \"Error shutting down the daemon: %v\"\n\n#: cli/lib/uninstall.go:61\nmsgid \"Error uninstalling %s: %v\"\nmsgstr \"Error uninstalling %s: %v\"\n\n#: cli/core/uninstall.go:56\nmsgid \"Error uninstalling: %v\"\nmsgstr \"Error uninstalling: %v\"\n\n#: cli/selfupdate/selfupdate.go:76\nmsgid \"Error updating Arduino CLI: %v\"\nmsgstr \"Error updating Arduino CLI: %v\"\n\n#: cli/env/apply.go:78\n#: cli/update/update.go:60\nmsgid \"Error updating core and libraries index: %v\"\nmsgstr \"Error updating core and libraries index: %v\"\n\n#: cli/core/update_index.go:61\nmsgid \"Error updating index: %v\"\nmsgstr \"Error updating index: %v\"\n\n#: cli/lib/update_index.go:50\nmsgid \"Error updating library index: %v\"\nmsgstr \"Error updating library index: %v\"\n\n#: commands/core/install.go:238\nmsgid \"Error updating platform: %s\"\nmsgstr \"Error updating platform: %s\"\n\n#: cli/lib/upgrade.go:83\n#: cli/lib/upgrade.go:89\nmsgid \"Error upgrading libraries: %v\"\nmsgstr \"Error upgrading libraries: %v\"\n\n#: commands/instances.go:868\nmsgid \"Error upgrading platform: %s\"\nmsgstr \"Error upgrading platform: %s\"\n\n#: cli/core/upgrade.go:62\n#: cli/upgrade/upgrade.go:51\n#: cli/upgrade/upgrade.go:65\nmsgid \"Error upgrading: %v\"\nmsgstr \"Error upgrading: %v\"\n\n#: cli/debug/debug.go:174\n#: cli/debug/debug.go:178\nmsgid \"Error writing %s: %v\"\nmsgstr \"Error writing %s: %v\"\n\n#: inventory/inventory.go:82\nmsgid \"Error writing inventory file: %v\"\nmsgstr \"Error writing inventory file: %v\"\n\n#: cli/completion/completion.go:49\nmsgid \"Error: command description is not supported by %v\"\nmsgstr \"Error: command description is not supported by %v\"\n\n#: cli/compile/batch.go:256\nmsgid \"Errors\"\nmsgstr \"Errors\"\n\n#: cli/usage.go:29\nmsgid \"Examples:\"\nmsgstr \"Examples:\"\n\n#: cli/sketch/archive.go:57\nmsgid \"Excludes the files matching the given .gitignore-style patterns, in addition to the ones in the sketch .gitignore.\"\nmsgstr \"Excludes the files matching the given .gitignore-style patterns, in addition to the ones in the sketch .gitignore.\"\n\n#: cli/env/env.go:29\nmsgid \"Export and apply lockfiles pinning the installed platforms, tools and libraries to exact versions.\"\nmsgstr \"Export and apply lockfiles pinning the installed platforms, tools and libraries to exact versions.\"\n\n#: cli/env/export.go:33\nmsgid \"Exports the installed platforms, tools and libraries to a lockfile.\"\nmsgstr \"Exports the installed platforms, tools and libraries to a lockfile.\"\n\n#: cli/daemon/daemon.go:237\nmsgid \"Failed to listen on TCP port: %s. %s is an invalid port.\"\nmsgstr \"Failed to listen on TCP port: %s. %s is an invalid port.\"\n\n#: cli/daemon/daemon.go:231\nmsgid \"Failed to listen on TCP port: %s. %s is unknown name.\"\nmsgstr \"Failed to listen on TCP port: %s. %s is unknown name.\"\n\n#: cli/daemon/daemon.go:243\nmsgid \"Failed to listen on TCP port: %s. Address already in use.\"\nmsgstr \"Failed to listen on TCP port: %s. Address already in use.\"\n\n#: cli/daemon/daemon.go:246\nmsgid \"Failed to listen on TCP port: %s. Unexpected error: %v\"\nmsgstr \"Failed to listen on TCP port: %s. Unexpected error: %v\"\n\n#: cli/daemon/daemon.go:260\nmsgid \"Failed to listen on socket: %s. Address already in use.\"\nmsgstr \"Failed to listen on socket: %s. Address already in use.\"\n\n#: cli/daemon/daemon.go:264\nmsgid \"Failed to listen on socket: %s. File exists.\"\nmsgstr \"Failed to listen on socket: %s. File exists.\"\n\n#: cli/daemon/daemon.go:274\nmsgid \"Failed to listen on socket: %s. Unexpected error: %v\"\nmsgstr \"Failed to listen on socket: %s. Unexpected error: %v\"\n\n#: cli/daemon/daemon.go:268\nmsgid \"Failed to remove stale socket: %s. %v\"\nmsgstr \"Failed to remove stale socket: %s. %v\"\n\n#: cli/daemon/daemon.go:279\nmsgid \"Failed to set socket permissions: %s. %v\"\nmsgstr \"Failed to set socket permissions: %s. %v\"\n\n#: cli/board/details.go:176\nmsgid \"File:\"\nmsgstr \"File:\"\n\n#: cli/usage.go:31\nmsgid \"Flags:\"\nmsgstr \"Flags:\"\n\n#: cli/compile/batch.go:256\nmsgid \"Flash\"\nmsgstr \"Flash\"\n\n#: cli/sketch/archive.go:56\nmsgid \"Fully Qualified Board Name used to find the platform listed in the manifest, e.g.: arduino:avr:uno\"\nmsgstr \"Fully Qualified Board Name used to find the platform listed in the manifest, e.g.: arduino:avr:uno\"\n\n#: cli/board/details.go:50\n#: cli/burnbootloader/burnbootloader.go:56\n#: cli/debug/debug.go:64\n#: cli/sketch/includes.go:59\n#: cli/sketch/preprocess.go:58\n#: cli/upload/upload.go:74\nmsgid \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\nmsgstr \"Fully Qualified Board Name, e.g.: arduino:avr:uno\"\n\n#: cli/compile/compile.go:95\nmsgid \"Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times together with --examples-of or --sketches-in to compile for multiple boards.\"\nmsgstr \"Fully Qualified Board Name, e.g.: arduino:avr:uno. Can be used multiple times together with --examples-of or --sketches-in to compile for multiple boards.\"\n\n#: cli/debug/debug.go:71\nmsgid \"GDB command to run at the start of the debug session. Can be used multiple times.\"\nmsgstr \"GDB command to run at the start of the debug session. Can be used multiple times.\"\n\n#: cli/generatedocs/generatedocs.go:34\n#: cli/generatedocs/generatedocs.go:35\nmsgid \"Generates bash completion and command manpages.\"\nmsgstr \"Generates bash completion and command manpages.\"\n\n#: cli/completion/completion.go:36\nmsgid \"Generates completion scripts\"\nmsgstr \"Generates completion scripts\"\n\n#: cli/completion/completion.go:37\nmsgid \"Generates completion scripts for various shells\"\nmsgstr \"Generates completion scripts for various shells\"\n\n#: cli/usage.go:32\nmsgid \"Global Flags:\"\nmsgstr \"Global Flags:\"\n\n#: cli/daemon/shutdown.go:45\nmsgid \"How long the daemon waits for the calls in progress to end\"\nmsgstr \"How long the daemon waits for the calls in progress to end\"\n\n#: cli/daemon/health.go:43\n#: cli/daemon/shutdown.go:44\nmsgid \"How long to wait for the daemon to answer\"\nmsgstr \"How long to wait for the daemon to answer\"\n\n#: cli/board/details.go:97\n#: cli/board/details.go:204\nmsgid \"Id\"\nmsgstr \"Id\"\n\n#: cli/board/details.go:146\nmsgid \"Identification properties:\"\nmsgstr \"Identification properties:\"\n\n#: cli/lib/list.go:52\nmsgid \"Include built-in libraries (from platforms and IDE) in listing.\"\nmsgstr \"Include built-in libraries (from platforms and IDE) in listing.\"\n\n#: cli/board/listall.go:48\nmsgid \"Include the configuration options of each board in the JSON output\"\nmsgstr \"Include the configuration options of each board in the JSON output\"\n\n#: cli/sketch/archive.go:55\nmsgid \"Includes a manifest of the platform and libraries used by the sketch.\"\nmsgstr \"Includes a manifest of the platform and libraries used by the sketch.\"\n\n#: cli/sketch/archive.go:54\nmsgid \"Includes build directory in the archive.\"\nmsgstr \"Includes build directory in the archive.\"\n\n#: cli/compile/compile.go:146\nmsgid \"Install the library providing each missing header, when exactly one library of the library index provides it, without asking for confirmation.\"\nmsgstr \"Install the library providing each missing header, when exactly one library of the library index provides it, without asking for confirmation.\"\n\n#: cli/compile/compile.go:145\nmsgid \"Install the platform and the libraries required by the build profile if they are missing.\"\nmsgstr \"Install the platform and the libraries required by the build profile if they are missing.\"\n\n#: commands/instances.go:784\n#: commands/lib/install.go:131\nmsgid \"Installed %s\"\nmsgstr \"Installed %s\"\n\n#: commands/core/install.go:135\n#: commands/core/install.go:212\n#: commands/core/install.go:219\n#: commands/instances.go:767\n#: commands/lib/install.go:111\n#: commands/lib/install.go:127\nmsgid \"Installing %s\"\nmsgstr \"Installing %s\"\n\n#: commands/updater/updater.go:139\nmsgid \"Installing Arduino CLI %s\"\nmsgstr \"Installing Arduino CLI %s\"\n\n#: cli/core/install.go:40\n#: cli/core/install.go:41\nmsgid \"Installs one or more cores and corresponding tool dependencies.\"\nmsgstr \"Installs one or more cores and corresponding tool dependencies.\"\n\n#: cli/lib/install.go:34\n#: cli/lib/install.go:35\nmsgid \"Installs one or more specified libraries into the system.\"\nmsgstr \"Installs one or more specified libraries into the system.\"\n\n#: cli/env/apply.go:39\nmsgid \"Installs the exact versions of the platforms, tools and libraries listed in a lockfile created with `env export`. The additional package index URLs of the lockfile are used together with the configured ones.\"\nmsgstr \"Installs the exact versions of the platforms, tools and libraries listed in a lockfile created with `env export`. The additional package index URLs of the lockfile are used together with the configured ones.\"\n\n#: cli/env/apply.go:38\nmsgid \"Installs the platforms, tools and libraries listed in a lockfile.\"\nmsgstr \"Installs the platforms, tools and libraries listed in a lockfile.\"\n\n#: cli/core/download.go:80\n#: cli/core/install.go:144\n#: cli/core/uninstall.go:64\n#: cli/core/upgrade.go:106\n#: cli/lib/download.go:72\n#: cli/lib/uninstall.go:50\nmsgid \"Invalid argument passed: %v\"\nmsgstr \"Invalid argument passed: %v\"\n\n#: cli/debug/debug.go:151\nmsgid \"Invalid argument: %v\"\nmsgstr \"Invalid argument: %v\"\n\n#: cli/compile/compile.go:160\nmsgid \"Invalid arguments: %v\"\nmsgstr \"Invalid arguments: %v\"\n\n#: cli/compile/compile.go:183\nmsgid \"Invalid build property '%s', it must be in the key=value form.\"\nmsgstr \"Invalid build property '%s', it must be in the key=value form.\"\n\n#: cli/build/replay.go:63\nmsgid \"Invalid command ID '%s': %v\"\nmsgstr \"Invalid command ID '%s': %v\"\n\n#: cli/cli.go:214\nmsgid \"Invalid option for --log-level: %s\"\nmsgstr \"Invalid option for --log-level: %s\"\n\n#: cli/compile/compile.go:200\nmsgid \"Invalid size report '%s', it must be either 'short' or 'full'.\"\nmsgstr \"Invalid size report '%s', it must be either 'short' or 'full'.\"\n\n#: cli/board/list.go:57\nmsgid \"Invalid timeout: %v\"\nmsgstr \"Invalid timeout: %v\"\n\n#: cli/compile/compile.go:139\nmsgid \"Just produce the compilation database, without actually compiling.\"\nmsgstr \"Just produce the compilation database, without actually compiling.\"\n\n#: commands/core/uninstall.go:73\nmsgid \"Keeping %s, tool is still required by %s\"\nmsgstr \"Keeping %s, tool is still required by %s\"\n\n#: commands/lib/install.go:77\nmsgid \"Libraries to install: %s\"\nmsgstr \"Libraries to install: %s\"\n\n#: commands/lib/uninstall.go:36\nmsgid \"Library %s is not installed\"\nmsgstr \"Library %s is not installed\"\n\n#: cli/compile/compile.go:109\nmsgid \"Linker script to use among the ones provided by the platform for the board, instead of the one selected by the board options.\"\nmsgstr \"Linker script to use among the ones provided by the platform for the board, instead of the one selected by the board options.\"\n\n#: commands/core/install.go:57\nmsgid \"Linking %s\"\nmsgstr \"Linking %s\"\n\n#: cli/board/listall.go:36\nmsgid \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\nmsgstr \"List all boards that have the support platform installed. You can search\\n\"\n\"for a specific board if you specify the board name\"\n\n#: cli/board/listall.go:35\nmsgid \"List all known boards and their corresponding FQBN.\"\nmsgstr \"List all known boards and their corresponding FQBN.\"\n\n#: cli/board/list.go:38\nmsgid \"List connected boards.\"\nmsgstr \"List connected boards.\"\n\n#: cli/compile/compile.go:118\nmsgid \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\nmsgstr \"List of custom build properties separated by commas. Or can be used multiple times for multiple properties.\"\n\n#: cli/sketch/includes.go:63\n#: cli/sketch/preprocess.go:63\nmsgid \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\nmsgstr \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths.\"\n\n#: cli/compile/compile.go:132\nmsgid \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths. Their libraries have priority over the installed ones.\"\nmsgstr \"List of custom libraries dir paths separated by commas. Or can be used multiple times for multiple libraries dir paths. Their libraries have priority over the installed ones.\"\n\n#: cli/core/clean_cache.go:46\n#: cli/lib/clean_cache.go:46\nmsgid \"List the archives to remove and their size without removing them.\"\nmsgstr \"List the archives to remove and their size without removing them.\"\n\n#: cli/lib/list.go:54\nmsgid \"List updatable libraries.\"\nmsgstr \"List updatable libraries.\"\n\n#: cli/core/list.go:41\nmsgid \"List updatable platforms.\"\nmsgstr \"List updatable platforms.\"\n\n#: cli/outdated/outdated.go:36\nmsgid \"Lists cores and libraries that can be upgraded\"\nmsgstr \"Lists cores and libraries that can be upgraded\"\n\n#: cli/sketch/includes.go:47\nmsgid \"Lists the libraries used by the sketch and the missing includes.\"\nmsgstr \"Lists the libraries used by the sketch and the missing includes.\"\n\n#: cli/sketch/includes.go:48\nmsgid \"Lists the libraries used by the sketch and the missing includes.\\n\"\n\"Only the detection of the includes is run, nothing is compiled. The headers\\n\"\n\"that can't be found in the core or in the installed libraries are reported\\n\"\n\"with the libraries of the library index that provide them.\"\nmsgstr \"Lists the libraries used by the sketch and the missing includes.\\n\"\n\"Only the detection of the includes is run, nothing is compiled. The headers\\n\"\n\"that can't be found in the core or in the installed libraries are reported\\n\"\n\"with the libraries of the library index that provide them.\"\n\n#: cli/board/listall.go:47\nmsgid \"Match the board names approximately, the best matches are listed first\"\nmsgstr \"Match the board names approximately, the best matches are listed first\"\n\n#: cli/upload/upload.go:77\nmsgid \"Max number of boards uploaded at the same time when uploading to many boards, all of them if set to 0.\"\nmsgstr \"Max number of boards uploaded at the same time when uploading to many boards, all of them if set to 0.\"\n\n#: cli/compile/compile.go:141\nmsgid \"Max number of parallel compiles. If set to 0 the build.jobs setting is used, or the number of available CPU cores if it is 0 too.\"\nmsgstr \"Max number of parallel compiles. If set to 0 the build.jobs setting is used, or the number of available CPU cores if it is 0 too.\"\n\n#: cli/cli.go:111\nmsgid \"Messages with this level and above will be logged. Valid levels are: trace, debug, info, warn, error, fatal, panic\"\nmsgstr \"Messages with this level and above will be logged. Valid levels are: trace, debug, info, warn, error, fatal, panic\"\n\n#: cli/compile/compile.go:164\nmsgid \"Multiple boards can be given only together with --examples-of or --sketches-in.\"\nmsgstr \"Multiple boards can be given only together with --examples-of or --sketches-in.\"\n\n#: cli/board/details.go:204\nmsgid \"Name\"\nmsgstr \"Name\"\n\n#: cli/compile/compile.go:101\nmsgid \"Name of the artifacts saved in the output directory, instead of <sketch>.ino. The placeholders {sketch}, {fqbn}, {board}, {git} (git describe of the sketch folder) and {timestamp} are expanded, e.g.: {sketch}-{board}-{git}\"\nmsgstr \"Name of the artifacts saved in the output directory, instead of <sketch>.ino. The placeholders {sketch}, {fqbn}, {board}, {git} (git describe of the sketch folder) and {timestamp} are expanded, e.g.: {sketch}-{board}-{git}\"\n\n#: cli/output/cached_archives.go:44\nmsgid \"No archives to remove.\"\nmsgstr \"No archives to remove.\"\n\n#: cli/board/details.go:175\nmsgid \"OS:\"\nmsgstr \"OS:\"\n\n#: cli/board/details.go:133\nmsgid \"Official Arduino board:\"\nmsgstr \"Official Arduino board:\"\n\n#: cli/selfupdate/selfupdate.go:51\nmsgid \"Only check if a newer release is available\"\nmsgstr \"Only check if a newer release is available\"\n\n#: cli/core/install.go:153\nmsgid \"Only one platform can be installed from a directory.\"\nmsgstr \"Only one platform can be installed from a directory.\"\n\n#: cli/core/install.go:149\nmsgid \"Only one platform can be installed from an archive.\"\nmsgstr \"Only one platform can be installed from an archive.\"\n\n#: cli/upload/upload.go:84\nmsgid \"Open the serial monitor on the board port after a successful upload.\"\nmsgstr \"Open the serial monitor on the board port after a successful upload.\"\n\n#: cli/board/details.go:187\nmsgid \"Option:\"\nmsgstr \"Option:\"\n\n#: cli/compile/compile.go:124\nmsgid \"Optional, can be \\\"none\\\", \\\"default\\\", \\\"more\\\" and \\\"all\\\". Defaults to the build.warnings setting, \\\"none\\\" if not set. Used to tell gcc which warning level to use (-W flag).\"\nmsgstr \"Optional, can be \\\"none\\\", \\\"default\\\", \\\"more\\\" and \\\"all\\\". Defaults to the build.warnings setting, \\\"none\\\" if not set. Used to tell gcc which warning level to use (-W flag).\"\n\n#: cli/compile/compile.go:138\nmsgid \"Optional, cleanup the build folder and do not use any cached build.\"\nmsgstr \"Optional, cleanup the build folder and do not use any cached build.\"\n\n#: cli/compile/compile.go:136\nmsgid \"Optional, optimize compile output for debugging, rather than for release.\"\nmsgstr \"Optional, optimize compile output for debugging, rather than for release.\"\n\n#: cli/compile/compile.go:142\nmsgid \"Optional, print the memory used by the compiled sketch. \\\"short\\\" (the default if no value is given) shows the memory usage and the 10 largest symbols, \\\"full\\\" adds the sections of the executable and all the symbols.\"\nmsgstr \"Optional, print the memory used by the compiled sketch. \\\"short\\\" (the default if no value is given) shows the memory usage and the 10 largest symbols, \\\"full\\\" adds the sections of the executable and all the symbols.\"\n\n#: cli/compile/compile.go:127\nmsgid \"Optional, suppresses almost every output.\"\nmsgstr \"Optional, suppresses almost every output.\"\n\n#: cli/compile/compile.go:126\n#: cli/upload/upload.go:81\nmsgid \"Optional, turns on verbose mode.\"\nmsgstr \"Optional, turns on verbose mode.\"\n\n#: cli/upload/upload.go:82\nmsgid \"Optional, use the specified programmer to upload or 'list' to list supported programmers.\"\nmsgstr \"Optional, use the specified programmer to upload or 'list' to list supported programmers.\"\n\n#: cli/compile/compile.go:137\nmsgid \"Optional, use the specified programmer to upload.\"\nmsgstr \"Optional, use the specified programmer to upload.\"\n\n#: cli/compile/compile.go:120\nmsgid \"Override a build property with a custom value, in the key=value form. Can be used multiple times for multiple properties.\"\nmsgstr \"Override a build property with a custom value, in the key=value form. Can be used multiple times for multiple properties.\"\n\n#: cli/sketch/includes.go:61\n#: cli/sketch/preprocess.go:61\nmsgid \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\nmsgstr \"Override a build property with a custom value. Can be used multiple times for multiple properties.\"\n\n#: cli/board/details.go:155\nmsgid \"Package URL:\"\nmsgstr \"Package URL:\"\n\n#: cli/board/details.go:154\nmsgid \"Package maintainer:\"\nmsgstr \"Package maintainer:\"\n\n#: cli/board/details.go:153\nmsgid \"Package name:\"\nmsgstr \"Package name:\"\n\n#: cli/board/details.go:157\nmsgid \"Package online help:\"\nmsgstr \"Package online help:\"\n\n#: cli/board/details.go:156\nmsgid \"Package website:\"\nmsgstr \"Package website:\"\n\n#: cli/compile/compile.go:107\nmsgid \"Partition table to use, e.g. huge_app, among the ones provided by the platform for the board, instead of the one selected by the board options.\"\nmsgstr \"Partition table to use, e.g. huge_app, among the ones provided by the platform for the board, instead of the one selected by the board options.\"\n\n#: cli/core/install.go:56\nmsgid \"Path of a platform directory to install as PACKAGER:ARCH by linking it, the changes to the directory are used without reinstalling.\"\nmsgstr \"Path of a platform directory to install as PACKAGER:ARCH by linking it, the changes to the directory are used without reinstalling.\"\n\n#: cli/compile/compile.go:111\nmsgid \"Path of the key used to sign the executable of the sketch with the signing tool of the platform. The signed executable is exported with the other artifacts.\"\nmsgstr \"Path of the key used to sign the executable of the sketch with the signing tool of the platform. The signed executable is exported with the other artifacts.\"\n\n#: cli/core/install.go:54\nmsgid \"Path or URL of a platform archive to install as PACKAGER:ARCH, without looking it up in the package index.\"\nmsgstr \"Path or URL of a platform archive to install as PACKAGER:ARCH, without looking it up in the package index.\"\n\n#: cli/sketch/includes.go:65\n#: cli/sketch/preprocess.go:65\nmsgid \"Path to a single library's root folder. Can be used multiple times for multiple libraries.\"\nmsgstr \"Path to a single library's root folder. Can be used multiple times for multiple libraries.\"\n\n#: cli/compile/compile.go:134\nmsgid \"Path to a single library's root folder. Can be used multiple times for multiple libraries. The libraries have priority over the installed ones.\"\nmsgstr \"Path to a single library's root folder. Can be used multiple times for multiple libraries. The libraries have priority over the installed ones.\"\n\n#: cli/cli.go:113\nmsgid \"Path to the file where logs will be written.\"\nmsgstr \"Path to the file where logs will be written.\"\n\n#: cli/compile/compile.go:116\nmsgid \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\nmsgstr \"Path where to save compiled files. If omitted, a directory will be created in the default temporary path of your OS.\"\n\n#: cli/sketch/includes.go:60\nmsgid \"Path where to save the files used to find the includes.\"\nmsgstr \"Path where to save the files used to find the includes.\"\n\n#: cli/sketch/preprocess.go:60\nmsgid \"Path where to save the files used to preprocess the sketch.\"\nmsgstr \"Path where to save the files used to preprocess the sketch.\"\n\n#: cli/compile/compile.go:115\nmsgid \"Perform the build but do not copy the compile output file.\"\nmsgstr \"Perform the build but do not copy the compile output file.\"\n\n#: commands/core/install.go:159\nmsgid \"Platform %s already installed\"\nmsgstr \"Platform %s already installed\"\n\n#: commands/core/post_install.go:49\nmsgid \"Platform %s has no post-install script\"\nmsgstr \"Platform %s has no post-install script\"\n\n#: cli/board/details.go:163\nmsgid \"Platform URL:\"\nmsgstr \"Platform URL:\"\n\n#: cli/board/details.go:162\nmsgid \"Platform architecture:\"\nmsgstr \"Platform architecture:\"\n\n#: cli/board/details.go:161\nmsgid \"Platform category:\"\nmsgstr \"Platform category:\"\n\n#: cli/board/details.go:168\nmsgid \"Platform checksum:\"\nmsgstr \"Platform checksum:\"\n\n#: cli/board/details.go:164\nmsgid \"Platform file name:\"\nmsgstr \"Platform file name:\"\n\n#: cli/board/details.go:160\nmsgid \"Platform name:\"\nmsgstr \"Platform name:\"\n\n#: cli/board/details.go:166\nmsgid \"Platform size (bytes):\"\nmsgstr \"Platform size (bytes):\"\n\n#: cli/upload/multiple.go:130\nmsgid \"Port\"\nmsgstr \"Port\"\n\n#: cli/board/details.go:42\nmsgid \"Print details about a board.\"\nmsgstr \"Print details about a board.\"\n\n#: cli/compile/compile.go:98\nmsgid \"Print preprocessed code to stdout instead of compiling.\"\nmsgstr \"Print preprocessed code to stdout instead of compiling.\"\n\n#: cli/cli.go:110\nmsgid \"Print the logs on the standard output.\"\nmsgstr \"Print the logs on the standard output.\"\n\n#: cli/env/export.go:34\nmsgid \"Prints a lockfile listing the additional package index URLs and the exact versions of the installed platforms, tools and libraries. The lockfile can be committed in version control and installed on another machine with `env apply`.\"\nmsgstr \"Prints a lockfile listing the additional package index URLs and the exact versions of the installed platforms, tools and libraries. The lockfile can be committed in version control and installed on another machine with `env apply`.\"\n\n#: cli/config/dump.go:31\nmsgid \"Prints the current configuration\"\nmsgstr \"Prints the current configuration\"\n\n#: cli/config/dump.go:32\nmsgid \"Prints the current configuration.\"\nmsgstr \"Prints the current configuration.\"\n\n#: cli/config/get.go:34\n#: cli/config/get.go:35\nmsgid \"Prints the value of a setting.\"\nmsgstr \"Prints the value of a setting.\"\n\n#: cli/compile/compile.go:103\nmsgid \"Produce the linker map file of the sketch, <sketch>.ino.map, in the build path and the output directory.\"\nmsgstr \"Produce the linker map file of the sketch, <sketch>.ino.map, in the build path and the output directory.\"\n\n#: cli/board/details.go:97\nmsgid \"Programmer name\"\nmsgstr \"Programmer name\"\n\n#: cli/debug/debug.go:66\nmsgid \"Programmer to use for debugging\"\nmsgstr \"Programmer to use for debugging\"\n\n#: cli/board/details.go:204\nmsgid \"Programmers:\"\nmsgstr \"Programmers:\"\n\n#: cli/debug/debug.go:70\nmsgid \"RTOS running on the board for thread-aware debugging, e.g.: FreeRTOS, Zephyr or auto\"\nmsgstr \"RTOS running on the board for thread-aware debugging, e.g.: FreeRTOS, Zephyr or auto\"\n\n#: cli/compile/compile.go:113\nmsgid \"Record every tool run by the build, with its recipe, command line, environment, exit code and duration, in this file. Run a recorded command again with build replay.\"\nmsgstr \"Record every tool run by the build, with its recipe, command line, environment, exit code and duration, in this file. Run a recorded command again with build replay.\"\n\n#: cli/selfupdate/selfupdate.go:52\nmsgid \"Release channel to use, stable or nightly\"\nmsgstr \"Release channel to use, stable or nightly\"\n\n#: cli/core/clean_cache.go:44\n#: cli/lib/clean_cache.go:44\nmsgid \"Remove only the archives downloaded more than the given number of days ago.\"\nmsgstr \"Remove only the archives downloaded more than the given number of days ago.\"\n\n#: cli/lib/clean_cache.go:45\nmsgid \"Remove only the archives no longer listed in the libraries index.\"\nmsgstr \"Remove only the archives no longer listed in the libraries index.\"\n\n#: cli/core/clean_cache.go:45\nmsgid \"Remove only the archives no longer listed in the package index.\"\nmsgstr \"Remove only the archives no longer listed in the package index.\"\n\n#: commands/core/clean_tools.go:42\nmsgid \"Removed %s\"\nmsgstr \"Removed %s\"\n\n#: cli/config/remove.go:31\nmsgid \"Removes one or more values from a setting.\"\nmsgstr \"Removes one or more values from a setting.\"\n\n#: cli/config/remove.go:32\nmsgid \"Removes one or more values from a setting. Only settings holding a list of values are supported.\"\nmsgstr \"Removes one or more values from a setting. Only settings holding a list of values are supported.\"\n\n#: cli/lib/clean_cache.go:35\nmsgid \"Removes the downloaded library archives.\"\nmsgstr \"Removes the downloaded library archives.\"\n\n#: cli/core/clean_cache.go:35\nmsgid \"Removes the downloaded platform and tool archives.\"\nmsgstr \"Removes the downloaded platform and tool archives.\"\n\n#: cli/lib/clean_cache.go:36\nmsgid \"Removes the library archives from the downloads directory.\\n\"\n\"The archives can be filtered by age and by whether they're still listed in the libraries index,\\n\"\n\"use --dry-run to only list them with their size.\"\nmsgstr \"Removes the library archives from the downloads directory.\\n\"\n\"The archives can be filtered by age and by whether they're still listed in the libraries index,\\n\"\n\"use --dry-run to only list them with their size.\"\n\n#: cli/core/clean_cache.go:36\nmsgid \"Removes the platform and tool archives from the downloads directory.\\n\"\n\"The archives can be filtered by age and by whether they're still listed in the package index,\\n\"\n\"use --dry-run to only list them with their size.\"\nmsgstr \"Removes the platform and tool archives from the downloads directory.\\n\"\n\"The archives can be filtered by age and by whether they're still listed in the package index,\\n\"\n\"use --dry-run to only list them with their size.\"\n\n#: cli/core/clean_tools.go:35\nmsgid \"Removes the tools no longer used by the installed cores.\"\nmsgstr \"Removes the tools no longer used by the installed cores.\"\n\n#: cli/core/clean_tools.go:36\nmsgid \"Removes the tools no longer used by the installed cores.\\n\"\n\"Tools archives are extracted once and shared by all the tool versions using the same\\n\"\n\"archive: the extracted archives no longer referenced by any tool are removed as well.\"\nmsgstr \"Removes the tools no longer used by the installed cores.\\n\"\n\"Tools archives are extracted once and shared by all the tool versions using the same\\n\"\n\"archive: the extracted archives no longer referenced by any tool are removed as well.\"\n\n#: commands/instances.go:777\n#: commands/lib/install.go:124\nmsgid \"Replacing %s with %s\"\nmsgstr \"Replacing %s with %s\"\n\n#: cli/board/details.go:172\nmsgid \"Required tool:\"\nmsgstr \"Required tool:\"\n\n#: cli/compile/batch.go:256\n#: cli/upload/multiple.go:130\nmsgid \"Result\"\nmsgstr \"Result\"\n\n#: cli/core/install.go:74\nmsgid \"Run post-install scripts without asking for confirmation.\"\nmsgstr \"Run post-install scripts without asking for confirmation.\"\n\n#: cli/daemon/daemon.go:55\nmsgid \"Running as a daemon the initialization of cores and libraries is done only once.\"\nmsgstr \"Running as a daemon the initialization of cores and libraries is done only once.\"\n\n#: cli/build/replay.go:39\nmsgid \"Runs again a command recorded in a build log.\"\nmsgstr \"Runs again a command recorded in a build log.\"\n\n#: cli/build/replay.go:40\nmsgid \"Runs again the command with the given ID recorded with compile --log-build, in the same working directory and with the same environment. Without ID the recorded commands are listed.\"\nmsgstr \"Runs again the command with the given ID recorded with compile --log-build, in the same working directory and with the same environment. Without ID the recorded commands are listed.\"\n\n#: cli/compile/compile.go:100\nmsgid \"Save build artifacts in this directory.\"\nmsgstr \"Save build artifacts in this directory.\"\n\n#: cli/sketch/preprocess.go:59\nmsgid \"Save the generated source in this directory instead of printing it.\"\nmsgstr \"Save the generated source in this directory instead of printing it.\"\n\n#: cli/core/search.go:41\nmsgid \"Search for a core in Boards Manager using the specified keywords.\\n\"\n\"\\n\"\n\"A core matches when each keyword is found in its name, architecture, package,\\n\"\n\"maintainer, website or in the name of one of its boards.\"\nmsgstr \"Search for a core in Boards Manager using the specified keywords.\\n\"\n\"\\n\"\n\"A core matches when each keyword is found in its name, architecture, package,\\n\"\n\"maintainer, website or in the name of one of its boards.\"\n\n#: cli/core/search.go:40\nmsgid \"Search for a core in Boards Manager.\"\nmsgstr \"Search for a core in Boards Manager.\"\n\n#: cli/lib/search.go:40\nmsgid \"Search for one or more libraries data (case insensitive search).\\n\"\n\"The name, sentence, paragraph, architectures and provided headers of the libraries are searched, the\\n\"\n\"results are sorted by relevance. The search can be narrowed with the following qualifiers:\\n\"\n\"  author:NAME    the author or maintainer contains NAME\\n\"\n\"  arch:ARCH      the library is compatible with the ARCH architecture\\n\"\n\"  header:HEADER  the library provides the HEADER include file\\n\"\n\"  topic:TOPIC    the library category contains TOPIC\"\nmsgstr \"Search for one or more libraries data (case insensitive search).\\n\"\n\"The name, sentence, paragraph, architectures and provided headers of the libraries are searched, the\\n\"\n\"results are sorted by relevance. The search can be narrowed with the following qualifiers:\\n\"\n\"  author:NAME    the author or maintainer contains NAME\\n\"\n\"  arch:ARCH      the library is compatible with the ARCH architecture\\n\"\n\"  header:HEADER  the library provides the HEADER include file\\n\"\n\"  topic:TOPIC    the library category contains TOPIC\"\n\n#: cli/lib/search.go:39\nmsgid \"Searches for one or more libraries data.\"\nmsgstr \"Searches for one or more libraries data.\"\n\n#: commands/board/attach.go:142\nmsgid \"Selected fqbn: %s\"\nmsgstr \"Selected fqbn: %s\"\n\n#: commands/board/attach.go:137\nmsgid \"Selected port: %s\"\nmsgstr \"Selected port: %s\"\n\n#: cli/config/set.go:34\nmsgid \"Sets a setting value in the configuration file. List settings accept multiple values, replacing the current list.\"\nmsgstr \"Sets a setting value in the configuration file. List settings accept multiple values, replacing the current list.\"\n\n#: cli/config/set.go:33\nmsgid \"Sets a setting value.\"\nmsgstr \"Sets a setting value.\"\n\n#: cli/board/setup.go:35\nmsgid \"Sets up the system to use a board.\"\nmsgstr \"Sets up the system to use a board.\"\n\n#: cli/board/setup.go:36\nmsgid \"Sets up the system to use a board: installs the udev rules on Linux, so that the serial port of the board can be used without root privileges, or shows where to get the drivers on Windows. The rules and the drivers are declared by the platform of the board.\"\nmsgstr \"Sets up the system to use a board: installs the udev rules on Linux, so that the serial port of the board can be used without root privileges, or shows where to get the drivers on Windows. The rules and the drivers are declared by the platform of the board.\"\n\n#: cli/config/init.go:44\nmsgid \"Sets where to save the configuration file.\"\nmsgstr \"Sets where to save the configuration file.\"\n\n#: cli/core/search.go:49\nmsgid \"Show all available core versions.\"\nmsgstr \"Show all available core versions.\"\n\n#: cli/compile/compile.go:97\nmsgid \"Show all build properties used instead of compiling.\"\nmsgstr \"Show all build properties used instead of compiling.\"\n\n#: cli/board/listall.go:46\nmsgid \"Show also boards marked as 'hidden' in the platform\"\nmsgstr \"Show also boards marked as 'hidden' in the platform\"\n\n#: cli/board/details.go:49\nmsgid \"Show full board details\"\nmsgstr \"Show full board details\"\n\n#: cli/board/details.go:43\nmsgid \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\nmsgstr \"Show information about a board, in particular if the board has options to be specified in the FQBN.\"\n\n#: cli/lib/examples.go:46\n#: cli/lib/list.go:53\nmsgid \"Show libraries for the specified board FQBN.\"\nmsgstr \"Show libraries for the specified board FQBN.\"\n\n#: cli/lib/search.go:53\nmsgid \"Show library names only.\"\nmsgstr \"Show library names only.\"\n\n#: cli/board/details.go:51\nmsgid \"Show list of available programmers\"\nmsgstr \"Show list of available programmers\"\n\n#: cli/debug/debug.go:69\nmsgid \"Show metadata about the debug session instead of starting the debugger.\"\nmsgstr \"Show metadata about the debug session instead of starting the debugger.\"\n\n#: cli/lib/examples.go:47\nmsgid \"Show only the libraries compatible with the board specified with --fqbn.\"\nmsgstr \"Show only the libraries compatible with the board specified with --fqbn.\"\n\n#: cli/update/update.go:43\nmsgid \"Show outdated cores and libraries after index update\"\nmsgstr \"Show outdated cores and libraries after index update\"\n\n#: cli/lib/upgrade.go:49\nmsgid \"Show the libraries that would be upgraded without upgrading them.\"\nmsgstr \"Show the libraries that would be upgraded without upgrading them.\"\n\n#: cli/core/upgrade.go:51\nmsgid \"Show the platforms that would be upgraded without upgrading them.\"\nmsgstr \"Show the platforms that would be upgraded without upgrading them.\"\n\n#: cli/board/setup.go:42\nmsgid \"Show what would be installed without installing it.\"\nmsgstr \"Show what would be installed without installing it.\"\n\n#: cli/lib/list.go:37\nmsgid \"Shows a list of installed libraries.\"\nmsgstr \"Shows a list of installed libraries.\"\n\n#: cli/lib/list.go:38\nmsgid \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\\n\"\n\"\\n\"\n\"Libraries installed in the sketchbook that are not in the libraries index\\n\"\n\"(for example installed from a zip file or a git repository) are marked as\\n\"\n\"of unknown origin, they can be uninstalled by name as any other library.\\n\"\n\"\\n\"\n\"Built-in libraries replaced by a library with the same name installed in the\\n\"\n\"sketchbook are marked as shadowed, the JSON output reports the path of the\\n\"\n\"library used in their place.\"\nmsgstr \"Shows a list of installed libraries.\\n\"\n\"\\n\"\n\"If the LIBNAME parameter is specified the listing is limited to that specific\\n\"\n\"library. By default the libraries provided as built-in by platforms/core are\\n\"\n\"not listed, they can be listed by adding the --all flag.\\n\"\n\"\\n\"\n\"Libraries installed in the sketchbook that are not in the libraries index\\n\"\n\"(for example installed from a zip file or a git repository) are marked as\\n\"\n\"of unknown origin, they can be uninstalled by name as any other library.\\n\"\n\"\\n\"\n\"Built-in libraries replaced by a library with the same name installed in the\\n\"\n\"sketchbook are marked as shadowed, the JSON output reports the path of the\\n\"\n\"library used in their place.\"\n\n#: cli/core/list.go:35\n#: cli/core/list.go:36\nmsgid \"Shows the list of installed platforms.\"\nmsgstr \"Shows the list of installed platforms.\"\n\n#: cli/lib/examples.go:39\nmsgid \"Shows the list of the examples for libraries.\"\nmsgstr \"Shows the list of the examples for libraries.\"\n\n#: cli/lib/examples.go:40\nmsgid \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\nmsgstr \"Shows the list of the examples for libraries. A name may be given as argument to search a specific library.\"\n\n#: cli/version/version.go:41\nmsgid \"Shows the version number of Arduino CLI which is installed on your system.\"\nmsgstr \"Shows the version number of Arduino CLI which is installed on your system.\"\n\n#: cli/version/version.go:40\nmsgid \"Shows version number of Arduino CLI.\"\nmsgstr \"Shows version number of Arduino CLI.\"\n\n#: cli/output/cached_archives.go:48\nmsgid \"Size\"\nmsgstr \"Size\"\n\n#: cli/board/details.go:177\nmsgid \"Size (bytes):\"\nmsgstr \"Size (bytes):\"\n\n#: cli/compile/batch.go:256\nmsgid \"Sketch\"\nmsgstr \"Sketch\"\n\n#: cli/core/install.go:75\nmsgid \"Skip post-install scripts without asking for confirmation.\"\nmsgstr \"Skip post-install scripts without asking for confirmation.\"\n\n#: cli/core/update_index.go:47\n#: cli/lib/update_index.go:55\nmsgid \"Skip the update if the index has been checked less than this duration ago, e.g. 1h.\"\nmsgstr \"Skip the update if the index has been checked less than this duration ago, e.g. 1h.\"\n\n#: commands/post_install.go:37\nmsgid \"Skipping platform configuration\"\nmsgstr \"Skipping platform configuration\"\n\n#: cli/daemon/shutdown.go:37\nmsgid \"Stops a running daemon.\"\nmsgstr \"Stops a running daemon.\"\n\n#: cli/sketch/new.go:49\nmsgid \"Template name or path to a template folder.\"\nmsgstr \"Template name or path to a template folder.\"\n\n#: cli/lib/examples.go:66\nmsgid \"The --compatible-only flag requires --fqbn.\"\nmsgstr \"The --compatible-only flag requires --fqbn.\"\n\n#: cli/daemon/daemon.go:62\nmsgid \"The IP address the daemon will listen to\"\nmsgstr \"The IP address the daemon will listen to\"\n\n#: cli/daemon/daemon.go:60\nmsgid \"The TCP port the daemon will listen to\"\nmsgstr \"The TCP port the daemon will listen to\"\n\n#: cli/daemon/daemon.go:64\nmsgid \"The Unix domain socket the daemon will listen to, instead of the TCP port\"\nmsgstr \"The Unix domain socket the daemon will listen to, instead of the TCP port\"\n\n#: cli/board/setup.go:82\nmsgid \"The board %s doesn't need any setup on this system.\"\nmsgstr \"The board %s doesn't need any setup on this system.\"\n\n#: cli/board/attach.go:48\n#: cli/board/list.go:45\nmsgid \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to 10s).\"\nmsgstr \"The connected devices search timeout, raise it if your board doesn't show up (e.g. to 10s).\"\n\n#: cli/core/install.go:81\nmsgid \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\nmsgstr \"The flags --run-post-install and --skip-post-install can't be both set at the same time.\"\n\n#: cli/board/setup.go:107\nmsgid \"The following actions would be done to set up %s:\"\nmsgstr \"The following actions would be done to set up %s:\"\n\n#: cli/cli.go:115\nmsgid \"The output format for the logs, can be {text|json}.\"\nmsgstr \"The output format for the logs, can be {text|json}.\"\n\n#: cli/cli.go:117\nmsgid \"The output format, can be {text|json}.\"\nmsgstr \"The output format, can be {text|json}.\"\n\n#: cli/board/attach.go:50\nmsgid \"The port to attach together with the FQBN, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\nmsgstr \"The port to attach together with the FQBN, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\n\n#: cli/lib/upgrade.go:39\nmsgid \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\nmsgstr \"This command upgrades an installed library to the latest available version. Multiple libraries can be passed separated by a space. If no arguments are provided, the command will upgrade all the installed libraries where an update is available.\"\n\n#: cli/outdated/outdated.go:37\nmsgid \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\nmsgstr \"This commands shows a list of installed cores and/or libraries\\n\"\n\"that can be upgraded. If nothing needs to be updated the output is empty.\"\n\n#: cli/board/details.go:97\nmsgid \"Tool\"\nmsgstr \"Tool\"\n\n#: commands/bundled_tools.go:54\n#: commands/core/install.go:167\n#: commands/instances.go:817\nmsgid \"Tool %s already installed\"\nmsgstr \"Tool %s already installed\"\n\n#: cli/sketch/preprocess.go:46\nmsgid \"Translates the sketch into the C++ source that is compiled.\"\nmsgstr \"Translates the sketch into the C++ source that is compiled.\"\n\n#: cli/sketch/preprocess.go:47\nmsgid \"Translates the sketch into the C++ source that is compiled.\\n\"\n\"The .ino files are merged, the missing prototypes are generated and the\\n\"\n\"#line directives pointing to the original files are added. The generated\\n\"\n\"source is printed on the standard output, or saved in the --output-dir directory.\"\nmsgstr \"Translates the sketch into the C++ source that is compiled.\\n\"\n\"The .ino files are merged, the missing prototypes are generated and the\\n\"\n\"#line directives pointing to the original files are added. The generated\\n\"\n\"source is printed on the standard output, or saved in the --output-dir directory.\"\n\n#: cli/burnbootloader/burnbootloader.go:59\nmsgid \"Turns on verbose mode.\"\nmsgstr \"Turns on verbose mode.\"\n\n#: configuration/configuration.go:230\nmsgid \"Unable to get Documents Folder: %v\"\nmsgstr \"Unable to get Documents Folder: %v\"\n\n#: configuration/configuration.go:205\nmsgid \"Unable to get Local App Data Folder: %v\"\nmsgstr \"Unable to get Local App Data Folder: %v\"\n\n#: configuration/configuration.go:193\n#: configuration/configuration.go:218\nmsgid \"Unable to get user home dir: %v\"\nmsgstr \"Unable to get user home dir: %v\"\n\n#: cli/core/uninstall.go:45\nmsgid \"Uninstall the cores even if other installed cores depend on them.\"\nmsgstr \"Uninstall the cores even if other installed cores depend on them.\"\n\n#: commands/core/uninstall.go:90\n#: commands/lib/uninstall.go:38\nmsgid \"Uninstalling %s\"\nmsgstr \"Uninstalling %s\"\n\n#: commands/core/uninstall.go:106\nmsgid \"Uninstalling %s, tool is no more required\"\nmsgstr \"Uninstalling %s, tool is no more required\"\n\n#: cli/core/uninstall.go:36\nmsgid \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\nmsgstr \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\"\n\n#: cli/core/uninstall.go:37\nmsgid \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\\n\"\n\"Tools still required by other installed cores are kept. A core whose core or variants\\n\"\n\"are referenced by other installed cores is not uninstalled unless --force is given.\"\nmsgstr \"Uninstalls one or more cores and corresponding tool dependencies if no longer used.\\n\"\n\"Tools still required by other installed cores are kept. A core whose core or variants\\n\"\n\"are referenced by other installed cores is not uninstalled unless --force is given.\"\n\n#: cli/lib/uninstall.go:35\n#: cli/lib/uninstall.go:36\nmsgid \"Uninstalls one or more libraries.\"\nmsgstr \"Uninstalls one or more libraries.\"\n\n#: cli/selfupdate/selfupdate.go:42\nmsgid \"Updates Arduino CLI to the latest release.\"\nmsgstr \"Updates Arduino CLI to the latest release.\"\n\n#: cli/update/update.go:37\nmsgid \"Updates the index of cores and libraries\"\nmsgstr \"Updates the index of cores and libraries\"\n\n#: cli/update/update.go:38\nmsgid \"Updates the index of cores and libraries to the latest versions.\"\nmsgstr \"Updates the index of cores and libraries to the latest versions.\"\n\n#: cli/core/update_index.go:42\nmsgid \"Updates the index of cores to the latest version.\"\nmsgstr \"Updates the index of cores to the latest version.\"\n\n#: cli/core/update_index.go:41\nmsgid \"Updates the index of cores.\"\nmsgstr \"Updates the index of cores.\"\n\n#: cli/lib/update_index.go:40\nmsgid \"Updates the libraries index to the latest version.\"\nmsgstr \"Updates the libraries index to the latest version.\"\n\n#: cli/lib/update_index.go:39\nmsgid \"Updates the libraries index.\"\nmsgstr \"Updates the libraries index.\"\n\n#: commands/instances.go:839\n#: commands/instances.go:850\nmsgid \"Updating %s\"\nmsgstr \"Updating %s\"\n\n#: commands/core/install.go:215\nmsgid \"Updating %s with %s\"\nmsgstr \"Updating %s with %s\"\n\n#: cli/upgrade/upgrade.go:38\nmsgid \"Upgrades installed cores and libraries to latest version.\"\nmsgstr \"Upgrades installed cores and libraries to latest version.\"\n\n#: cli/upgrade/upgrade.go:37\nmsgid \"Upgrades installed cores and libraries.\"\nmsgstr \"Upgrades installed cores and libraries.\"\n\n#: cli/lib/upgrade.go:38\nmsgid \"Upgrades installed libraries.\"\nmsgstr \"Upgrades installed libraries.\"\n\n#: cli/core/upgrade.go:39\n#: cli/core/upgrade.go:40\nmsgid \"Upgrades one or all installed platforms to the latest version.\"\nmsgstr \"Upgrades one or all installed platforms to the latest version.\"\n\n#: cli/upload/upload.go:59\nmsgid \"Upload Arduino sketches.\"\nmsgstr \"Upload Arduino sketches.\"\n\n#: cli/upload/upload.go:60\nmsgid \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\\n\"\n\"If the FQBN is not given, the board connected to the port is detected automatically.\"\nmsgstr \"Upload Arduino sketches. This does NOT compile the sketch prior to upload.\\n\"\n\"If the FQBN is not given, the board connected to the port is detected automatically.\"\n\n#: cli/burnbootloader/burnbootloader.go:57\n#: cli/compile/compile.go:129\nmsgid \"Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\nmsgstr \"Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>\"\n\n#: cli/upload/upload.go:75\nmsgid \"Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>. Can be used multiple times to upload to many boards at the same time.\"\nmsgstr \"Upload port, e.g.: COM10, /dev/ttyACM0 or serial://<board serial number>. Can be used multiple times to upload to many boards at the same time.\"\n\n#: cli/compile/compile.go:128\nmsgid \"Upload the binary after the compilation.\"\nmsgstr \"Upload the binary after the compilation.\"\n\n#: cli/burnbootloader/burnbootloader.go:49\nmsgid \"Upload the bootloader on the board using an external programmer.\"\nmsgstr \"Upload the bootloader on the board using an external programmer.\"\n\n#: cli/burnbootloader/burnbootloader.go:48\nmsgid \"Upload the bootloader.\"\nmsgstr \"Upload the bootloader.\"\n\n#: cli/upload/upload.go:83\nmsgid \"Upload the filesystem image built with compile --build-fs instead of the sketch.\"\nmsgstr \"Upload the filesystem image built with compile --build-fs instead of the sketch.\"\n\n#: cli/upload/upload.go:76\nmsgid \"Upload to all the connected boards identified by the given FQBN, e.g.: arduino:avr:uno\"\nmsgstr \"Upload to all the connected boards identified by the given FQBN, e.g.: arduino:avr:uno\"\n\n#: cli/usage.go:27\nmsgid \"Usage:\"\nmsgstr \"Usage:\"\n\n#: cli/usage.go:34\nmsgid \"Use %s for more information about a command.\"\nmsgstr \"Use %s for more information about a command.\"\n\n#: cli/burnbootloader/burnbootloader.go:60\nmsgid \"Use the specified programmer to upload or 'list' to list supported programmers.\"\nmsgstr \"Use the specified programmer to upload or 'list' to list supported programmers.\"\n\n#: cli/burnbootloader/burnbootloader.go:58\n#: cli/compile/compile.go:130\n#: cli/upload/upload.go:80\nmsgid \"Verify uploaded binary after the upload.\"\nmsgstr \"Verify uploaded binary after the upload.\"\n\n#: commands/core/install.go:179\nmsgid \"WARNING: platform %s is deprecated\"\nmsgstr \"WARNING: platform %s is deprecated\"\n\n#: commands/core/uninstall.go:63\nmsgid \"Warning: uninstalling %s breaks %s\"\nmsgstr \"Warning: uninstalling %s breaks %s\"\n\n#: cli/compile/batch.go:256\nmsgid \"Warnings\"\nmsgstr \"Warnings\"\n\n#: cli/compile/compile.go:131\nmsgid \"When specified, VID/PID specific build properties are used, if board supports them.\"\nmsgstr \"When specified, VID/PID specific build properties are used, if board supports them.\"\n\n#: cli/compile/compile.go:104\nmsgid \"Write the disassembly of the sketch, interleaved with the source code, in <sketch>.ino.lst in the build path and the output directory.\"\nmsgstr \"Write the disassembly of the sketch, interleaved with the source code, in <sketch>.ino.lst in the build path and the output directory.\"\n\n#: cli/config/init.go:36\nmsgid \"Writes current configuration to a configuration file.\"\nmsgstr \"Writes current configuration to a configuration file.\"\n\n#: cli/lib/download.go:50\nmsgid \"YAML file listing the libraries to download, in the NAME@VERSION form, under the \\\"libraries\\\" key.\"\nmsgstr \"YAML file listing the libraries to download, in the NAME@VERSION form, under the \\\"libraries\\\" key.\"\n\n#: cli/core/download.go:51\nmsgid \"YAML file listing the platforms to download, in the PACKAGER:ARCH@VERSION form, under the \\\"platforms\\\" key.\"\nmsgstr \"YAML file listing the platforms to download, in the PACKAGER:ARCH@VERSION form, under the \\\"platforms\\\" key.\"\n\n#: i18n/cmd/commands/catalog/catalog.go:23\nmsgid \"catalog\"\nmsgstr \"catalog\"\n\n#: cli/upload/upload.go:96\nmsgid \"error: --attach-monitor can be used only with the text output format\"\nmsgstr \"error: --attach-monitor can be used only with the text output format\"\n\n#: cli/upload/upload.go:100\nmsgid \"error: --attach-monitor cannot be used when listing programmers\"\nmsgstr \"error: --attach-monitor cannot be used when listing programmers\"\n\n#: cli/upload/upload.go:104\nmsgid \"error: --attach-monitor cannot be used when uploading to many boards\"\nmsgstr \"error: --attach-monitor cannot be used when uploading to many boards\"\n\n#: cli/upload/upload.go:92\nmsgid \"error: --input-file and --input-dir flags cannot be used together\"\nmsgstr \"error: --input-file and --input-dir flags cannot be used together\"\n\n#: cli/compile/batch.go:260\n#: cli/upload/multiple.go:134\nmsgid \"failed\"\nmsgstr \"failed\"\n\n#: i18n/cmd/commands/catalog/generate_catalog.go:28\nmsgid \"generates the en catalog from source files\"\nmsgstr \"generates the en catalog from source files\"\n\n#: i18n/cmd/commands/root.go:26\nmsgid \"i18n\"\nmsgstr \"i18n\"\n\n#: cli/compile/batch.go:258\n#: cli/upload/multiple.go:132\nmsgid \"passed\"\nmsgstr \"passed\"\n\n#: i18n/cmd/commands/transifex/pull_transifex.go:31\nmsgid \"pulls the translation files from transifex\"\nmsgstr \"pulls the translation files from transifex\"\n\n#: i18n/cmd/commands/transifex/push_transifex.go:33\nmsgid \"pushes the translation files to transifex\"\nmsgstr \"pushes the translation files to transifex\"\n\n#: i18n/cmd/commands/transifex/transifex.go:28\nmsgid \"transifex\"\nmsgstr \"transifex\"\n\n#: cli/config/dump.go:53\nmsgid \"unable to marshal config to YAML: %v\"\nmsgstr \"unable to marshal config to YAML: %v\"\n\n"),
 	}
 	file4 := &embedded.EmbeddedFile{
 		Filename:    "it_IT.po",
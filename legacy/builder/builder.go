@@ -16,7 +16,6 @@
 package builder
 
 import (
-	"fmt"
 	"os"
 	"reflect"
 	"strconv"
@@ -50,6 +49,9 @@ func (s *Builder) Run(ctx *types.Context) error {
 		return err
 	}
 
+	ctx.CompilationDatabase = bldr.NewCompilationDatabase(ctx.BuildPath.Join("compile_commands.json"))
+	ctx.BuildState = bldr.LoadBuildState(ctx.BuildPath.Join(constants.BUILD_STATE_FILE))
+
 	commands := []types.Command{
 		&ContainerSetupHardwareToolsLibsSketchAndProps{},
 
@@ -84,23 +86,25 @@ func (s *Builder) Run(ctx *types.Context) error {
 		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_CORE_PREBUILD, Suffix: constants.HOOKS_PATTERN_SUFFIX},
 		&phases.CoreBuilder{},
 		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_CORE_POSTBUILD, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+	}
 
-		utils.LogIfVerbose(constants.LOG_LEVEL_INFO, "Linking everything together..."),
-		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_LINKING_PRELINK, Suffix: constants.HOOKS_PATTERN_SUFFIX},
-		&phases.Linker{},
-		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_LINKING_POSTLINK, Suffix: constants.HOOKS_PATTERN_SUFFIX},
-
-		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_OBJCOPY_PREOBJCOPY, Suffix: constants.HOOKS_PATTERN_SUFFIX},
-		&RecipeByPrefixSuffixRunner{Prefix: "recipe.objcopy.", Suffix: constants.HOOKS_PATTERN_SUFFIX},
-		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_OBJCOPY_POSTOBJCOPY, Suffix: constants.HOOKS_PATTERN_SUFFIX},
-
-		&MergeSketchWithBootloader{},
-
-		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_POSTBUILD, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+	if !ctx.OnlyUpdateCompilationDatabase {
+		commands = append(commands, linkAndObjcopyCommands()...)
 	}
 
 	mainErr := runCommands(ctx, commands)
 
+	if ctx.CompilationDatabase != nil {
+		if err := ctx.CompilationDatabase.SaveToFile(); err != nil && mainErr == nil {
+			mainErr = errors.WithStack(err)
+		}
+	}
+	if ctx.BuildState != nil && !ctx.OnlyUpdateCompilationDatabase {
+		if err := ctx.BuildState.SaveToFile(); err != nil && mainErr == nil {
+			mainErr = errors.WithStack(err)
+		}
+	}
+
 	commands = []types.Command{
 		&PrintUsedAndNotUsedLibraries{SketchError: mainErr != nil},
 
@@ -119,6 +123,31 @@ func (s *Builder) Run(ctx *types.Context) error {
 	return otherErr
 }
 
+// linkAndObjcopyCommands returns the steps that produce the final binaries
+// out of the compiled object files.
+func linkAndObjcopyCommands() []types.Command {
+	return []types.Command{
+		utils.LogIfVerbose(constants.LOG_LEVEL_INFO, "Linking everything together..."),
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_LINKING_PRELINK, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+		&phases.Linker{},
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_LINKING_POSTLINK, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_OBJCOPY_PREOBJCOPY, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+		&RecipeByPrefixSuffixRunner{Prefix: "recipe.objcopy.", Suffix: constants.HOOKS_PATTERN_SUFFIX},
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_OBJCOPY_POSTOBJCOPY, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+
+		&MergeSketchWithBootloader{},
+
+		&phases.Disassembler{},
+
+		&phases.FilesystemImageBuilder{},
+
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_POSTBUILD, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+
+		&phases.Signer{},
+	}
+}
+
 type PreprocessSketch struct{}
 
 func (s *PreprocessSketch) Run(ctx *types.Context) error {
@@ -158,13 +187,39 @@ func (s *Preprocess) Run(ctx *types.Context) error {
 		&PreprocessSketch{},
 	}
 
-	if err := runCommands(ctx, commands); err != nil {
+	// The arduino-preprocessed source is left in ctx.Source
+	return runCommands(ctx, commands)
+}
+
+// FindIncludes runs the build only until the libraries used by the sketch
+// are found: nothing is compiled, the libraries are left in
+// ctx.ImportedLibraries and ctx.LibrariesResolutionResults.
+type FindIncludes struct{}
+
+func (s *FindIncludes) Run(ctx *types.Context) error {
+	if ctx.BuildPath == nil {
+		ctx.BuildPath = bldr.GenBuildPath(ctx.SketchLocation)
+	}
+
+	if err := bldr.EnsureBuildPathExists(ctx.BuildPath.String()); err != nil {
 		return err
 	}
 
-	// Output arduino-preprocessed source
-	fmt.Println(ctx.Source)
-	return nil
+	commands := []types.Command{
+		&ContainerSetupHardwareToolsLibsSketchAndProps{},
+
+		&ContainerBuildOptions{},
+
+		&RecipeByPrefixSuffixRunner{Prefix: constants.HOOKS_PREBUILD, Suffix: constants.HOOKS_PATTERN_SUFFIX},
+
+		&ContainerMergeCopySketchFiles{},
+
+		&ContainerFindIncludes{},
+
+		&WarnAboutArchIncompatibleLibraries{},
+	}
+
+	return runCommands(ctx, commands)
 }
 
 type ParseHardwareAndDumpBuildProperties struct{}
@@ -219,3 +274,8 @@ func RunPreprocess(ctx *types.Context) error {
 	command := Preprocess{}
 	return command.Run(ctx)
 }
+
+func RunFindIncludes(ctx *types.Context) error {
+	command := FindIncludes{}
+	return command.Run(ctx)
+}
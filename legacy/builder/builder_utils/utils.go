@@ -16,6 +16,8 @@
 package builder_utils
 
 import (
+	"crypto/sha1"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -24,6 +26,8 @@ import (
 	"strings"
 	"sync"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	arduinoutils "github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -33,6 +37,26 @@ import (
 	"github.com/pkg/errors"
 )
 
+// diagnosticsToSketchFolder makes the diagnostics reported for the copies of
+// the sketch files in the build path, e.g. the ones in the `src` subfolder,
+// point to the original files in the sketch folder. The diagnostics of the
+// .ino files already point to them through the #line directives.
+func diagnosticsToSketchFolder(ctx *types.Context, diagnostics []*bldr.CompilerDiagnostic) {
+	if ctx.Sketch == nil || ctx.SketchBuildPath == nil {
+		return
+	}
+	sketchFolder := ctx.Sketch.MainFile.Name.Parent()
+	for _, diag := range diagnostics {
+		rel, err := filepath.Rel(ctx.SketchBuildPath.String(), diag.File)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			if original := sketchFolder.Join(rel); original.Exist() {
+				diag.File = original.String()
+			}
+		}
+		diagnosticsToSketchFolder(ctx, diag.Notes)
+	}
+}
+
 func PrintProgressIfProgressEnabledAndMachineLogger(ctx *types.Context) {
 
 	if !ctx.Progress.PrintEnabled {
@@ -84,24 +108,9 @@ func CompileFiles(ctx *types.Context, sourcePath *paths.Path, recurse bool, buil
 	ctx.Progress.AddSubSteps(len(sSources) + len(cSources) + len(cppSources))
 	defer ctx.Progress.RemoveSubSteps()
 
-	sObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, sSources, buildPath, buildProperties, includes, constants.RECIPE_S_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	cObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, cSources, buildPath, buildProperties, includes, constants.RECIPE_C_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	cppObjectFiles, err := compileFilesWithRecipe(ctx, sourcePath, cppSources, buildPath, buildProperties, includes, constants.RECIPE_CPP_PATTERN)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-
-	objectFiles := paths.NewPathList()
-	objectFiles.AddAll(sObjectFiles)
-	objectFiles.AddAll(cObjectFiles)
-	objectFiles.AddAll(cppObjectFiles)
-	return objectFiles, nil
+	return compileFilesWithRecipes(ctx, sourcePath, buildPath, buildProperties, includes,
+		[]paths.PathList{sSources, cSources, cppSources},
+		[]string{constants.RECIPE_S_PATTERN, constants.RECIPE_C_PATTERN, constants.RECIPE_CPP_PATTERN})
 }
 
 func findFilesInFolder(sourcePath *paths.Path, extension string, recurse bool) (paths.PathList, error) {
@@ -163,25 +172,29 @@ func findAllFilesInFolder(sourcePath string, recurse bool) ([]string, error) {
 	return sources, nil
 }
 
-func compileFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources paths.PathList, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (paths.PathList, error) {
-	objectFiles := paths.NewPathList()
-	if len(sources) == 0 {
-		return objectFiles, nil
-	}
+// compileFilesWithRecipes compiles each list of sources with the recipe at the
+// same index, all the files are compiled in parallel by ctx.Jobs workers. The
+// object files are returned grouped by recipe, in the same order of recipes.
+func compileFilesWithRecipes(ctx *types.Context, sourcePath *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, sources []paths.PathList, recipes []string) (paths.PathList, error) {
+	objectFiles := make([]paths.PathList, len(recipes))
 	var objectFilesMux sync.Mutex
 	var errorsList []error
 	var errorsMux sync.Mutex
 
-	queue := make(chan *paths.Path)
-	job := func(source *paths.Path) {
-		objectFile, err := compileFileWithRecipe(ctx, sourcePath, source, buildPath, buildProperties, includes, recipe)
+	type compileJob struct {
+		source *paths.Path
+		group  int
+	}
+	queue := make(chan compileJob)
+	job := func(j compileJob) {
+		objectFile, err := compileFileWithRecipe(ctx, sourcePath, j.source, buildPath, buildProperties, includes, recipes[j.group])
 		if err != nil {
 			errorsMux.Lock()
 			errorsList = append(errorsList, err)
 			errorsMux.Unlock()
 		} else {
 			objectFilesMux.Lock()
-			objectFiles.Add(objectFile)
+			objectFiles[j.group].Add(objectFile)
 			objectFilesMux.Unlock()
 		}
 	}
@@ -195,25 +208,28 @@ func compileFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources
 	for i := 0; i < jobs; i++ {
 		wg.Add(1)
 		go func() {
-			for source := range queue {
-				job(source)
+			for j := range queue {
+				job(j)
 			}
 			wg.Done()
 		}()
 	}
 
 	// Feed jobs until error or done
-	for _, source := range sources {
-		errorsMux.Lock()
-		gotError := len(errorsList) > 0
-		errorsMux.Unlock()
-		if gotError {
-			break
-		}
-		queue <- source
+feed:
+	for group, groupSources := range sources {
+		for _, source := range groupSources {
+			errorsMux.Lock()
+			gotError := len(errorsList) > 0
+			errorsMux.Unlock()
+			if gotError {
+				break feed
+			}
+			queue <- compileJob{source: source, group: group}
 
-		ctx.Progress.CompleteStep()
-		PrintProgressIfProgressEnabledAndMachineLogger(ctx)
+			ctx.Progress.CompleteStep()
+			PrintProgressIfProgressEnabledAndMachineLogger(ctx)
+		}
 	}
 	close(queue)
 	wg.Wait()
@@ -221,8 +237,13 @@ func compileFilesWithRecipe(ctx *types.Context, sourcePath *paths.Path, sources
 		// output the first error
 		return nil, errors.WithStack(errorsList[0])
 	}
-	objectFiles.Sort()
-	return objectFiles, nil
+
+	res := paths.NewPathList()
+	for _, groupObjectFiles := range objectFiles {
+		groupObjectFiles.Sort()
+		res.AddAll(groupObjectFiles)
+	}
+	return res, nil
 }
 
 func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *paths.Path, buildPath *paths.Path, buildProperties *properties.Map, includes []string, recipe string) (*paths.Path, error) {
@@ -235,8 +256,9 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	depsFile := buildPath.Join(relativeSource.String() + ".d")
-	objectFile := buildPath.Join(relativeSource.String() + ".o")
+	objectBase := objectFileBase(buildPath, relativeSource)
+	depsFile := objectBase.Parent().Join(objectBase.Base() + ".d")
+	objectFile := objectBase.Parent().Join(objectBase.Base() + ".o")
 
 	properties.SetPath(constants.BUILD_PROPERTIES_OBJECT_FILE, objectFile)
 	err = objectFile.Parent().MkdirAll()
@@ -248,18 +270,107 @@ func compileFileWithRecipe(ctx *types.Context, sourcePath *paths.Path, source *p
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	if !objIsUpToDate {
-		_, _, err = ExecRecipe(ctx, properties, recipe, false /* stdout */, utils.ShowIfVerbose /* stderr */, utils.Show)
+	command, err := PrepareCommandForRecipe(ctx, properties, recipe, false)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if ctx.CompilationDatabase != nil {
+		ctx.CompilationDatabase.Add(source, command)
+	}
+	// the launcher is not part of the compilation database, tools using
+	// it need the actual compiler command line
+	if ctx.CompilerLauncher != "" {
+		command, err = prefixCompilerLauncher(ctx, command)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	if !objIsUpToDate && !ctx.OnlyUpdateCompilationDatabase {
+		if ctx.BuildState != nil {
+			ctx.BuildState.Remove(objectFile)
+		}
+		var stdout, stderr []byte
+		ctx.BuildLog.SetRecipe(command, recipe, properties.Get(recipe))
+		stdout, stderr, err = utils.ExecCommand(ctx, command, utils.Capture /* stdout */, utils.Capture /* stderr */)
+		// the output is captured to extract the diagnostics and to avoid mixing
+		// it with the output of the other jobs, but it must be shown anyway
+		if !ctx.Verbose {
+			stdout = nil
+		}
+		ctx.WriteCompilerOutput(stdout, stderr)
+		diagnostics := bldr.ParseCompilerDiagnostics(stderr)
+		diagnosticsToSketchFolder(ctx, diagnostics)
+		ctx.AddCompilerDiagnostics(diagnostics...)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
+		objIsUpToDate = true
 	} else if ctx.Verbose {
-		logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_USING_PREVIOUS_COMPILED_FILE, objectFile)
+		if objIsUpToDate {
+			logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_USING_PREVIOUS_COMPILED_FILE, objectFile)
+		} else {
+			logger.Println(constants.LOG_LEVEL_INFO, "Skipping compile of: {0}", objectFile)
+		}
+	}
+
+	if objIsUpToDate && ctx.BuildState != nil {
+		updateBuildState(ctx, source, objectFile, depsFile)
 	}
 
 	return objectFile, nil
 }
 
+// prefixCompilerLauncher returns the command that runs the compiler command
+// through the compiler launcher set in the configuration, e.g. ccache.
+func prefixCompilerLauncher(ctx *types.Context, command *exec.Cmd) (*exec.Cmd, error) {
+	launcher, err := utils.ParseCommandLine(ctx.CompilerLauncher, ctx.GetLogger())
+	if err != nil {
+		return nil, errors.Errorf("invalid compiler launcher '%s': %s", ctx.CompilerLauncher, err)
+	}
+	if len(launcher) == 0 {
+		return command, nil
+	}
+	args := append(launcher[1:], command.Args...)
+	res := exec.Command(launcher[0], args...)
+	res.Dir = command.Dir
+	if len(ctx.CompilerLauncherEnv) > 0 {
+		res.Env = append(os.Environ(), ctx.CompilerLauncherEnv...)
+	}
+	return res, nil
+}
+
+// updateBuildState records the content of the object file and of the files
+// used to compile it, so that the next builds don't rely on the timestamps.
+// If the dependency file can't be parsed the object file is just forgotten.
+// objectFileBase returns the path, without extension, of the object file of
+// relativeSource. When the build subfolders mirroring a deep source tree would
+// exceed the path length the compiler can handle (on Windows), they're
+// replaced by a short folder named after a hash of the source folder.
+func objectFileBase(buildPath, relativeSource *paths.Path) *paths.Path {
+	objectBase := buildPath.JoinPath(relativeSource)
+	if !arduinoutils.IsLongPath(objectBase.String() + ".o") {
+		return objectBase
+	}
+	hash := sha1.Sum([]byte(filepath.ToSlash(relativeSource.Parent().String())))
+	return buildPath.Join(fmt.Sprintf("%x", hash[:4]), relativeSource.Base())
+}
+
+func updateBuildState(ctx *types.Context, sourceFile, objectFile, dependencyFile *paths.Path) {
+	rows, err := dependencyFile.ReadFileAsLines()
+	if err == nil {
+		var deps paths.PathList
+		if deps, err = parseDependencyFile(objectFile, rows); err == nil {
+			err = ctx.BuildState.Update(objectFile, append(paths.PathList{sourceFile.Clean()}, deps...))
+		}
+	}
+	if err != nil {
+		if ctx.DebugLevel >= 20 {
+			ctx.GetLogger().Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Cannot record build state of {0}: {1}", objectFile, err.Error())
+		}
+		ctx.BuildState.Remove(objectFile)
+	}
+}
+
 func ObjFileIsUpToDate(ctx *types.Context, sourceFile, objectFile, dependencyFile *paths.Path) (bool, error) {
 	logger := ctx.GetLogger()
 	debugLevel := ctx.DebugLevel
@@ -305,69 +416,63 @@ func ObjFileIsUpToDate(ctx *types.Context, sourceFile, objectFile, dependencyFil
 		}
 	}
 
-	if sourceFileStat.ModTime().After(objectFileStat.ModTime()) {
-		if debugLevel >= 20 {
-			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", sourceFile, objectFile)
-		}
-		return false, nil
+	rows, err := dependencyFile.ReadFileAsLines()
+	if err != nil {
+		return false, errors.WithStack(err)
 	}
-	if sourceFileStat.ModTime().After(dependencyFileStat.ModTime()) {
+	deps, err := parseDependencyFile(objectFile, rows)
+	if err != nil {
 		if debugLevel >= 20 {
-			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", sourceFile, dependencyFile)
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, err.Error())
 		}
 		return false, nil
 	}
 
-	rows, err := dependencyFile.ReadFileAsLines()
-	if err != nil {
-		return false, errors.WithStack(err)
-	}
-
-	rows = utils.Map(rows, removeEndingBackSlash)
-	rows = utils.Map(rows, strings.TrimSpace)
-	rows = utils.Map(rows, unescapeDep)
-	rows = utils.Filter(rows, nonEmptyString)
-
-	if len(rows) == 0 {
+	// When the content of the files used to compile the object has been
+	// recorded in a previous build the timestamps are not used at all
+	if ctx.BuildState != nil && ctx.BuildState.Has(objectFile) {
+		if !ctx.BuildState.IsUpToDate(objectFile, append(paths.PathList{sourceFile}, deps...)) {
+			if debugLevel >= 20 {
+				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} or its dependencies changed since the last build", sourceFile)
+			}
+			return false, nil
+		}
 		return true, nil
 	}
 
-	firstRow := rows[0]
-	if !strings.HasSuffix(firstRow, ":") {
+	if sourceFileStat.ModTime().After(objectFileStat.ModTime()) {
 		if debugLevel >= 20 {
-			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "No colon in first line of depfile")
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", sourceFile, objectFile)
 		}
 		return false, nil
 	}
-	objFileInDepFile := firstRow[:len(firstRow)-1]
-	if objFileInDepFile != objectFile.String() {
+	if sourceFileStat.ModTime().After(dependencyFileStat.ModTime()) {
 		if debugLevel >= 20 {
-			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Depfile is about different file: {0}", objFileInDepFile)
+			logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", sourceFile, dependencyFile)
 		}
 		return false, nil
 	}
 
-	rows = rows[1:]
-	for _, row := range rows {
-		depStat, err := os.Stat(row)
+	for _, dep := range deps {
+		depStat, err := dep.Stat()
 		if err != nil && !os.IsNotExist(err) {
 			// There is probably a parsing error of the dep file
 			// Ignore the error and trigger a full rebuild anyway
 			if debugLevel >= 20 {
-				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Failed to read: {0}", row)
+				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Failed to read: {0}", dep)
 				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, err.Error())
 			}
 			return false, nil
 		}
 		if os.IsNotExist(err) {
 			if debugLevel >= 20 {
-				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Not found: {0}", row)
+				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "Not found: {0}", dep)
 			}
 			return false, nil
 		}
 		if depStat.ModTime().After(objectFileStat.ModTime()) {
 			if debugLevel >= 20 {
-				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", row, objectFile)
+				logger.Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, "{0} newer than {1}", dep, objectFile)
 			}
 			return false, nil
 		}
@@ -376,6 +481,30 @@ func ObjFileIsUpToDate(ctx *types.Context, sourceFile, objectFile, dependencyFil
 	return true, nil
 }
 
+// parseDependencyFile returns the files listed as dependencies of objectFile
+// in the rows of the dependency file produced by the compiler.
+func parseDependencyFile(objectFile *paths.Path, rows []string) (paths.PathList, error) {
+	rows = utils.Map(rows, removeEndingBackSlash)
+	rows = utils.Map(rows, strings.TrimSpace)
+	rows = utils.Map(rows, unescapeDep)
+	rows = utils.Filter(rows, nonEmptyString)
+
+	if len(rows) == 0 {
+		return paths.PathList{}, nil
+	}
+
+	firstRow := rows[0]
+	if !strings.HasSuffix(firstRow, ":") {
+		return nil, errors.New("No colon in first line of depfile")
+	}
+	objFileInDepFile := firstRow[:len(firstRow)-1]
+	if objFileInDepFile != objectFile.String() {
+		return nil, errors.Errorf("Depfile is about different file: %s", objFileInDepFile)
+	}
+
+	return paths.NewPathList(rows[1:]...), nil
+}
+
 func unescapeDep(s string) string {
 	s = strings.Replace(s, "\\ ", " ", -1)
 	s = strings.Replace(s, "\\\t", "\t", -1)
@@ -448,6 +577,13 @@ func ArchiveCompiledFiles(ctx *types.Context, buildPath *paths.Path, archiveFile
 	logger := ctx.GetLogger()
 	archiveFilePath := buildPath.JoinPath(archiveFile)
 
+	if ctx.OnlyUpdateCompilationDatabase {
+		if ctx.Verbose {
+			logger.Println(constants.LOG_LEVEL_INFO, "Skipping archive creation of: {0}", archiveFilePath)
+		}
+		return archiveFilePath, nil
+	}
+
 	rebuildArchive := false
 
 	if archiveFileStat, err := archiveFilePath.Stat(); err == nil {
@@ -495,6 +631,7 @@ func ExecRecipe(ctx *types.Context, buildProperties *properties.Map, recipe stri
 		return nil, nil, errors.WithStack(err)
 	}
 
+	ctx.BuildLog.SetRecipe(command, recipe, buildProperties.Get(recipe))
 	return utils.ExecCommand(ctx, command, stdout, stderr)
 }
 
@@ -17,6 +17,7 @@
 package constants
 
 const BUILD_OPTIONS_FILE = "build.options.json"
+const BUILD_STATE_FILE = "build_state.json"
 const BUILD_PROPERTIES_ARCHIVE_FILE = "archive_file"
 const BUILD_PROPERTIES_ARCHIVE_FILE_PATH = "archive_file_path"
 const BUILD_PROPERTIES_ARCH_OVERRIDE_CHECK = "architecture.override_check"
@@ -45,6 +46,7 @@ const FILE_CTAGS_TARGET_FOR_GCC_MINUS_E = "ctags_target_for_gcc_minus_e.cpp"
 const FILE_PLATFORM_KEYS_REWRITE_TXT = "platform.keys.rewrite.txt"
 const FOLDER_BOOTLOADERS = "bootloaders"
 const FOLDER_CORE = "core"
+const FOLDER_MISSING_INCLUDES = "missing-includes"
 const FOLDER_PREPROC = "preproc"
 const FOLDER_SKETCH = "sketch"
 const FOLDER_TOOLS = "tools"
@@ -95,6 +97,7 @@ const MSG_INVALID_QUOTING = "Invalid quoting: no closing [{0}] char found."
 const MSG_LIB_LEGACY = "(legacy)"
 const MSG_LIBRARIES_MULTIPLE_LIBS_FOUND_FOR = "Multiple libraries were found for \"{0}\""
 const MSG_LIBRARIES_NOT_USED = " Not used: {0}"
+const MSG_LIBRARIES_NOT_USED_BECAUSE = " Not used: {0} ({1})"
 const MSG_LIBRARIES_USED = " Used: {0}"
 const MSG_LIBRARY_CAN_USE_SRC_AND_UTILITY_FOLDERS = "Library can't use both 'src' and 'utility' folders. Double check {0}"
 const MSG_LIBRARY_INCOMPATIBLE_ARCH = "WARNING: library {0} claims to run on {1} architecture(s) and may be incompatible with your current board which runs on {2} architecture(s)."
@@ -148,6 +151,8 @@ const RECIPE_S_PATTERN = "recipe.S.o.pattern"
 const RECIPE_SIZE_REGEXP = "recipe.size.regex"
 const RECIPE_SIZE_REGEXP_DATA = "recipe.size.regex.data"
 const RECIPE_SIZE_REGEXP_EEPROM = "recipe.size.regex.eeprom"
+const RECIPE_SIGNING_PATTERN = HOOKS_POSTBUILD + ".signing" + HOOKS_PATTERN_SUFFIX
+const RECIPE_SIGNING_OUTPUT = HOOKS_POSTBUILD + ".signing.output"
 const REWRITING_DISABLED = "disabled"
 const REWRITING = "rewriting"
 const SPACE = " "
@@ -97,6 +97,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -114,10 +116,20 @@ func (s *ContainerFindIncludes) Run(ctx *types.Context) error {
 	cachePath := ctx.BuildPath.Join("includes.cache")
 	cache := readCache(cachePath)
 
+	// The headers left by a previous run could hide the ones provided by
+	// libraries installed since then
+	if err := ctx.BuildPath.Join(constants.FOLDER_MISSING_INCLUDES).RemoveAll(); err != nil {
+		return errors.WithStack(err)
+	}
+
 	appendIncludeFolder(ctx, cache, nil, "", ctx.BuildProperties.GetPath("build.core.path"))
 	if ctx.BuildProperties.Get("build.variant.path") != "" {
 		appendIncludeFolder(ctx, cache, nil, "", ctx.BuildProperties.GetPath("build.variant.path"))
 	}
+	// the sketch include dirs point to the copy of the sketch in the build path
+	for _, dir := range ctx.SketchIncludeDirs {
+		appendIncludeFolder(ctx, cache, nil, "", ctx.SketchBuildPath.Join(dir))
+	}
 
 	sketch := ctx.Sketch
 	mergedfile, err := types.MakeSourceFile(ctx, sketch, paths.New(sketch.MainFile.Name.Base()+".cpp"))
@@ -350,6 +362,16 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 		}
 
 		library := ResolveLibrary(ctx, include)
+		if library == nil && ctx.ContinueOnMissingIncludes {
+			// Provide an empty header in place of the missing one, so that
+			// the preprocessor goes on with the other includes
+			if stubsFolder, err := stubMissingInclude(ctx, include); err == nil {
+				ctx.UnresolvedIncludes = append(ctx.UnresolvedIncludes, types.UnresolvedInclude{Header: include, SourceFile: sourcePath})
+				appendIncludeFolder(ctx, cache, sourcePath, include, stubsFolder)
+				first = false
+				continue
+			}
+		}
 		if library == nil {
 			// Library could not be resolved, show error
 			// err := runCommand(ctx, &GCCPreprocRunner{SourceFilePath: sourcePath, TargetFileName: paths.New(constants.FILE_CTAGS_TARGET_FOR_GCC_MINUS_E), Includes: includes})
@@ -382,6 +404,23 @@ func findIncludesUntilDone(ctx *types.Context, cache *includeCache, sourceFile t
 	}
 }
 
+// stubMissingInclude creates an empty header in place of the missing include
+// and returns the folder containing it
+func stubMissingInclude(ctx *types.Context, include string) (*paths.Path, error) {
+	if filepath.IsAbs(include) || strings.HasPrefix(filepath.Clean(include), "..") {
+		return nil, errors.Errorf("invalid include %s", include)
+	}
+	stubsFolder := ctx.BuildPath.Join(constants.FOLDER_MISSING_INCLUDES)
+	stub := stubsFolder.Join(include)
+	if err := stub.Parent().MkdirAll(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := stub.WriteFile([]byte{}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return stubsFolder, nil
+}
+
 func queueSourceFilesFromFolder(ctx *types.Context, queue *types.UniqueSourceFileQueue, origin interface{}, folder *paths.Path, recurse bool) error {
 	extensions := func(ext string) bool { return ADDITIONAL_FILE_VALID_EXTENSIONS_NO_HEADERS[ext] }
 
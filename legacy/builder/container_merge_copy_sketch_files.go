@@ -16,8 +16,16 @@
 package builder
 
 import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	bldr "github.com/arduino/arduino-cli/arduino/builder"
+	"github.com/arduino/arduino-cli/arduino/sketch"
+	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
 )
 
@@ -39,9 +47,40 @@ func (s *ContainerMergeCopySketchFiles) Run(ctx *types.Context) error {
 		return errors.WithStack(err)
 	}
 
+	if ctx.SecretsHeader != nil {
+		// The generated header replaces the one of the sketch, if any, that
+		// usually contains placeholders
+		secretsHeader := filepath.Join(sk.LocationPath, sketches.SecretsHeaderFileName)
+		additionalFiles := []*sketch.Item{}
+		for _, item := range sk.AdditionalFiles {
+			if item.Path != secretsHeader {
+				additionalFiles = append(additionalFiles, item)
+			}
+		}
+		sk.AdditionalFiles = additionalFiles
+	}
+
 	if err := bldr.SketchCopyAdditionalFiles(sk, ctx.SketchBuildPath.String()); err != nil {
 		return errors.WithStack(err)
 	}
 
+	if ctx.SecretsHeader != nil {
+		if err := writeSecretsHeader(ctx.SketchBuildPath.Join(sketches.SecretsHeaderFileName), ctx.SecretsHeader); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
 	return nil
 }
+
+// writeSecretsHeader writes the header only if its content changed, so that
+// the sketch isn't rebuilt every time, and makes it readable only by the user
+func writeSecretsHeader(header *paths.Path, content []byte) error {
+	if existing, err := header.ReadFile(); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	if err := ioutil.WriteFile(header.String(), content, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(header.String(), 0600)
+}
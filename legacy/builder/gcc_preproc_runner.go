@@ -77,6 +77,8 @@ func prepareGCCPreprocRecipeProperties(ctx *types.Context, sourceFilePath *paths
 	// Remove -MMD argument if present. Leaving it will make gcc try
 	// to create a /dev/null.d dependency file, which won't work.
 	cmd.Args = utils.Filter(cmd.Args, func(a string) bool { return a != "-MMD" })
+	// The command is always run by the callers
+	ctx.BuildLog.SetRecipe(cmd, constants.RECIPE_PREPROC_MACROS, properties.Get(constants.RECIPE_PREPROC_MACROS))
 
 	return cmd, nil
 }
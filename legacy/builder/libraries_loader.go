@@ -57,10 +57,28 @@ func (s *LibrariesLoader) Run(ctx *types.Context) error {
 		lm.AddLibrariesDir(folder, libraries.User)
 	}
 
+	unmanagedLibrariesFolders := ctx.UnmanagedLibrariesDirs
+	if err := unmanagedLibrariesFolders.ToAbs(); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, folder := range unmanagedLibrariesFolders {
+		lm.AddLibrariesDir(folder, libraries.Unmanaged)
+	}
+
 	if err := lm.RescanLibraries(); err != nil {
 		return errors.WithStack(err)
 	}
 
+	libraryFolders := ctx.LibraryDirs
+	if err := libraryFolders.ToAbs(); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, folder := range libraryFolders {
+		if err := lm.LoadLibraryFromDir(folder, libraries.Unmanaged); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
 	if debugLevel > 0 {
 		for _, lib := range lm.Libraries {
 			for _, libAlt := range lib.Alternatives {
@@ -0,0 +1,85 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+)
+
+const recipeObjdumpPattern = "recipe.objdump.pattern"
+
+// Disassembler writes the disassembly of the executable, interleaved with the
+// source code, in the {build.project_name}.lst file of the build path
+type Disassembler struct{}
+
+func (s *Disassembler) Run(ctx *types.Context) error {
+	buildProperties := ctx.BuildProperties
+	listingFile := ctx.BuildPath.Join(buildProperties.Get("build.project_name") + ".lst")
+	if !ctx.GenerateDisassembly {
+		// The listing of a previous build would not match the executable
+		if listingFile.Exist() {
+			return listingFile.Remove()
+		}
+		return nil
+	}
+
+	elfFile := ctx.BuildPath.Join(buildProperties.Get("build.project_name") + ".elf")
+	if !elfFile.Exist() {
+		return errors.Errorf("can't disassemble the sketch: %s not found", elfFile.Base())
+	}
+
+	properties, err := disassemblerProperties(buildProperties)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	listing, _, err := builder_utils.ExecRecipe(ctx, properties, recipeObjdumpPattern, false, utils.Capture /* stdout */, utils.ShowIfVerbose /* stderr */)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := listingFile.WriteFile(listing); err != nil {
+		return errors.WithMessage(err, "writing disassembly")
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, "Disassembly written to {0}", listingFile)
+	}
+	return nil
+}
+
+// disassemblerProperties returns the build properties with the objdump recipe.
+// The platforms may define recipe.objdump.pattern or compiler.objdump.cmd,
+// otherwise objdump is looked for in the same toolchain of objcopy.
+func disassemblerProperties(buildProperties *properties.Map) (*properties.Map, error) {
+	properties := buildProperties.Clone()
+	if properties.ContainsKey(recipeObjdumpPattern) {
+		return properties, nil
+	}
+	if !properties.ContainsKey("compiler.objdump.cmd") {
+		objcopy := properties.Get("compiler.objcopy.cmd")
+		if !strings.Contains(objcopy, "objcopy") {
+			return nil, errors.New("the platform doesn't define how to disassemble the sketch, recipe.objdump.pattern is missing")
+		}
+		properties.Set("compiler.objdump.cmd", strings.Replace(objcopy, "objcopy", "objdump", 1))
+	}
+	properties.Set(recipeObjdumpPattern, `"{compiler.path}{compiler.objdump.cmd}" -d -C -S "{build.path}/{build.project_name}.elf"`)
+	return properties, nil
+}
@@ -0,0 +1,53 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"testing"
+
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisassemblerProperties(t *testing.T) {
+	buildProperties := properties.NewFromHashmap(map[string]string{
+		"compiler.path":        "/avr/bin/",
+		"compiler.objcopy.cmd": "avr-objcopy",
+		"build.path":           "/tmp/build",
+		"build.project_name":   "Blink.ino",
+	})
+	res, err := disassemblerProperties(buildProperties)
+	require.NoError(t, err)
+	require.Equal(t, `"/avr/bin/avr-objdump" -d -C -S "/tmp/build/Blink.ino.elf"`, res.ExpandPropsInString(res.Get(recipeObjdumpPattern)))
+	require.False(t, buildProperties.ContainsKey(recipeObjdumpPattern))
+
+	// The command defined by the platform is used
+	buildProperties.Set("compiler.objdump.cmd", "objdump-custom")
+	res, err = disassemblerProperties(buildProperties)
+	require.NoError(t, err)
+	require.Equal(t, `"/avr/bin/objdump-custom" -d -C -S "/tmp/build/Blink.ino.elf"`, res.ExpandPropsInString(res.Get(recipeObjdumpPattern)))
+
+	// The recipe defined by the platform is used
+	buildProperties.Set(recipeObjdumpPattern, "dump {build.project_name}")
+	res, err = disassemblerProperties(buildProperties)
+	require.NoError(t, err)
+	require.Equal(t, "dump Blink.ino", res.ExpandPropsInString(res.Get(recipeObjdumpPattern)))
+
+	// Without objcopy the toolchain is unknown
+	noObjcopy := properties.NewFromHashmap(map[string]string{"compiler.objcopy.cmd": "elf2bin"})
+	_, err = disassemblerProperties(noObjcopy)
+	require.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/pkg/errors"
+)
+
+const recipeFilesystemPattern = "recipe.fs.pattern"
+
+// FilesystemImageBuilder builds the image of the filesystem of the board
+// (e.g. SPIFFS or LittleFS) with the content of the data folder of the
+// sketch. The platform writes it in {build.project_name}.fs.bin of the build
+// path, running recipe.fs.pattern with {build.fs.data_path} set to the data
+// folder.
+type FilesystemImageBuilder struct{}
+
+func (s *FilesystemImageBuilder) Run(ctx *types.Context) error {
+	buildProperties := ctx.BuildProperties
+	imageFile := ctx.BuildPath.Join(buildProperties.Get("build.project_name") + ".fs.bin")
+	if ctx.FilesystemDataDir == nil {
+		// The image of a previous build would not match the data folder
+		if imageFile.Exist() {
+			return imageFile.Remove()
+		}
+		return nil
+	}
+
+	if !buildProperties.ContainsKey(recipeFilesystemPattern) {
+		return errors.New("the platform doesn't support filesystem images, recipe.fs.pattern is missing")
+	}
+	properties := buildProperties.Clone()
+	properties.SetPath("build.fs.data_path", ctx.FilesystemDataDir)
+	if _, _, err := builder_utils.ExecRecipe(ctx, properties, recipeFilesystemPattern, false, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */); err != nil {
+		return errors.WithStack(err)
+	}
+	if !imageFile.Exist() {
+		return errors.Errorf("the filesystem image %s has not been written by %s", imageFile.Base(), recipeFilesystemPattern)
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, "Filesystem image written to {0}", imageFile)
+	}
+	return nil
+}
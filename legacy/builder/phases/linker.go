@@ -30,6 +30,13 @@ import (
 type Linker struct{}
 
 func (s *Linker) Run(ctx *types.Context) error {
+	if ctx.OnlyUpdateCompilationDatabase {
+		if ctx.Verbose {
+			ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, "Skip linking of final executable.")
+		}
+		return nil
+	}
+
 	objectFilesSketch := ctx.SketchObjectFiles
 	objectFilesLibraries := ctx.LibrariesObjectFiles
 	objectFilesCore := ctx.CoreObjectsFiles
@@ -67,6 +74,18 @@ func link(ctx *types.Context, objectFiles paths.PathList, coreDotARelPath *paths
 	properties.Set(constants.BUILD_PROPERTIES_ARCHIVE_FILE_PATH, coreArchiveFilePath.String())
 	properties.Set(constants.BUILD_PROPERTIES_OBJECT_FILES, objectFileList)
 
+	// Ask the linker for the map file, unless the platform already does. The
+	// map file of a previous build is removed, it would not match the executable
+	mapFile := ctx.BuildPath.Join(properties.Get("build.project_name") + ".map")
+	if mapFile.Exist() {
+		if err := mapFile.Remove(); err != nil {
+			return err
+		}
+	}
+	if pattern := properties.Get(constants.RECIPE_C_COMBINE_PATTERN); ctx.GenerateMapFile && !strings.Contains(pattern, "-Map") {
+		properties.Set(constants.RECIPE_C_COMBINE_PATTERN, pattern+` "-Wl,-Map,{build.path}/{build.project_name}.map"`)
+	}
+
 	_, _, err := builder_utils.ExecRecipe(ctx, properties, constants.RECIPE_C_COMBINE_PATTERN, false /* stdout */, utils.ShowIfVerbose /* stderr */, utils.Show)
 	return err
 }
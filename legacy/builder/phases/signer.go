@@ -0,0 +1,79 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package phases
+
+import (
+	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
+	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/legacy/builder/utils"
+	"github.com/arduino/go-paths-helper"
+	"github.com/arduino/go-properties-orderedmap"
+	"github.com/pkg/errors"
+)
+
+// Signer runs the signing hook of the platform, recipe.hooks.postbuild.signing.pattern,
+// when a signing key is given. The path of the key is in {build.sign_key}
+// and the recipe must write the signed executable in the file given by
+// recipe.hooks.postbuild.signing.output, {build.path}/{build.project_name}.signed.bin
+// by default.
+type Signer struct{}
+
+func (s *Signer) Run(ctx *types.Context) error {
+	ctx.SignedArtifact = nil
+	properties := ctx.BuildProperties.Clone()
+	if ctx.SignKey != nil {
+		properties.SetPath("build.sign_key", ctx.SignKey)
+	}
+	signedFile := signedArtifactPath(properties)
+	if ctx.SignKey == nil {
+		// The signed executable of a previous build would not match
+		if signedFile.Exist() {
+			return signedFile.Remove()
+		}
+		return nil
+	}
+
+	if !properties.ContainsKey(constants.RECIPE_SIGNING_PATTERN) {
+		return errors.Errorf("the platform doesn't support signing, %s is missing", constants.RECIPE_SIGNING_PATTERN)
+	}
+	if signedFile.Exist() {
+		if err := signedFile.Remove(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if _, _, err := builder_utils.ExecRecipe(ctx, properties, constants.RECIPE_SIGNING_PATTERN, false, utils.ShowIfVerbose /* stdout */, utils.Show /* stderr */); err != nil {
+		return errors.WithStack(err)
+	}
+	if !signedFile.Exist() {
+		return errors.Errorf("the signed executable %s has not been written by %s", signedFile, constants.RECIPE_SIGNING_PATTERN)
+	}
+	if ctx.Verbose {
+		ctx.GetLogger().Println(constants.LOG_LEVEL_INFO, "Signed executable written to {0}", signedFile)
+	}
+	ctx.SignedArtifact = signedFile
+	return nil
+}
+
+// signedArtifactPath returns the path of the signed executable written by
+// the signing hook
+func signedArtifactPath(buildProperties *properties.Map) *paths.Path {
+	output := buildProperties.Get(constants.RECIPE_SIGNING_OUTPUT)
+	if output == "" {
+		output = "{build.path}/{build.project_name}.signed.bin"
+	}
+	return paths.New(buildProperties.ExpandPropsInString(output))
+}
@@ -16,9 +16,11 @@
 package phases
 
 import (
+	"os"
 	"regexp"
 	"strconv"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
@@ -33,12 +35,22 @@ type Sizer struct {
 
 func (s *Sizer) Run(ctx *types.Context) error {
 
+	if ctx.OnlyUpdateCompilationDatabase {
+		return nil
+	}
 	if s.SketchError {
 		return nil
 	}
 
 	buildProperties := ctx.BuildProperties
 
+	ctx.SizeReport = &bldr.SizeReport{
+		Memory:   []*bldr.MemoryUsage{},
+		Sections: []*bldr.SectionSize{},
+		Symbols:  []*bldr.SymbolSize{},
+	}
+	readExecutableSizes(ctx, buildProperties)
+
 	err := checkSize(ctx, buildProperties)
 	if err != nil {
 		return errors.WithStack(err)
@@ -47,6 +59,24 @@ func (s *Sizer) Run(ctx *types.Context) error {
 	return nil
 }
 
+// readExecutableSizes adds the sections and the symbols of the executable to
+// the size report. Nothing is added if the platform doesn't produce an ELF.
+func readExecutableSizes(ctx *types.Context, buildProperties *properties.Map) {
+	elfFile := buildProperties.GetPath("build.path").Join(buildProperties.Get("build.project_name") + ".elf")
+	if !elfFile.Exist() {
+		return
+	}
+	sections, symbols, err := bldr.ReadELFSizes(elfFile, ctx.SizeReportSymbols)
+	if err != nil {
+		if ctx.DebugLevel >= 20 {
+			ctx.GetLogger().Fprintln(os.Stdout, constants.LOG_LEVEL_DEBUG, err.Error())
+		}
+		return
+	}
+	ctx.SizeReport.Sections = sections
+	ctx.SizeReport.Symbols = symbols
+}
+
 func checkSize(ctx *types.Context, buildProperties *properties.Map) error {
 	logger := ctx.GetLogger()
 
@@ -73,12 +103,24 @@ func checkSize(ctx *types.Context, buildProperties *properties.Map) error {
 		}
 	}
 
-	textSize, dataSize, _, err := execSizeRecipe(ctx, properties)
+	textSize, dataSize, eepromSize, err := execSizeRecipe(ctx, properties)
 	if err != nil {
 		logger.Println(constants.LOG_LEVEL_WARN, constants.MSG_SIZER_ERROR_NO_RULE)
 		return nil
 	}
 
+	ctx.SizeReport.Memory = append(ctx.SizeReport.Memory, &bldr.MemoryUsage{Name: "flash", Size: textSize, MaxSize: maxTextSize})
+	if dataSize >= 0 {
+		usage := &bldr.MemoryUsage{Name: "ram", Size: dataSize}
+		if maxDataSize > 0 {
+			usage.MaxSize = maxDataSize
+		}
+		ctx.SizeReport.Memory = append(ctx.SizeReport.Memory, usage)
+	}
+	if eepromSize >= 0 {
+		ctx.SizeReport.Memory = append(ctx.SizeReport.Memory, &bldr.MemoryUsage{Name: "eeprom", Size: eepromSize})
+	}
+
 	logger.Println(constants.LOG_LEVEL_INFO, constants.MSG_SIZER_TEXT_FULL, strconv.Itoa(textSize), strconv.Itoa(maxTextSize), strconv.Itoa(textSize*100/maxTextSize))
 	if dataSize >= 0 {
 		if maxDataSize > 0 {
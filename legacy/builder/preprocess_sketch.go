@@ -16,8 +16,6 @@
 package builder
 
 import (
-	"fmt"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -117,14 +115,9 @@ func (s *ArduinoPreprocessorRunner) Run(ctx *types.Context) error {
 		//command.Args[0], _ = filepath.Rel(command.Dir, command.Args[0])
 	}
 
-	verbose := ctx.Verbose
-	if verbose {
-		fmt.Println(commandLine)
-	}
-
-	buf, err := command.Output()
+	buf, stderr, err := utils.ExecCommand(ctx, command, utils.Capture /* stdout */, utils.Capture /* stderr */)
 	if err != nil {
-		return errors.New(errors.WithStack(err).Error() + string(err.(*exec.ExitError).Stderr))
+		return errors.New(err.Error() + string(stderr))
 	}
 
 	result := utils.NormalizeUTF8(buf)
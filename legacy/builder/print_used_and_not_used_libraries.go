@@ -49,8 +49,12 @@ func (s *PrintUsedAndNotUsedLibraries) Run(ctx *types.Context) error {
 		}
 		logger.Fprintln(os.Stdout, logLevel, constants.MSG_LIBRARIES_MULTIPLE_LIBS_FOUND_FOR, header)
 		logger.Fprintln(os.Stdout, logLevel, constants.MSG_LIBRARIES_USED, libResResult.Library.InstallDir)
-		for _, notUsedLibrary := range libResResult.NotUsedLibraries {
-			logger.Fprintln(os.Stdout, logLevel, constants.MSG_LIBRARIES_NOT_USED, notUsedLibrary.InstallDir)
+		for i, notUsedLibrary := range libResResult.NotUsedLibraries {
+			if i < len(libResResult.NotUsedReasons) {
+				logger.Fprintln(os.Stdout, logLevel, constants.MSG_LIBRARIES_NOT_USED_BECAUSE, notUsedLibrary.InstallDir, libResResult.NotUsedReasons[i])
+			} else {
+				logger.Fprintln(os.Stdout, logLevel, constants.MSG_LIBRARIES_NOT_USED, notUsedLibrary.InstallDir)
+			}
 		}
 	}
 
@@ -60,6 +60,10 @@ func (s *RecipeByPrefixSuffixRunner) Run(ctx *types.Context) error {
 func findRecipes(buildProperties *properties.Map, patternPrefix string, patternSuffix string) []string {
 	var recipes []string
 	for _, key := range buildProperties.Keys() {
+		// The signing hook runs only when a signing key is given
+		if key == constants.RECIPE_SIGNING_PATTERN {
+			continue
+		}
 		if strings.HasPrefix(key, patternPrefix) && strings.HasSuffix(key, patternSuffix) && buildProperties.Get(key) != "" {
 			recipes = append(recipes, key)
 		}
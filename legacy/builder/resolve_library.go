@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 )
@@ -46,14 +47,21 @@ func ResolveLibrary(ctx *types.Context, header string) *libraries.Library {
 		}
 	}
 
-	selected := resolver.ResolveFor(header, ctx.TargetPlatform.Platform.Architecture)
+	arch := ctx.TargetPlatform.Platform.Architecture
+	selected := resolver.ResolveFor(header, arch)
 	if alreadyImported := importedLibraries.FindByName(selected.Name); alreadyImported != nil {
 		selected = alreadyImported
 	}
 
+	notUsedLibraries := filterOutLibraryFrom(candidates, selected)
+	notUsedReasons := []string{}
+	for _, notUsed := range notUsedLibraries {
+		notUsedReasons = append(notUsedReasons, librariesresolver.PreferenceReason(selected, notUsed, header, arch))
+	}
 	ctx.LibrariesResolutionResults[header] = types.LibraryResolutionResult{
 		Library:          selected,
-		NotUsedLibraries: filterOutLibraryFrom(candidates, selected),
+		NotUsedLibraries: notUsedLibraries,
+		NotUsedReasons:   notUsedReasons,
 	}
 
 	return selected
@@ -16,6 +16,8 @@
 package builder
 
 import (
+	"strings"
+
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/pkg/errors"
@@ -32,5 +34,12 @@ func (s *SetCustomBuildProperties) Run(ctx *types.Context) error {
 
 	buildProperties.Merge(customBuildProperties)
 
+	if len(ctx.ExtraCompilerFlags) > 0 {
+		extraFlags := strings.Join(ctx.ExtraCompilerFlags, " ")
+		for _, key := range []string{"compiler.c.extra_flags", "compiler.cpp.extra_flags", "compiler.S.extra_flags"} {
+			buildProperties.Set(key, strings.TrimSpace(buildProperties.Get(key)+" "+extraFlags))
+		}
+	}
+
 	return nil
 }
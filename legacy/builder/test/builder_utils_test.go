@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	bldr "github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/builder_utils"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
 	paths "github.com/arduino/go-paths-helper"
@@ -175,3 +176,46 @@ func TestObjFileIsUpToDateDepIsWrong(t *testing.T) {
 	NoError(t, err)
 	require.False(t, upToDate)
 }
+
+func TestObjFileIsUpToDateWithBuildState(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "build")
+	NoError(t, err)
+	defer buildPath.RemoveAll()
+	ctx := &types.Context{
+		BuildState: bldr.NewBuildState(buildPath.Join("build_state.json")),
+	}
+
+	objFile := tempFile(t, "obj")
+	defer objFile.RemoveAll()
+	depFile := tempFile(t, "dep")
+	defer depFile.RemoveAll()
+
+	sleep(t)
+
+	sourceFile := tempFile(t, "source")
+	defer sourceFile.RemoveAll()
+	headerFile := tempFile(t, "header")
+	defer headerFile.RemoveAll()
+
+	res := objFile.String() + ": \\\n\t" + sourceFile.String() + " \\\n\t" + headerFile.String()
+	depFile.WriteFile([]byte(res))
+
+	// The state is not recorded, the timestamps are used
+	upToDate, err := builder_utils.ObjFileIsUpToDate(ctx, sourceFile, objFile, depFile)
+	NoError(t, err)
+	require.False(t, upToDate)
+
+	// The sources are newer than the object, but their content didn't change
+	NoError(t, ctx.BuildState.Update(objFile, paths.PathList{sourceFile, headerFile}))
+	upToDate, err = builder_utils.ObjFileIsUpToDate(ctx, sourceFile, objFile, depFile)
+	NoError(t, err)
+	require.True(t, upToDate)
+
+	// A new build, where the content of the header changed
+	ctx.BuildState = bldr.LoadBuildState(buildPath.Join("build_state.json"))
+	NoError(t, ctx.BuildState.Update(objFile, paths.PathList{sourceFile, headerFile}))
+	NoError(t, headerFile.WriteFile([]byte("int a;")))
+	upToDate, err = builder_utils.ObjFileIsUpToDate(ctx, sourceFile, objFile, depFile)
+	NoError(t, err)
+	require.False(t, upToDate)
+}
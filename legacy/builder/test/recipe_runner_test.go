@@ -20,7 +20,9 @@ import (
 
 	"github.com/arduino/arduino-cli/legacy/builder"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
+	"github.com/arduino/arduino-cli/legacy/builder/phases"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
 	"github.com/stretchr/testify/require"
 )
@@ -52,4 +54,30 @@ func TestRecipesComposition(t *testing.T) {
 	require.Equal(t, "recipe.hooks.postbuild", constants.HOOKS_POSTBUILD)
 	require.Equal(t, "recipe.hooks.linking.prelink", constants.HOOKS_LINKING_PRELINK)
 	require.Equal(t, "recipe.hooks.objcopy.preobjcopy", constants.HOOKS_OBJCOPY_PREOBJCOPY)
+	require.Equal(t, "recipe.hooks.postbuild.signing.pattern", constants.RECIPE_SIGNING_PATTERN)
+}
+
+func TestSigner(t *testing.T) {
+	buildPath, err := paths.MkTempDir("", "test_signer")
+	require.NoError(t, err)
+	defer buildPath.RemoveAll()
+
+	ctx := &types.Context{BuildPath: buildPath}
+	ctx.BuildProperties = properties.NewFromHashmap(map[string]string{
+		"build.path":         buildPath.String(),
+		"build.project_name": "sketch.ino",
+	})
+
+	// The signed executable of a previous build is removed
+	signed := buildPath.Join("sketch.ino.signed.bin")
+	require.NoError(t, signed.WriteFile([]byte{}))
+	require.NoError(t, (&phases.Signer{}).Run(ctx))
+	require.True(t, signed.NotExist())
+	require.Nil(t, ctx.SignedArtifact)
+
+	// The signing hook is required if a key is given
+	ctx.SignKey = buildPath.Join("key.pem")
+	err = (&phases.Signer{}).Run(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), constants.RECIPE_SIGNING_PATTERN)
 }
@@ -16,9 +16,12 @@
 package types
 
 import (
+	"context"
 	"io"
 	"strings"
+	"sync"
 
+	"github.com/arduino/arduino-cli/arduino/builder"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/libraries"
@@ -72,6 +75,11 @@ type Context struct {
 	CodeCompleteAt       string
 	Clean                bool
 
+	// Folders of libraries and single libraries added to the build, they
+	// have priority over the installed libraries
+	UnmanagedLibrariesDirs paths.PathList
+	LibraryDirs            paths.PathList
+
 	// Build options are serialized here
 	BuildOptionsJson         string
 	BuildOptionsJsonPrevious string
@@ -118,6 +126,10 @@ type Context struct {
 	ImportedLibraries          libraries.List
 	LibrariesResolutionResults map[string]LibraryResolutionResult
 	IncludeFolders             paths.PathList
+	// When set, the includes that can't be resolved to a library are
+	// collected in UnresolvedIncludes instead of failing the build
+	ContinueOnMissingIncludes bool
+	UnresolvedIncludes        []UnresolvedInclude
 	//OutputGccMinusM            string
 
 	// C++ Parsing
@@ -137,11 +149,31 @@ type Context struct {
 	OptimizeForDebug  bool
 	OptimizationFlags string
 
+	// Content of the arduino_secrets.h generated in the sketch build path,
+	// nil if the sketch doesn't declare any secret. It must never be logged.
+	SecretsHeader []byte
+
+	// Additional artifacts: the linker map file and the disassembly of the
+	// executable
+	GenerateMapFile     bool
+	GenerateDisassembly bool
+
+	// The data folder of the sketch, when the image of the filesystem of the
+	// board must be built out of it
+	FilesystemDataDir *paths.Path
+
+	// The key passed to the signing hook of the platform, and the signed
+	// executable it produced
+	SignKey        *paths.Path
+	SignedArtifact *paths.Path
+
 	// Dry run, only create progress map
 	Progress ProgressStruct
 
 	// Contents of a custom build properties file (line by line)
 	CustomBuildProperties []string
+	// Flags appended to the compiler.{c,cpp,S}.extra_flags properties
+	ExtraCompilerFlags []string
 
 	// Logging
 	logger     i18n.Logger
@@ -156,9 +188,64 @@ type Context struct {
 	// Parallel processes
 	Jobs int
 
+	// Command prefixed to the compiler invocations (e.g. ccache) and the
+	// environment variables, in the KEY=value form, to run it with
+	CompilerLauncher    string
+	CompilerLauncherEnv []string
+
+	// Subfolders of the sketch, relative to the sketch folder, added to the
+	// include path of the build
+	SketchIncludeDirs []string
+
 	// Out and Err stream to redirect all Exec commands
-	ExecStdout io.Writer
-	ExecStderr io.Writer
+	ExecStdout    io.Writer
+	ExecStderr    io.Writer
+	execOutputMux sync.Mutex
+	// Context of the request that started the build, the running commands
+	// are killed when it's canceled and their logs carry the request ID
+	RequestCtx context.Context
+
+	// Compilation database, collects the command lines used to compile each file
+	CompilationDatabase *builder.CompilationDatabase
+	// Set to true to skip build and produce only Compilation Database
+	OnlyUpdateCompilationDatabase bool
+	// Log of all the tools run by the build, nil if not requested
+	BuildLog *builder.BuildLog
+
+	// Content of the files used to compile each object file in the previous builds
+	BuildState *builder.BuildState
+
+	// Number of the largest symbols of the executable to add to the size
+	// report, all the symbols are added if negative
+	SizeReportSymbols int
+	// Memory used by the compiled sketch
+	SizeReport *builder.SizeReport
+
+	// Errors and warnings reported by the compiler
+	CompilerDiagnostics    []*builder.CompilerDiagnostic
+	compilerDiagnosticsMux sync.Mutex
+}
+
+// AddCompilerDiagnostics appends the given diagnostics to the ones collected
+// so far, it's safe to call it from concurrent compile jobs.
+func (ctx *Context) AddCompilerDiagnostics(diags ...*builder.CompilerDiagnostic) {
+	ctx.compilerDiagnosticsMux.Lock()
+	ctx.CompilerDiagnostics = append(ctx.CompilerDiagnostics, diags...)
+	ctx.compilerDiagnosticsMux.Unlock()
+}
+
+// WriteCompilerOutput writes the output of a compiler run to ExecStdout and
+// ExecStderr. The output of each run is written at once, so that the output
+// of the concurrent compile jobs doesn't get mixed up.
+func (ctx *Context) WriteCompilerOutput(stdout, stderr []byte) {
+	ctx.execOutputMux.Lock()
+	defer ctx.execOutputMux.Unlock()
+	if len(stdout) > 0 && ctx.ExecStdout != nil {
+		ctx.ExecStdout.Write(stdout)
+	}
+	if len(stderr) > 0 && ctx.ExecStderr != nil {
+		ctx.ExecStderr.Write(stderr)
+	}
 }
 
 func (ctx *Context) ExtractBuildOptions() *properties.Map {
@@ -167,6 +254,15 @@ func (ctx *Context) ExtractBuildOptions() *properties.Map {
 	opts.Set("builtInToolsFolders", strings.Join(ctx.BuiltInToolsDirs.AsStrings(), ","))
 	opts.Set("builtInLibrariesFolders", strings.Join(ctx.BuiltInLibrariesDirs.AsStrings(), ","))
 	opts.Set("otherLibrariesFolders", strings.Join(ctx.OtherLibrariesDirs.AsStrings(), ","))
+	if len(ctx.UnmanagedLibrariesDirs) > 0 {
+		opts.Set("unmanagedLibrariesFolders", strings.Join(ctx.UnmanagedLibrariesDirs.AsStrings(), ","))
+	}
+	if len(ctx.LibraryDirs) > 0 {
+		opts.Set("libraryFolders", strings.Join(ctx.LibraryDirs.AsStrings(), ","))
+	}
+	if len(ctx.SketchIncludeDirs) > 0 {
+		opts.Set("sketchIncludeFolders", strings.Join(ctx.SketchIncludeDirs, ","))
+	}
 	opts.SetPath("sketchLocation", ctx.SketchLocation)
 	var additionalFilesRelative []string
 	if ctx.Sketch != nil {
@@ -182,6 +278,9 @@ func (ctx *Context) ExtractBuildOptions() *properties.Map {
 	opts.Set("fqbn", ctx.FQBN.String())
 	opts.Set("runtime.ide.version", ctx.ArduinoAPIVersion)
 	opts.Set("customBuildProperties", strings.Join(ctx.CustomBuildProperties, ","))
+	if len(ctx.ExtraCompilerFlags) > 0 {
+		opts.Set("extraCompilerFlags", strings.Join(ctx.ExtraCompilerFlags, " "))
+	}
 	opts.Set("additionalFiles", strings.Join(additionalFilesRelative, ","))
 	opts.Set("compiler.optimization_flags", ctx.OptimizationFlags)
 	return opts
@@ -192,6 +291,12 @@ func (ctx *Context) InjectBuildOptions(opts *properties.Map) {
 	ctx.BuiltInToolsDirs = paths.NewPathList(strings.Split(opts.Get("builtInToolsFolders"), ",")...)
 	ctx.BuiltInLibrariesDirs = paths.NewPathList(strings.Split(opts.Get("builtInLibrariesFolders"), ",")...)
 	ctx.OtherLibrariesDirs = paths.NewPathList(strings.Split(opts.Get("otherLibrariesFolders"), ",")...)
+	if dirs := opts.Get("unmanagedLibrariesFolders"); dirs != "" {
+		ctx.UnmanagedLibrariesDirs = paths.NewPathList(strings.Split(dirs, ",")...)
+	}
+	if dirs := opts.Get("libraryFolders"); dirs != "" {
+		ctx.LibraryDirs = paths.NewPathList(strings.Split(dirs, ",")...)
+	}
 	ctx.SketchLocation = opts.GetPath("sketchLocation")
 	fqbn, err := cores.ParseFQBN(opts.Get("fqbn"))
 	if err != nil {
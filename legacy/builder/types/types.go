@@ -182,6 +182,15 @@ func (proto *Prototype) String() string {
 type LibraryResolutionResult struct {
 	Library          *libraries.Library
 	NotUsedLibraries []*libraries.Library
+	// Why each of the NotUsedLibraries has been discarded
+	NotUsedReasons []string
+}
+
+// UnresolvedInclude is an #include that isn't provided by the core, the
+// sketch or any of the installed libraries
+type UnresolvedInclude struct {
+	Header     string
+	SourceFile *paths.Path
 }
 
 type CTag struct {
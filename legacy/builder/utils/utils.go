@@ -26,15 +26,19 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
+	"github.com/arduino/arduino-cli/executils"
 	"github.com/arduino/arduino-cli/legacy/builder/constants"
 	"github.com/arduino/arduino-cli/legacy/builder/gohasissues"
 	"github.com/arduino/arduino-cli/legacy/builder/i18n"
 	"github.com/arduino/arduino-cli/legacy/builder/types"
+	"github.com/arduino/arduino-cli/tracing"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/text/transform"
 	"golang.org/x/text/unicode/norm"
 )
@@ -284,12 +288,43 @@ func ExecCommand(ctx *types.Context, command *exec.Cmd, stdout int, stderr int)
 		command.Stderr = ctx.ExecStderr
 	}
 
-	err := command.Start()
+	if ctx.RequestCtx != nil {
+		if err := ctx.RequestCtx.Err(); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+	logEntry := ctx.BuildLog.Begin(command)
+	// The compiler drivers spawn other processes (cc1plus, as, ...) that must
+	// be killed too if the build is canceled
+	group, err := executils.StartProcessGroup(command)
 	if err != nil {
+		ctx.BuildLog.End(logEntry, err)
 		return nil, nil, errors.WithStack(err)
 	}
+	defer group.Release()
+	log := tracing.Logger(ctx.RequestCtx).WithFields(logrus.Fields{"cmd": command.Path, "pid": command.Process.Pid})
+	log.Debug("Process started")
+	start := time.Now()
+
+	if ctx.RequestCtx != nil {
+		exited := make(chan struct{})
+		defer close(exited)
+		go func() {
+			select {
+			case <-ctx.RequestCtx.Done():
+				group.Kill()
+			case <-exited:
+			}
+		}()
+	}
 
 	err = command.Wait()
+	ctx.BuildLog.End(logEntry, err)
+	log = log.WithField("duration", time.Since(start).String())
+	if err != nil {
+		log = log.WithError(err)
+	}
+	log.Debug("Process exited")
 
 	var outbytes, errbytes []byte
 	if buf, ok := command.Stdout.(*bytes.Buffer); ok {
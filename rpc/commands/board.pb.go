@@ -130,6 +130,12 @@ type BoardDetailsResp struct {
 	IdentificationPref []*IdentificationPref `protobuf:"bytes,12,rep,name=identification_pref,json=identificationPref,proto3" json:"identification_pref,omitempty"`
 	// List of programmers supported by the board
 	Programmers []*Programmer `protobuf:"bytes,13,rep,name=programmers,proto3" json:"programmers,omitempty"`
+	// Set to true if the board supports debugging
+	DebuggingSupported bool `protobuf:"varint,14,opt,name=debugging_supported,json=debuggingSupported,proto3" json:"debugging_supported,omitempty"`
+	// The requested FQBN with all the board's configuration options set, the
+	// missing ones to their default value (e.g.,
+	// `arduino:avr:nano:cpu=atmega328`).
+	NormalizedFqbn string `protobuf:"bytes,15,opt,name=normalized_fqbn,json=normalizedFqbn,proto3" json:"normalized_fqbn,omitempty"`
 }
 
 func (x *BoardDetailsResp) Reset() {
@@ -255,6 +261,128 @@ func (x *BoardDetailsResp) GetProgrammers() []*Programmer {
 	return nil
 }
 
+func (x *BoardDetailsResp) GetDebuggingSupported() bool {
+	if x != nil {
+		return x.DebuggingSupported
+	}
+	return false
+}
+
+func (x *BoardDetailsResp) GetNormalizedFqbn() string {
+	if x != nil {
+		return x.NormalizedFqbn
+	}
+	return ""
+}
+
+type BoardNormalizeFQBNReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The fully qualified board name to normalize, optionally with
+	// configuration options (e.g., `arduino:avr:nano:cpu=atmega168`).
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+}
+
+func (x *BoardNormalizeFQBNReq) Reset() {
+	*x = BoardNormalizeFQBNReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_board_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardNormalizeFQBNReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardNormalizeFQBNReq) ProtoMessage() {}
+
+func (x *BoardNormalizeFQBNReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_board_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardNormalizeFQBNReq.ProtoReflect.Descriptor instead.
+func (*BoardNormalizeFQBNReq) Descriptor() ([]byte, []int) {
+	return file_commands_board_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BoardNormalizeFQBNReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardNormalizeFQBNReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+type BoardNormalizeFQBNResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The FQBN with all the board's configuration options set, in the order
+	// defined by the board's menus. The options not set in the request have
+	// their default value.
+	Fqbn string `protobuf:"bytes,1,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+}
+
+func (x *BoardNormalizeFQBNResp) Reset() {
+	*x = BoardNormalizeFQBNResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_board_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardNormalizeFQBNResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardNormalizeFQBNResp) ProtoMessage() {}
+
+func (x *BoardNormalizeFQBNResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_board_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardNormalizeFQBNResp.ProtoReflect.Descriptor instead.
+func (*BoardNormalizeFQBNResp) Descriptor() ([]byte, []int) {
+	return file_commands_board_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BoardNormalizeFQBNResp) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
 type IdentificationPref struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -267,7 +395,7 @@ type IdentificationPref struct {
 func (x *IdentificationPref) Reset() {
 	*x = IdentificationPref{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[2]
+		mi := &file_commands_board_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -280,7 +408,7 @@ func (x *IdentificationPref) String() string {
 func (*IdentificationPref) ProtoMessage() {}
 
 func (x *IdentificationPref) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[2]
+	mi := &file_commands_board_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +421,7 @@ func (x *IdentificationPref) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentificationPref.ProtoReflect.Descriptor instead.
 func (*IdentificationPref) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{2}
+	return file_commands_board_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IdentificationPref) GetUsbID() *USBID {
@@ -317,7 +445,7 @@ type USBID struct {
 func (x *USBID) Reset() {
 	*x = USBID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[3]
+		mi := &file_commands_board_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +458,7 @@ func (x *USBID) String() string {
 func (*USBID) ProtoMessage() {}
 
 func (x *USBID) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[3]
+	mi := &file_commands_board_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +471,7 @@ func (x *USBID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use USBID.ProtoReflect.Descriptor instead.
 func (*USBID) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{3}
+	return file_commands_board_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *USBID) GetVID() string {
@@ -383,7 +511,7 @@ type Package struct {
 func (x *Package) Reset() {
 	*x = Package{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[4]
+		mi := &file_commands_board_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +524,7 @@ func (x *Package) String() string {
 func (*Package) ProtoMessage() {}
 
 func (x *Package) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[4]
+	mi := &file_commands_board_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +537,7 @@ func (x *Package) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Package.ProtoReflect.Descriptor instead.
 func (*Package) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{4}
+	return file_commands_board_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Package) GetMaintainer() string {
@@ -466,7 +594,7 @@ type Help struct {
 func (x *Help) Reset() {
 	*x = Help{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[5]
+		mi := &file_commands_board_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +607,7 @@ func (x *Help) String() string {
 func (*Help) ProtoMessage() {}
 
 func (x *Help) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[5]
+	mi := &file_commands_board_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +620,7 @@ func (x *Help) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Help.ProtoReflect.Descriptor instead.
 func (*Help) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{5}
+	return file_commands_board_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Help) GetOnline() string {
@@ -526,7 +654,7 @@ type BoardPlatform struct {
 func (x *BoardPlatform) Reset() {
 	*x = BoardPlatform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[6]
+		mi := &file_commands_board_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +667,7 @@ func (x *BoardPlatform) String() string {
 func (*BoardPlatform) ProtoMessage() {}
 
 func (x *BoardPlatform) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[6]
+	mi := &file_commands_board_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +680,7 @@ func (x *BoardPlatform) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardPlatform.ProtoReflect.Descriptor instead.
 func (*BoardPlatform) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{6}
+	return file_commands_board_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BoardPlatform) GetArchitecture() string {
@@ -622,7 +750,7 @@ type ToolsDependencies struct {
 func (x *ToolsDependencies) Reset() {
 	*x = ToolsDependencies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[7]
+		mi := &file_commands_board_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -635,7 +763,7 @@ func (x *ToolsDependencies) String() string {
 func (*ToolsDependencies) ProtoMessage() {}
 
 func (x *ToolsDependencies) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[7]
+	mi := &file_commands_board_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +776,7 @@ func (x *ToolsDependencies) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolsDependencies.ProtoReflect.Descriptor instead.
 func (*ToolsDependencies) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{7}
+	return file_commands_board_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ToolsDependencies) GetPackager() string {
@@ -699,7 +827,7 @@ type Systems struct {
 func (x *Systems) Reset() {
 	*x = Systems{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[8]
+		mi := &file_commands_board_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -712,7 +840,7 @@ func (x *Systems) String() string {
 func (*Systems) ProtoMessage() {}
 
 func (x *Systems) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[8]
+	mi := &file_commands_board_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +853,7 @@ func (x *Systems) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Systems.ProtoReflect.Descriptor instead.
 func (*Systems) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{8}
+	return file_commands_board_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Systems) GetChecksum() string {
@@ -779,7 +907,7 @@ type ConfigOption struct {
 func (x *ConfigOption) Reset() {
 	*x = ConfigOption{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[9]
+		mi := &file_commands_board_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -792,7 +920,7 @@ func (x *ConfigOption) String() string {
 func (*ConfigOption) ProtoMessage() {}
 
 func (x *ConfigOption) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[9]
+	mi := &file_commands_board_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +933,7 @@ func (x *ConfigOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigOption.ProtoReflect.Descriptor instead.
 func (*ConfigOption) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{9}
+	return file_commands_board_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ConfigOption) GetOption() string {
@@ -845,7 +973,7 @@ type ConfigValue struct {
 func (x *ConfigValue) Reset() {
 	*x = ConfigValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[10]
+		mi := &file_commands_board_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -858,7 +986,7 @@ func (x *ConfigValue) String() string {
 func (*ConfigValue) ProtoMessage() {}
 
 func (x *ConfigValue) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[10]
+	mi := &file_commands_board_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +999,7 @@ func (x *ConfigValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigValue.ProtoReflect.Descriptor instead.
 func (*ConfigValue) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{10}
+	return file_commands_board_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ConfigValue) GetValue() string {
@@ -910,12 +1038,18 @@ type BoardAttachReq struct {
 	// Duration in seconds to search the given URI for a connected board before
 	// timing out. The default value is 5 seconds.
 	SearchTimeout string `protobuf:"bytes,4,opt,name=search_timeout,json=searchTimeout,proto3" json:"search_timeout,omitempty"`
+	// Optional: the port to attach together with the board, used only when
+	// `board_uri` is a FQBN. If omitted the previously attached port is kept.
+	// The serial number of the board connected to the port is recorded too,
+	// `serial://<serial number>` selects the port of the board with that
+	// serial number.
+	Port string `protobuf:"bytes,5,opt,name=port,proto3" json:"port,omitempty"`
 }
 
 func (x *BoardAttachReq) Reset() {
 	*x = BoardAttachReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[11]
+		mi := &file_commands_board_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -928,7 +1062,7 @@ func (x *BoardAttachReq) String() string {
 func (*BoardAttachReq) ProtoMessage() {}
 
 func (x *BoardAttachReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[11]
+	mi := &file_commands_board_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1075,7 @@ func (x *BoardAttachReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardAttachReq.ProtoReflect.Descriptor instead.
 func (*BoardAttachReq) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{11}
+	return file_commands_board_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BoardAttachReq) GetInstance() *Instance {
@@ -972,6 +1106,13 @@ func (x *BoardAttachReq) GetSearchTimeout() string {
 	return ""
 }
 
+func (x *BoardAttachReq) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
 type BoardAttachResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -984,7 +1125,7 @@ type BoardAttachResp struct {
 func (x *BoardAttachResp) Reset() {
 	*x = BoardAttachResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[12]
+		mi := &file_commands_board_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1138,7 @@ func (x *BoardAttachResp) String() string {
 func (*BoardAttachResp) ProtoMessage() {}
 
 func (x *BoardAttachResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[12]
+	mi := &file_commands_board_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1151,7 @@ func (x *BoardAttachResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardAttachResp.ProtoReflect.Descriptor instead.
 func (*BoardAttachResp) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{12}
+	return file_commands_board_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BoardAttachResp) GetTaskProgress() *TaskProgress {
@@ -1032,7 +1173,7 @@ type BoardListReq struct {
 func (x *BoardListReq) Reset() {
 	*x = BoardListReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[13]
+		mi := &file_commands_board_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1186,7 @@ func (x *BoardListReq) String() string {
 func (*BoardListReq) ProtoMessage() {}
 
 func (x *BoardListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[13]
+	mi := &file_commands_board_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1199,7 @@ func (x *BoardListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardListReq.ProtoReflect.Descriptor instead.
 func (*BoardListReq) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{13}
+	return file_commands_board_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BoardListReq) GetInstance() *Instance {
@@ -1080,7 +1221,7 @@ type BoardListResp struct {
 func (x *BoardListResp) Reset() {
 	*x = BoardListResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[14]
+		mi := &file_commands_board_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1093,7 +1234,7 @@ func (x *BoardListResp) String() string {
 func (*BoardListResp) ProtoMessage() {}
 
 func (x *BoardListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[14]
+	mi := &file_commands_board_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1247,7 @@ func (x *BoardListResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardListResp.ProtoReflect.Descriptor instead.
 func (*BoardListResp) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{14}
+	return file_commands_board_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BoardListResp) GetPorts() []*DetectedPort {
@@ -1129,12 +1270,23 @@ type DetectedPort struct {
 	ProtocolLabel string `protobuf:"bytes,3,opt,name=protocol_label,json=protocolLabel,proto3" json:"protocol_label,omitempty"`
 	// The boards attached to the port.
 	Boards []*BoardListItem `protobuf:"bytes,4,rep,name=boards,proto3" json:"boards,omitempty"`
+	// Serial number of the USB device connected to the port, if available.
+	SerialNumber string `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Position of the USB device in the USB tree (e.g., `1-1.2`), it changes
+	// only if the device is plugged in another USB port. Currently available
+	// only on Linux.
+	UsbLocation string `protobuf:"bytes,6,opt,name=usb_location,json=usbLocation,proto3" json:"usb_location,omitempty"`
+	// Identifier of the USB device that doesn't depend on the name of the
+	// port: VID and PID followed by the serial number (e.g.,
+	// `2341:0043:75830333238351806212`) or, if the device has no serial
+	// number, by the USB location (e.g., `1a86:7523@1-1.2`).
+	HardwareId string `protobuf:"bytes,7,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"`
 }
 
 func (x *DetectedPort) Reset() {
 	*x = DetectedPort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[15]
+		mi := &file_commands_board_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1147,7 +1299,7 @@ func (x *DetectedPort) String() string {
 func (*DetectedPort) ProtoMessage() {}
 
 func (x *DetectedPort) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[15]
+	mi := &file_commands_board_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,7 +1312,7 @@ func (x *DetectedPort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DetectedPort.ProtoReflect.Descriptor instead.
 func (*DetectedPort) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{15}
+	return file_commands_board_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DetectedPort) GetAddress() string {
@@ -1191,6 +1343,27 @@ func (x *DetectedPort) GetBoards() []*BoardListItem {
 	return nil
 }
 
+func (x *DetectedPort) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DetectedPort) GetUsbLocation() string {
+	if x != nil {
+		return x.UsbLocation
+	}
+	return ""
+}
+
+func (x *DetectedPort) GetHardwareId() string {
+	if x != nil {
+		return x.HardwareId
+	}
+	return ""
+}
+
 type BoardListAllReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1202,12 +1375,20 @@ type BoardListAllReq struct {
 	SearchArgs []string `protobuf:"bytes,2,rep,name=search_args,json=searchArgs,proto3" json:"search_args,omitempty"`
 	// Set to true to get also the boards marked as "hidden" in the platform
 	IncludeHiddenBoards bool `protobuf:"varint,3,opt,name=include_hidden_boards,json=includeHiddenBoards,proto3" json:"include_hidden_boards,omitempty"`
+	// Set to true to match the search terms approximately: a term matches a
+	// board name containing it, a word of the name with a typo (e.g. `uon`
+	// for `Uno`) or a sequence of characters of the name (e.g. `mkrwf` for
+	// `MKR WiFi 1010`). The boards are sorted from the best match.
+	Fuzzy bool `protobuf:"varint,4,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Set to true to get the configuration options of each board with all
+	// their values, the same returned by `BoardDetails`.
+	IncludeConfigOptions bool `protobuf:"varint,5,opt,name=include_config_options,json=includeConfigOptions,proto3" json:"include_config_options,omitempty"`
 }
 
 func (x *BoardListAllReq) Reset() {
 	*x = BoardListAllReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[16]
+		mi := &file_commands_board_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1220,7 +1401,7 @@ func (x *BoardListAllReq) String() string {
 func (*BoardListAllReq) ProtoMessage() {}
 
 func (x *BoardListAllReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[16]
+	mi := &file_commands_board_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1414,7 @@ func (x *BoardListAllReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardListAllReq.ProtoReflect.Descriptor instead.
 func (*BoardListAllReq) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{16}
+	return file_commands_board_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BoardListAllReq) GetInstance() *Instance {
@@ -1257,6 +1438,20 @@ func (x *BoardListAllReq) GetIncludeHiddenBoards() bool {
 	return false
 }
 
+func (x *BoardListAllReq) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *BoardListAllReq) GetIncludeConfigOptions() bool {
+	if x != nil {
+		return x.IncludeConfigOptions
+	}
+	return false
+}
+
 type BoardListAllResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1269,7 +1464,7 @@ type BoardListAllResp struct {
 func (x *BoardListAllResp) Reset() {
 	*x = BoardListAllResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[17]
+		mi := &file_commands_board_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1477,7 @@ func (x *BoardListAllResp) String() string {
 func (*BoardListAllResp) ProtoMessage() {}
 
 func (x *BoardListAllResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[17]
+	mi := &file_commands_board_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1490,7 @@ func (x *BoardListAllResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardListAllResp.ProtoReflect.Descriptor instead.
 func (*BoardListAllResp) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{17}
+	return file_commands_board_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *BoardListAllResp) GetBoards() []*BoardListItem {
@@ -1316,12 +1511,15 @@ type BoardListItem struct {
 	FQBN string `protobuf:"bytes,2,opt,name=FQBN,proto3" json:"FQBN,omitempty"`
 	// If the board is marked as "hidden" in the platform
 	IsHidden bool `protobuf:"varint,3,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	// The board's custom configuration options, if requested with
+	// `include_config_options`.
+	ConfigOptions []*ConfigOption `protobuf:"bytes,4,rep,name=config_options,json=configOptions,proto3" json:"config_options,omitempty"`
 }
 
 func (x *BoardListItem) Reset() {
 	*x = BoardListItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_board_proto_msgTypes[18]
+		mi := &file_commands_board_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +1532,7 @@ func (x *BoardListItem) String() string {
 func (*BoardListItem) ProtoMessage() {}
 
 func (x *BoardListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_board_proto_msgTypes[18]
+	mi := &file_commands_board_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1545,7 @@ func (x *BoardListItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoardListItem.ProtoReflect.Descriptor instead.
 func (*BoardListItem) Descriptor() ([]byte, []int) {
-	return file_commands_board_proto_rawDescGZIP(), []int{18}
+	return file_commands_board_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BoardListItem) GetName() string {
@@ -1371,6 +1569,224 @@ func (x *BoardListItem) GetIsHidden() bool {
 	return false
 }
 
+func (x *BoardListItem) GetConfigOptions() []*ConfigOption {
+	if x != nil {
+		return x.ConfigOptions
+	}
+	return nil
+}
+
+type BoardSetupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// The fully qualified board name of the board to set up.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Set to true to get the actions needed to set up the board without
+	// doing them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *BoardSetupReq) Reset() {
+	*x = BoardSetupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_board_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSetupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSetupReq) ProtoMessage() {}
+
+func (x *BoardSetupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_board_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSetupReq.ProtoReflect.Descriptor instead.
+func (*BoardSetupReq) Descriptor() ([]byte, []int) {
+	return file_commands_board_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BoardSetupReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *BoardSetupReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *BoardSetupReq) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BoardSetupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The actions needed to use the board on this system, empty if the board
+	// doesn't need any setup.
+	Actions []*BoardSetupAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *BoardSetupResp) Reset() {
+	*x = BoardSetupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_board_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSetupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSetupResp) ProtoMessage() {}
+
+func (x *BoardSetupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_board_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSetupResp.ProtoReflect.Descriptor instead.
+func (*BoardSetupResp) Descriptor() ([]byte, []int) {
+	return file_commands_board_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BoardSetupResp) GetActions() []*BoardSetupAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type BoardSetupAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Description of the action, for humans.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// File provided by the platform that is installed by the action, if any.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Path where `source` is installed.
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Command run by the action. When `done` is false the user has to run it.
+	Command string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	// URL of the drivers to be installed by the user, if any.
+	Url string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// True if the action has been done (or it was not needed because it was
+	// already done). It's false in dry-run and for the actions that must be
+	// done by the user, e.g. the installation of drivers.
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *BoardSetupAction) Reset() {
+	*x = BoardSetupAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_board_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardSetupAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardSetupAction) ProtoMessage() {}
+
+func (x *BoardSetupAction) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_board_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardSetupAction.ProtoReflect.Descriptor instead.
+func (*BoardSetupAction) Descriptor() ([]byte, []int) {
+	return file_commands_board_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BoardSetupAction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BoardSetupAction) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BoardSetupAction) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *BoardSetupAction) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *BoardSetupAction) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BoardSetupAction) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
 var File_commands_board_proto protoreflect.FileDescriptor
 
 var file_commands_board_proto_rawDesc = []byte{
@@ -1384,7 +1800,7 @@ var file_commands_board_proto_rawDesc = []byte{
 	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x8f, 0x05, 0x0a,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0xe9, 0x05, 0x0a,
 	0x10, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
@@ -1425,138 +1841,195 @@ var file_commands_board_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
 	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65,
-	0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x4a,
-	0x0a, 0x12, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x65, 0x66, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x73, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x53,
-	0x42, 0x49, 0x44, 0x52, 0x05, 0x75, 0x73, 0x62, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x05, 0x55, 0x53,
-	0x42, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x56, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x50, 0x49, 0x44, 0x22, 0xb8, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x73, 0x69,
-	0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x31, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x6c, 0x70, 0x52, 0x04, 0x68, 0x65,
-	0x6c, 0x70, 0x22, 0x1e, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
-	0x6e, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x64, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x66, 0x71,
+	0x62, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x46, 0x71, 0x62, 0x6e, 0x22, 0x6a, 0x0a, 0x15, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x51, 0x42, 0x4e, 0x52, 0x65,
+	0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x22, 0x2c, 0x0a, 0x16, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x51, 0x42, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71,
+	0x62, 0x6e, 0x22, 0x4a, 0x0a, 0x12, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x73, 0x62, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x55, 0x53, 0x42, 0x49, 0x44, 0x52, 0x05, 0x75, 0x73, 0x62, 0x49, 0x44, 0x22, 0x2b,
+	0x0a, 0x05, 0x55, 0x53, 0x42, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x56, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x56, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x50, 0x49, 0x44, 0x22, 0xb8, 0x01, 0x0a, 0x07,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x48, 0x65, 0x6c, 0x70,
+	0x52, 0x04, 0x68, 0x65, 0x6c, 0x70, 0x22, 0x1e, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x6f,
+	0x6c, 0x73, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x07, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x07, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73,
-	0x22, 0x89, 0x01, 0x0a, 0x07, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x87, 0x01, 0x0a,
-	0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x28, 0x0a, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x87, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xc8, 0x01, 0x0a,
+	0x0e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x55, 0x72, 0x69, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63,
-	0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b,
-	0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
-	0x5d, 0x0a, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4d,
-	0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x3d,
+	0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x62, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73,
+	0x62, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x3d,
 	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
 	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4c, 0x0a,
-	0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b,
-	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0c,
-	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x73, 0x22, 0x52, 0x0a, 0x10, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x22, 0x54, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x51,
-	0x42, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x51, 0x42, 0x4e, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70,
-	0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52,
+	0x0a, 0x10, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x51, 0x42, 0x4e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x51, 0x42, 0x4e, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7b, 0x0a, 0x0d, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0x55, 0x0a, 0x0e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x75, 0x70, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x10,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x75, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x42, 0x2d, 0x5a, 0x2b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72,
+	0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1571,55 +2044,64 @@ func file_commands_board_proto_rawDescGZIP() []byte {
 	return file_commands_board_proto_rawDescData
 }
 
-var file_commands_board_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_commands_board_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_commands_board_proto_goTypes = []interface{}{
-	(*BoardDetailsReq)(nil),    // 0: cc.arduino.cli.commands.BoardDetailsReq
-	(*BoardDetailsResp)(nil),   // 1: cc.arduino.cli.commands.BoardDetailsResp
-	(*IdentificationPref)(nil), // 2: cc.arduino.cli.commands.IdentificationPref
-	(*USBID)(nil),              // 3: cc.arduino.cli.commands.USBID
-	(*Package)(nil),            // 4: cc.arduino.cli.commands.Package
-	(*Help)(nil),               // 5: cc.arduino.cli.commands.Help
-	(*BoardPlatform)(nil),      // 6: cc.arduino.cli.commands.BoardPlatform
-	(*ToolsDependencies)(nil),  // 7: cc.arduino.cli.commands.ToolsDependencies
-	(*Systems)(nil),            // 8: cc.arduino.cli.commands.Systems
-	(*ConfigOption)(nil),       // 9: cc.arduino.cli.commands.ConfigOption
-	(*ConfigValue)(nil),        // 10: cc.arduino.cli.commands.ConfigValue
-	(*BoardAttachReq)(nil),     // 11: cc.arduino.cli.commands.BoardAttachReq
-	(*BoardAttachResp)(nil),    // 12: cc.arduino.cli.commands.BoardAttachResp
-	(*BoardListReq)(nil),       // 13: cc.arduino.cli.commands.BoardListReq
-	(*BoardListResp)(nil),      // 14: cc.arduino.cli.commands.BoardListResp
-	(*DetectedPort)(nil),       // 15: cc.arduino.cli.commands.DetectedPort
-	(*BoardListAllReq)(nil),    // 16: cc.arduino.cli.commands.BoardListAllReq
-	(*BoardListAllResp)(nil),   // 17: cc.arduino.cli.commands.BoardListAllResp
-	(*BoardListItem)(nil),      // 18: cc.arduino.cli.commands.BoardListItem
-	(*Instance)(nil),           // 19: cc.arduino.cli.commands.Instance
-	(*Programmer)(nil),         // 20: cc.arduino.cli.commands.Programmer
-	(*TaskProgress)(nil),       // 21: cc.arduino.cli.commands.TaskProgress
+	(*BoardDetailsReq)(nil),        // 0: cc.arduino.cli.commands.BoardDetailsReq
+	(*BoardDetailsResp)(nil),       // 1: cc.arduino.cli.commands.BoardDetailsResp
+	(*BoardNormalizeFQBNReq)(nil),  // 2: cc.arduino.cli.commands.BoardNormalizeFQBNReq
+	(*BoardNormalizeFQBNResp)(nil), // 3: cc.arduino.cli.commands.BoardNormalizeFQBNResp
+	(*IdentificationPref)(nil),     // 4: cc.arduino.cli.commands.IdentificationPref
+	(*USBID)(nil),                  // 5: cc.arduino.cli.commands.USBID
+	(*Package)(nil),                // 6: cc.arduino.cli.commands.Package
+	(*Help)(nil),                   // 7: cc.arduino.cli.commands.Help
+	(*BoardPlatform)(nil),          // 8: cc.arduino.cli.commands.BoardPlatform
+	(*ToolsDependencies)(nil),      // 9: cc.arduino.cli.commands.ToolsDependencies
+	(*Systems)(nil),                // 10: cc.arduino.cli.commands.Systems
+	(*ConfigOption)(nil),           // 11: cc.arduino.cli.commands.ConfigOption
+	(*ConfigValue)(nil),            // 12: cc.arduino.cli.commands.ConfigValue
+	(*BoardAttachReq)(nil),         // 13: cc.arduino.cli.commands.BoardAttachReq
+	(*BoardAttachResp)(nil),        // 14: cc.arduino.cli.commands.BoardAttachResp
+	(*BoardListReq)(nil),           // 15: cc.arduino.cli.commands.BoardListReq
+	(*BoardListResp)(nil),          // 16: cc.arduino.cli.commands.BoardListResp
+	(*DetectedPort)(nil),           // 17: cc.arduino.cli.commands.DetectedPort
+	(*BoardListAllReq)(nil),        // 18: cc.arduino.cli.commands.BoardListAllReq
+	(*BoardListAllResp)(nil),       // 19: cc.arduino.cli.commands.BoardListAllResp
+	(*BoardListItem)(nil),          // 20: cc.arduino.cli.commands.BoardListItem
+	(*BoardSetupReq)(nil),          // 21: cc.arduino.cli.commands.BoardSetupReq
+	(*BoardSetupResp)(nil),         // 22: cc.arduino.cli.commands.BoardSetupResp
+	(*BoardSetupAction)(nil),       // 23: cc.arduino.cli.commands.BoardSetupAction
+	(*Instance)(nil),               // 24: cc.arduino.cli.commands.Instance
+	(*Programmer)(nil),             // 25: cc.arduino.cli.commands.Programmer
+	(*TaskProgress)(nil),           // 26: cc.arduino.cli.commands.TaskProgress
 }
 var file_commands_board_proto_depIdxs = []int32{
-	19, // 0: cc.arduino.cli.commands.BoardDetailsReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	4,  // 1: cc.arduino.cli.commands.BoardDetailsResp.package:type_name -> cc.arduino.cli.commands.Package
-	6,  // 2: cc.arduino.cli.commands.BoardDetailsResp.platform:type_name -> cc.arduino.cli.commands.BoardPlatform
-	7,  // 3: cc.arduino.cli.commands.BoardDetailsResp.toolsDependencies:type_name -> cc.arduino.cli.commands.ToolsDependencies
-	9,  // 4: cc.arduino.cli.commands.BoardDetailsResp.config_options:type_name -> cc.arduino.cli.commands.ConfigOption
-	2,  // 5: cc.arduino.cli.commands.BoardDetailsResp.identification_pref:type_name -> cc.arduino.cli.commands.IdentificationPref
-	20, // 6: cc.arduino.cli.commands.BoardDetailsResp.programmers:type_name -> cc.arduino.cli.commands.Programmer
-	3,  // 7: cc.arduino.cli.commands.IdentificationPref.usbID:type_name -> cc.arduino.cli.commands.USBID
-	5,  // 8: cc.arduino.cli.commands.Package.help:type_name -> cc.arduino.cli.commands.Help
-	8,  // 9: cc.arduino.cli.commands.ToolsDependencies.systems:type_name -> cc.arduino.cli.commands.Systems
-	10, // 10: cc.arduino.cli.commands.ConfigOption.values:type_name -> cc.arduino.cli.commands.ConfigValue
-	19, // 11: cc.arduino.cli.commands.BoardAttachReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	21, // 12: cc.arduino.cli.commands.BoardAttachResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
-	19, // 13: cc.arduino.cli.commands.BoardListReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	15, // 14: cc.arduino.cli.commands.BoardListResp.ports:type_name -> cc.arduino.cli.commands.DetectedPort
-	18, // 15: cc.arduino.cli.commands.DetectedPort.boards:type_name -> cc.arduino.cli.commands.BoardListItem
-	19, // 16: cc.arduino.cli.commands.BoardListAllReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	18, // 17: cc.arduino.cli.commands.BoardListAllResp.boards:type_name -> cc.arduino.cli.commands.BoardListItem
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	24, // 0: cc.arduino.cli.commands.BoardDetailsReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	6,  // 1: cc.arduino.cli.commands.BoardDetailsResp.package:type_name -> cc.arduino.cli.commands.Package
+	8,  // 2: cc.arduino.cli.commands.BoardDetailsResp.platform:type_name -> cc.arduino.cli.commands.BoardPlatform
+	9,  // 3: cc.arduino.cli.commands.BoardDetailsResp.toolsDependencies:type_name -> cc.arduino.cli.commands.ToolsDependencies
+	11, // 4: cc.arduino.cli.commands.BoardDetailsResp.config_options:type_name -> cc.arduino.cli.commands.ConfigOption
+	4,  // 5: cc.arduino.cli.commands.BoardDetailsResp.identification_pref:type_name -> cc.arduino.cli.commands.IdentificationPref
+	25, // 6: cc.arduino.cli.commands.BoardDetailsResp.programmers:type_name -> cc.arduino.cli.commands.Programmer
+	24, // 7: cc.arduino.cli.commands.BoardNormalizeFQBNReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	5,  // 8: cc.arduino.cli.commands.IdentificationPref.usbID:type_name -> cc.arduino.cli.commands.USBID
+	7,  // 9: cc.arduino.cli.commands.Package.help:type_name -> cc.arduino.cli.commands.Help
+	10, // 10: cc.arduino.cli.commands.ToolsDependencies.systems:type_name -> cc.arduino.cli.commands.Systems
+	12, // 11: cc.arduino.cli.commands.ConfigOption.values:type_name -> cc.arduino.cli.commands.ConfigValue
+	24, // 12: cc.arduino.cli.commands.BoardAttachReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	26, // 13: cc.arduino.cli.commands.BoardAttachResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	24, // 14: cc.arduino.cli.commands.BoardListReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	17, // 15: cc.arduino.cli.commands.BoardListResp.ports:type_name -> cc.arduino.cli.commands.DetectedPort
+	20, // 16: cc.arduino.cli.commands.DetectedPort.boards:type_name -> cc.arduino.cli.commands.BoardListItem
+	24, // 17: cc.arduino.cli.commands.BoardListAllReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	20, // 18: cc.arduino.cli.commands.BoardListAllResp.boards:type_name -> cc.arduino.cli.commands.BoardListItem
+	11, // 19: cc.arduino.cli.commands.BoardListItem.config_options:type_name -> cc.arduino.cli.commands.ConfigOption
+	24, // 20: cc.arduino.cli.commands.BoardSetupReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	23, // 21: cc.arduino.cli.commands.BoardSetupResp.actions:type_name -> cc.arduino.cli.commands.BoardSetupAction
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_commands_board_proto_init() }
@@ -1654,7 +2136,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IdentificationPref); i {
+			switch v := v.(*BoardNormalizeFQBNReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1666,7 +2148,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*USBID); i {
+			switch v := v.(*BoardNormalizeFQBNResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1678,7 +2160,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Package); i {
+			switch v := v.(*IdentificationPref); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1690,7 +2172,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Help); i {
+			switch v := v.(*USBID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1702,7 +2184,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardPlatform); i {
+			switch v := v.(*Package); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1714,7 +2196,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToolsDependencies); i {
+			switch v := v.(*Help); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1726,7 +2208,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Systems); i {
+			switch v := v.(*BoardPlatform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1738,7 +2220,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigOption); i {
+			switch v := v.(*ToolsDependencies); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1750,7 +2232,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigValue); i {
+			switch v := v.(*Systems); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1762,7 +2244,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardAttachReq); i {
+			switch v := v.(*ConfigOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1774,7 +2256,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardAttachResp); i {
+			switch v := v.(*ConfigValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1786,7 +2268,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListReq); i {
+			switch v := v.(*BoardAttachReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1798,7 +2280,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListResp); i {
+			switch v := v.(*BoardAttachResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1810,7 +2292,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectedPort); i {
+			switch v := v.(*BoardListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,7 +2304,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListAllReq); i {
+			switch v := v.(*BoardListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,7 +2316,7 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardListAllResp); i {
+			switch v := v.(*DetectedPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,6 +2328,30 @@ func file_commands_board_proto_init() {
 			}
 		}
 		file_commands_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListAllReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardListAllResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BoardListItem); i {
 			case 0:
 				return &v.state
@@ -1857,6 +2363,42 @@ func file_commands_board_proto_init() {
 				return nil
 			}
 		}
+		file_commands_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardSetupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardSetupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardSetupAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1864,7 +2406,7 @@ func file_commands_board_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commands_board_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -313,6 +313,122 @@ func (x *RescanReq) GetInstance() *Instance {
 	return nil
 }
 
+type WatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the Init response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+}
+
+func (x *WatchReq) Reset() {
+	*x = WatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReq) ProtoMessage() {}
+
+func (x *WatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+type WatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The files and folders changed since the previous event.
+	ChangedPaths []string `protobuf:"bytes,1,rep,name=changed_paths,json=changedPaths,proto3" json:"changed_paths,omitempty"`
+	// Error messages related to any problems encountered while parsing the
+	// platforms index file during the rescan.
+	PlatformsIndexErrors []string `protobuf:"bytes,2,rep,name=platforms_index_errors,json=platformsIndexErrors,proto3" json:"platforms_index_errors,omitempty"`
+	// Error message if a problem was encountered while parsing the libraries
+	// index file during the rescan.
+	LibrariesIndexError string `protobuf:"bytes,3,opt,name=libraries_index_error,json=librariesIndexError,proto3" json:"libraries_index_error,omitempty"`
+}
+
+func (x *WatchResp) Reset() {
+	*x = WatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchResp) ProtoMessage() {}
+
+func (x *WatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchResp.ProtoReflect.Descriptor instead.
+func (*WatchResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchResp) GetChangedPaths() []string {
+	if x != nil {
+		return x.ChangedPaths
+	}
+	return nil
+}
+
+func (x *WatchResp) GetPlatformsIndexErrors() []string {
+	if x != nil {
+		return x.PlatformsIndexErrors
+	}
+	return nil
+}
+
+func (x *WatchResp) GetLibrariesIndexError() string {
+	if x != nil {
+		return x.LibrariesIndexError
+	}
+	return ""
+}
+
 type RescanResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -329,7 +445,7 @@ type RescanResp struct {
 func (x *RescanResp) Reset() {
 	*x = RescanResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[5]
+		mi := &file_commands_commands_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +458,7 @@ func (x *RescanResp) String() string {
 func (*RescanResp) ProtoMessage() {}
 
 func (x *RescanResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[5]
+	mi := &file_commands_commands_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +471,7 @@ func (x *RescanResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RescanResp.ProtoReflect.Descriptor instead.
 func (*RescanResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{5}
+	return file_commands_commands_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RescanResp) GetPlatformsIndexErrors() []string {
@@ -379,12 +495,15 @@ type UpdateIndexReq struct {
 
 	// Arduino Core Service instance from the Init response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Skip the update if the index has been checked less than this duration
+	// ago, e.g. `1h`. The index is always checked if empty.
+	IfOlderThan string `protobuf:"bytes,2,opt,name=if_older_than,json=ifOlderThan,proto3" json:"if_older_than,omitempty"`
 }
 
 func (x *UpdateIndexReq) Reset() {
 	*x = UpdateIndexReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[6]
+		mi := &file_commands_commands_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -397,7 +516,7 @@ func (x *UpdateIndexReq) String() string {
 func (*UpdateIndexReq) ProtoMessage() {}
 
 func (x *UpdateIndexReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[6]
+	mi := &file_commands_commands_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +529,7 @@ func (x *UpdateIndexReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIndexReq.ProtoReflect.Descriptor instead.
 func (*UpdateIndexReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{6}
+	return file_commands_commands_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateIndexReq) GetInstance() *Instance {
@@ -420,6 +539,13 @@ func (x *UpdateIndexReq) GetInstance() *Instance {
 	return nil
 }
 
+func (x *UpdateIndexReq) GetIfOlderThan() string {
+	if x != nil {
+		return x.IfOlderThan
+	}
+	return ""
+}
+
 type UpdateIndexResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -427,12 +553,14 @@ type UpdateIndexResp struct {
 
 	// Progress of the platforms index download.
 	DownloadProgress *DownloadProgress `protobuf:"bytes,1,opt,name=download_progress,json=downloadProgress,proto3" json:"download_progress,omitempty"`
+	// Signature verification status of each updated index.
+	Signatures []*IndexSignature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
 func (x *UpdateIndexResp) Reset() {
 	*x = UpdateIndexResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[7]
+		mi := &file_commands_commands_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -445,7 +573,7 @@ func (x *UpdateIndexResp) String() string {
 func (*UpdateIndexResp) ProtoMessage() {}
 
 func (x *UpdateIndexResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[7]
+	mi := &file_commands_commands_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +586,7 @@ func (x *UpdateIndexResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateIndexResp.ProtoReflect.Descriptor instead.
 func (*UpdateIndexResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{7}
+	return file_commands_commands_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateIndexResp) GetDownloadProgress() *DownloadProgress {
@@ -468,6 +596,79 @@ func (x *UpdateIndexResp) GetDownloadProgress() *DownloadProgress {
 	return nil
 }
 
+func (x *UpdateIndexResp) GetSignatures() []*IndexSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type IndexSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the index.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// True if the index signature has been verified against a trusted key.
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Identity of the key that signed the index.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *IndexSignature) Reset() {
+	*x = IndexSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IndexSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexSignature) ProtoMessage() {}
+
+func (x *IndexSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexSignature.ProtoReflect.Descriptor instead.
+func (*IndexSignature) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IndexSignature) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *IndexSignature) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *IndexSignature) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
 type UpdateLibrariesIndexReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -475,12 +676,15 @@ type UpdateLibrariesIndexReq struct {
 
 	// Arduino Core Service instance from the Init response.
 	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Skip the update if the index has been checked less than this duration
+	// ago, e.g. `1h`. The index is always checked if empty.
+	IfOlderThan string `protobuf:"bytes,2,opt,name=if_older_than,json=ifOlderThan,proto3" json:"if_older_than,omitempty"`
 }
 
 func (x *UpdateLibrariesIndexReq) Reset() {
 	*x = UpdateLibrariesIndexReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[8]
+		mi := &file_commands_commands_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -493,7 +697,7 @@ func (x *UpdateLibrariesIndexReq) String() string {
 func (*UpdateLibrariesIndexReq) ProtoMessage() {}
 
 func (x *UpdateLibrariesIndexReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[8]
+	mi := &file_commands_commands_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,7 +710,7 @@ func (x *UpdateLibrariesIndexReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLibrariesIndexReq.ProtoReflect.Descriptor instead.
 func (*UpdateLibrariesIndexReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{8}
+	return file_commands_commands_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateLibrariesIndexReq) GetInstance() *Instance {
@@ -516,6 +720,13 @@ func (x *UpdateLibrariesIndexReq) GetInstance() *Instance {
 	return nil
 }
 
+func (x *UpdateLibrariesIndexReq) GetIfOlderThan() string {
+	if x != nil {
+		return x.IfOlderThan
+	}
+	return ""
+}
+
 type UpdateLibrariesIndexResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -528,7 +739,7 @@ type UpdateLibrariesIndexResp struct {
 func (x *UpdateLibrariesIndexResp) Reset() {
 	*x = UpdateLibrariesIndexResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[9]
+		mi := &file_commands_commands_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -541,7 +752,7 @@ func (x *UpdateLibrariesIndexResp) String() string {
 func (*UpdateLibrariesIndexResp) ProtoMessage() {}
 
 func (x *UpdateLibrariesIndexResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[9]
+	mi := &file_commands_commands_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +765,7 @@ func (x *UpdateLibrariesIndexResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLibrariesIndexResp.ProtoReflect.Descriptor instead.
 func (*UpdateLibrariesIndexResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{9}
+	return file_commands_commands_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateLibrariesIndexResp) GetDownloadProgress() *DownloadProgress {
@@ -576,7 +787,7 @@ type UpdateCoreLibrariesIndexReq struct {
 func (x *UpdateCoreLibrariesIndexReq) Reset() {
 	*x = UpdateCoreLibrariesIndexReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[10]
+		mi := &file_commands_commands_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +800,7 @@ func (x *UpdateCoreLibrariesIndexReq) String() string {
 func (*UpdateCoreLibrariesIndexReq) ProtoMessage() {}
 
 func (x *UpdateCoreLibrariesIndexReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[10]
+	mi := &file_commands_commands_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +813,7 @@ func (x *UpdateCoreLibrariesIndexReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCoreLibrariesIndexReq.ProtoReflect.Descriptor instead.
 func (*UpdateCoreLibrariesIndexReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{10}
+	return file_commands_commands_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateCoreLibrariesIndexReq) GetInstance() *Instance {
@@ -624,7 +835,7 @@ type UpdateCoreLibrariesIndexResp struct {
 func (x *UpdateCoreLibrariesIndexResp) Reset() {
 	*x = UpdateCoreLibrariesIndexResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[11]
+		mi := &file_commands_commands_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -637,7 +848,7 @@ func (x *UpdateCoreLibrariesIndexResp) String() string {
 func (*UpdateCoreLibrariesIndexResp) ProtoMessage() {}
 
 func (x *UpdateCoreLibrariesIndexResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[11]
+	mi := &file_commands_commands_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +861,7 @@ func (x *UpdateCoreLibrariesIndexResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCoreLibrariesIndexResp.ProtoReflect.Descriptor instead.
 func (*UpdateCoreLibrariesIndexResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{11}
+	return file_commands_commands_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateCoreLibrariesIndexResp) GetDownloadProgress() *DownloadProgress {
@@ -672,7 +883,7 @@ type OutdatedReq struct {
 func (x *OutdatedReq) Reset() {
 	*x = OutdatedReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[12]
+		mi := &file_commands_commands_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +896,7 @@ func (x *OutdatedReq) String() string {
 func (*OutdatedReq) ProtoMessage() {}
 
 func (x *OutdatedReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[12]
+	mi := &file_commands_commands_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +909,7 @@ func (x *OutdatedReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutdatedReq.ProtoReflect.Descriptor instead.
 func (*OutdatedReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{12}
+	return file_commands_commands_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OutdatedReq) GetInstance() *Instance {
@@ -722,7 +933,7 @@ type OutdatedResp struct {
 func (x *OutdatedResp) Reset() {
 	*x = OutdatedResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[13]
+		mi := &file_commands_commands_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +946,7 @@ func (x *OutdatedResp) String() string {
 func (*OutdatedResp) ProtoMessage() {}
 
 func (x *OutdatedResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[13]
+	mi := &file_commands_commands_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +959,7 @@ func (x *OutdatedResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutdatedResp.ProtoReflect.Descriptor instead.
 func (*OutdatedResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{13}
+	return file_commands_commands_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *OutdatedResp) GetOutdatedLibrary() []*InstalledLibrary {
@@ -779,7 +990,7 @@ type UpgradeReq struct {
 func (x *UpgradeReq) Reset() {
 	*x = UpgradeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[14]
+		mi := &file_commands_commands_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -792,7 +1003,7 @@ func (x *UpgradeReq) String() string {
 func (*UpgradeReq) ProtoMessage() {}
 
 func (x *UpgradeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[14]
+	mi := &file_commands_commands_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1016,7 @@ func (x *UpgradeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradeReq.ProtoReflect.Descriptor instead.
 func (*UpgradeReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{14}
+	return file_commands_commands_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpgradeReq) GetInstance() *Instance {
@@ -836,7 +1047,7 @@ type UpgradeResp struct {
 func (x *UpgradeResp) Reset() {
 	*x = UpgradeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[15]
+		mi := &file_commands_commands_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -849,7 +1060,7 @@ func (x *UpgradeResp) String() string {
 func (*UpgradeResp) ProtoMessage() {}
 
 func (x *UpgradeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[15]
+	mi := &file_commands_commands_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,7 +1073,7 @@ func (x *UpgradeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradeResp.ProtoReflect.Descriptor instead.
 func (*UpgradeResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{15}
+	return file_commands_commands_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpgradeResp) GetProgress() *DownloadProgress {
@@ -888,7 +1099,7 @@ type VersionReq struct {
 func (x *VersionReq) Reset() {
 	*x = VersionReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[16]
+		mi := &file_commands_commands_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1112,7 @@ func (x *VersionReq) String() string {
 func (*VersionReq) ProtoMessage() {}
 
 func (x *VersionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[16]
+	mi := &file_commands_commands_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1125,7 @@ func (x *VersionReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionReq.ProtoReflect.Descriptor instead.
 func (*VersionReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{16}
+	return file_commands_commands_proto_rawDescGZIP(), []int{19}
 }
 
 type VersionResp struct {
@@ -924,12 +1135,17 @@ type VersionResp struct {
 
 	// The version of Arduino CLI in use.
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The version of the gRPC API, increased every time services or messages
+	// change in a way clients may need to know about.
+	ApiVersion string `protobuf:"bytes,2,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The optional features supported by the daemon, e.g. `debug` or `monitor`.
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
 }
 
 func (x *VersionResp) Reset() {
 	*x = VersionResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[17]
+		mi := &file_commands_commands_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -942,7 +1158,7 @@ func (x *VersionResp) String() string {
 func (*VersionResp) ProtoMessage() {}
 
 func (x *VersionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[17]
+	mi := &file_commands_commands_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1171,7 @@ func (x *VersionResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResp.ProtoReflect.Descriptor instead.
 func (*VersionResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{17}
+	return file_commands_commands_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VersionResp) GetVersion() string {
@@ -965,34 +1181,50 @@ func (x *VersionResp) GetVersion() string {
 	return ""
 }
 
-type LoadSketchReq struct {
+func (x *VersionResp) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *VersionResp) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type CheckForUpdatesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Arduino Core Service instance from the Init response.
-	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	// Absolute path to single sketch file or a sketch folder
-	SketchPath string `protobuf:"bytes,2,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// Release channel to check, `stable` or `nightly`. If empty the
+	// `updater.channel` setting is used.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Set to true to ignore the result of the last check, that is otherwise
+	// reused for 24 hours.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *LoadSketchReq) Reset() {
-	*x = LoadSketchReq{}
+func (x *CheckForUpdatesReq) Reset() {
+	*x = CheckForUpdatesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[18]
+		mi := &file_commands_commands_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoadSketchReq) String() string {
+func (x *CheckForUpdatesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadSketchReq) ProtoMessage() {}
+func (*CheckForUpdatesReq) ProtoMessage() {}
 
-func (x *LoadSketchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[18]
+func (x *CheckForUpdatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,9 +1235,345 @@ func (x *LoadSketchReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadSketchReq.ProtoReflect.Descriptor instead.
-func (*LoadSketchReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CheckForUpdatesReq.ProtoReflect.Descriptor instead.
+func (*CheckForUpdatesReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckForUpdatesReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CheckForUpdatesReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CheckForUpdatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The version of Arduino CLI in use.
+	CurrentVersion string `protobuf:"bytes,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	// The latest version of Arduino CLI available in the channel.
+	LatestVersion string `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// True if the latest version is newer than the one in use.
+	UpdateAvailable bool `protobuf:"varint,3,opt,name=update_available,json=updateAvailable,proto3" json:"update_available,omitempty"`
+	// URL of the archive of the latest version for the current OS and
+	// architecture.
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *CheckForUpdatesResp) Reset() {
+	*x = CheckForUpdatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckForUpdatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckForUpdatesResp) ProtoMessage() {}
+
+func (x *CheckForUpdatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckForUpdatesResp.ProtoReflect.Descriptor instead.
+func (*CheckForUpdatesResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckForUpdatesResp) GetCurrentVersion() string {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return ""
+}
+
+func (x *CheckForUpdatesResp) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+func (x *CheckForUpdatesResp) GetUpdateAvailable() bool {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return false
+}
+
+func (x *CheckForUpdatesResp) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SelfUpdateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Release channel to update from, `stable` or `nightly`. If empty the
+	// `updater.channel` setting is used.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (x *SelfUpdateReq) Reset() {
+	*x = SelfUpdateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfUpdateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfUpdateReq) ProtoMessage() {}
+
+func (x *SelfUpdateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfUpdateReq.ProtoReflect.Descriptor instead.
+func (*SelfUpdateReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SelfUpdateReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type SelfUpdateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Progress of the download of the new release.
+	Progress *DownloadProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Description of the current stage of the update.
+	TaskProgress *TaskProgress `protobuf:"bytes,2,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
+	// The version installed, set in the last message of the stream. Empty if
+	// the version in use is already the latest one.
+	InstalledVersion string `protobuf:"bytes,3,opt,name=installed_version,json=installedVersion,proto3" json:"installed_version,omitempty"`
+}
+
+func (x *SelfUpdateResp) Reset() {
+	*x = SelfUpdateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfUpdateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfUpdateResp) ProtoMessage() {}
+
+func (x *SelfUpdateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfUpdateResp.ProtoReflect.Descriptor instead.
+func (*SelfUpdateResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SelfUpdateResp) GetProgress() *DownloadProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SelfUpdateResp) GetTaskProgress() *TaskProgress {
+	if x != nil {
+		return x.TaskProgress
+	}
+	return nil
+}
+
+func (x *SelfUpdateResp) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+type ShutdownReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long to wait for the calls in progress to end, e.g. `10s`. After this
+	// time they are canceled. `5s` by default.
+	Timeout string `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *ShutdownReq) Reset() {
+	*x = ShutdownReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownReq) ProtoMessage() {}
+
+func (x *ShutdownReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownReq.ProtoReflect.Descriptor instead.
+func (*ShutdownReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ShutdownReq) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+type ShutdownResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShutdownResp) Reset() {
+	*x = ShutdownResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResp) ProtoMessage() {}
+
+func (x *ShutdownResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownResp.ProtoReflect.Descriptor instead.
+func (*ShutdownResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{26}
+}
+
+type LoadSketchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the Init response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Absolute path to single sketch file or a sketch folder
+	SketchPath string `protobuf:"bytes,2,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+}
+
+func (x *LoadSketchReq) Reset() {
+	*x = LoadSketchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSketchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSketchReq) ProtoMessage() {}
+
+func (x *LoadSketchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSketchReq.ProtoReflect.Descriptor instead.
+func (*LoadSketchReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LoadSketchReq) GetInstance() *Instance {
@@ -1035,12 +1603,20 @@ type LoadSketchResp struct {
 	OtherSketchFiles []string `protobuf:"bytes,3,rep,name=other_sketch_files,json=otherSketchFiles,proto3" json:"other_sketch_files,omitempty"`
 	// List of absolute paths to additional sketch files
 	AdditionalFiles []string `protobuf:"bytes,4,rep,name=additional_files,json=additionalFiles,proto3" json:"additional_files,omitempty"`
+	// Where the compiled source files are placed: "flat" if they are all in the
+	// sketch folder, "src" if they are in the `src` subfolder, that is compiled
+	// recursively, "mixed" if they are in both.
+	Layout string `protobuf:"bytes,5,opt,name=layout,proto3" json:"layout,omitempty"`
+	// List of the paths of the additional sketch files relative to
+	// location_path, in the same order of additional_files and always using
+	// `/` as separator.
+	AdditionalFilesRelative []string `protobuf:"bytes,6,rep,name=additional_files_relative,json=additionalFilesRelative,proto3" json:"additional_files_relative,omitempty"`
 }
 
 func (x *LoadSketchResp) Reset() {
 	*x = LoadSketchResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[19]
+		mi := &file_commands_commands_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1053,7 +1629,7 @@ func (x *LoadSketchResp) String() string {
 func (*LoadSketchResp) ProtoMessage() {}
 
 func (x *LoadSketchResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[19]
+	mi := &file_commands_commands_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1642,7 @@ func (x *LoadSketchResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadSketchResp.ProtoReflect.Descriptor instead.
 func (*LoadSketchResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{19}
+	return file_commands_commands_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *LoadSketchResp) GetMainFile() string {
@@ -1097,6 +1673,20 @@ func (x *LoadSketchResp) GetAdditionalFiles() []string {
 	return nil
 }
 
+func (x *LoadSketchResp) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+func (x *LoadSketchResp) GetAdditionalFilesRelative() []string {
+	if x != nil {
+		return x.AdditionalFilesRelative
+	}
+	return nil
+}
+
 type ArchiveSketchReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1108,12 +1698,24 @@ type ArchiveSketchReq struct {
 	ArchivePath string `protobuf:"bytes,2,opt,name=archive_path,json=archivePath,proto3" json:"archive_path,omitempty"`
 	// Specifies if build directory should be included in the archive
 	IncludeBuildDir bool `protobuf:"varint,3,opt,name=include_build_dir,json=includeBuildDir,proto3" json:"include_build_dir,omitempty"`
+	// Arduino Core Service instance used to resolve the libraries and the platform
+	// listed in the archive manifest.
+	Instance *Instance `protobuf:"bytes,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the board the sketch is meant for (e.g.,
+	// `arduino:avr:uno`). If empty the one attached to the sketch is used.
+	Fqbn string `protobuf:"bytes,5,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Specifies if a manifest of the libraries and the platform used by the
+	// sketch should be included in the archive. Requires `instance`.
+	IncludeManifest bool `protobuf:"varint,6,opt,name=include_manifest,json=includeManifest,proto3" json:"include_manifest,omitempty"`
+	// Additional `.gitignore`-style patterns of files to exclude from the archive.
+	// The patterns in the `.gitignore` file of the sketch are always honored.
+	Exclude []string `protobuf:"bytes,7,rep,name=exclude,proto3" json:"exclude,omitempty"`
 }
 
 func (x *ArchiveSketchReq) Reset() {
 	*x = ArchiveSketchReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[20]
+		mi := &file_commands_commands_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1126,7 +1728,7 @@ func (x *ArchiveSketchReq) String() string {
 func (*ArchiveSketchReq) ProtoMessage() {}
 
 func (x *ArchiveSketchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[20]
+	mi := &file_commands_commands_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,53 +1741,199 @@ func (x *ArchiveSketchReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveSketchReq.ProtoReflect.Descriptor instead.
 func (*ArchiveSketchReq) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{20}
+	return file_commands_commands_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArchiveSketchReq) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *ArchiveSketchReq) GetArchivePath() string {
+	if x != nil {
+		return x.ArchivePath
+	}
+	return ""
+}
+
+func (x *ArchiveSketchReq) GetIncludeBuildDir() bool {
+	if x != nil {
+		return x.IncludeBuildDir
+	}
+	return false
+}
+
+func (x *ArchiveSketchReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *ArchiveSketchReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *ArchiveSketchReq) GetIncludeManifest() bool {
+	if x != nil {
+		return x.IncludeManifest
+	}
+	return false
+}
+
+func (x *ArchiveSketchReq) GetExclude() []string {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+type ArchiveSketchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ArchiveSketchResp) Reset() {
+	*x = ArchiveSketchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveSketchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveSketchResp) ProtoMessage() {}
+
+func (x *ArchiveSketchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveSketchResp.ProtoReflect.Descriptor instead.
+func (*ArchiveSketchResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{30}
+}
+
+type NewSketchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the Sketch, the main file will be named `{sketch_name}.ino`.
+	SketchName string `protobuf:"bytes,1,opt,name=sketch_name,json=sketchName,proto3" json:"sketch_name,omitempty"`
+	// Folder where the Sketch folder will be created, if empty the user
+	// directory (`directories.User`) is used.
+	SketchDir string `protobuf:"bytes,2,opt,name=sketch_dir,json=sketchDir,proto3" json:"sketch_dir,omitempty"`
+	// Name of the template to use, looked up in `{directories.User}/templates`,
+	// or path to a template folder. If empty the built-in template is used.
+	Template string `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	// Value of the `{{author}}` placeholder, if empty the current OS user is used.
+	Author string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+}
+
+func (x *NewSketchReq) Reset() {
+	*x = NewSketchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_commands_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewSketchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSketchReq) ProtoMessage() {}
+
+func (x *NewSketchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSketchReq.ProtoReflect.Descriptor instead.
+func (*NewSketchReq) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NewSketchReq) GetSketchName() string {
+	if x != nil {
+		return x.SketchName
+	}
+	return ""
 }
 
-func (x *ArchiveSketchReq) GetSketchPath() string {
+func (x *NewSketchReq) GetSketchDir() string {
 	if x != nil {
-		return x.SketchPath
+		return x.SketchDir
 	}
 	return ""
 }
 
-func (x *ArchiveSketchReq) GetArchivePath() string {
+func (x *NewSketchReq) GetTemplate() string {
 	if x != nil {
-		return x.ArchivePath
+		return x.Template
 	}
 	return ""
 }
 
-func (x *ArchiveSketchReq) GetIncludeBuildDir() bool {
+func (x *NewSketchReq) GetAuthor() string {
 	if x != nil {
-		return x.IncludeBuildDir
+		return x.Author
 	}
-	return false
+	return ""
 }
 
-type ArchiveSketchResp struct {
+type NewSketchResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Absolute path to the main file of the new Sketch.
+	MainFile string `protobuf:"bytes,1,opt,name=main_file,json=mainFile,proto3" json:"main_file,omitempty"`
 }
 
-func (x *ArchiveSketchResp) Reset() {
-	*x = ArchiveSketchResp{}
+func (x *NewSketchResp) Reset() {
+	*x = NewSketchResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_commands_proto_msgTypes[21]
+		mi := &file_commands_commands_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveSketchResp) String() string {
+func (x *NewSketchResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveSketchResp) ProtoMessage() {}
+func (*NewSketchResp) ProtoMessage() {}
 
-func (x *ArchiveSketchResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_commands_proto_msgTypes[21]
+func (x *NewSketchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_commands_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1196,9 +1944,16 @@ func (x *ArchiveSketchResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveSketchResp.ProtoReflect.Descriptor instead.
-func (*ArchiveSketchResp) Descriptor() ([]byte, []int) {
-	return file_commands_commands_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use NewSketchResp.ProtoReflect.Descriptor instead.
+func (*NewSketchResp) Descriptor() ([]byte, []int) {
+	return file_commands_commands_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NewSketchResp) GetMainFile() string {
+	if x != nil {
+		return x.MainFile
+	}
+	return ""
 }
 
 var File_commands_commands_proto protoreflect.FileDescriptor
@@ -1251,117 +2006,210 @@ var file_commands_commands_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
 	0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x9a, 0x01, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x49,
 	0x6e, 0x64, 0x65, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69,
 	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4f,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71,
-	0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
-	0x69, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x76,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x69, 0x66, 0x4f, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x56, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x56, 0x0a, 0x0e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x7c, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74,
+	0x68, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x66, 0x4f, 0x6c, 0x64,
+	0x65, 0x72, 0x54, 0x68, 0x61, 0x6e, 0x22, 0x72, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
 	0x73, 0x70, 0x12, 0x56, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70,
 	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
 	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
 	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x58, 0x0a, 0x17, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x72, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x56, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63,
+	0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x1b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63,
 	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x4c, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb4,
+	0x01, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x54, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4c,
-	0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xb4, 0x01, 0x0a,
-	0x0c, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a,
-	0x10, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x75, 0x0a, 0x0a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69,
+	0x70, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x22, 0xa0, 0x01, 0x0a,
+	0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
 	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x22, 0x75, 0x0a, 0x0a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x50,
-	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x22, 0xa0, 0x01, 0x0a, 0x0b, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x0c, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x22, 0x64, 0x0a,
+	0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x13, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x29,
+	0x0a, 0x0d, 0x53, 0x65, 0x6c, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x53, 0x65,
+	0x6c, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x0b,
+	0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6f, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x0c, 0x0a,
-	0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x22, 0x27, 0x0a, 0x0b, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0d, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
-	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x22, 0xab, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b,
-	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6e,
-	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x69,
-	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x6b, 0x65,
-	0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
-	0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74,
-	0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x69,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x69, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x32, 0xda, 0x1a,
+	0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74,
+	0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x22, 0xff, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+	0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x69,
+	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61,
+	0x69, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x6b,
+	0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x19,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x17, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x69, 0x72, 0x12, 0x3d, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x4e,
+	0x65, 0x77, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x44, 0x69, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22,
+	0x2c, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x32, 0x95, 0x27,
 	0x0a, 0x0b, 0x41, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x4f, 0x0a,
 	0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
@@ -1378,208 +2226,307 @@ var file_commands_commands_proto_rawDesc = []byte{
 	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61,
 	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
 	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x52,
-	0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x2e, 0x63, 0x63, 0x2e,
-	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x05, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x64, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x1a,
+	0x31, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
 	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x7f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x31, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72,
-	0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x34, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x59, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4f, 0x75, 0x74,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x07, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x08, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
 	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
-	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5f, 0x0a,
-	0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x12, 0x26, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x6f,
-	0x61, 0x64, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x68,
-	0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x12,
-	0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x63, 0x63, 0x2e,
+	0x2e, 0x4f, 0x75, 0x74, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x07, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x2e,
 	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x6e, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x61, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64,
+	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x5f, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x12, 0x26, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x68, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x12, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x6b,
+	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x09, 0x4e, 0x65,
+	0x77, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x26,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x65, 0x74,
 	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72,
 	0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
 	0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
 	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
 	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a,
-	0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x27, 0x2e, 0x63,
+	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a,
+	0x12, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46,
+	0x51, 0x42, 0x4e, 0x12, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x51, 0x42, 0x4e,
+	0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x46, 0x51, 0x42, 0x4e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x12, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63,
 	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
 	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x30,
-	0x01, 0x12, 0x5a, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x12, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x29,
 	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
-	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a,
-	0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x28, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0a, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x65, 0x74, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x56, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x2e,
+	0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a,
+	0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53,
+	0x65, 0x74, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01,
+	0x12, 0x5d, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x69, 0x0a, 0x0e, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x12, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x6b, 0x65, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e,
 	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
-	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x0f, 0x50, 0x6c,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x0f, 0x50, 0x6c,
 	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2b, 0x2e,
 	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e,
 	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x10, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c,
+	0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x13, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x12, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x30, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x11, 0x50, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2d,
 	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x74, 0x0a,
-	0x11, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x6d, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12,
+	0x77, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x54, 0x6f, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x75, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2e,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2f,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x6e, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
 	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74,
-	0x66, 0x6f, 0x72, 0x6d, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
-	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x2e,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12,
+	0x53, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
 	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x71, 0x1a, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0xa2, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d,
+	0x73, 0x70, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x30,
+	0x01, 0x12, 0xa2, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72,
+	0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x3e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x0e, 0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f,
+	0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42,
+	0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63,
+	0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28,
 	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x3e, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a,
-	0x0e, 0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f,
-	0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f,
-	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x0e, 0x50, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
 	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e, 0x0a, 0x0f, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x2e,
-	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e,
-	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0e, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01,
+	0x12, 0x71, 0x0a, 0x10, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x11, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
 	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x10, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x2e, 0x63, 0x63,
-	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x8d, 0x01, 0x0a, 0x1a, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x37, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x0d, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x60, 0x0a, 0x0b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61,
 	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x11, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x12,
-	0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2e,
-	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01,
-	0x12, 0x8d, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
-	0x36, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x37, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x11, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x66, 0x0a, 0x0d, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x12, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
-	0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x63,
-	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0b, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
-	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1594,166 +2541,232 @@ func file_commands_commands_proto_rawDescGZIP() []byte {
 	return file_commands_commands_proto_rawDescData
 }
 
-var file_commands_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_commands_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_commands_commands_proto_goTypes = []interface{}{
 	(*InitReq)(nil),                               // 0: cc.arduino.cli.commands.InitReq
 	(*InitResp)(nil),                              // 1: cc.arduino.cli.commands.InitResp
 	(*DestroyReq)(nil),                            // 2: cc.arduino.cli.commands.DestroyReq
 	(*DestroyResp)(nil),                           // 3: cc.arduino.cli.commands.DestroyResp
 	(*RescanReq)(nil),                             // 4: cc.arduino.cli.commands.RescanReq
-	(*RescanResp)(nil),                            // 5: cc.arduino.cli.commands.RescanResp
-	(*UpdateIndexReq)(nil),                        // 6: cc.arduino.cli.commands.UpdateIndexReq
-	(*UpdateIndexResp)(nil),                       // 7: cc.arduino.cli.commands.UpdateIndexResp
-	(*UpdateLibrariesIndexReq)(nil),               // 8: cc.arduino.cli.commands.UpdateLibrariesIndexReq
-	(*UpdateLibrariesIndexResp)(nil),              // 9: cc.arduino.cli.commands.UpdateLibrariesIndexResp
-	(*UpdateCoreLibrariesIndexReq)(nil),           // 10: cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq
-	(*UpdateCoreLibrariesIndexResp)(nil),          // 11: cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp
-	(*OutdatedReq)(nil),                           // 12: cc.arduino.cli.commands.OutdatedReq
-	(*OutdatedResp)(nil),                          // 13: cc.arduino.cli.commands.OutdatedResp
-	(*UpgradeReq)(nil),                            // 14: cc.arduino.cli.commands.UpgradeReq
-	(*UpgradeResp)(nil),                           // 15: cc.arduino.cli.commands.UpgradeResp
-	(*VersionReq)(nil),                            // 16: cc.arduino.cli.commands.VersionReq
-	(*VersionResp)(nil),                           // 17: cc.arduino.cli.commands.VersionResp
-	(*LoadSketchReq)(nil),                         // 18: cc.arduino.cli.commands.LoadSketchReq
-	(*LoadSketchResp)(nil),                        // 19: cc.arduino.cli.commands.LoadSketchResp
-	(*ArchiveSketchReq)(nil),                      // 20: cc.arduino.cli.commands.ArchiveSketchReq
-	(*ArchiveSketchResp)(nil),                     // 21: cc.arduino.cli.commands.ArchiveSketchResp
-	(*Instance)(nil),                              // 22: cc.arduino.cli.commands.Instance
-	(*DownloadProgress)(nil),                      // 23: cc.arduino.cli.commands.DownloadProgress
-	(*TaskProgress)(nil),                          // 24: cc.arduino.cli.commands.TaskProgress
-	(*InstalledLibrary)(nil),                      // 25: cc.arduino.cli.commands.InstalledLibrary
-	(*Platform)(nil),                              // 26: cc.arduino.cli.commands.Platform
-	(*BoardDetailsReq)(nil),                       // 27: cc.arduino.cli.commands.BoardDetailsReq
-	(*BoardAttachReq)(nil),                        // 28: cc.arduino.cli.commands.BoardAttachReq
-	(*BoardListReq)(nil),                          // 29: cc.arduino.cli.commands.BoardListReq
-	(*BoardListAllReq)(nil),                       // 30: cc.arduino.cli.commands.BoardListAllReq
-	(*CompileReq)(nil),                            // 31: cc.arduino.cli.commands.CompileReq
-	(*PlatformInstallReq)(nil),                    // 32: cc.arduino.cli.commands.PlatformInstallReq
-	(*PlatformDownloadReq)(nil),                   // 33: cc.arduino.cli.commands.PlatformDownloadReq
-	(*PlatformUninstallReq)(nil),                  // 34: cc.arduino.cli.commands.PlatformUninstallReq
-	(*PlatformUpgradeReq)(nil),                    // 35: cc.arduino.cli.commands.PlatformUpgradeReq
-	(*UploadReq)(nil),                             // 36: cc.arduino.cli.commands.UploadReq
-	(*ListProgrammersAvailableForUploadReq)(nil),  // 37: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
-	(*BurnBootloaderReq)(nil),                     // 38: cc.arduino.cli.commands.BurnBootloaderReq
-	(*PlatformSearchReq)(nil),                     // 39: cc.arduino.cli.commands.PlatformSearchReq
-	(*PlatformListReq)(nil),                       // 40: cc.arduino.cli.commands.PlatformListReq
-	(*LibraryDownloadReq)(nil),                    // 41: cc.arduino.cli.commands.LibraryDownloadReq
-	(*LibraryInstallReq)(nil),                     // 42: cc.arduino.cli.commands.LibraryInstallReq
-	(*LibraryUninstallReq)(nil),                   // 43: cc.arduino.cli.commands.LibraryUninstallReq
-	(*LibraryUpgradeAllReq)(nil),                  // 44: cc.arduino.cli.commands.LibraryUpgradeAllReq
-	(*LibraryResolveDependenciesReq)(nil),         // 45: cc.arduino.cli.commands.LibraryResolveDependenciesReq
-	(*LibrarySearchReq)(nil),                      // 46: cc.arduino.cli.commands.LibrarySearchReq
-	(*LibraryListReq)(nil),                        // 47: cc.arduino.cli.commands.LibraryListReq
-	(*BoardDetailsResp)(nil),                      // 48: cc.arduino.cli.commands.BoardDetailsResp
-	(*BoardAttachResp)(nil),                       // 49: cc.arduino.cli.commands.BoardAttachResp
-	(*BoardListResp)(nil),                         // 50: cc.arduino.cli.commands.BoardListResp
-	(*BoardListAllResp)(nil),                      // 51: cc.arduino.cli.commands.BoardListAllResp
-	(*CompileResp)(nil),                           // 52: cc.arduino.cli.commands.CompileResp
-	(*PlatformInstallResp)(nil),                   // 53: cc.arduino.cli.commands.PlatformInstallResp
-	(*PlatformDownloadResp)(nil),                  // 54: cc.arduino.cli.commands.PlatformDownloadResp
-	(*PlatformUninstallResp)(nil),                 // 55: cc.arduino.cli.commands.PlatformUninstallResp
-	(*PlatformUpgradeResp)(nil),                   // 56: cc.arduino.cli.commands.PlatformUpgradeResp
-	(*UploadResp)(nil),                            // 57: cc.arduino.cli.commands.UploadResp
-	(*ListProgrammersAvailableForUploadResp)(nil), // 58: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
-	(*BurnBootloaderResp)(nil),                    // 59: cc.arduino.cli.commands.BurnBootloaderResp
-	(*PlatformSearchResp)(nil),                    // 60: cc.arduino.cli.commands.PlatformSearchResp
-	(*PlatformListResp)(nil),                      // 61: cc.arduino.cli.commands.PlatformListResp
-	(*LibraryDownloadResp)(nil),                   // 62: cc.arduino.cli.commands.LibraryDownloadResp
-	(*LibraryInstallResp)(nil),                    // 63: cc.arduino.cli.commands.LibraryInstallResp
-	(*LibraryUninstallResp)(nil),                  // 64: cc.arduino.cli.commands.LibraryUninstallResp
-	(*LibraryUpgradeAllResp)(nil),                 // 65: cc.arduino.cli.commands.LibraryUpgradeAllResp
-	(*LibraryResolveDependenciesResp)(nil),        // 66: cc.arduino.cli.commands.LibraryResolveDependenciesResp
-	(*LibrarySearchResp)(nil),                     // 67: cc.arduino.cli.commands.LibrarySearchResp
-	(*LibraryListResp)(nil),                       // 68: cc.arduino.cli.commands.LibraryListResp
+	(*WatchReq)(nil),                              // 5: cc.arduino.cli.commands.WatchReq
+	(*WatchResp)(nil),                             // 6: cc.arduino.cli.commands.WatchResp
+	(*RescanResp)(nil),                            // 7: cc.arduino.cli.commands.RescanResp
+	(*UpdateIndexReq)(nil),                        // 8: cc.arduino.cli.commands.UpdateIndexReq
+	(*UpdateIndexResp)(nil),                       // 9: cc.arduino.cli.commands.UpdateIndexResp
+	(*IndexSignature)(nil),                        // 10: cc.arduino.cli.commands.IndexSignature
+	(*UpdateLibrariesIndexReq)(nil),               // 11: cc.arduino.cli.commands.UpdateLibrariesIndexReq
+	(*UpdateLibrariesIndexResp)(nil),              // 12: cc.arduino.cli.commands.UpdateLibrariesIndexResp
+	(*UpdateCoreLibrariesIndexReq)(nil),           // 13: cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq
+	(*UpdateCoreLibrariesIndexResp)(nil),          // 14: cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp
+	(*OutdatedReq)(nil),                           // 15: cc.arduino.cli.commands.OutdatedReq
+	(*OutdatedResp)(nil),                          // 16: cc.arduino.cli.commands.OutdatedResp
+	(*UpgradeReq)(nil),                            // 17: cc.arduino.cli.commands.UpgradeReq
+	(*UpgradeResp)(nil),                           // 18: cc.arduino.cli.commands.UpgradeResp
+	(*VersionReq)(nil),                            // 19: cc.arduino.cli.commands.VersionReq
+	(*VersionResp)(nil),                           // 20: cc.arduino.cli.commands.VersionResp
+	(*CheckForUpdatesReq)(nil),                    // 21: cc.arduino.cli.commands.CheckForUpdatesReq
+	(*CheckForUpdatesResp)(nil),                   // 22: cc.arduino.cli.commands.CheckForUpdatesResp
+	(*SelfUpdateReq)(nil),                         // 23: cc.arduino.cli.commands.SelfUpdateReq
+	(*SelfUpdateResp)(nil),                        // 24: cc.arduino.cli.commands.SelfUpdateResp
+	(*ShutdownReq)(nil),                           // 25: cc.arduino.cli.commands.ShutdownReq
+	(*ShutdownResp)(nil),                          // 26: cc.arduino.cli.commands.ShutdownResp
+	(*LoadSketchReq)(nil),                         // 27: cc.arduino.cli.commands.LoadSketchReq
+	(*LoadSketchResp)(nil),                        // 28: cc.arduino.cli.commands.LoadSketchResp
+	(*ArchiveSketchReq)(nil),                      // 29: cc.arduino.cli.commands.ArchiveSketchReq
+	(*ArchiveSketchResp)(nil),                     // 30: cc.arduino.cli.commands.ArchiveSketchResp
+	(*NewSketchReq)(nil),                          // 31: cc.arduino.cli.commands.NewSketchReq
+	(*NewSketchResp)(nil),                         // 32: cc.arduino.cli.commands.NewSketchResp
+	(*Instance)(nil),                              // 33: cc.arduino.cli.commands.Instance
+	(*DownloadProgress)(nil),                      // 34: cc.arduino.cli.commands.DownloadProgress
+	(*TaskProgress)(nil),                          // 35: cc.arduino.cli.commands.TaskProgress
+	(*InstalledLibrary)(nil),                      // 36: cc.arduino.cli.commands.InstalledLibrary
+	(*Platform)(nil),                              // 37: cc.arduino.cli.commands.Platform
+	(*BoardDetailsReq)(nil),                       // 38: cc.arduino.cli.commands.BoardDetailsReq
+	(*BoardNormalizeFQBNReq)(nil),                 // 39: cc.arduino.cli.commands.BoardNormalizeFQBNReq
+	(*BoardAttachReq)(nil),                        // 40: cc.arduino.cli.commands.BoardAttachReq
+	(*BoardListReq)(nil),                          // 41: cc.arduino.cli.commands.BoardListReq
+	(*BoardListAllReq)(nil),                       // 42: cc.arduino.cli.commands.BoardListAllReq
+	(*BoardSetupReq)(nil),                         // 43: cc.arduino.cli.commands.BoardSetupReq
+	(*CompileReq)(nil),                            // 44: cc.arduino.cli.commands.CompileReq
+	(*PreprocessReq)(nil),                         // 45: cc.arduino.cli.commands.PreprocessReq
+	(*SketchIncludesReq)(nil),                     // 46: cc.arduino.cli.commands.SketchIncludesReq
+	(*PlatformInstallReq)(nil),                    // 47: cc.arduino.cli.commands.PlatformInstallReq
+	(*PlatformPostInstallReq)(nil),                // 48: cc.arduino.cli.commands.PlatformPostInstallReq
+	(*PlatformDownloadReq)(nil),                   // 49: cc.arduino.cli.commands.PlatformDownloadReq
+	(*PlatformUninstallReq)(nil),                  // 50: cc.arduino.cli.commands.PlatformUninstallReq
+	(*PlatformCleanToolsReq)(nil),                 // 51: cc.arduino.cli.commands.PlatformCleanToolsReq
+	(*PlatformCleanCacheReq)(nil),                 // 52: cc.arduino.cli.commands.PlatformCleanCacheReq
+	(*PlatformUpgradeReq)(nil),                    // 53: cc.arduino.cli.commands.PlatformUpgradeReq
+	(*UploadReq)(nil),                             // 54: cc.arduino.cli.commands.UploadReq
+	(*UploadMultipleReq)(nil),                     // 55: cc.arduino.cli.commands.UploadMultipleReq
+	(*ListProgrammersAvailableForUploadReq)(nil),  // 56: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
+	(*BurnBootloaderReq)(nil),                     // 57: cc.arduino.cli.commands.BurnBootloaderReq
+	(*PlatformSearchReq)(nil),                     // 58: cc.arduino.cli.commands.PlatformSearchReq
+	(*PlatformListReq)(nil),                       // 59: cc.arduino.cli.commands.PlatformListReq
+	(*LibraryDownloadReq)(nil),                    // 60: cc.arduino.cli.commands.LibraryDownloadReq
+	(*LibraryInstallReq)(nil),                     // 61: cc.arduino.cli.commands.LibraryInstallReq
+	(*LibraryUninstallReq)(nil),                   // 62: cc.arduino.cli.commands.LibraryUninstallReq
+	(*LibraryUpgradeAllReq)(nil),                  // 63: cc.arduino.cli.commands.LibraryUpgradeAllReq
+	(*LibraryResolveDependenciesReq)(nil),         // 64: cc.arduino.cli.commands.LibraryResolveDependenciesReq
+	(*LibrarySearchReq)(nil),                      // 65: cc.arduino.cli.commands.LibrarySearchReq
+	(*LibraryListReq)(nil),                        // 66: cc.arduino.cli.commands.LibraryListReq
+	(*LibraryCleanCacheReq)(nil),                  // 67: cc.arduino.cli.commands.LibraryCleanCacheReq
+	(*LibraryExamplesReq)(nil),                    // 68: cc.arduino.cli.commands.LibraryExamplesReq
+	(*BoardDetailsResp)(nil),                      // 69: cc.arduino.cli.commands.BoardDetailsResp
+	(*BoardNormalizeFQBNResp)(nil),                // 70: cc.arduino.cli.commands.BoardNormalizeFQBNResp
+	(*BoardAttachResp)(nil),                       // 71: cc.arduino.cli.commands.BoardAttachResp
+	(*BoardListResp)(nil),                         // 72: cc.arduino.cli.commands.BoardListResp
+	(*BoardListAllResp)(nil),                      // 73: cc.arduino.cli.commands.BoardListAllResp
+	(*BoardSetupResp)(nil),                        // 74: cc.arduino.cli.commands.BoardSetupResp
+	(*CompileResp)(nil),                           // 75: cc.arduino.cli.commands.CompileResp
+	(*PreprocessResp)(nil),                        // 76: cc.arduino.cli.commands.PreprocessResp
+	(*SketchIncludesResp)(nil),                    // 77: cc.arduino.cli.commands.SketchIncludesResp
+	(*PlatformInstallResp)(nil),                   // 78: cc.arduino.cli.commands.PlatformInstallResp
+	(*PlatformPostInstallResp)(nil),               // 79: cc.arduino.cli.commands.PlatformPostInstallResp
+	(*PlatformDownloadResp)(nil),                  // 80: cc.arduino.cli.commands.PlatformDownloadResp
+	(*PlatformUninstallResp)(nil),                 // 81: cc.arduino.cli.commands.PlatformUninstallResp
+	(*PlatformCleanToolsResp)(nil),                // 82: cc.arduino.cli.commands.PlatformCleanToolsResp
+	(*PlatformCleanCacheResp)(nil),                // 83: cc.arduino.cli.commands.PlatformCleanCacheResp
+	(*PlatformUpgradeResp)(nil),                   // 84: cc.arduino.cli.commands.PlatformUpgradeResp
+	(*UploadResp)(nil),                            // 85: cc.arduino.cli.commands.UploadResp
+	(*UploadMultipleResp)(nil),                    // 86: cc.arduino.cli.commands.UploadMultipleResp
+	(*ListProgrammersAvailableForUploadResp)(nil), // 87: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
+	(*BurnBootloaderResp)(nil),                    // 88: cc.arduino.cli.commands.BurnBootloaderResp
+	(*PlatformSearchResp)(nil),                    // 89: cc.arduino.cli.commands.PlatformSearchResp
+	(*PlatformListResp)(nil),                      // 90: cc.arduino.cli.commands.PlatformListResp
+	(*LibraryDownloadResp)(nil),                   // 91: cc.arduino.cli.commands.LibraryDownloadResp
+	(*LibraryInstallResp)(nil),                    // 92: cc.arduino.cli.commands.LibraryInstallResp
+	(*LibraryUninstallResp)(nil),                  // 93: cc.arduino.cli.commands.LibraryUninstallResp
+	(*LibraryUpgradeAllResp)(nil),                 // 94: cc.arduino.cli.commands.LibraryUpgradeAllResp
+	(*LibraryResolveDependenciesResp)(nil),        // 95: cc.arduino.cli.commands.LibraryResolveDependenciesResp
+	(*LibrarySearchResp)(nil),                     // 96: cc.arduino.cli.commands.LibrarySearchResp
+	(*LibraryListResp)(nil),                       // 97: cc.arduino.cli.commands.LibraryListResp
+	(*LibraryCleanCacheResp)(nil),                 // 98: cc.arduino.cli.commands.LibraryCleanCacheResp
+	(*LibraryExamplesResp)(nil),                   // 99: cc.arduino.cli.commands.LibraryExamplesResp
 }
 var file_commands_commands_proto_depIdxs = []int32{
-	22, // 0: cc.arduino.cli.commands.InitResp.instance:type_name -> cc.arduino.cli.commands.Instance
-	23, // 1: cc.arduino.cli.commands.InitResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
-	24, // 2: cc.arduino.cli.commands.InitResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
-	22, // 3: cc.arduino.cli.commands.DestroyReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	22, // 4: cc.arduino.cli.commands.RescanReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	22, // 5: cc.arduino.cli.commands.UpdateIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	23, // 6: cc.arduino.cli.commands.UpdateIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
-	22, // 7: cc.arduino.cli.commands.UpdateLibrariesIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	23, // 8: cc.arduino.cli.commands.UpdateLibrariesIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
-	22, // 9: cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	23, // 10: cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
-	22, // 11: cc.arduino.cli.commands.OutdatedReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	25, // 12: cc.arduino.cli.commands.OutdatedResp.outdated_library:type_name -> cc.arduino.cli.commands.InstalledLibrary
-	26, // 13: cc.arduino.cli.commands.OutdatedResp.outdated_platform:type_name -> cc.arduino.cli.commands.Platform
-	22, // 14: cc.arduino.cli.commands.UpgradeReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	23, // 15: cc.arduino.cli.commands.UpgradeResp.progress:type_name -> cc.arduino.cli.commands.DownloadProgress
-	24, // 16: cc.arduino.cli.commands.UpgradeResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
-	22, // 17: cc.arduino.cli.commands.LoadSketchReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	0,  // 18: cc.arduino.cli.commands.ArduinoCore.Init:input_type -> cc.arduino.cli.commands.InitReq
-	2,  // 19: cc.arduino.cli.commands.ArduinoCore.Destroy:input_type -> cc.arduino.cli.commands.DestroyReq
-	4,  // 20: cc.arduino.cli.commands.ArduinoCore.Rescan:input_type -> cc.arduino.cli.commands.RescanReq
-	6,  // 21: cc.arduino.cli.commands.ArduinoCore.UpdateIndex:input_type -> cc.arduino.cli.commands.UpdateIndexReq
-	8,  // 22: cc.arduino.cli.commands.ArduinoCore.UpdateLibrariesIndex:input_type -> cc.arduino.cli.commands.UpdateLibrariesIndexReq
-	10, // 23: cc.arduino.cli.commands.ArduinoCore.UpdateCoreLibrariesIndex:input_type -> cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq
-	12, // 24: cc.arduino.cli.commands.ArduinoCore.Outdated:input_type -> cc.arduino.cli.commands.OutdatedReq
-	14, // 25: cc.arduino.cli.commands.ArduinoCore.Upgrade:input_type -> cc.arduino.cli.commands.UpgradeReq
-	16, // 26: cc.arduino.cli.commands.ArduinoCore.Version:input_type -> cc.arduino.cli.commands.VersionReq
-	18, // 27: cc.arduino.cli.commands.ArduinoCore.LoadSketch:input_type -> cc.arduino.cli.commands.LoadSketchReq
-	20, // 28: cc.arduino.cli.commands.ArduinoCore.ArchiveSketch:input_type -> cc.arduino.cli.commands.ArchiveSketchReq
-	27, // 29: cc.arduino.cli.commands.ArduinoCore.BoardDetails:input_type -> cc.arduino.cli.commands.BoardDetailsReq
-	28, // 30: cc.arduino.cli.commands.ArduinoCore.BoardAttach:input_type -> cc.arduino.cli.commands.BoardAttachReq
-	29, // 31: cc.arduino.cli.commands.ArduinoCore.BoardList:input_type -> cc.arduino.cli.commands.BoardListReq
-	30, // 32: cc.arduino.cli.commands.ArduinoCore.BoardListAll:input_type -> cc.arduino.cli.commands.BoardListAllReq
-	31, // 33: cc.arduino.cli.commands.ArduinoCore.Compile:input_type -> cc.arduino.cli.commands.CompileReq
-	32, // 34: cc.arduino.cli.commands.ArduinoCore.PlatformInstall:input_type -> cc.arduino.cli.commands.PlatformInstallReq
-	33, // 35: cc.arduino.cli.commands.ArduinoCore.PlatformDownload:input_type -> cc.arduino.cli.commands.PlatformDownloadReq
-	34, // 36: cc.arduino.cli.commands.ArduinoCore.PlatformUninstall:input_type -> cc.arduino.cli.commands.PlatformUninstallReq
-	35, // 37: cc.arduino.cli.commands.ArduinoCore.PlatformUpgrade:input_type -> cc.arduino.cli.commands.PlatformUpgradeReq
-	36, // 38: cc.arduino.cli.commands.ArduinoCore.Upload:input_type -> cc.arduino.cli.commands.UploadReq
-	37, // 39: cc.arduino.cli.commands.ArduinoCore.ListProgrammersAvailableForUpload:input_type -> cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
-	38, // 40: cc.arduino.cli.commands.ArduinoCore.BurnBootloader:input_type -> cc.arduino.cli.commands.BurnBootloaderReq
-	39, // 41: cc.arduino.cli.commands.ArduinoCore.PlatformSearch:input_type -> cc.arduino.cli.commands.PlatformSearchReq
-	40, // 42: cc.arduino.cli.commands.ArduinoCore.PlatformList:input_type -> cc.arduino.cli.commands.PlatformListReq
-	41, // 43: cc.arduino.cli.commands.ArduinoCore.LibraryDownload:input_type -> cc.arduino.cli.commands.LibraryDownloadReq
-	42, // 44: cc.arduino.cli.commands.ArduinoCore.LibraryInstall:input_type -> cc.arduino.cli.commands.LibraryInstallReq
-	43, // 45: cc.arduino.cli.commands.ArduinoCore.LibraryUninstall:input_type -> cc.arduino.cli.commands.LibraryUninstallReq
-	44, // 46: cc.arduino.cli.commands.ArduinoCore.LibraryUpgradeAll:input_type -> cc.arduino.cli.commands.LibraryUpgradeAllReq
-	45, // 47: cc.arduino.cli.commands.ArduinoCore.LibraryResolveDependencies:input_type -> cc.arduino.cli.commands.LibraryResolveDependenciesReq
-	46, // 48: cc.arduino.cli.commands.ArduinoCore.LibrarySearch:input_type -> cc.arduino.cli.commands.LibrarySearchReq
-	47, // 49: cc.arduino.cli.commands.ArduinoCore.LibraryList:input_type -> cc.arduino.cli.commands.LibraryListReq
-	1,  // 50: cc.arduino.cli.commands.ArduinoCore.Init:output_type -> cc.arduino.cli.commands.InitResp
-	3,  // 51: cc.arduino.cli.commands.ArduinoCore.Destroy:output_type -> cc.arduino.cli.commands.DestroyResp
-	5,  // 52: cc.arduino.cli.commands.ArduinoCore.Rescan:output_type -> cc.arduino.cli.commands.RescanResp
-	7,  // 53: cc.arduino.cli.commands.ArduinoCore.UpdateIndex:output_type -> cc.arduino.cli.commands.UpdateIndexResp
-	9,  // 54: cc.arduino.cli.commands.ArduinoCore.UpdateLibrariesIndex:output_type -> cc.arduino.cli.commands.UpdateLibrariesIndexResp
-	11, // 55: cc.arduino.cli.commands.ArduinoCore.UpdateCoreLibrariesIndex:output_type -> cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp
-	13, // 56: cc.arduino.cli.commands.ArduinoCore.Outdated:output_type -> cc.arduino.cli.commands.OutdatedResp
-	15, // 57: cc.arduino.cli.commands.ArduinoCore.Upgrade:output_type -> cc.arduino.cli.commands.UpgradeResp
-	17, // 58: cc.arduino.cli.commands.ArduinoCore.Version:output_type -> cc.arduino.cli.commands.VersionResp
-	19, // 59: cc.arduino.cli.commands.ArduinoCore.LoadSketch:output_type -> cc.arduino.cli.commands.LoadSketchResp
-	21, // 60: cc.arduino.cli.commands.ArduinoCore.ArchiveSketch:output_type -> cc.arduino.cli.commands.ArchiveSketchResp
-	48, // 61: cc.arduino.cli.commands.ArduinoCore.BoardDetails:output_type -> cc.arduino.cli.commands.BoardDetailsResp
-	49, // 62: cc.arduino.cli.commands.ArduinoCore.BoardAttach:output_type -> cc.arduino.cli.commands.BoardAttachResp
-	50, // 63: cc.arduino.cli.commands.ArduinoCore.BoardList:output_type -> cc.arduino.cli.commands.BoardListResp
-	51, // 64: cc.arduino.cli.commands.ArduinoCore.BoardListAll:output_type -> cc.arduino.cli.commands.BoardListAllResp
-	52, // 65: cc.arduino.cli.commands.ArduinoCore.Compile:output_type -> cc.arduino.cli.commands.CompileResp
-	53, // 66: cc.arduino.cli.commands.ArduinoCore.PlatformInstall:output_type -> cc.arduino.cli.commands.PlatformInstallResp
-	54, // 67: cc.arduino.cli.commands.ArduinoCore.PlatformDownload:output_type -> cc.arduino.cli.commands.PlatformDownloadResp
-	55, // 68: cc.arduino.cli.commands.ArduinoCore.PlatformUninstall:output_type -> cc.arduino.cli.commands.PlatformUninstallResp
-	56, // 69: cc.arduino.cli.commands.ArduinoCore.PlatformUpgrade:output_type -> cc.arduino.cli.commands.PlatformUpgradeResp
-	57, // 70: cc.arduino.cli.commands.ArduinoCore.Upload:output_type -> cc.arduino.cli.commands.UploadResp
-	58, // 71: cc.arduino.cli.commands.ArduinoCore.ListProgrammersAvailableForUpload:output_type -> cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
-	59, // 72: cc.arduino.cli.commands.ArduinoCore.BurnBootloader:output_type -> cc.arduino.cli.commands.BurnBootloaderResp
-	60, // 73: cc.arduino.cli.commands.ArduinoCore.PlatformSearch:output_type -> cc.arduino.cli.commands.PlatformSearchResp
-	61, // 74: cc.arduino.cli.commands.ArduinoCore.PlatformList:output_type -> cc.arduino.cli.commands.PlatformListResp
-	62, // 75: cc.arduino.cli.commands.ArduinoCore.LibraryDownload:output_type -> cc.arduino.cli.commands.LibraryDownloadResp
-	63, // 76: cc.arduino.cli.commands.ArduinoCore.LibraryInstall:output_type -> cc.arduino.cli.commands.LibraryInstallResp
-	64, // 77: cc.arduino.cli.commands.ArduinoCore.LibraryUninstall:output_type -> cc.arduino.cli.commands.LibraryUninstallResp
-	65, // 78: cc.arduino.cli.commands.ArduinoCore.LibraryUpgradeAll:output_type -> cc.arduino.cli.commands.LibraryUpgradeAllResp
-	66, // 79: cc.arduino.cli.commands.ArduinoCore.LibraryResolveDependencies:output_type -> cc.arduino.cli.commands.LibraryResolveDependenciesResp
-	67, // 80: cc.arduino.cli.commands.ArduinoCore.LibrarySearch:output_type -> cc.arduino.cli.commands.LibrarySearchResp
-	68, // 81: cc.arduino.cli.commands.ArduinoCore.LibraryList:output_type -> cc.arduino.cli.commands.LibraryListResp
-	50, // [50:82] is the sub-list for method output_type
-	18, // [18:50] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	33, // 0: cc.arduino.cli.commands.InitResp.instance:type_name -> cc.arduino.cli.commands.Instance
+	34, // 1: cc.arduino.cli.commands.InitResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	35, // 2: cc.arduino.cli.commands.InitResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	33, // 3: cc.arduino.cli.commands.DestroyReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	33, // 4: cc.arduino.cli.commands.RescanReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	33, // 5: cc.arduino.cli.commands.WatchReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	33, // 6: cc.arduino.cli.commands.UpdateIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	34, // 7: cc.arduino.cli.commands.UpdateIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	10, // 8: cc.arduino.cli.commands.UpdateIndexResp.signatures:type_name -> cc.arduino.cli.commands.IndexSignature
+	33, // 9: cc.arduino.cli.commands.UpdateLibrariesIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	34, // 10: cc.arduino.cli.commands.UpdateLibrariesIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	33, // 11: cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	34, // 12: cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp.download_progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	33, // 13: cc.arduino.cli.commands.OutdatedReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	36, // 14: cc.arduino.cli.commands.OutdatedResp.outdated_library:type_name -> cc.arduino.cli.commands.InstalledLibrary
+	37, // 15: cc.arduino.cli.commands.OutdatedResp.outdated_platform:type_name -> cc.arduino.cli.commands.Platform
+	33, // 16: cc.arduino.cli.commands.UpgradeReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	34, // 17: cc.arduino.cli.commands.UpgradeResp.progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	35, // 18: cc.arduino.cli.commands.UpgradeResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	34, // 19: cc.arduino.cli.commands.SelfUpdateResp.progress:type_name -> cc.arduino.cli.commands.DownloadProgress
+	35, // 20: cc.arduino.cli.commands.SelfUpdateResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	33, // 21: cc.arduino.cli.commands.LoadSketchReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	33, // 22: cc.arduino.cli.commands.ArchiveSketchReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	0,  // 23: cc.arduino.cli.commands.ArduinoCore.Init:input_type -> cc.arduino.cli.commands.InitReq
+	2,  // 24: cc.arduino.cli.commands.ArduinoCore.Destroy:input_type -> cc.arduino.cli.commands.DestroyReq
+	4,  // 25: cc.arduino.cli.commands.ArduinoCore.Rescan:input_type -> cc.arduino.cli.commands.RescanReq
+	5,  // 26: cc.arduino.cli.commands.ArduinoCore.Watch:input_type -> cc.arduino.cli.commands.WatchReq
+	8,  // 27: cc.arduino.cli.commands.ArduinoCore.UpdateIndex:input_type -> cc.arduino.cli.commands.UpdateIndexReq
+	11, // 28: cc.arduino.cli.commands.ArduinoCore.UpdateLibrariesIndex:input_type -> cc.arduino.cli.commands.UpdateLibrariesIndexReq
+	13, // 29: cc.arduino.cli.commands.ArduinoCore.UpdateCoreLibrariesIndex:input_type -> cc.arduino.cli.commands.UpdateCoreLibrariesIndexReq
+	15, // 30: cc.arduino.cli.commands.ArduinoCore.Outdated:input_type -> cc.arduino.cli.commands.OutdatedReq
+	17, // 31: cc.arduino.cli.commands.ArduinoCore.Upgrade:input_type -> cc.arduino.cli.commands.UpgradeReq
+	19, // 32: cc.arduino.cli.commands.ArduinoCore.Version:input_type -> cc.arduino.cli.commands.VersionReq
+	21, // 33: cc.arduino.cli.commands.ArduinoCore.CheckForUpdates:input_type -> cc.arduino.cli.commands.CheckForUpdatesReq
+	23, // 34: cc.arduino.cli.commands.ArduinoCore.SelfUpdate:input_type -> cc.arduino.cli.commands.SelfUpdateReq
+	25, // 35: cc.arduino.cli.commands.ArduinoCore.Shutdown:input_type -> cc.arduino.cli.commands.ShutdownReq
+	27, // 36: cc.arduino.cli.commands.ArduinoCore.LoadSketch:input_type -> cc.arduino.cli.commands.LoadSketchReq
+	29, // 37: cc.arduino.cli.commands.ArduinoCore.ArchiveSketch:input_type -> cc.arduino.cli.commands.ArchiveSketchReq
+	31, // 38: cc.arduino.cli.commands.ArduinoCore.NewSketch:input_type -> cc.arduino.cli.commands.NewSketchReq
+	38, // 39: cc.arduino.cli.commands.ArduinoCore.BoardDetails:input_type -> cc.arduino.cli.commands.BoardDetailsReq
+	39, // 40: cc.arduino.cli.commands.ArduinoCore.BoardNormalizeFQBN:input_type -> cc.arduino.cli.commands.BoardNormalizeFQBNReq
+	40, // 41: cc.arduino.cli.commands.ArduinoCore.BoardAttach:input_type -> cc.arduino.cli.commands.BoardAttachReq
+	41, // 42: cc.arduino.cli.commands.ArduinoCore.BoardList:input_type -> cc.arduino.cli.commands.BoardListReq
+	42, // 43: cc.arduino.cli.commands.ArduinoCore.BoardListAll:input_type -> cc.arduino.cli.commands.BoardListAllReq
+	43, // 44: cc.arduino.cli.commands.ArduinoCore.BoardSetup:input_type -> cc.arduino.cli.commands.BoardSetupReq
+	44, // 45: cc.arduino.cli.commands.ArduinoCore.Compile:input_type -> cc.arduino.cli.commands.CompileReq
+	45, // 46: cc.arduino.cli.commands.ArduinoCore.Preprocess:input_type -> cc.arduino.cli.commands.PreprocessReq
+	46, // 47: cc.arduino.cli.commands.ArduinoCore.SketchIncludes:input_type -> cc.arduino.cli.commands.SketchIncludesReq
+	47, // 48: cc.arduino.cli.commands.ArduinoCore.PlatformInstall:input_type -> cc.arduino.cli.commands.PlatformInstallReq
+	48, // 49: cc.arduino.cli.commands.ArduinoCore.PlatformPostInstall:input_type -> cc.arduino.cli.commands.PlatformPostInstallReq
+	49, // 50: cc.arduino.cli.commands.ArduinoCore.PlatformDownload:input_type -> cc.arduino.cli.commands.PlatformDownloadReq
+	50, // 51: cc.arduino.cli.commands.ArduinoCore.PlatformUninstall:input_type -> cc.arduino.cli.commands.PlatformUninstallReq
+	51, // 52: cc.arduino.cli.commands.ArduinoCore.PlatformCleanTools:input_type -> cc.arduino.cli.commands.PlatformCleanToolsReq
+	52, // 53: cc.arduino.cli.commands.ArduinoCore.PlatformCleanCache:input_type -> cc.arduino.cli.commands.PlatformCleanCacheReq
+	53, // 54: cc.arduino.cli.commands.ArduinoCore.PlatformUpgrade:input_type -> cc.arduino.cli.commands.PlatformUpgradeReq
+	54, // 55: cc.arduino.cli.commands.ArduinoCore.Upload:input_type -> cc.arduino.cli.commands.UploadReq
+	55, // 56: cc.arduino.cli.commands.ArduinoCore.UploadMultiple:input_type -> cc.arduino.cli.commands.UploadMultipleReq
+	56, // 57: cc.arduino.cli.commands.ArduinoCore.ListProgrammersAvailableForUpload:input_type -> cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
+	57, // 58: cc.arduino.cli.commands.ArduinoCore.BurnBootloader:input_type -> cc.arduino.cli.commands.BurnBootloaderReq
+	58, // 59: cc.arduino.cli.commands.ArduinoCore.PlatformSearch:input_type -> cc.arduino.cli.commands.PlatformSearchReq
+	59, // 60: cc.arduino.cli.commands.ArduinoCore.PlatformList:input_type -> cc.arduino.cli.commands.PlatformListReq
+	60, // 61: cc.arduino.cli.commands.ArduinoCore.LibraryDownload:input_type -> cc.arduino.cli.commands.LibraryDownloadReq
+	61, // 62: cc.arduino.cli.commands.ArduinoCore.LibraryInstall:input_type -> cc.arduino.cli.commands.LibraryInstallReq
+	62, // 63: cc.arduino.cli.commands.ArduinoCore.LibraryUninstall:input_type -> cc.arduino.cli.commands.LibraryUninstallReq
+	63, // 64: cc.arduino.cli.commands.ArduinoCore.LibraryUpgradeAll:input_type -> cc.arduino.cli.commands.LibraryUpgradeAllReq
+	64, // 65: cc.arduino.cli.commands.ArduinoCore.LibraryResolveDependencies:input_type -> cc.arduino.cli.commands.LibraryResolveDependenciesReq
+	65, // 66: cc.arduino.cli.commands.ArduinoCore.LibrarySearch:input_type -> cc.arduino.cli.commands.LibrarySearchReq
+	66, // 67: cc.arduino.cli.commands.ArduinoCore.LibraryList:input_type -> cc.arduino.cli.commands.LibraryListReq
+	67, // 68: cc.arduino.cli.commands.ArduinoCore.LibraryCleanCache:input_type -> cc.arduino.cli.commands.LibraryCleanCacheReq
+	68, // 69: cc.arduino.cli.commands.ArduinoCore.LibraryExamples:input_type -> cc.arduino.cli.commands.LibraryExamplesReq
+	1,  // 70: cc.arduino.cli.commands.ArduinoCore.Init:output_type -> cc.arduino.cli.commands.InitResp
+	3,  // 71: cc.arduino.cli.commands.ArduinoCore.Destroy:output_type -> cc.arduino.cli.commands.DestroyResp
+	7,  // 72: cc.arduino.cli.commands.ArduinoCore.Rescan:output_type -> cc.arduino.cli.commands.RescanResp
+	6,  // 73: cc.arduino.cli.commands.ArduinoCore.Watch:output_type -> cc.arduino.cli.commands.WatchResp
+	9,  // 74: cc.arduino.cli.commands.ArduinoCore.UpdateIndex:output_type -> cc.arduino.cli.commands.UpdateIndexResp
+	12, // 75: cc.arduino.cli.commands.ArduinoCore.UpdateLibrariesIndex:output_type -> cc.arduino.cli.commands.UpdateLibrariesIndexResp
+	14, // 76: cc.arduino.cli.commands.ArduinoCore.UpdateCoreLibrariesIndex:output_type -> cc.arduino.cli.commands.UpdateCoreLibrariesIndexResp
+	16, // 77: cc.arduino.cli.commands.ArduinoCore.Outdated:output_type -> cc.arduino.cli.commands.OutdatedResp
+	18, // 78: cc.arduino.cli.commands.ArduinoCore.Upgrade:output_type -> cc.arduino.cli.commands.UpgradeResp
+	20, // 79: cc.arduino.cli.commands.ArduinoCore.Version:output_type -> cc.arduino.cli.commands.VersionResp
+	22, // 80: cc.arduino.cli.commands.ArduinoCore.CheckForUpdates:output_type -> cc.arduino.cli.commands.CheckForUpdatesResp
+	24, // 81: cc.arduino.cli.commands.ArduinoCore.SelfUpdate:output_type -> cc.arduino.cli.commands.SelfUpdateResp
+	26, // 82: cc.arduino.cli.commands.ArduinoCore.Shutdown:output_type -> cc.arduino.cli.commands.ShutdownResp
+	28, // 83: cc.arduino.cli.commands.ArduinoCore.LoadSketch:output_type -> cc.arduino.cli.commands.LoadSketchResp
+	30, // 84: cc.arduino.cli.commands.ArduinoCore.ArchiveSketch:output_type -> cc.arduino.cli.commands.ArchiveSketchResp
+	32, // 85: cc.arduino.cli.commands.ArduinoCore.NewSketch:output_type -> cc.arduino.cli.commands.NewSketchResp
+	69, // 86: cc.arduino.cli.commands.ArduinoCore.BoardDetails:output_type -> cc.arduino.cli.commands.BoardDetailsResp
+	70, // 87: cc.arduino.cli.commands.ArduinoCore.BoardNormalizeFQBN:output_type -> cc.arduino.cli.commands.BoardNormalizeFQBNResp
+	71, // 88: cc.arduino.cli.commands.ArduinoCore.BoardAttach:output_type -> cc.arduino.cli.commands.BoardAttachResp
+	72, // 89: cc.arduino.cli.commands.ArduinoCore.BoardList:output_type -> cc.arduino.cli.commands.BoardListResp
+	73, // 90: cc.arduino.cli.commands.ArduinoCore.BoardListAll:output_type -> cc.arduino.cli.commands.BoardListAllResp
+	74, // 91: cc.arduino.cli.commands.ArduinoCore.BoardSetup:output_type -> cc.arduino.cli.commands.BoardSetupResp
+	75, // 92: cc.arduino.cli.commands.ArduinoCore.Compile:output_type -> cc.arduino.cli.commands.CompileResp
+	76, // 93: cc.arduino.cli.commands.ArduinoCore.Preprocess:output_type -> cc.arduino.cli.commands.PreprocessResp
+	77, // 94: cc.arduino.cli.commands.ArduinoCore.SketchIncludes:output_type -> cc.arduino.cli.commands.SketchIncludesResp
+	78, // 95: cc.arduino.cli.commands.ArduinoCore.PlatformInstall:output_type -> cc.arduino.cli.commands.PlatformInstallResp
+	79, // 96: cc.arduino.cli.commands.ArduinoCore.PlatformPostInstall:output_type -> cc.arduino.cli.commands.PlatformPostInstallResp
+	80, // 97: cc.arduino.cli.commands.ArduinoCore.PlatformDownload:output_type -> cc.arduino.cli.commands.PlatformDownloadResp
+	81, // 98: cc.arduino.cli.commands.ArduinoCore.PlatformUninstall:output_type -> cc.arduino.cli.commands.PlatformUninstallResp
+	82, // 99: cc.arduino.cli.commands.ArduinoCore.PlatformCleanTools:output_type -> cc.arduino.cli.commands.PlatformCleanToolsResp
+	83, // 100: cc.arduino.cli.commands.ArduinoCore.PlatformCleanCache:output_type -> cc.arduino.cli.commands.PlatformCleanCacheResp
+	84, // 101: cc.arduino.cli.commands.ArduinoCore.PlatformUpgrade:output_type -> cc.arduino.cli.commands.PlatformUpgradeResp
+	85, // 102: cc.arduino.cli.commands.ArduinoCore.Upload:output_type -> cc.arduino.cli.commands.UploadResp
+	86, // 103: cc.arduino.cli.commands.ArduinoCore.UploadMultiple:output_type -> cc.arduino.cli.commands.UploadMultipleResp
+	87, // 104: cc.arduino.cli.commands.ArduinoCore.ListProgrammersAvailableForUpload:output_type -> cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
+	88, // 105: cc.arduino.cli.commands.ArduinoCore.BurnBootloader:output_type -> cc.arduino.cli.commands.BurnBootloaderResp
+	89, // 106: cc.arduino.cli.commands.ArduinoCore.PlatformSearch:output_type -> cc.arduino.cli.commands.PlatformSearchResp
+	90, // 107: cc.arduino.cli.commands.ArduinoCore.PlatformList:output_type -> cc.arduino.cli.commands.PlatformListResp
+	91, // 108: cc.arduino.cli.commands.ArduinoCore.LibraryDownload:output_type -> cc.arduino.cli.commands.LibraryDownloadResp
+	92, // 109: cc.arduino.cli.commands.ArduinoCore.LibraryInstall:output_type -> cc.arduino.cli.commands.LibraryInstallResp
+	93, // 110: cc.arduino.cli.commands.ArduinoCore.LibraryUninstall:output_type -> cc.arduino.cli.commands.LibraryUninstallResp
+	94, // 111: cc.arduino.cli.commands.ArduinoCore.LibraryUpgradeAll:output_type -> cc.arduino.cli.commands.LibraryUpgradeAllResp
+	95, // 112: cc.arduino.cli.commands.ArduinoCore.LibraryResolveDependencies:output_type -> cc.arduino.cli.commands.LibraryResolveDependenciesResp
+	96, // 113: cc.arduino.cli.commands.ArduinoCore.LibrarySearch:output_type -> cc.arduino.cli.commands.LibrarySearchResp
+	97, // 114: cc.arduino.cli.commands.ArduinoCore.LibraryList:output_type -> cc.arduino.cli.commands.LibraryListResp
+	98, // 115: cc.arduino.cli.commands.ArduinoCore.LibraryCleanCache:output_type -> cc.arduino.cli.commands.LibraryCleanCacheResp
+	99, // 116: cc.arduino.cli.commands.ArduinoCore.LibraryExamples:output_type -> cc.arduino.cli.commands.LibraryExamplesResp
+	70, // [70:117] is the sub-list for method output_type
+	23, // [23:70] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_commands_commands_proto_init() }
@@ -1780,8 +2793,140 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitResp); i {
+		file_commands_commands_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIndexReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIndexResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndexSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLibrariesIndexReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_commands_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLibrariesIndexResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1792,8 +2937,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyReq); i {
+		file_commands_commands_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCoreLibrariesIndexReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1804,8 +2949,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyResp); i {
+		file_commands_commands_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCoreLibrariesIndexResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1816,8 +2961,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RescanReq); i {
+		file_commands_commands_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutdatedReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1828,8 +2973,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RescanResp); i {
+		file_commands_commands_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutdatedResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1840,8 +2985,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIndexReq); i {
+		file_commands_commands_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1852,8 +2997,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIndexResp); i {
+		file_commands_commands_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1864,8 +3009,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLibrariesIndexReq); i {
+		file_commands_commands_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1876,8 +3021,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLibrariesIndexResp); i {
+		file_commands_commands_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1888,8 +3033,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCoreLibrariesIndexReq); i {
+		file_commands_commands_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckForUpdatesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1900,8 +3045,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCoreLibrariesIndexResp); i {
+		file_commands_commands_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckForUpdatesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1912,8 +3057,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutdatedReq); i {
+		file_commands_commands_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfUpdateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1924,8 +3069,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OutdatedResp); i {
+		file_commands_commands_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfUpdateResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1936,8 +3081,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeReq); i {
+		file_commands_commands_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1948,8 +3093,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeResp); i {
+		file_commands_commands_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1960,8 +3105,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionReq); i {
+		file_commands_commands_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSketchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1972,8 +3117,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResp); i {
+		file_commands_commands_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSketchResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1984,8 +3129,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadSketchReq); i {
+		file_commands_commands_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSketchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1996,8 +3141,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadSketchResp); i {
+		file_commands_commands_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveSketchResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2008,8 +3153,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveSketchReq); i {
+		file_commands_commands_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSketchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2020,8 +3165,8 @@ func file_commands_commands_proto_init() {
 				return nil
 			}
 		}
-		file_commands_commands_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveSketchResp); i {
+		file_commands_commands_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewSketchResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2039,7 +3184,7 @@ func file_commands_commands_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commands_commands_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2071,6 +3216,9 @@ type ArduinoCoreClient interface {
 	Destroy(ctx context.Context, in *DestroyReq, opts ...grpc.CallOption) (*DestroyResp, error)
 	// Rescan instance of the Arduino Core Service
 	Rescan(ctx context.Context, in *RescanReq, opts ...grpc.CallOption) (*RescanResp, error)
+	// Watch the libraries and platforms folders, rescanning the instance and
+	// sending an event every time they are changed outside the CLI
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (ArduinoCore_WatchClient, error)
 	// Update package index of the Arduino Core Service
 	UpdateIndex(ctx context.Context, in *UpdateIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateIndexClient, error)
 	// Update libraries index
@@ -2083,12 +3231,26 @@ type ArduinoCoreClient interface {
 	Upgrade(ctx context.Context, in *UpgradeReq, opts ...grpc.CallOption) (ArduinoCore_UpgradeClient, error)
 	// Get the version of Arduino CLI in use.
 	Version(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionResp, error)
+	// Check if a newer release of Arduino CLI is available. Fails if the check
+	// is disabled by the `updater.enable_check` setting.
+	CheckForUpdates(ctx context.Context, in *CheckForUpdatesReq, opts ...grpc.CallOption) (*CheckForUpdatesResp, error)
+	// Download, verify and install the latest release of Arduino CLI, replacing
+	// the executable in use. The daemon keeps running the old release until it
+	// is restarted.
+	SelfUpdate(ctx context.Context, in *SelfUpdateReq, opts ...grpc.CallOption) (ArduinoCore_SelfUpdateClient, error)
+	// Stop the daemon, waiting for the calls in progress to end.
+	Shutdown(ctx context.Context, in *ShutdownReq, opts ...grpc.CallOption) (*ShutdownResp, error)
 	// Returns all files composing a Sketch
 	LoadSketch(ctx context.Context, in *LoadSketchReq, opts ...grpc.CallOption) (*LoadSketchResp, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(ctx context.Context, in *ArchiveSketchReq, opts ...grpc.CallOption) (*ArchiveSketchResp, error)
+	// Creates a new Sketch from a template
+	NewSketch(ctx context.Context, in *NewSketchReq, opts ...grpc.CallOption) (*NewSketchResp, error)
 	// Requests details about a board
 	BoardDetails(ctx context.Context, in *BoardDetailsReq, opts ...grpc.CallOption) (*BoardDetailsResp, error)
+	// Validate the configuration options of an FQBN against the board's menus
+	// and return the FQBN with all the options set.
+	BoardNormalizeFQBN(ctx context.Context, in *BoardNormalizeFQBNReq, opts ...grpc.CallOption) (*BoardNormalizeFQBNResp, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
 	// provided, the FQBN of the attached board will be used.
 	BoardAttach(ctx context.Context, in *BoardAttachReq, opts ...grpc.CallOption) (ArduinoCore_BoardAttachClient, error)
@@ -2096,20 +3258,39 @@ type ArduinoCoreClient interface {
 	BoardList(ctx context.Context, in *BoardListReq, opts ...grpc.CallOption) (*BoardListResp, error)
 	// List all the boards provided by installed platforms.
 	BoardListAll(ctx context.Context, in *BoardListAllReq, opts ...grpc.CallOption) (*BoardListAllResp, error)
+	// Set up the system to use a board: install the udev rules (Linux) or
+	// point to the drivers (Windows) declared by its platform.
+	BoardSetup(ctx context.Context, in *BoardSetupReq, opts ...grpc.CallOption) (*BoardSetupResp, error)
 	// Compile an Arduino sketch.
 	Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error)
+	// Translate the .ino files of a sketch into the C++ source that is compiled,
+	// without compiling it.
+	Preprocess(ctx context.Context, in *PreprocessReq, opts ...grpc.CallOption) (*PreprocessResp, error)
+	// Find the libraries used by a sketch and the includes that can't be
+	// resolved, without compiling it.
+	SketchIncludes(ctx context.Context, in *SketchIncludesReq, opts ...grpc.CallOption) (*SketchIncludesResp, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error)
+	// Run the post-install script of an installed platform, streaming its
+	// output.
+	PlatformPostInstall(ctx context.Context, in *PlatformPostInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformPostInstallClient, error)
 	// Download a platform and its tool dependencies to the `staging/packages`
 	// subdirectory of the data directory.
 	PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error)
 	// Uninstall a platform as well as its tool dependencies that are not used by
 	// other installed platforms.
 	PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error)
+	// Uninstall the tools not required by any installed platform and remove
+	// from the tools store the archives no longer used by any tool.
+	PlatformCleanTools(ctx context.Context, in *PlatformCleanToolsReq, opts ...grpc.CallOption) (ArduinoCore_PlatformCleanToolsClient, error)
+	// Remove the platform and tool archives from the downloads directory.
+	PlatformCleanCache(ctx context.Context, in *PlatformCleanCacheReq, opts ...grpc.CallOption) (*PlatformCleanCacheResp, error)
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error)
 	// Upload a compiled sketch to an Arduino board.
 	Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error)
+	// Upload the same binary to many boards concurrently.
+	UploadMultiple(ctx context.Context, in *UploadMultipleReq, opts ...grpc.CallOption) (ArduinoCore_UploadMultipleClient, error)
 	ListProgrammersAvailableForUpload(ctx context.Context, in *ListProgrammersAvailableForUploadReq, opts ...grpc.CallOption) (*ListProgrammersAvailableForUploadResp, error)
 	// Burn bootloader to a board.
 	BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error)
@@ -2133,6 +3314,11 @@ type ArduinoCoreClient interface {
 	LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error)
 	// List the installed libraries.
 	LibraryList(ctx context.Context, in *LibraryListReq, opts ...grpc.CallOption) (*LibraryListResp, error)
+	// Remove the library archives from the downloads directory.
+	LibraryCleanCache(ctx context.Context, in *LibraryCleanCacheReq, opts ...grpc.CallOption) (*LibraryCleanCacheResp, error)
+	// List the examples of the installed libraries, including the ones bundled
+	// with the platforms.
+	LibraryExamples(ctx context.Context, in *LibraryExamplesReq, opts ...grpc.CallOption) (*LibraryExamplesResp, error)
 }
 
 type arduinoCoreClient struct {
@@ -2193,8 +3379,40 @@ func (c *arduinoCoreClient) Rescan(ctx context.Context, in *RescanReq, opts ...g
 	return out, nil
 }
 
+func (c *arduinoCoreClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (ArduinoCore_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[1], "/cc.arduino.cli.commands.ArduinoCore/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_WatchClient interface {
+	Recv() (*WatchResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreWatchClient) Recv() (*WatchResp, error) {
+	m := new(WatchResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreClient) UpdateIndex(ctx context.Context, in *UpdateIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateIndexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[1], "/cc.arduino.cli.commands.ArduinoCore/UpdateIndex", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[2], "/cc.arduino.cli.commands.ArduinoCore/UpdateIndex", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2226,7 +3444,7 @@ func (x *arduinoCoreUpdateIndexClient) Recv() (*UpdateIndexResp, error) {
 }
 
 func (c *arduinoCoreClient) UpdateLibrariesIndex(ctx context.Context, in *UpdateLibrariesIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateLibrariesIndexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[2], "/cc.arduino.cli.commands.ArduinoCore/UpdateLibrariesIndex", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[3], "/cc.arduino.cli.commands.ArduinoCore/UpdateLibrariesIndex", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2258,7 +3476,7 @@ func (x *arduinoCoreUpdateLibrariesIndexClient) Recv() (*UpdateLibrariesIndexRes
 }
 
 func (c *arduinoCoreClient) UpdateCoreLibrariesIndex(ctx context.Context, in *UpdateCoreLibrariesIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateCoreLibrariesIndexClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[3], "/cc.arduino.cli.commands.ArduinoCore/UpdateCoreLibrariesIndex", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[4], "/cc.arduino.cli.commands.ArduinoCore/UpdateCoreLibrariesIndex", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2299,7 +3517,7 @@ func (c *arduinoCoreClient) Outdated(ctx context.Context, in *OutdatedReq, opts
 }
 
 func (c *arduinoCoreClient) Upgrade(ctx context.Context, in *UpgradeReq, opts ...grpc.CallOption) (ArduinoCore_UpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[4], "/cc.arduino.cli.commands.ArduinoCore/Upgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[5], "/cc.arduino.cli.commands.ArduinoCore/Upgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2339,6 +3557,56 @@ func (c *arduinoCoreClient) Version(ctx context.Context, in *VersionReq, opts ..
 	return out, nil
 }
 
+func (c *arduinoCoreClient) CheckForUpdates(ctx context.Context, in *CheckForUpdatesReq, opts ...grpc.CallOption) (*CheckForUpdatesResp, error) {
+	out := new(CheckForUpdatesResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/CheckForUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) SelfUpdate(ctx context.Context, in *SelfUpdateReq, opts ...grpc.CallOption) (ArduinoCore_SelfUpdateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[6], "/cc.arduino.cli.commands.ArduinoCore/SelfUpdate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreSelfUpdateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_SelfUpdateClient interface {
+	Recv() (*SelfUpdateResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreSelfUpdateClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreSelfUpdateClient) Recv() (*SelfUpdateResp, error) {
+	m := new(SelfUpdateResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) Shutdown(ctx context.Context, in *ShutdownReq, opts ...grpc.CallOption) (*ShutdownResp, error) {
+	out := new(ShutdownResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/Shutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) LoadSketch(ctx context.Context, in *LoadSketchReq, opts ...grpc.CallOption) (*LoadSketchResp, error) {
 	out := new(LoadSketchResp)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LoadSketch", in, out, opts...)
@@ -2357,6 +3625,15 @@ func (c *arduinoCoreClient) ArchiveSketch(ctx context.Context, in *ArchiveSketch
 	return out, nil
 }
 
+func (c *arduinoCoreClient) NewSketch(ctx context.Context, in *NewSketchReq, opts ...grpc.CallOption) (*NewSketchResp, error) {
+	out := new(NewSketchResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/NewSketch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) BoardDetails(ctx context.Context, in *BoardDetailsReq, opts ...grpc.CallOption) (*BoardDetailsResp, error) {
 	out := new(BoardDetailsResp)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/BoardDetails", in, out, opts...)
@@ -2366,8 +3643,17 @@ func (c *arduinoCoreClient) BoardDetails(ctx context.Context, in *BoardDetailsRe
 	return out, nil
 }
 
+func (c *arduinoCoreClient) BoardNormalizeFQBN(ctx context.Context, in *BoardNormalizeFQBNReq, opts ...grpc.CallOption) (*BoardNormalizeFQBNResp, error) {
+	out := new(BoardNormalizeFQBNResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/BoardNormalizeFQBN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) BoardAttach(ctx context.Context, in *BoardAttachReq, opts ...grpc.CallOption) (ArduinoCore_BoardAttachClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[5], "/cc.arduino.cli.commands.ArduinoCore/BoardAttach", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[7], "/cc.arduino.cli.commands.ArduinoCore/BoardAttach", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2416,8 +3702,17 @@ func (c *arduinoCoreClient) BoardListAll(ctx context.Context, in *BoardListAllRe
 	return out, nil
 }
 
+func (c *arduinoCoreClient) BoardSetup(ctx context.Context, in *BoardSetupReq, opts ...grpc.CallOption) (*BoardSetupResp, error) {
+	out := new(BoardSetupResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/BoardSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[6], "/cc.arduino.cli.commands.ArduinoCore/Compile", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[8], "/cc.arduino.cli.commands.ArduinoCore/Compile", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2448,8 +3743,26 @@ func (x *arduinoCoreCompileClient) Recv() (*CompileResp, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreClient) Preprocess(ctx context.Context, in *PreprocessReq, opts ...grpc.CallOption) (*PreprocessResp, error) {
+	out := new(PreprocessResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/Preprocess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) SketchIncludes(ctx context.Context, in *SketchIncludesReq, opts ...grpc.CallOption) (*SketchIncludesResp, error) {
+	out := new(SketchIncludesResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/SketchIncludes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[7], "/cc.arduino.cli.commands.ArduinoCore/PlatformInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[9], "/cc.arduino.cli.commands.ArduinoCore/PlatformInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2480,8 +3793,40 @@ func (x *arduinoCorePlatformInstallClient) Recv() (*PlatformInstallResp, error)
 	return m, nil
 }
 
+func (c *arduinoCoreClient) PlatformPostInstall(ctx context.Context, in *PlatformPostInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformPostInstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[10], "/cc.arduino.cli.commands.ArduinoCore/PlatformPostInstall", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformPostInstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformPostInstallClient interface {
+	Recv() (*PlatformPostInstallResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformPostInstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformPostInstallClient) Recv() (*PlatformPostInstallResp, error) {
+	m := new(PlatformPostInstallResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreClient) PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[8], "/cc.arduino.cli.commands.ArduinoCore/PlatformDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[11], "/cc.arduino.cli.commands.ArduinoCore/PlatformDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2513,7 +3858,7 @@ func (x *arduinoCorePlatformDownloadClient) Recv() (*PlatformDownloadResp, error
 }
 
 func (c *arduinoCoreClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[9], "/cc.arduino.cli.commands.ArduinoCore/PlatformUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[12], "/cc.arduino.cli.commands.ArduinoCore/PlatformUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2544,8 +3889,49 @@ func (x *arduinoCorePlatformUninstallClient) Recv() (*PlatformUninstallResp, err
 	return m, nil
 }
 
+func (c *arduinoCoreClient) PlatformCleanTools(ctx context.Context, in *PlatformCleanToolsReq, opts ...grpc.CallOption) (ArduinoCore_PlatformCleanToolsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[13], "/cc.arduino.cli.commands.ArduinoCore/PlatformCleanTools", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformCleanToolsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformCleanToolsClient interface {
+	Recv() (*PlatformCleanToolsResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformCleanToolsClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformCleanToolsClient) Recv() (*PlatformCleanToolsResp, error) {
+	m := new(PlatformCleanToolsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformCleanCache(ctx context.Context, in *PlatformCleanCacheReq, opts ...grpc.CallOption) (*PlatformCleanCacheResp, error) {
+	out := new(PlatformCleanCacheResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/PlatformCleanCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *arduinoCoreClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[10], "/cc.arduino.cli.commands.ArduinoCore/PlatformUpgrade", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[14], "/cc.arduino.cli.commands.ArduinoCore/PlatformUpgrade", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2577,7 +3963,7 @@ func (x *arduinoCorePlatformUpgradeClient) Recv() (*PlatformUpgradeResp, error)
 }
 
 func (c *arduinoCoreClient) Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[11], "/cc.arduino.cli.commands.ArduinoCore/Upload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[15], "/cc.arduino.cli.commands.ArduinoCore/Upload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2608,6 +3994,38 @@ func (x *arduinoCoreUploadClient) Recv() (*UploadResp, error) {
 	return m, nil
 }
 
+func (c *arduinoCoreClient) UploadMultiple(ctx context.Context, in *UploadMultipleReq, opts ...grpc.CallOption) (ArduinoCore_UploadMultipleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[16], "/cc.arduino.cli.commands.ArduinoCore/UploadMultiple", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreUploadMultipleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_UploadMultipleClient interface {
+	Recv() (*UploadMultipleResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreUploadMultipleClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreUploadMultipleClient) Recv() (*UploadMultipleResp, error) {
+	m := new(UploadMultipleResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *arduinoCoreClient) ListProgrammersAvailableForUpload(ctx context.Context, in *ListProgrammersAvailableForUploadReq, opts ...grpc.CallOption) (*ListProgrammersAvailableForUploadResp, error) {
 	out := new(ListProgrammersAvailableForUploadResp)
 	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/ListProgrammersAvailableForUpload", in, out, opts...)
@@ -2618,7 +4036,7 @@ func (c *arduinoCoreClient) ListProgrammersAvailableForUpload(ctx context.Contex
 }
 
 func (c *arduinoCoreClient) BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[12], "/cc.arduino.cli.commands.ArduinoCore/BurnBootloader", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[17], "/cc.arduino.cli.commands.ArduinoCore/BurnBootloader", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2668,7 +4086,7 @@ func (c *arduinoCoreClient) PlatformList(ctx context.Context, in *PlatformListRe
 }
 
 func (c *arduinoCoreClient) LibraryDownload(ctx context.Context, in *LibraryDownloadReq, opts ...grpc.CallOption) (ArduinoCore_LibraryDownloadClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[13], "/cc.arduino.cli.commands.ArduinoCore/LibraryDownload", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[18], "/cc.arduino.cli.commands.ArduinoCore/LibraryDownload", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2700,7 +4118,7 @@ func (x *arduinoCoreLibraryDownloadClient) Recv() (*LibraryDownloadResp, error)
 }
 
 func (c *arduinoCoreClient) LibraryInstall(ctx context.Context, in *LibraryInstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryInstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[14], "/cc.arduino.cli.commands.ArduinoCore/LibraryInstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[19], "/cc.arduino.cli.commands.ArduinoCore/LibraryInstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2732,7 +4150,7 @@ func (x *arduinoCoreLibraryInstallClient) Recv() (*LibraryInstallResp, error) {
 }
 
 func (c *arduinoCoreClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUninstallClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[15], "/cc.arduino.cli.commands.ArduinoCore/LibraryUninstall", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[20], "/cc.arduino.cli.commands.ArduinoCore/LibraryUninstall", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2764,7 +4182,7 @@ func (x *arduinoCoreLibraryUninstallClient) Recv() (*LibraryUninstallResp, error
 }
 
 func (c *arduinoCoreClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUpgradeAllClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[16], "/cc.arduino.cli.commands.ArduinoCore/LibraryUpgradeAll", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ArduinoCore_serviceDesc.Streams[21], "/cc.arduino.cli.commands.ArduinoCore/LibraryUpgradeAll", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2804,18 +4222,36 @@ func (c *arduinoCoreClient) LibraryResolveDependencies(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *arduinoCoreClient) LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error) {
-	out := new(LibrarySearchResp)
-	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibrarySearch", in, out, opts...)
+func (c *arduinoCoreClient) LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error) {
+	out := new(LibrarySearchResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibrarySearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) LibraryList(ctx context.Context, in *LibraryListReq, opts ...grpc.CallOption) (*LibraryListResp, error) {
+	out := new(LibraryListResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibraryList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) LibraryCleanCache(ctx context.Context, in *LibraryCleanCacheReq, opts ...grpc.CallOption) (*LibraryCleanCacheResp, error) {
+	out := new(LibraryCleanCacheResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibraryCleanCache", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *arduinoCoreClient) LibraryList(ctx context.Context, in *LibraryListReq, opts ...grpc.CallOption) (*LibraryListResp, error) {
-	out := new(LibraryListResp)
-	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibraryList", in, out, opts...)
+func (c *arduinoCoreClient) LibraryExamples(ctx context.Context, in *LibraryExamplesReq, opts ...grpc.CallOption) (*LibraryExamplesResp, error) {
+	out := new(LibraryExamplesResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.commands.ArduinoCore/LibraryExamples", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2830,6 +4266,9 @@ type ArduinoCoreServer interface {
 	Destroy(context.Context, *DestroyReq) (*DestroyResp, error)
 	// Rescan instance of the Arduino Core Service
 	Rescan(context.Context, *RescanReq) (*RescanResp, error)
+	// Watch the libraries and platforms folders, rescanning the instance and
+	// sending an event every time they are changed outside the CLI
+	Watch(*WatchReq, ArduinoCore_WatchServer) error
 	// Update package index of the Arduino Core Service
 	UpdateIndex(*UpdateIndexReq, ArduinoCore_UpdateIndexServer) error
 	// Update libraries index
@@ -2842,12 +4281,26 @@ type ArduinoCoreServer interface {
 	Upgrade(*UpgradeReq, ArduinoCore_UpgradeServer) error
 	// Get the version of Arduino CLI in use.
 	Version(context.Context, *VersionReq) (*VersionResp, error)
+	// Check if a newer release of Arduino CLI is available. Fails if the check
+	// is disabled by the `updater.enable_check` setting.
+	CheckForUpdates(context.Context, *CheckForUpdatesReq) (*CheckForUpdatesResp, error)
+	// Download, verify and install the latest release of Arduino CLI, replacing
+	// the executable in use. The daemon keeps running the old release until it
+	// is restarted.
+	SelfUpdate(*SelfUpdateReq, ArduinoCore_SelfUpdateServer) error
+	// Stop the daemon, waiting for the calls in progress to end.
+	Shutdown(context.Context, *ShutdownReq) (*ShutdownResp, error)
 	// Returns all files composing a Sketch
 	LoadSketch(context.Context, *LoadSketchReq) (*LoadSketchResp, error)
 	// Creates a zip file containing all files of specified Sketch
 	ArchiveSketch(context.Context, *ArchiveSketchReq) (*ArchiveSketchResp, error)
+	// Creates a new Sketch from a template
+	NewSketch(context.Context, *NewSketchReq) (*NewSketchResp, error)
 	// Requests details about a board
 	BoardDetails(context.Context, *BoardDetailsReq) (*BoardDetailsResp, error)
+	// Validate the configuration options of an FQBN against the board's menus
+	// and return the FQBN with all the options set.
+	BoardNormalizeFQBN(context.Context, *BoardNormalizeFQBNReq) (*BoardNormalizeFQBNResp, error)
 	// Attach a board to a sketch. When the `fqbn` field of a request is not
 	// provided, the FQBN of the attached board will be used.
 	BoardAttach(*BoardAttachReq, ArduinoCore_BoardAttachServer) error
@@ -2855,20 +4308,39 @@ type ArduinoCoreServer interface {
 	BoardList(context.Context, *BoardListReq) (*BoardListResp, error)
 	// List all the boards provided by installed platforms.
 	BoardListAll(context.Context, *BoardListAllReq) (*BoardListAllResp, error)
+	// Set up the system to use a board: install the udev rules (Linux) or
+	// point to the drivers (Windows) declared by its platform.
+	BoardSetup(context.Context, *BoardSetupReq) (*BoardSetupResp, error)
 	// Compile an Arduino sketch.
 	Compile(*CompileReq, ArduinoCore_CompileServer) error
+	// Translate the .ino files of a sketch into the C++ source that is compiled,
+	// without compiling it.
+	Preprocess(context.Context, *PreprocessReq) (*PreprocessResp, error)
+	// Find the libraries used by a sketch and the includes that can't be
+	// resolved, without compiling it.
+	SketchIncludes(context.Context, *SketchIncludesReq) (*SketchIncludesResp, error)
 	// Download and install a platform and its tool dependencies.
 	PlatformInstall(*PlatformInstallReq, ArduinoCore_PlatformInstallServer) error
+	// Run the post-install script of an installed platform, streaming its
+	// output.
+	PlatformPostInstall(*PlatformPostInstallReq, ArduinoCore_PlatformPostInstallServer) error
 	// Download a platform and its tool dependencies to the `staging/packages`
 	// subdirectory of the data directory.
 	PlatformDownload(*PlatformDownloadReq, ArduinoCore_PlatformDownloadServer) error
 	// Uninstall a platform as well as its tool dependencies that are not used by
 	// other installed platforms.
 	PlatformUninstall(*PlatformUninstallReq, ArduinoCore_PlatformUninstallServer) error
+	// Uninstall the tools not required by any installed platform and remove
+	// from the tools store the archives no longer used by any tool.
+	PlatformCleanTools(*PlatformCleanToolsReq, ArduinoCore_PlatformCleanToolsServer) error
+	// Remove the platform and tool archives from the downloads directory.
+	PlatformCleanCache(context.Context, *PlatformCleanCacheReq) (*PlatformCleanCacheResp, error)
 	// Upgrade an installed platform to the latest version.
 	PlatformUpgrade(*PlatformUpgradeReq, ArduinoCore_PlatformUpgradeServer) error
 	// Upload a compiled sketch to an Arduino board.
 	Upload(*UploadReq, ArduinoCore_UploadServer) error
+	// Upload the same binary to many boards concurrently.
+	UploadMultiple(*UploadMultipleReq, ArduinoCore_UploadMultipleServer) error
 	ListProgrammersAvailableForUpload(context.Context, *ListProgrammersAvailableForUploadReq) (*ListProgrammersAvailableForUploadResp, error)
 	// Burn bootloader to a board.
 	BurnBootloader(*BurnBootloaderReq, ArduinoCore_BurnBootloaderServer) error
@@ -2892,6 +4364,11 @@ type ArduinoCoreServer interface {
 	LibrarySearch(context.Context, *LibrarySearchReq) (*LibrarySearchResp, error)
 	// List the installed libraries.
 	LibraryList(context.Context, *LibraryListReq) (*LibraryListResp, error)
+	// Remove the library archives from the downloads directory.
+	LibraryCleanCache(context.Context, *LibraryCleanCacheReq) (*LibraryCleanCacheResp, error)
+	// List the examples of the installed libraries, including the ones bundled
+	// with the platforms.
+	LibraryExamples(context.Context, *LibraryExamplesReq) (*LibraryExamplesResp, error)
 }
 
 // UnimplementedArduinoCoreServer can be embedded to have forward compatible implementations.
@@ -2907,6 +4384,9 @@ func (*UnimplementedArduinoCoreServer) Destroy(context.Context, *DestroyReq) (*D
 func (*UnimplementedArduinoCoreServer) Rescan(context.Context, *RescanReq) (*RescanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
 }
+func (*UnimplementedArduinoCoreServer) Watch(*WatchReq, ArduinoCore_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (*UnimplementedArduinoCoreServer) UpdateIndex(*UpdateIndexReq, ArduinoCore_UpdateIndexServer) error {
 	return status.Errorf(codes.Unimplemented, "method UpdateIndex not implemented")
 }
@@ -2925,15 +4405,30 @@ func (*UnimplementedArduinoCoreServer) Upgrade(*UpgradeReq, ArduinoCore_UpgradeS
 func (*UnimplementedArduinoCoreServer) Version(context.Context, *VersionReq) (*VersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
+func (*UnimplementedArduinoCoreServer) CheckForUpdates(context.Context, *CheckForUpdatesReq) (*CheckForUpdatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckForUpdates not implemented")
+}
+func (*UnimplementedArduinoCoreServer) SelfUpdate(*SelfUpdateReq, ArduinoCore_SelfUpdateServer) error {
+	return status.Errorf(codes.Unimplemented, "method SelfUpdate not implemented")
+}
+func (*UnimplementedArduinoCoreServer) Shutdown(context.Context, *ShutdownReq) (*ShutdownResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
 func (*UnimplementedArduinoCoreServer) LoadSketch(context.Context, *LoadSketchReq) (*LoadSketchResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadSketch not implemented")
 }
 func (*UnimplementedArduinoCoreServer) ArchiveSketch(context.Context, *ArchiveSketchReq) (*ArchiveSketchResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveSketch not implemented")
 }
+func (*UnimplementedArduinoCoreServer) NewSketch(context.Context, *NewSketchReq) (*NewSketchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewSketch not implemented")
+}
 func (*UnimplementedArduinoCoreServer) BoardDetails(context.Context, *BoardDetailsReq) (*BoardDetailsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardDetails not implemented")
 }
+func (*UnimplementedArduinoCoreServer) BoardNormalizeFQBN(context.Context, *BoardNormalizeFQBNReq) (*BoardNormalizeFQBNResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardNormalizeFQBN not implemented")
+}
 func (*UnimplementedArduinoCoreServer) BoardAttach(*BoardAttachReq, ArduinoCore_BoardAttachServer) error {
 	return status.Errorf(codes.Unimplemented, "method BoardAttach not implemented")
 }
@@ -2943,24 +4438,45 @@ func (*UnimplementedArduinoCoreServer) BoardList(context.Context, *BoardListReq)
 func (*UnimplementedArduinoCoreServer) BoardListAll(context.Context, *BoardListAllReq) (*BoardListAllResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BoardListAll not implemented")
 }
+func (*UnimplementedArduinoCoreServer) BoardSetup(context.Context, *BoardSetupReq) (*BoardSetupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardSetup not implemented")
+}
 func (*UnimplementedArduinoCoreServer) Compile(*CompileReq, ArduinoCore_CompileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
 }
+func (*UnimplementedArduinoCoreServer) Preprocess(context.Context, *PreprocessReq) (*PreprocessResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preprocess not implemented")
+}
+func (*UnimplementedArduinoCoreServer) SketchIncludes(context.Context, *SketchIncludesReq) (*SketchIncludesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SketchIncludes not implemented")
+}
 func (*UnimplementedArduinoCoreServer) PlatformInstall(*PlatformInstallReq, ArduinoCore_PlatformInstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
 }
+func (*UnimplementedArduinoCoreServer) PlatformPostInstall(*PlatformPostInstallReq, ArduinoCore_PlatformPostInstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformPostInstall not implemented")
+}
 func (*UnimplementedArduinoCoreServer) PlatformDownload(*PlatformDownloadReq, ArduinoCore_PlatformDownloadServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformDownload not implemented")
 }
 func (*UnimplementedArduinoCoreServer) PlatformUninstall(*PlatformUninstallReq, ArduinoCore_PlatformUninstallServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUninstall not implemented")
 }
+func (*UnimplementedArduinoCoreServer) PlatformCleanTools(*PlatformCleanToolsReq, ArduinoCore_PlatformCleanToolsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformCleanTools not implemented")
+}
+func (*UnimplementedArduinoCoreServer) PlatformCleanCache(context.Context, *PlatformCleanCacheReq) (*PlatformCleanCacheResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformCleanCache not implemented")
+}
 func (*UnimplementedArduinoCoreServer) PlatformUpgrade(*PlatformUpgradeReq, ArduinoCore_PlatformUpgradeServer) error {
 	return status.Errorf(codes.Unimplemented, "method PlatformUpgrade not implemented")
 }
 func (*UnimplementedArduinoCoreServer) Upload(*UploadReq, ArduinoCore_UploadServer) error {
 	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (*UnimplementedArduinoCoreServer) UploadMultiple(*UploadMultipleReq, ArduinoCore_UploadMultipleServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMultiple not implemented")
+}
 func (*UnimplementedArduinoCoreServer) ListProgrammersAvailableForUpload(context.Context, *ListProgrammersAvailableForUploadReq) (*ListProgrammersAvailableForUploadResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProgrammersAvailableForUpload not implemented")
 }
@@ -2994,6 +4510,12 @@ func (*UnimplementedArduinoCoreServer) LibrarySearch(context.Context, *LibrarySe
 func (*UnimplementedArduinoCoreServer) LibraryList(context.Context, *LibraryListReq) (*LibraryListResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
 }
+func (*UnimplementedArduinoCoreServer) LibraryCleanCache(context.Context, *LibraryCleanCacheReq) (*LibraryCleanCacheResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryCleanCache not implemented")
+}
+func (*UnimplementedArduinoCoreServer) LibraryExamples(context.Context, *LibraryExamplesReq) (*LibraryExamplesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryExamples not implemented")
+}
 
 func RegisterArduinoCoreServer(s *grpc.Server, srv ArduinoCoreServer) {
 	s.RegisterService(&_ArduinoCore_serviceDesc, srv)
@@ -3056,6 +4578,27 @@ func _ArduinoCore_Rescan_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).Watch(m, &arduinoCoreWatchServer{stream})
+}
+
+type ArduinoCore_WatchServer interface {
+	Send(*WatchResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreWatchServer) Send(m *WatchResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCore_UpdateIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(UpdateIndexReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3176,6 +4719,63 @@ func _ArduinoCore_Version_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_CheckForUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckForUpdatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).CheckForUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/CheckForUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).CheckForUpdates(ctx, req.(*CheckForUpdatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_SelfUpdate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SelfUpdateReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).SelfUpdate(m, &arduinoCoreSelfUpdateServer{stream})
+}
+
+type ArduinoCore_SelfUpdateServer interface {
+	Send(*SelfUpdateResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreSelfUpdateServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreSelfUpdateServer) Send(m *SelfUpdateResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).Shutdown(ctx, req.(*ShutdownReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_LoadSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoadSketchReq)
 	if err := dec(in); err != nil {
@@ -3212,6 +4812,24 @@ func _ArduinoCore_ArchiveSketch_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_NewSketch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewSketchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).NewSketch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/NewSketch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).NewSketch(ctx, req.(*NewSketchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_BoardDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BoardDetailsReq)
 	if err := dec(in); err != nil {
@@ -3230,6 +4848,24 @@ func _ArduinoCore_BoardDetails_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_BoardNormalizeFQBN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardNormalizeFQBNReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).BoardNormalizeFQBN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/BoardNormalizeFQBN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).BoardNormalizeFQBN(ctx, req.(*BoardNormalizeFQBNReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_BoardAttach_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(BoardAttachReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3287,6 +4923,24 @@ func _ArduinoCore_BoardListAll_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_BoardSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardSetupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).BoardSetup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/BoardSetup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).BoardSetup(ctx, req.(*BoardSetupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_Compile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CompileReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3308,6 +4962,42 @@ func (x *arduinoCoreCompileServer) Send(m *CompileResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_Preprocess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreprocessReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).Preprocess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/Preprocess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).Preprocess(ctx, req.(*PreprocessReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_SketchIncludes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SketchIncludesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).SketchIncludes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/SketchIncludes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).SketchIncludes(ctx, req.(*SketchIncludesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformInstallReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3329,6 +5019,27 @@ func (x *arduinoCorePlatformInstallServer) Send(m *PlatformInstallResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_PlatformPostInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformPostInstallReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformPostInstall(m, &arduinoCorePlatformPostInstallServer{stream})
+}
+
+type ArduinoCore_PlatformPostInstallServer interface {
+	Send(*PlatformPostInstallResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformPostInstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformPostInstallServer) Send(m *PlatformPostInstallResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCore_PlatformDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformDownloadReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3371,6 +5082,45 @@ func (x *arduinoCorePlatformUninstallServer) Send(m *PlatformUninstallResp) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_PlatformCleanTools_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformCleanToolsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformCleanTools(m, &arduinoCorePlatformCleanToolsServer{stream})
+}
+
+type ArduinoCore_PlatformCleanToolsServer interface {
+	Send(*PlatformCleanToolsResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformCleanToolsServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformCleanToolsServer) Send(m *PlatformCleanToolsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformCleanCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformCleanCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).PlatformCleanCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/PlatformCleanCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).PlatformCleanCache(ctx, req.(*PlatformCleanCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArduinoCore_PlatformUpgrade_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PlatformUpgradeReq)
 	if err := stream.RecvMsg(m); err != nil {
@@ -3413,6 +5163,27 @@ func (x *arduinoCoreUploadServer) Send(m *UploadResp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ArduinoCore_UploadMultiple_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UploadMultipleReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).UploadMultiple(m, &arduinoCoreUploadMultipleServer{stream})
+}
+
+type ArduinoCore_UploadMultipleServer interface {
+	Send(*UploadMultipleResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreUploadMultipleServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreUploadMultipleServer) Send(m *UploadMultipleResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ArduinoCore_ListProgrammersAvailableForUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProgrammersAvailableForUploadReq)
 	if err := dec(in); err != nil {
@@ -3626,6 +5397,42 @@ func _ArduinoCore_LibraryList_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArduinoCore_LibraryCleanCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryCleanCacheReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).LibraryCleanCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/LibraryCleanCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).LibraryCleanCache(ctx, req.(*LibraryCleanCacheReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_LibraryExamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryExamplesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).LibraryExamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.commands.ArduinoCore/LibraryExamples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).LibraryExamples(ctx, req.(*LibraryExamplesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cc.arduino.cli.commands.ArduinoCore",
 	HandlerType: (*ArduinoCoreServer)(nil),
@@ -3646,6 +5453,14 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _ArduinoCore_Version_Handler,
 		},
+		{
+			MethodName: "CheckForUpdates",
+			Handler:    _ArduinoCore_CheckForUpdates_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _ArduinoCore_Shutdown_Handler,
+		},
 		{
 			MethodName: "LoadSketch",
 			Handler:    _ArduinoCore_LoadSketch_Handler,
@@ -3654,10 +5469,18 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveSketch",
 			Handler:    _ArduinoCore_ArchiveSketch_Handler,
 		},
+		{
+			MethodName: "NewSketch",
+			Handler:    _ArduinoCore_NewSketch_Handler,
+		},
 		{
 			MethodName: "BoardDetails",
 			Handler:    _ArduinoCore_BoardDetails_Handler,
 		},
+		{
+			MethodName: "BoardNormalizeFQBN",
+			Handler:    _ArduinoCore_BoardNormalizeFQBN_Handler,
+		},
 		{
 			MethodName: "BoardList",
 			Handler:    _ArduinoCore_BoardList_Handler,
@@ -3666,6 +5489,22 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BoardListAll",
 			Handler:    _ArduinoCore_BoardListAll_Handler,
 		},
+		{
+			MethodName: "BoardSetup",
+			Handler:    _ArduinoCore_BoardSetup_Handler,
+		},
+		{
+			MethodName: "Preprocess",
+			Handler:    _ArduinoCore_Preprocess_Handler,
+		},
+		{
+			MethodName: "SketchIncludes",
+			Handler:    _ArduinoCore_SketchIncludes_Handler,
+		},
+		{
+			MethodName: "PlatformCleanCache",
+			Handler:    _ArduinoCore_PlatformCleanCache_Handler,
+		},
 		{
 			MethodName: "ListProgrammersAvailableForUpload",
 			Handler:    _ArduinoCore_ListProgrammersAvailableForUpload_Handler,
@@ -3690,6 +5529,14 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LibraryList",
 			Handler:    _ArduinoCore_LibraryList_Handler,
 		},
+		{
+			MethodName: "LibraryCleanCache",
+			Handler:    _ArduinoCore_LibraryCleanCache_Handler,
+		},
+		{
+			MethodName: "LibraryExamples",
+			Handler:    _ArduinoCore_LibraryExamples_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3697,6 +5544,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_Init_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Watch",
+			Handler:       _ArduinoCore_Watch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "UpdateIndex",
 			Handler:       _ArduinoCore_UpdateIndex_Handler,
@@ -3717,6 +5569,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_Upgrade_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SelfUpdate",
+			Handler:       _ArduinoCore_SelfUpdate_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BoardAttach",
 			Handler:       _ArduinoCore_BoardAttach_Handler,
@@ -3732,6 +5589,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_PlatformInstall_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PlatformPostInstall",
+			Handler:       _ArduinoCore_PlatformPostInstall_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformDownload",
 			Handler:       _ArduinoCore_PlatformDownload_Handler,
@@ -3742,6 +5604,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_PlatformUninstall_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PlatformCleanTools",
+			Handler:       _ArduinoCore_PlatformCleanTools_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PlatformUpgrade",
 			Handler:       _ArduinoCore_PlatformUpgrade_Handler,
@@ -3752,6 +5619,11 @@ var _ArduinoCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ArduinoCore_Upload_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "UploadMultiple",
+			Handler:       _ArduinoCore_UploadMultiple_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "BurnBootloader",
 			Handler:       _ArduinoCore_BurnBootloader_Handler,
@@ -52,19 +52,35 @@ type CompileReq struct {
 	Preprocess      bool      `protobuf:"varint,5,opt,name=preprocess,proto3" json:"preprocess,omitempty"`          // Print preprocessed code to stdout instead of compiling.
 	BuildCachePath  string    `protobuf:"bytes,6,opt,name=buildCachePath,proto3" json:"buildCachePath,omitempty"`   // Builds of 'core.a' are saved into this path to be cached and reused.
 	BuildPath       string    `protobuf:"bytes,7,opt,name=buildPath,proto3" json:"buildPath,omitempty"`             // Path to use to store the files used for the compilation. If omitted, a directory will be created in the operating system's default temporary path.
-	BuildProperties []string  `protobuf:"bytes,8,rep,name=buildProperties,proto3" json:"buildProperties,omitempty"` // List of custom build properties separated by commas.
-	Warnings        string    `protobuf:"bytes,9,opt,name=warnings,proto3" json:"warnings,omitempty"`               // Used to tell gcc which warning level to use. The level names are: "none", "default", "more" and "all".
+	BuildProperties []string  `protobuf:"bytes,8,rep,name=buildProperties,proto3" json:"buildProperties,omitempty"` // List of custom build properties, each one in the `key=value` form. They override the platform and board properties.
+	Warnings        string    `protobuf:"bytes,9,opt,name=warnings,proto3" json:"warnings,omitempty"`               // Used to tell gcc which warning level to use. The level names are: "none", "default", "more" and "all". If empty the `build.warnings` setting is used.
 	Verbose         bool      `protobuf:"varint,10,opt,name=verbose,proto3" json:"verbose,omitempty"`               // Turns on verbose mode.
 	Quiet           bool      `protobuf:"varint,11,opt,name=quiet,proto3" json:"quiet,omitempty"`                   // Suppresses almost every output.
 	VidPid          string    `protobuf:"bytes,12,opt,name=vidPid,proto3" json:"vidPid,omitempty"`                  // VID/PID specific build properties.
 	// Deprecated: Do not use.
-	ExportFile       string   `protobuf:"bytes,13,opt,name=exportFile,proto3" json:"exportFile,omitempty"`                // DEPRECATED: use exportDir instead
-	Jobs             int32    `protobuf:"varint,14,opt,name=jobs,proto3" json:"jobs,omitempty"`                           // The max number of concurrent compiler instances to run (as `make -jx`). If jobs is set to 0, it will use the number of available CPUs as the maximum.
-	Libraries        []string `protobuf:"bytes,15,rep,name=libraries,proto3" json:"libraries,omitempty"`                  // List of custom libraries paths separated by commas.
-	OptimizeForDebug bool     `protobuf:"varint,16,opt,name=optimizeForDebug,proto3" json:"optimizeForDebug,omitempty"`   // Optimize compile output for debug, not for release.
-	DryRun           bool     `protobuf:"varint,17,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                       // When set to `true` the compiled binary will not be copied to the export directory.
-	ExportDir        string   `protobuf:"bytes,18,opt,name=export_dir,json=exportDir,proto3" json:"export_dir,omitempty"` // Optional: save the build artifacts in this directory, the directory must exist.
-	Clean            bool     `protobuf:"varint,19,opt,name=clean,proto3" json:"clean,omitempty"`                         // Optional: cleanup the build folder and do not use any previously cached build
+	ExportFile                 string   `protobuf:"bytes,13,opt,name=exportFile,proto3" json:"exportFile,omitempty"`                                                                      // DEPRECATED: use exportDir instead
+	Jobs                       int32    `protobuf:"varint,14,opt,name=jobs,proto3" json:"jobs,omitempty"`                                                                                 // The max number of concurrent compiler instances to run (as `make -jx`). If jobs is set to 0, it will use the number of available CPUs as the maximum.
+	Libraries                  []string `protobuf:"bytes,15,rep,name=libraries,proto3" json:"libraries,omitempty"`                                                                        // List of folders containing libraries to add to the build, their libraries have priority over the installed ones.
+	OptimizeForDebug           bool     `protobuf:"varint,16,opt,name=optimizeForDebug,proto3" json:"optimizeForDebug,omitempty"`                                                         // Optimize compile output for debug, not for release.
+	DryRun                     bool     `protobuf:"varint,17,opt,name=dryRun,proto3" json:"dryRun,omitempty"`                                                                             // When set to `true` the compiled binary will not be copied to the export directory.
+	ExportDir                  string   `protobuf:"bytes,18,opt,name=export_dir,json=exportDir,proto3" json:"export_dir,omitempty"`                                                       // Optional: save the build artifacts in this directory, the directory must exist.
+	Clean                      bool     `protobuf:"varint,19,opt,name=clean,proto3" json:"clean,omitempty"`                                                                               // Optional: cleanup the build folder and do not use any previously cached build
+	OnlyCompilationDatabase    bool     `protobuf:"varint,20,opt,name=only_compilation_database,json=onlyCompilationDatabase,proto3" json:"only_compilation_database,omitempty"`          // Optional: do not compile anything, only write the `compile_commands.json` compilation database in the build path.
+	ExtraFlags                 []string `protobuf:"bytes,21,rep,name=extra_flags,json=extraFlags,proto3" json:"extra_flags,omitempty"`                                                    // Optional: flags passed to the compiler for C, C++ and assembly files, e.g. `-DDEBUG_LEVEL=3`. They are appended to the `compiler.c.extra_flags`, `compiler.cpp.extra_flags` and `compiler.S.extra_flags` build properties.
+	Profile                    string   `protobuf:"bytes,22,opt,name=profile,proto3" json:"profile,omitempty"`                                                                            // Optional: the profile of the `sketch.yaml` file to use, if empty the `default_profile` of the file is used, if any.
+	InstallProfileDependencies bool     `protobuf:"varint,23,opt,name=install_profile_dependencies,json=installProfileDependencies,proto3" json:"install_profile_dependencies,omitempty"` // Optional: install the platform and the libraries pinned by the profile if they are missing.
+	Library                    []string `protobuf:"bytes,24,rep,name=library,proto3" json:"library,omitempty"`                                                                            // Optional: list of single libraries root folders to add to the build, they have priority over the installed libraries.
+	SizeReportSymbols          int32    `protobuf:"varint,25,opt,name=size_report_symbols,json=sizeReportSymbols,proto3" json:"size_report_symbols,omitempty"`                            // Optional: the number of the largest functions and variables to add to the size report of the response, -1 to add all of them.
+	ArtifactsName              string   `protobuf:"bytes,26,opt,name=artifacts_name,json=artifactsName,proto3" json:"artifacts_name,omitempty"`                                           // Optional: the name of the exported artifacts, replacing `<sketch>.ino` (e.g. `{sketch}-{board}-{git}` gives `Blink-uno-v1.2.0.hex`). The placeholders `{sketch}`, `{fqbn}`, `{board}`, `{git}` (`git describe --tags --always --dirty` of the sketch folder) and `{timestamp}` (UTC build time, or `SOURCE_DATE_EPOCH` if set) are expanded.
+	GenerateMapFile            bool     `protobuf:"varint,27,opt,name=generate_map_file,json=generateMapFile,proto3" json:"generate_map_file,omitempty"`                                  // Optional: ask the linker for the map file of the sketch (`<sketch>.ino.map`) even if the platform doesn't produce it.
+	GenerateDisassembly        bool     `protobuf:"varint,28,opt,name=generate_disassembly,json=generateDisassembly,proto3" json:"generate_disassembly,omitempty"`                        // Optional: write the disassembly of the sketch, interleaved with the source code, in `<sketch>.ino.lst`. The platform may define `recipe.objdump.pattern` or `compiler.objdump.cmd`, otherwise the `objdump` of the toolchain is used.
+	AutoInstallDeps            bool     `protobuf:"varint,29,opt,name=auto_install_deps,json=autoInstallDeps,proto3" json:"auto_install_deps,omitempty"`                                  // Optional: before compiling, install the library of the library index providing each header of the sketch that can't be found, when exactly one library provides it.
+	BuildMetadata              bool     `protobuf:"varint,30,opt,name=build_metadata,json=buildMetadata,proto3" json:"build_metadata,omitempty"`                                          // Optional: define macros describing the build, e.g. `ARDUINO_CLI_BUILD_TIMESTAMP`, `ARDUINO_CLI_BUILD_GIT` (`git describe` of the sketch folder), `ARDUINO_CLI_BUILD_PROFILE` and `ARDUINO_CLI_VERSION`. The macros are taken from the `build.metadata` setting if set, in that case they are always defined.
+	BuildFs                    bool     `protobuf:"varint,31,opt,name=build_fs,json=buildFs,proto3" json:"build_fs,omitempty"`                                                            // Optional: build the image of the filesystem of the board (e.g. SPIFFS or LittleFS) with the content of the `data` folder of the sketch, using the `recipe.fs.pattern` of the platform. The image is `<sketch>.ino.fs.bin`, exported with the other artifacts.
+	Partitions                 string   `protobuf:"bytes,32,opt,name=partitions,proto3" json:"partitions,omitempty"`                                                                      // Optional: the partition table to use (e.g. `huge_app`), among the `partitions.<ID>` options declared by the platform or the board. It sets the build properties of the option, the ones given in `build_properties` override them.
+	LinkerScript               string   `protobuf:"bytes,33,opt,name=linker_script,json=linkerScript,proto3" json:"linker_script,omitempty"`                                              // Optional: the linker script to use, among the `linker_scripts.<ID>` options declared by the platform or the board. It sets the build properties of the option, the ones given in `build_properties` override them.
+	SignKey                    string   `protobuf:"bytes,34,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"`                                                             // Optional: the path of the key used to sign the executable of the sketch with the `recipe.hooks.postbuild.signing.pattern` hook of the platform. The path of the signed executable is returned in `signed_artifact`.
+	LogBuild                   string   `protobuf:"bytes,35,opt,name=log_build,json=logBuild,proto3" json:"log_build,omitempty"`                                                          // Optional: record every tool run by the build in this file, one JSON object per line with the recipe it comes from, the command line, the working directory, the environment variables set by the builder, the exit code and the duration. A recorded command can be run again with `arduino-cli build replay`.
 }
 
 func (x *CompileReq) Reset() {
@@ -233,32 +249,984 @@ func (x *CompileReq) GetClean() bool {
 	return false
 }
 
+func (x *CompileReq) GetOnlyCompilationDatabase() bool {
+	if x != nil {
+		return x.OnlyCompilationDatabase
+	}
+	return false
+}
+
+func (x *CompileReq) GetExtraFlags() []string {
+	if x != nil {
+		return x.ExtraFlags
+	}
+	return nil
+}
+
+func (x *CompileReq) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *CompileReq) GetInstallProfileDependencies() bool {
+	if x != nil {
+		return x.InstallProfileDependencies
+	}
+	return false
+}
+
+func (x *CompileReq) GetLibrary() []string {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *CompileReq) GetSizeReportSymbols() int32 {
+	if x != nil {
+		return x.SizeReportSymbols
+	}
+	return 0
+}
+
+func (x *CompileReq) GetArtifactsName() string {
+	if x != nil {
+		return x.ArtifactsName
+	}
+	return ""
+}
+
+func (x *CompileReq) GetGenerateMapFile() bool {
+	if x != nil {
+		return x.GenerateMapFile
+	}
+	return false
+}
+
+func (x *CompileReq) GetGenerateDisassembly() bool {
+	if x != nil {
+		return x.GenerateDisassembly
+	}
+	return false
+}
+
+func (x *CompileReq) GetAutoInstallDeps() bool {
+	if x != nil {
+		return x.AutoInstallDeps
+	}
+	return false
+}
+
+func (x *CompileReq) GetBuildMetadata() bool {
+	if x != nil {
+		return x.BuildMetadata
+	}
+	return false
+}
+
+func (x *CompileReq) GetBuildFs() bool {
+	if x != nil {
+		return x.BuildFs
+	}
+	return false
+}
+
+func (x *CompileReq) GetPartitions() string {
+	if x != nil {
+		return x.Partitions
+	}
+	return ""
+}
+
+func (x *CompileReq) GetLinkerScript() string {
+	if x != nil {
+		return x.LinkerScript
+	}
+	return ""
+}
+
+func (x *CompileReq) GetSignKey() string {
+	if x != nil {
+		return x.SignKey
+	}
+	return ""
+}
+
+func (x *CompileReq) GetLogBuild() string {
+	if x != nil {
+		return x.LogBuild
+	}
+	return ""
+}
+
 type CompileResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"` // The output of the compilation process.
-	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"` // The error output of the compilation process.
+	OutStream          []byte                `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`                             // The output of the compilation process.
+	ErrStream          []byte                `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`                             // The error output of the compilation process.
+	Diagnostics        []*CompilerDiagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`                                          // The errors, warnings and notes reported by the compiler, sent with the last message.
+	LibraryResolutions []*LibraryResolution  `protobuf:"bytes,4,rep,name=library_resolutions,json=libraryResolutions,proto3" json:"library_resolutions,omitempty"`  // The headers provided by more than one library and how they have been resolved.
+	Fqbn               string                `protobuf:"bytes,5,opt,name=fqbn,proto3" json:"fqbn,omitempty"`                                                        // The FQBN of the board the sketch has been built for, sent with the last message.
+	BuildPath          string                `protobuf:"bytes,6,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`                             // The directory containing the build artifacts, sent with the last message.
+	SizeReport         *SizeReport           `protobuf:"bytes,7,opt,name=size_report,json=sizeReport,proto3" json:"size_report,omitempty"`                          // The memory used by the compiled sketch, sent with the last message.
+	Artifacts          []string              `protobuf:"bytes,8,rep,name=artifacts,proto3" json:"artifacts,omitempty"`                                              // The paths of the artifacts copied to the export directory, sent with the last message.
+	MapFile            string                `protobuf:"bytes,9,opt,name=map_file,json=mapFile,proto3" json:"map_file,omitempty"`                                   // The path of the linker map file in the build directory, if produced, sent with the last message.
+	DisassemblyFile    string                `protobuf:"bytes,10,opt,name=disassembly_file,json=disassemblyFile,proto3" json:"disassembly_file,omitempty"`          // The path of the disassembly in the build directory, if requested, sent with the last message.
+	InstalledLibraries []string              `protobuf:"bytes,11,rep,name=installed_libraries,json=installedLibraries,proto3" json:"installed_libraries,omitempty"` // The libraries installed because of `auto_install_deps`, in the `NAME@VERSION` form, sent with the last message.
+	SignedArtifact     string                `protobuf:"bytes,12,opt,name=signed_artifact,json=signedArtifact,proto3" json:"signed_artifact,omitempty"`             // The path of the signed executable, if `sign_key` is given: the exported one, or the one in the build directory if it has not been exported. Sent with the last message.
+}
+
+func (x *CompileResp) Reset() {
+	*x = CompileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileResp) ProtoMessage() {}
+
+func (x *CompileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileResp.ProtoReflect.Descriptor instead.
+func (*CompileResp) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompileResp) GetOutStream() []byte {
+	if x != nil {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *CompileResp) GetErrStream() []byte {
+	if x != nil {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *CompileResp) GetDiagnostics() []*CompilerDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *CompileResp) GetLibraryResolutions() []*LibraryResolution {
+	if x != nil {
+		return x.LibraryResolutions
+	}
+	return nil
+}
+
+func (x *CompileResp) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *CompileResp) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *CompileResp) GetSizeReport() *SizeReport {
+	if x != nil {
+		return x.SizeReport
+	}
+	return nil
+}
+
+func (x *CompileResp) GetArtifacts() []string {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *CompileResp) GetMapFile() string {
+	if x != nil {
+		return x.MapFile
+	}
+	return ""
+}
+
+func (x *CompileResp) GetDisassemblyFile() string {
+	if x != nil {
+		return x.DisassemblyFile
+	}
+	return ""
+}
+
+func (x *CompileResp) GetInstalledLibraries() []string {
+	if x != nil {
+		return x.InstalledLibraries
+	}
+	return nil
+}
+
+func (x *CompileResp) GetSignedArtifact() string {
+	if x != nil {
+		return x.SignedArtifact
+	}
+	return ""
+}
+
+type PreprocessReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance        *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`                                      // Arduino Core Service instance from the `Init` response.
+	Fqbn            string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`                                              // Fully Qualified Board Name, e.g.: `arduino:avr:uno`. If this field is not defined, the FQBN of the board attached to the sketch is used.
+	SketchPath      string    `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`                // The path where the sketch is stored.
+	BuildPath       string    `protobuf:"bytes,4,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`                   // Optional: path to use to store the files used for the preprocessing. If omitted, the default build path of the sketch is used.
+	BuildProperties []string  `protobuf:"bytes,5,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty"` // List of custom build properties, each one in the `key=value` form.
+	Libraries       []string  `protobuf:"bytes,6,rep,name=libraries,proto3" json:"libraries,omitempty"`                                    // List of folders containing libraries to add to the build.
+	Library         []string  `protobuf:"bytes,7,rep,name=library,proto3" json:"library,omitempty"`                                        // Optional: list of single libraries root folders to add to the build.
+	Profile         string    `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`                                        // Optional: the profile of the `sketch.yaml` file to use.
+}
+
+func (x *PreprocessReq) Reset() {
+	*x = PreprocessReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreprocessReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreprocessReq) ProtoMessage() {}
+
+func (x *PreprocessReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreprocessReq.ProtoReflect.Descriptor instead.
+func (*PreprocessReq) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PreprocessReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *PreprocessReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *PreprocessReq) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *PreprocessReq) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *PreprocessReq) GetBuildProperties() []string {
+	if x != nil {
+		return x.BuildProperties
+	}
+	return nil
+}
+
+func (x *PreprocessReq) GetLibraries() []string {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *PreprocessReq) GetLibrary() []string {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *PreprocessReq) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type PreprocessResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // The name of the generated file, e.g. `Blink.ino.cpp`.
+	Source   string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`                     // The C++ source generated from the .ino files of the sketch, with the prototypes and the `#line` directives added by the preprocessor.
+}
+
+func (x *PreprocessResp) Reset() {
+	*x = PreprocessResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreprocessResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreprocessResp) ProtoMessage() {}
+
+func (x *PreprocessResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreprocessResp.ProtoReflect.Descriptor instead.
+func (*PreprocessResp) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreprocessResp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PreprocessResp) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type SketchIncludesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance        *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`                                      // Arduino Core Service instance from the `Init` response.
+	Fqbn            string    `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`                                              // Fully Qualified Board Name, e.g.: `arduino:avr:uno`. If this field is not defined, the FQBN of the board attached to the sketch is used.
+	SketchPath      string    `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`                // The path where the sketch is stored.
+	BuildPath       string    `protobuf:"bytes,4,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`                   // Optional: path to use to store the files used to find the includes. If omitted, the default build path of the sketch is used.
+	BuildProperties []string  `protobuf:"bytes,5,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty"` // List of custom build properties, each one in the `key=value` form.
+	Libraries       []string  `protobuf:"bytes,6,rep,name=libraries,proto3" json:"libraries,omitempty"`                                    // List of folders containing libraries to add to the build.
+	Library         []string  `protobuf:"bytes,7,rep,name=library,proto3" json:"library,omitempty"`                                        // Optional: list of single libraries root folders to add to the build.
+	Profile         string    `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`                                        // Optional: the profile of the `sketch.yaml` file to use.
+}
+
+func (x *SketchIncludesReq) Reset() {
+	*x = SketchIncludesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SketchIncludesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SketchIncludesReq) ProtoMessage() {}
+
+func (x *SketchIncludesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SketchIncludesReq.ProtoReflect.Descriptor instead.
+func (*SketchIncludesReq) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SketchIncludesReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *SketchIncludesReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *SketchIncludesReq) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *SketchIncludesReq) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *SketchIncludesReq) GetBuildProperties() []string {
+	if x != nil {
+		return x.BuildProperties
+	}
+	return nil
+}
+
+func (x *SketchIncludesReq) GetLibraries() []string {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *SketchIncludesReq) GetLibrary() []string {
+	if x != nil {
+		return x.Library
+	}
+	return nil
+}
+
+func (x *SketchIncludesReq) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type SketchIncludesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Libraries          []*LibraryResolution `protobuf:"bytes,1,rep,name=libraries,proto3" json:"libraries,omitempty"`                                             // The headers included by the sketch and by its libraries that are provided by a library, sorted by header.
+	UnresolvedIncludes []*UnresolvedInclude `protobuf:"bytes,2,rep,name=unresolved_includes,json=unresolvedIncludes,proto3" json:"unresolved_includes,omitempty"` // The headers not provided by the core or by any installed library.
+}
+
+func (x *SketchIncludesResp) Reset() {
+	*x = SketchIncludesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SketchIncludesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SketchIncludesResp) ProtoMessage() {}
+
+func (x *SketchIncludesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SketchIncludesResp.ProtoReflect.Descriptor instead.
+func (*SketchIncludesResp) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SketchIncludesResp) GetLibraries() []*LibraryResolution {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+func (x *SketchIncludesResp) GetUnresolvedIncludes() []*UnresolvedInclude {
+	if x != nil {
+		return x.UnresolvedIncludes
+	}
+	return nil
+}
+
+type UnresolvedInclude struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header             string   `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`                                                   // The header, as written in the `#include` directive.
+	File               string   `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`                                                       // The file including the header, in the build path.
+	SuggestedLibraries []string `protobuf:"bytes,3,rep,name=suggested_libraries,json=suggestedLibraries,proto3" json:"suggested_libraries,omitempty"` // The libraries of the library index that provide the header, by name, e.g. to be installed with `LibraryInstall`.
+}
+
+func (x *UnresolvedInclude) Reset() {
+	*x = UnresolvedInclude{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnresolvedInclude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnresolvedInclude) ProtoMessage() {}
+
+func (x *UnresolvedInclude) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnresolvedInclude.ProtoReflect.Descriptor instead.
+func (*UnresolvedInclude) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnresolvedInclude) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *UnresolvedInclude) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *UnresolvedInclude) GetSuggestedLibraries() []string {
+	if x != nil {
+		return x.SuggestedLibraries
+	}
+	return nil
+}
+
+type SizeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Memory   []*MemoryUsage `protobuf:"bytes,1,rep,name=memory,proto3" json:"memory,omitempty"`     // The usage of the memories of the board, as computed by the size recipe of the platform.
+	Sections []*SectionSize `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"` // The allocated sections of the executable, empty if it's not an ELF file.
+	Symbols  []*SymbolSize  `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`   // The largest functions and variables of the executable, as many as requested with `size_report_symbols`.
+}
+
+func (x *SizeReport) Reset() {
+	*x = SizeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeReport) ProtoMessage() {}
+
+func (x *SizeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeReport.ProtoReflect.Descriptor instead.
+func (*SizeReport) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SizeReport) GetMemory() []*MemoryUsage {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *SizeReport) GetSections() []*SectionSize {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SizeReport) GetSymbols() []*SymbolSize {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type MemoryUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                       // One of "flash", "ram" or "eeprom".
+	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                      // The bytes of the memory used by the sketch.
+	MaxSize int64  `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"` // The size of the memory, 0 if it's not defined by the board.
+}
+
+func (x *MemoryUsage) Reset() {
+	*x = MemoryUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsage) ProtoMessage() {}
+
+func (x *MemoryUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsage.ProtoReflect.Descriptor instead.
+func (*MemoryUsage) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MemoryUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoryUsage) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type SectionSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // The name of the section, e.g. `.text`.
+	Address uint64 `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"` // The address of the section.
+	Size    uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`       // The size of the section in bytes.
+}
+
+func (x *SectionSize) Reset() {
+	*x = SectionSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionSize) ProtoMessage() {}
+
+func (x *SectionSize) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionSize.ProtoReflect.Descriptor instead.
+func (*SectionSize) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SectionSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SectionSize) GetAddress() uint64 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *SectionSize) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SymbolSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`       // The name of the symbol, C++ names are not demangled.
+	Section string `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"` // The section containing the symbol.
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`       // Either "function" or "variable".
+	Size    uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`      // The size of the symbol in bytes.
+}
+
+func (x *SymbolSize) Reset() {
+	*x = SymbolSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SymbolSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolSize) ProtoMessage() {}
+
+func (x *SymbolSize) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolSize.ProtoReflect.Descriptor instead.
+func (*SymbolSize) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SymbolSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SymbolSize) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SymbolSize) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SymbolSize) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type LibraryResolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header  string             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`                  // The header included by the sketch or by a library.
+	Used    *ResolvedLibrary   `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`                      // The library used to provide the header.
+	NotUsed []*ResolvedLibrary `protobuf:"bytes,3,rep,name=not_used,json=notUsed,proto3" json:"not_used,omitempty"` // The other libraries providing the header.
+}
+
+func (x *LibraryResolution) Reset() {
+	*x = LibraryResolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LibraryResolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryResolution) ProtoMessage() {}
+
+func (x *LibraryResolution) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryResolution.ProtoReflect.Descriptor instead.
+func (*LibraryResolution) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LibraryResolution) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *LibraryResolution) GetUsed() *ResolvedLibrary {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *LibraryResolution) GetNotUsed() []*ResolvedLibrary {
+	if x != nil {
+		return x.NotUsed
+	}
+	return nil
+}
+
+type ResolvedLibrary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                       // The library's directory name.
+	Version    string          `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`                                                 // Value of the `version` field in library.properties.
+	InstallDir string          `protobuf:"bytes,3,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`                         // The path of the library directory.
+	Location   LibraryLocation `protobuf:"varint,4,opt,name=location,proto3,enum=cc.arduino.cli.commands.LibraryLocation" json:"location,omitempty"` // The location type of the library installation.
+	Reason     string          `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                   // For a library not used, why the used library has been preferred to it.
 }
 
-func (x *CompileResp) Reset() {
-	*x = CompileResp{}
+func (x *ResolvedLibrary) Reset() {
+	*x = ResolvedLibrary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_compile_proto_msgTypes[1]
+		mi := &file_commands_compile_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CompileResp) String() string {
+func (x *ResolvedLibrary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompileResp) ProtoMessage() {}
+func (*ResolvedLibrary) ProtoMessage() {}
 
-func (x *CompileResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_compile_proto_msgTypes[1]
+func (x *ResolvedLibrary) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,25 +1237,149 @@ func (x *CompileResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompileResp.ProtoReflect.Descriptor instead.
-func (*CompileResp) Descriptor() ([]byte, []int) {
-	return file_commands_compile_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ResolvedLibrary.ProtoReflect.Descriptor instead.
+func (*ResolvedLibrary) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CompileResp) GetOutStream() []byte {
+func (x *ResolvedLibrary) GetName() string {
 	if x != nil {
-		return x.OutStream
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CompileResp) GetErrStream() []byte {
+func (x *ResolvedLibrary) GetVersion() string {
 	if x != nil {
-		return x.ErrStream
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ResolvedLibrary) GetInstallDir() string {
+	if x != nil {
+		return x.InstallDir
+	}
+	return ""
+}
+
+func (x *ResolvedLibrary) GetLocation() LibraryLocation {
+	if x != nil {
+		return x.Location
+	}
+	return LibraryLocation_ide_builtin
+}
+
+func (x *ResolvedLibrary) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CompilerDiagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File     string                `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`          // The file where the problem was found.
+	Line     int32                 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`         // The line in the file, starting from 1.
+	Column   int32                 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`     // The column in the line, starting from 1. It's 0 if the compiler didn't report it.
+	Severity string                `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`  // One of "error", "warning" or "note", localized severities are reported verbatim.
+	Message  string                `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`    // The description of the problem.
+	Notes    []*CompilerDiagnostic `protobuf:"bytes,6,rep,name=notes,proto3" json:"notes,omitempty"`        // The notes that the compiler attached to this diagnostic.
+	Option   string                `protobuf:"bytes,7,opt,name=option,proto3" json:"option,omitempty"`      // The option that enabled the warning, e.g. `-Wunused-variable`, if reported by the compiler.
+	Promoted bool                  `protobuf:"varint,8,opt,name=promoted,proto3" json:"promoted,omitempty"` // True if the diagnostic is a warning treated as an error, e.g. because of `-Werror`.
+}
+
+func (x *CompilerDiagnostic) Reset() {
+	*x = CompilerDiagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_compile_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompilerDiagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompilerDiagnostic) ProtoMessage() {}
+
+func (x *CompilerDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_compile_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompilerDiagnostic.ProtoReflect.Descriptor instead.
+func (*CompilerDiagnostic) Descriptor() ([]byte, []int) {
+	return file_commands_compile_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CompilerDiagnostic) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *CompilerDiagnostic) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CompilerDiagnostic) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *CompilerDiagnostic) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *CompilerDiagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompilerDiagnostic) GetNotes() []*CompilerDiagnostic {
+	if x != nil {
+		return x.Notes
 	}
 	return nil
 }
 
+func (x *CompilerDiagnostic) GetOption() string {
+	if x != nil {
+		return x.Option
+	}
+	return ""
+}
+
+func (x *CompilerDiagnostic) GetPromoted() bool {
+	if x != nil {
+		return x.Promoted
+	}
+	return false
+}
+
 var File_commands_compile_proto protoreflect.FileDescriptor
 
 var file_commands_compile_proto_rawDesc = []byte{
@@ -295,54 +1387,253 @@ var file_commands_compile_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
 	0x73, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x04, 0x0a, 0x0a, 0x43, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61,
-	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6b,
-	0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68,
-	0x6f, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x76, 0x69, 0x64, 0x50, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x76, 0x69, 0x64, 0x50, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a,
-	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f,
-	0x62, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65,
-	0x46, 0x6f, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52,
-	0x75, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x22, 0x4b, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2f, 0x6c, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x09, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71,
+	0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26,
+	0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x50, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x69, 0x64, 0x50, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x46, 0x6f, 0x72, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x65, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x6e, 0x6c,
+	0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6f, 0x6e,
+	0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x69, 0x7a, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x31, 0x0a, 0x14, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61,
+	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62,
+	0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61,
+	0x75, 0x74, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x66,
+	0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x72, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0xae, 0x04,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4d, 0x0a, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x5b, 0x0a, 0x13, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x0b, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73,
+	0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x9f,
+	0x02, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x22, 0x45, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa3, 0x02, 0x0a, 0x11, 0x53, 0x6b, 0x65, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xbb, 0x01,
+	0x0a, 0x12, 0x53, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x5b,
+	0x0a, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x12, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x22, 0x70, 0x0a, 0x11, 0x55,
+	0x6e, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x13,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x73, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0xcb, 0x01,
+	0x0a, 0x0a, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x07,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x50, 0x0a, 0x0b, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4f, 0x0a,
+	0x0b, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x62,
+	0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x3c, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x43,
+	0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x12, 0x44, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x41, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x72, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -357,19 +1648,46 @@ func file_commands_compile_proto_rawDescGZIP() []byte {
 	return file_commands_compile_proto_rawDescData
 }
 
-var file_commands_compile_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_commands_compile_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_commands_compile_proto_goTypes = []interface{}{
-	(*CompileReq)(nil),  // 0: cc.arduino.cli.commands.CompileReq
-	(*CompileResp)(nil), // 1: cc.arduino.cli.commands.CompileResp
-	(*Instance)(nil),    // 2: cc.arduino.cli.commands.Instance
+	(*CompileReq)(nil),         // 0: cc.arduino.cli.commands.CompileReq
+	(*CompileResp)(nil),        // 1: cc.arduino.cli.commands.CompileResp
+	(*PreprocessReq)(nil),      // 2: cc.arduino.cli.commands.PreprocessReq
+	(*PreprocessResp)(nil),     // 3: cc.arduino.cli.commands.PreprocessResp
+	(*SketchIncludesReq)(nil),  // 4: cc.arduino.cli.commands.SketchIncludesReq
+	(*SketchIncludesResp)(nil), // 5: cc.arduino.cli.commands.SketchIncludesResp
+	(*UnresolvedInclude)(nil),  // 6: cc.arduino.cli.commands.UnresolvedInclude
+	(*SizeReport)(nil),         // 7: cc.arduino.cli.commands.SizeReport
+	(*MemoryUsage)(nil),        // 8: cc.arduino.cli.commands.MemoryUsage
+	(*SectionSize)(nil),        // 9: cc.arduino.cli.commands.SectionSize
+	(*SymbolSize)(nil),         // 10: cc.arduino.cli.commands.SymbolSize
+	(*LibraryResolution)(nil),  // 11: cc.arduino.cli.commands.LibraryResolution
+	(*ResolvedLibrary)(nil),    // 12: cc.arduino.cli.commands.ResolvedLibrary
+	(*CompilerDiagnostic)(nil), // 13: cc.arduino.cli.commands.CompilerDiagnostic
+	(*Instance)(nil),           // 14: cc.arduino.cli.commands.Instance
+	(LibraryLocation)(0),       // 15: cc.arduino.cli.commands.LibraryLocation
 }
 var file_commands_compile_proto_depIdxs = []int32{
-	2, // 0: cc.arduino.cli.commands.CompileReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	14, // 0: cc.arduino.cli.commands.CompileReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	13, // 1: cc.arduino.cli.commands.CompileResp.diagnostics:type_name -> cc.arduino.cli.commands.CompilerDiagnostic
+	11, // 2: cc.arduino.cli.commands.CompileResp.library_resolutions:type_name -> cc.arduino.cli.commands.LibraryResolution
+	7,  // 3: cc.arduino.cli.commands.CompileResp.size_report:type_name -> cc.arduino.cli.commands.SizeReport
+	14, // 4: cc.arduino.cli.commands.PreprocessReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	14, // 5: cc.arduino.cli.commands.SketchIncludesReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	11, // 6: cc.arduino.cli.commands.SketchIncludesResp.libraries:type_name -> cc.arduino.cli.commands.LibraryResolution
+	6,  // 7: cc.arduino.cli.commands.SketchIncludesResp.unresolved_includes:type_name -> cc.arduino.cli.commands.UnresolvedInclude
+	8,  // 8: cc.arduino.cli.commands.SizeReport.memory:type_name -> cc.arduino.cli.commands.MemoryUsage
+	9,  // 9: cc.arduino.cli.commands.SizeReport.sections:type_name -> cc.arduino.cli.commands.SectionSize
+	10, // 10: cc.arduino.cli.commands.SizeReport.symbols:type_name -> cc.arduino.cli.commands.SymbolSize
+	12, // 11: cc.arduino.cli.commands.LibraryResolution.used:type_name -> cc.arduino.cli.commands.ResolvedLibrary
+	12, // 12: cc.arduino.cli.commands.LibraryResolution.not_used:type_name -> cc.arduino.cli.commands.ResolvedLibrary
+	15, // 13: cc.arduino.cli.commands.ResolvedLibrary.location:type_name -> cc.arduino.cli.commands.LibraryLocation
+	13, // 14: cc.arduino.cli.commands.CompilerDiagnostic.notes:type_name -> cc.arduino.cli.commands.CompilerDiagnostic
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_commands_compile_proto_init() }
@@ -378,6 +1696,7 @@ func file_commands_compile_proto_init() {
 		return
 	}
 	file_commands_common_proto_init()
+	file_commands_lib_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_commands_compile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CompileReq); i {
@@ -403,6 +1722,150 @@ func file_commands_compile_proto_init() {
 				return nil
 			}
 		}
+		file_commands_compile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreprocessReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreprocessResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SketchIncludesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SketchIncludesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnresolvedInclude); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SymbolSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryResolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvedLibrary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_compile_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompilerDiagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -410,7 +1873,7 @@ func file_commands_compile_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commands_compile_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
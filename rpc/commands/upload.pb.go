@@ -55,7 +55,8 @@ type UploadReq struct {
 	// field is defined, the compiled binary is assumed to be at the location and
 	// filename under this path where it is saved by the `Compile` method.
 	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
-	// The port of the board.
+	// The port of the board. `serial://<serial number>` selects the port of
+	// the connected board with that serial number.
 	Port string `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
 	// Whether to turn on verbose output during the upload.
 	Verbose bool `protobuf:"varint,5,opt,name=verbose,proto3" json:"verbose,omitempty"`
@@ -63,12 +64,21 @@ type UploadReq struct {
 	// uploaded binary.
 	Verify bool `protobuf:"varint,6,opt,name=verify,proto3" json:"verify,omitempty"`
 	// When `import_file` is specified, it overrides the `import_dir` and `sketch_path`
-	// params.
+	// params. The file extension is ignored, the recipe picks the one it needs among
+	// the files with the same name (e.g. Blink.ino.hex, Blink.ino.bin). A directory
+	// may be given too, in this case it's used like `import_dir`.
 	ImportFile string `protobuf:"bytes,7,opt,name=import_file,json=importFile,proto3" json:"import_file,omitempty"`
 	// Custom path to a directory containing compiled files. When `import_dir` is
 	// not specified, the standard build directory under `sketch_path` is used.
 	ImportDir  string `protobuf:"bytes,8,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
 	Programmer string `protobuf:"bytes,9,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// After upload, wait for the board to re-enumerate and report the port
+	// where the uploaded sketch is running in the `port` field of the response.
+	DetectPortAfterUpload bool `protobuf:"varint,10,opt,name=detect_port_after_upload,json=detectPortAfterUpload,proto3" json:"detect_port_after_upload,omitempty"`
+	// Upload the image of the filesystem of the board (`<sketch>.ino.fs.bin`,
+	// built by `Compile` with `build_fs`) instead of the sketch, using the
+	// `upload_fs.pattern` of the upload tool.
+	Filesystem bool `protobuf:"varint,11,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
 }
 
 func (x *UploadReq) Reset() {
@@ -166,6 +176,20 @@ func (x *UploadReq) GetProgrammer() string {
 	return ""
 }
 
+func (x *UploadReq) GetDetectPortAfterUpload() bool {
+	if x != nil {
+		return x.DetectPortAfterUpload
+	}
+	return false
+}
+
+func (x *UploadReq) GetFilesystem() bool {
+	if x != nil {
+		return x.Filesystem
+	}
+	return false
+}
+
 type UploadResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -175,6 +199,13 @@ type UploadResp struct {
 	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
 	// The error output of the upload process.
 	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	// The port of the board after the upload, it may differ from the
+	// requested one if the board re-enumerated.
+	Port string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The progress of the upload, as reported by the upload tool. It's sent
+	// only for the tools whose output can be parsed (avrdude, bossac, esptool
+	// and openocd).
+	TaskProgress *TaskProgress `protobuf:"bytes,4,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
 }
 
 func (x *UploadResp) Reset() {
@@ -223,6 +254,313 @@ func (x *UploadResp) GetErrStream() []byte {
 	return nil
 }
 
+func (x *UploadResp) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *UploadResp) GetTaskProgress() *TaskProgress {
+	if x != nil {
+		return x.TaskProgress
+	}
+	return nil
+}
+
+type UploadMultipleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Arduino Core Service instance from the `Init` response.
+	Instance *Instance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Fully qualified board name of the target boards. If this field is not
+	// defined, the FQBN of the board attached to the sketch is used, or the
+	// board connected to each port is detected.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Path where the sketch to be uploaded is stored, see `UploadReq`.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// The ports of the boards, see `UploadReq`.
+	Ports []string `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Upload also to all the connected boards identified by this FQBN (e.g.,
+	// `arduino:avr:uno`), its configuration part is ignored.
+	AllMatching string `protobuf:"bytes,5,opt,name=all_matching,json=allMatching,proto3" json:"all_matching,omitempty"`
+	// Whether to turn on verbose output during the uploads.
+	Verbose bool `protobuf:"varint,6,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	// After upload, verify that the contents of the memory on the boards
+	// match the uploaded binary.
+	Verify bool `protobuf:"varint,7,opt,name=verify,proto3" json:"verify,omitempty"`
+	// The binary file to upload, see `UploadReq`.
+	ImportFile string `protobuf:"bytes,8,opt,name=import_file,json=importFile,proto3" json:"import_file,omitempty"`
+	// The directory containing the binaries to upload, see `UploadReq`.
+	ImportDir  string `protobuf:"bytes,9,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
+	Programmer string `protobuf:"bytes,10,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// The maximum number of uploads run at the same time, all the boards are
+	// uploaded at the same time if not set.
+	Jobs int32 `protobuf:"varint,11,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	// Upload the image of the filesystem instead of the sketch, see
+	// `UploadReq`.
+	Filesystem bool `protobuf:"varint,12,opt,name=filesystem,proto3" json:"filesystem,omitempty"`
+}
+
+func (x *UploadMultipleReq) Reset() {
+	*x = UploadMultipleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_upload_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMultipleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMultipleReq) ProtoMessage() {}
+
+func (x *UploadMultipleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_upload_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMultipleReq.ProtoReflect.Descriptor instead.
+func (*UploadMultipleReq) Descriptor() ([]byte, []int) {
+	return file_commands_upload_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadMultipleReq) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *UploadMultipleReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *UploadMultipleReq) GetAllMatching() string {
+	if x != nil {
+		return x.AllMatching
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *UploadMultipleReq) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+func (x *UploadMultipleReq) GetImportFile() string {
+	if x != nil {
+		return x.ImportFile
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetImportDir() string {
+	if x != nil {
+		return x.ImportDir
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetProgrammer() string {
+	if x != nil {
+		return x.Programmer
+	}
+	return ""
+}
+
+func (x *UploadMultipleReq) GetJobs() int32 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+func (x *UploadMultipleReq) GetFilesystem() bool {
+	if x != nil {
+		return x.Filesystem
+	}
+	return false
+}
+
+type UploadMultipleResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The port of the board the message refers to.
+	Port string `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	// The output of the upload process.
+	OutStream []byte `protobuf:"bytes,2,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
+	// The error output of the upload process.
+	ErrStream []byte `protobuf:"bytes,3,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	// The progress of the upload, see `UploadResp`.
+	TaskProgress *TaskProgress `protobuf:"bytes,4,opt,name=task_progress,json=taskProgress,proto3" json:"task_progress,omitempty"`
+	// Set when the upload to the port is finished.
+	Result *UploadPortResult `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *UploadMultipleResp) Reset() {
+	*x = UploadMultipleResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_upload_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadMultipleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMultipleResp) ProtoMessage() {}
+
+func (x *UploadMultipleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_upload_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMultipleResp.ProtoReflect.Descriptor instead.
+func (*UploadMultipleResp) Descriptor() ([]byte, []int) {
+	return file_commands_upload_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UploadMultipleResp) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *UploadMultipleResp) GetOutStream() []byte {
+	if x != nil {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *UploadMultipleResp) GetErrStream() []byte {
+	if x != nil {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *UploadMultipleResp) GetTaskProgress() *TaskProgress {
+	if x != nil {
+		return x.TaskProgress
+	}
+	return nil
+}
+
+func (x *UploadMultipleResp) GetResult() *UploadPortResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UploadPortResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the upload succeeded.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The error message if the upload failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UploadPortResult) Reset() {
+	*x = UploadPortResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_commands_upload_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPortResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPortResult) ProtoMessage() {}
+
+func (x *UploadPortResult) ProtoReflect() protoreflect.Message {
+	mi := &file_commands_upload_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPortResult.ProtoReflect.Descriptor instead.
+func (*UploadPortResult) Descriptor() ([]byte, []int) {
+	return file_commands_upload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UploadPortResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadPortResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type BurnBootloaderReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -246,7 +584,7 @@ type BurnBootloaderReq struct {
 func (x *BurnBootloaderReq) Reset() {
 	*x = BurnBootloaderReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_upload_proto_msgTypes[2]
+		mi := &file_commands_upload_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -259,7 +597,7 @@ func (x *BurnBootloaderReq) String() string {
 func (*BurnBootloaderReq) ProtoMessage() {}
 
 func (x *BurnBootloaderReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_upload_proto_msgTypes[2]
+	mi := &file_commands_upload_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +610,7 @@ func (x *BurnBootloaderReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BurnBootloaderReq.ProtoReflect.Descriptor instead.
 func (*BurnBootloaderReq) Descriptor() ([]byte, []int) {
-	return file_commands_upload_proto_rawDescGZIP(), []int{2}
+	return file_commands_upload_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BurnBootloaderReq) GetInstance() *Instance {
@@ -331,7 +669,7 @@ type BurnBootloaderResp struct {
 func (x *BurnBootloaderResp) Reset() {
 	*x = BurnBootloaderResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_upload_proto_msgTypes[3]
+		mi := &file_commands_upload_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -344,7 +682,7 @@ func (x *BurnBootloaderResp) String() string {
 func (*BurnBootloaderResp) ProtoMessage() {}
 
 func (x *BurnBootloaderResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_upload_proto_msgTypes[3]
+	mi := &file_commands_upload_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +695,7 @@ func (x *BurnBootloaderResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BurnBootloaderResp.ProtoReflect.Descriptor instead.
 func (*BurnBootloaderResp) Descriptor() ([]byte, []int) {
-	return file_commands_upload_proto_rawDescGZIP(), []int{3}
+	return file_commands_upload_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BurnBootloaderResp) GetOutStream() []byte {
@@ -386,7 +724,7 @@ type ListProgrammersAvailableForUploadReq struct {
 func (x *ListProgrammersAvailableForUploadReq) Reset() {
 	*x = ListProgrammersAvailableForUploadReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_upload_proto_msgTypes[4]
+		mi := &file_commands_upload_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +737,7 @@ func (x *ListProgrammersAvailableForUploadReq) String() string {
 func (*ListProgrammersAvailableForUploadReq) ProtoMessage() {}
 
 func (x *ListProgrammersAvailableForUploadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_upload_proto_msgTypes[4]
+	mi := &file_commands_upload_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +750,7 @@ func (x *ListProgrammersAvailableForUploadReq) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListProgrammersAvailableForUploadReq.ProtoReflect.Descriptor instead.
 func (*ListProgrammersAvailableForUploadReq) Descriptor() ([]byte, []int) {
-	return file_commands_upload_proto_rawDescGZIP(), []int{4}
+	return file_commands_upload_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListProgrammersAvailableForUploadReq) GetInstance() *Instance {
@@ -440,7 +778,7 @@ type ListProgrammersAvailableForUploadResp struct {
 func (x *ListProgrammersAvailableForUploadResp) Reset() {
 	*x = ListProgrammersAvailableForUploadResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_commands_upload_proto_msgTypes[5]
+		mi := &file_commands_upload_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +791,7 @@ func (x *ListProgrammersAvailableForUploadResp) String() string {
 func (*ListProgrammersAvailableForUploadResp) ProtoMessage() {}
 
 func (x *ListProgrammersAvailableForUploadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_commands_upload_proto_msgTypes[5]
+	mi := &file_commands_upload_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +804,7 @@ func (x *ListProgrammersAvailableForUploadResp) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ListProgrammersAvailableForUploadResp.ProtoReflect.Descriptor instead.
 func (*ListProgrammersAvailableForUploadResp) Descriptor() ([]byte, []int) {
-	return file_commands_upload_proto_rawDescGZIP(), []int{5}
+	return file_commands_upload_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListProgrammersAvailableForUploadResp) GetProgrammers() []*Programmer {
@@ -483,7 +821,7 @@ var file_commands_upload_proto_rawDesc = []byte{
 	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
 	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
 	0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x02, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x02, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f,
 	0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
@@ -501,48 +839,104 @@ var file_commands_upload_proto_rawDesc = []byte{
 	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12,
 	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x22,
-	0x4a, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
-	0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xcc, 0x01, 0x0a, 0x11,
-	0x42, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
-	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x66, 0x71, 0x62, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62,
-	0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x12, 0x42, 0x75,
-	0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x79,
-	0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72,
-	0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
-	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x6e, 0x0a, 0x25, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12,
+	0x37, 0x0a, 0x18, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xaa, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x03, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71,
+	0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xf5,
+	0x01, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f,
+	0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x42, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xcc, 0x01, 0x0a, 0x11, 0x42,
+	0x75, 0x72, 0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x71, 0x62, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f,
+	0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x12, 0x42, 0x75, 0x72,
+	0x6e, 0x42, 0x6f, 0x6f, 0x74, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x79, 0x0a,
+	0x24, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
 	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f,
-	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x22, 0x6e, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x45, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -557,27 +951,35 @@ func file_commands_upload_proto_rawDescGZIP() []byte {
 	return file_commands_upload_proto_rawDescData
 }
 
-var file_commands_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_commands_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_commands_upload_proto_goTypes = []interface{}{
 	(*UploadReq)(nil),                             // 0: cc.arduino.cli.commands.UploadReq
 	(*UploadResp)(nil),                            // 1: cc.arduino.cli.commands.UploadResp
-	(*BurnBootloaderReq)(nil),                     // 2: cc.arduino.cli.commands.BurnBootloaderReq
-	(*BurnBootloaderResp)(nil),                    // 3: cc.arduino.cli.commands.BurnBootloaderResp
-	(*ListProgrammersAvailableForUploadReq)(nil),  // 4: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
-	(*ListProgrammersAvailableForUploadResp)(nil), // 5: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
-	(*Instance)(nil),                              // 6: cc.arduino.cli.commands.Instance
-	(*Programmer)(nil),                            // 7: cc.arduino.cli.commands.Programmer
+	(*UploadMultipleReq)(nil),                     // 2: cc.arduino.cli.commands.UploadMultipleReq
+	(*UploadMultipleResp)(nil),                    // 3: cc.arduino.cli.commands.UploadMultipleResp
+	(*UploadPortResult)(nil),                      // 4: cc.arduino.cli.commands.UploadPortResult
+	(*BurnBootloaderReq)(nil),                     // 5: cc.arduino.cli.commands.BurnBootloaderReq
+	(*BurnBootloaderResp)(nil),                    // 6: cc.arduino.cli.commands.BurnBootloaderResp
+	(*ListProgrammersAvailableForUploadReq)(nil),  // 7: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq
+	(*ListProgrammersAvailableForUploadResp)(nil), // 8: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp
+	(*Instance)(nil),                              // 9: cc.arduino.cli.commands.Instance
+	(*TaskProgress)(nil),                          // 10: cc.arduino.cli.commands.TaskProgress
+	(*Programmer)(nil),                            // 11: cc.arduino.cli.commands.Programmer
 }
 var file_commands_upload_proto_depIdxs = []int32{
-	6, // 0: cc.arduino.cli.commands.UploadReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	6, // 1: cc.arduino.cli.commands.BurnBootloaderReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	6, // 2: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	7, // 3: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp.programmers:type_name -> cc.arduino.cli.commands.Programmer
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	9,  // 0: cc.arduino.cli.commands.UploadReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	10, // 1: cc.arduino.cli.commands.UploadResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	9,  // 2: cc.arduino.cli.commands.UploadMultipleReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	10, // 3: cc.arduino.cli.commands.UploadMultipleResp.task_progress:type_name -> cc.arduino.cli.commands.TaskProgress
+	4,  // 4: cc.arduino.cli.commands.UploadMultipleResp.result:type_name -> cc.arduino.cli.commands.UploadPortResult
+	9,  // 5: cc.arduino.cli.commands.BurnBootloaderReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	9,  // 6: cc.arduino.cli.commands.ListProgrammersAvailableForUploadReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	11, // 7: cc.arduino.cli.commands.ListProgrammersAvailableForUploadResp.programmers:type_name -> cc.arduino.cli.commands.Programmer
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_commands_upload_proto_init() }
@@ -612,7 +1014,7 @@ func file_commands_upload_proto_init() {
 			}
 		}
 		file_commands_upload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnBootloaderReq); i {
+			switch v := v.(*UploadMultipleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -624,7 +1026,7 @@ func file_commands_upload_proto_init() {
 			}
 		}
 		file_commands_upload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurnBootloaderResp); i {
+			switch v := v.(*UploadMultipleResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -636,7 +1038,7 @@ func file_commands_upload_proto_init() {
 			}
 		}
 		file_commands_upload_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListProgrammersAvailableForUploadReq); i {
+			switch v := v.(*UploadPortResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -648,6 +1050,42 @@ func file_commands_upload_proto_init() {
 			}
 		}
 		file_commands_upload_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnBootloaderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_upload_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnBootloaderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_upload_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProgrammersAvailableForUploadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_commands_upload_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListProgrammersAvailableForUploadResp); i {
 			case 0:
 				return &v.state
@@ -666,7 +1104,7 @@ func file_commands_upload_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_commands_upload_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
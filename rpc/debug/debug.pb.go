@@ -63,6 +63,8 @@ type DebugReq struct {
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	// Set this to true to send and Interrupt signal to the debugger process
 	SendInterrupt bool `protobuf:"varint,3,opt,name=send_interrupt,json=sendInterrupt,proto3" json:"send_interrupt,omitempty"`
+	// A GDB command to be injected in the debug session.
+	SendCommand *SendCommand `protobuf:"bytes,4,opt,name=send_command,json=sendCommand,proto3" json:"send_command,omitempty"`
 }
 
 func (x *DebugReq) Reset() {
@@ -118,6 +120,73 @@ func (x *DebugReq) GetSendInterrupt() bool {
 	return false
 }
 
+func (x *DebugReq) GetSendCommand() *SendCommand {
+	if x != nil {
+		return x.SendCommand
+	}
+	return nil
+}
+
+type SendCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier chosen by the client, the result of the command is sent back
+	// with the same identifier. Results are tracked only when one of the MI
+	// interpreters is in use.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The GDB command to execute, either a console command (e.g.
+	// `break main`) or an MI command (e.g. `-data-read-memory-bytes 0x0 4`).
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *SendCommand) Reset() {
+	*x = SendCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCommand) ProtoMessage() {}
+
+func (x *SendCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCommand.ProtoReflect.Descriptor instead.
+func (*SendCommand) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendCommand) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SendCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
 type DebugConfigReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,12 +213,23 @@ type DebugConfigReq struct {
 	// specified, the executable is assumed to be in
 	// `{sketch_path}/build/{fqbn}/`.
 	ImportDir string `protobuf:"bytes,8,opt,name=import_dir,json=importDir,proto3" json:"import_dir,omitempty"`
+	// The programmer to use for debugging. Its properties are merged with the
+	// board properties, so a programmer may also select a different
+	// `debug.tool`.
+	Programmer string `protobuf:"bytes,9,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// The RTOS running on the board (e.g. `FreeRTOS`, `Zephyr` or `auto`),
+	// enables thread-aware debugging in OpenOCD. It replaces the `debug.rtos`
+	// property of the board.
+	Rtos string `protobuf:"bytes,10,opt,name=rtos,proto3" json:"rtos,omitempty"`
+	// GDB commands run at the start of the debug session, after the ones
+	// defined by the `debug.gdb_init` properties of the board.
+	GdbInitCommands []string `protobuf:"bytes,11,rep,name=gdb_init_commands,json=gdbInitCommands,proto3" json:"gdb_init_commands,omitempty"`
 }
 
 func (x *DebugConfigReq) Reset() {
 	*x = DebugConfigReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[1]
+		mi := &file_debug_debug_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -162,7 +242,7 @@ func (x *DebugConfigReq) String() string {
 func (*DebugConfigReq) ProtoMessage() {}
 
 func (x *DebugConfigReq) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[1]
+	mi := &file_debug_debug_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +255,7 @@ func (x *DebugConfigReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugConfigReq.ProtoReflect.Descriptor instead.
 func (*DebugConfigReq) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{1}
+	return file_debug_debug_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DebugConfigReq) GetInstance() *commands.Instance {
@@ -228,6 +308,27 @@ func (x *DebugConfigReq) GetImportDir() string {
 	return ""
 }
 
+func (x *DebugConfigReq) GetProgrammer() string {
+	if x != nil {
+		return x.Programmer
+	}
+	return ""
+}
+
+func (x *DebugConfigReq) GetRtos() string {
+	if x != nil {
+		return x.Rtos
+	}
+	return ""
+}
+
+func (x *DebugConfigReq) GetGdbInitCommands() []string {
+	if x != nil {
+		return x.GdbInitCommands
+	}
+	return nil
+}
+
 //
 type DebugResp struct {
 	state         protoimpl.MessageState
@@ -238,12 +339,20 @@ type DebugResp struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	// Incoming error output from the debugger tool.
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The configuration of the debug session, sent only in the first message
+	// of the stream.
+	DebugConfig *GetDebugConfigResp `protobuf:"bytes,3,opt,name=debug_config,json=debugConfig,proto3" json:"debug_config,omitempty"`
+	// The result of a command injected through a `SendCommand` message.
+	CommandResult *CommandResult `protobuf:"bytes,4,opt,name=command_result,json=commandResult,proto3" json:"command_result,omitempty"`
+	// The ID of the debug session, sent only in the first message of the
+	// stream.
+	SessionId string `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
 func (x *DebugResp) Reset() {
 	*x = DebugResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_debug_debug_proto_msgTypes[2]
+		mi := &file_debug_debug_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +365,7 @@ func (x *DebugResp) String() string {
 func (*DebugResp) ProtoMessage() {}
 
 func (x *DebugResp) ProtoReflect() protoreflect.Message {
-	mi := &file_debug_debug_proto_msgTypes[2]
+	mi := &file_debug_debug_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +378,7 @@ func (x *DebugResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugResp.ProtoReflect.Descriptor instead.
 func (*DebugResp) Descriptor() ([]byte, []int) {
-	return file_debug_debug_proto_rawDescGZIP(), []int{2}
+	return file_debug_debug_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DebugResp) GetData() []byte {
@@ -279,11 +388,891 @@ func (x *DebugResp) GetData() []byte {
 	return nil
 }
 
-func (x *DebugResp) GetError() string {
-	if x != nil {
-		return x.Error
+func (x *DebugResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DebugResp) GetDebugConfig() *GetDebugConfigResp {
+	if x != nil {
+		return x.DebugConfig
+	}
+	return nil
+}
+
+func (x *DebugResp) GetCommandResult() *CommandResult {
+	if x != nil {
+		return x.CommandResult
+	}
+	return nil
+}
+
+func (x *DebugResp) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CommandResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the command as set in the `SendCommand` message.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The MI result record of the command without the token (e.g.
+	// `^done,value="0x20"` or `^error,msg="..."`).
+	Result string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CommandResult) Reset() {
+	*x = CommandResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandResult) ProtoMessage() {}
+
+func (x *CommandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandResult.ProtoReflect.Descriptor instead.
+func (*CommandResult) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CommandResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CommandResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type GetDebugConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The executable binary to debug.
+	Executable string `protobuf:"bytes,1,opt,name=executable,proto3" json:"executable,omitempty"`
+	// The name of the debug tool defined by the `debug.tool` board property.
+	Tool string `protobuf:"bytes,2,opt,name=tool,proto3" json:"tool,omitempty"`
+	// Path to the GDB executable.
+	GdbPath string `protobuf:"bytes,3,opt,name=gdb_path,json=gdbPath,proto3" json:"gdb_path,omitempty"`
+	// The GDB server type (e.g. `openocd`), empty if not declared by the
+	// platform.
+	Server string `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	// Path to the GDB server executable.
+	ServerPath string `protobuf:"bytes,5,opt,name=server_path,json=serverPath,proto3" json:"server_path,omitempty"`
+	// Command line arguments to pass to the GDB server.
+	ServerArgs []string `protobuf:"bytes,6,rep,name=server_args,json=serverArgs,proto3" json:"server_args,omitempty"`
+	// Path to the SVD file describing the target peripherals, if available.
+	SvdFile string `protobuf:"bytes,7,opt,name=svd_file,json=svdFile,proto3" json:"svd_file,omitempty"`
+	// The full command line that would be used to launch the debugger.
+	CommandLine []string `protobuf:"bytes,8,rep,name=command_line,json=commandLine,proto3" json:"command_line,omitempty"`
+	// The programmer selected for the debug session, if any.
+	Programmer string `protobuf:"bytes,9,opt,name=programmer,proto3" json:"programmer,omitempty"`
+	// The RTOS running on the board, if thread-aware debugging is enabled.
+	Rtos string `protobuf:"bytes,10,opt,name=rtos,proto3" json:"rtos,omitempty"`
+	// GDB commands to run at the start of the debug session.
+	GdbInitCommands []string `protobuf:"bytes,11,rep,name=gdb_init_commands,json=gdbInitCommands,proto3" json:"gdb_init_commands,omitempty"`
+}
+
+func (x *GetDebugConfigResp) Reset() {
+	*x = GetDebugConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDebugConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDebugConfigResp) ProtoMessage() {}
+
+func (x *GetDebugConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDebugConfigResp.ProtoReflect.Descriptor instead.
+func (*GetDebugConfigResp) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDebugConfigResp) GetExecutable() string {
+	if x != nil {
+		return x.Executable
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetGdbPath() string {
+	if x != nil {
+		return x.GdbPath
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetServerPath() string {
+	if x != nil {
+		return x.ServerPath
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetServerArgs() []string {
+	if x != nil {
+		return x.ServerArgs
+	}
+	return nil
+}
+
+func (x *GetDebugConfigResp) GetSvdFile() string {
+	if x != nil {
+		return x.SvdFile
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetCommandLine() []string {
+	if x != nil {
+		return x.CommandLine
+	}
+	return nil
+}
+
+func (x *GetDebugConfigResp) GetProgrammer() string {
+	if x != nil {
+		return x.Programmer
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetRtos() string {
+	if x != nil {
+		return x.Rtos
+	}
+	return ""
+}
+
+func (x *GetDebugConfigResp) GetGdbInitCommands() []string {
+	if x != nil {
+		return x.GdbInitCommands
+	}
+	return nil
+}
+
+type GetPeripheralsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A peripheral described in the SVD file.
+	Peripheral *Peripheral `protobuf:"bytes,1,opt,name=peripheral,proto3" json:"peripheral,omitempty"`
+}
+
+func (x *GetPeripheralsResp) Reset() {
+	*x = GetPeripheralsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeripheralsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeripheralsResp) ProtoMessage() {}
+
+func (x *GetPeripheralsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeripheralsResp.ProtoReflect.Descriptor instead.
+func (*GetPeripheralsResp) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPeripheralsResp) GetPeripheral() *Peripheral {
+	if x != nil {
+		return x.Peripheral
+	}
+	return nil
+}
+
+type Peripheral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the peripheral (e.g. `PORT`).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the peripheral.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Name of the group the peripheral belongs to, if any.
+	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	// Address where the registers of the peripheral start.
+	BaseAddress uint64 `protobuf:"varint,4,opt,name=base_address,json=baseAddress,proto3" json:"base_address,omitempty"`
+	// Registers of the peripheral.
+	Registers []*Register `protobuf:"bytes,5,rep,name=registers,proto3" json:"registers,omitempty"`
+}
+
+func (x *Peripheral) Reset() {
+	*x = Peripheral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peripheral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peripheral) ProtoMessage() {}
+
+func (x *Peripheral) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peripheral.ProtoReflect.Descriptor instead.
+func (*Peripheral) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Peripheral) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Peripheral) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Peripheral) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *Peripheral) GetBaseAddress() uint64 {
+	if x != nil {
+		return x.BaseAddress
+	}
+	return 0
+}
+
+func (x *Peripheral) GetRegisters() []*Register {
+	if x != nil {
+		return x.Registers
+	}
+	return nil
+}
+
+type Register struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the register. Registers grouped in clusters are prefixed with
+	// the name of the cluster (e.g. `CLUSTER.REGISTER`).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the register.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Offset of the register from the base address of the peripheral.
+	AddressOffset uint64 `protobuf:"varint,3,opt,name=address_offset,json=addressOffset,proto3" json:"address_offset,omitempty"`
+	// Size of the register in bits.
+	Size uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// Access rights of the register (e.g. `read-only`, `read-write`).
+	Access string `protobuf:"bytes,5,opt,name=access,proto3" json:"access,omitempty"`
+	// Value of the register after a reset.
+	ResetValue uint64 `protobuf:"varint,6,opt,name=reset_value,json=resetValue,proto3" json:"reset_value,omitempty"`
+	// Bit fields of the register.
+	Fields []*RegisterField `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *Register) Reset() {
+	*x = Register{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Register) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Register) ProtoMessage() {}
+
+func (x *Register) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Register.ProtoReflect.Descriptor instead.
+func (*Register) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Register) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Register) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Register) GetAddressOffset() uint64 {
+	if x != nil {
+		return x.AddressOffset
+	}
+	return 0
+}
+
+func (x *Register) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Register) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+func (x *Register) GetResetValue() uint64 {
+	if x != nil {
+		return x.ResetValue
+	}
+	return 0
+}
+
+func (x *Register) GetFields() []*RegisterField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type RegisterField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the field.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the field.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Position of the least significant bit of the field.
+	BitOffset uint32 `protobuf:"varint,3,opt,name=bit_offset,json=bitOffset,proto3" json:"bit_offset,omitempty"`
+	// Number of bits of the field.
+	BitWidth uint32 `protobuf:"varint,4,opt,name=bit_width,json=bitWidth,proto3" json:"bit_width,omitempty"`
+	// Access rights of the field.
+	Access string `protobuf:"bytes,5,opt,name=access,proto3" json:"access,omitempty"`
+}
+
+func (x *RegisterField) Reset() {
+	*x = RegisterField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterField) ProtoMessage() {}
+
+func (x *RegisterField) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterField.ProtoReflect.Descriptor instead.
+func (*RegisterField) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegisterField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RegisterField) GetBitOffset() uint32 {
+	if x != nil {
+		return x.BitOffset
+	}
+	return 0
+}
+
+func (x *RegisterField) GetBitWidth() uint32 {
+	if x != nil {
+		return x.BitWidth
+	}
+	return 0
+}
+
+func (x *RegisterField) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+type ListDebugSessionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListDebugSessionsReq) Reset() {
+	*x = ListDebugSessionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDebugSessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDebugSessionsReq) ProtoMessage() {}
+
+func (x *ListDebugSessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDebugSessionsReq.ProtoReflect.Descriptor instead.
+func (*ListDebugSessionsReq) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{10}
+}
+
+type ListDebugSessionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The running debug sessions.
+	Sessions []*DebugSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *ListDebugSessionsResp) Reset() {
+	*x = ListDebugSessionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDebugSessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDebugSessionsResp) ProtoMessage() {}
+
+func (x *ListDebugSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDebugSessionsResp.ProtoReflect.Descriptor instead.
+func (*ListDebugSessionsResp) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDebugSessionsResp) GetSessions() []*DebugSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type DebugSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the session.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// FQBN of the board being debugged as set in the request, may be empty if
+	// the FQBN attached to the sketch is used.
+	Fqbn string `protobuf:"bytes,2,opt,name=fqbn,proto3" json:"fqbn,omitempty"`
+	// Path of the sketch being debugged.
+	SketchPath string `protobuf:"bytes,3,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	// Port of the debugger.
+	Port string `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	// Start time of the session as a Unix timestamp.
+	StartedAt int64 `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+}
+
+func (x *DebugSession) Reset() {
+	*x = DebugSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugSession) ProtoMessage() {}
+
+func (x *DebugSession) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugSession.ProtoReflect.Descriptor instead.
+func (*DebugSession) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DebugSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DebugSession) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *DebugSession) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *DebugSession) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *DebugSession) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+type SignalDebugSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the session.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *SignalDebugSessionReq) Reset() {
+	*x = SignalDebugSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalDebugSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalDebugSessionReq) ProtoMessage() {}
+
+func (x *SignalDebugSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalDebugSessionReq.ProtoReflect.Descriptor instead.
+func (*SignalDebugSessionReq) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SignalDebugSessionReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type SignalDebugSessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SignalDebugSessionResp) Reset() {
+	*x = SignalDebugSessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalDebugSessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalDebugSessionResp) ProtoMessage() {}
+
+func (x *SignalDebugSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalDebugSessionResp.ProtoReflect.Descriptor instead.
+func (*SignalDebugSessionResp) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{14}
+}
+
+type TerminateDebugSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the session.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *TerminateDebugSessionReq) Reset() {
+	*x = TerminateDebugSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateDebugSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateDebugSessionReq) ProtoMessage() {}
+
+func (x *TerminateDebugSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateDebugSessionReq.ProtoReflect.Descriptor instead.
+func (*TerminateDebugSessionReq) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TerminateDebugSessionReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type TerminateDebugSessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TerminateDebugSessionResp) Reset() {
+	*x = TerminateDebugSessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_debug_debug_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerminateDebugSessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateDebugSessionResp) ProtoMessage() {}
+
+func (x *TerminateDebugSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_debug_debug_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateDebugSessionResp.ProtoReflect.Descriptor instead.
+func (*TerminateDebugSessionResp) Descriptor() ([]byte, []int) {
+	return file_debug_debug_proto_rawDescGZIP(), []int{16}
 }
 
 var File_debug_debug_proto protoreflect.FileDescriptor
@@ -293,7 +1282,7 @@ var file_debug_debug_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
 	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
 	0x6e, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x87, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a,
+	0x22, 0xcd, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a,
 	0x08, 0x64, 0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
 	0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66,
@@ -301,35 +1290,190 @@ var file_debug_debug_proto_rawDesc = []byte{
 	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
 	0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x72, 0x75, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x6e,
-	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x0e, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
-	0x65, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
-	0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x22, 0x35, 0x0a, 0x09, 0x44,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c,
+	0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x22, 0x37, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0xde, 0x02, 0x0a, 0x0e, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x3d, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x65, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x74,
+	0x6f, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x74, 0x6f, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x67, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x64, 0x62, 0x49, 0x6e,
+	0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x09, 0x44,
 	0x65, 0x62, 0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x32, 0x57, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x4e, 0x0a, 0x05, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2a, 0x5a, 0x28, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
-	0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70,
-	0x63, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x0c, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x4a, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0xdb, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f,
+	0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x64, 0x62, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x76, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x76, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x6e,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x74, 0x6f, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x74, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x69,
+	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x67, 0x64, 0x62, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x22, 0x56, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x69, 0x70,
+	0x68, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x70, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x69, 0x70, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x50, 0x65,
+	0x72, 0x69, 0x70, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf1,
+	0x01, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x62, 0x69, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x74,
+	0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69,
+	0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x16,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x57, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3e, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x86, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x18, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x39, 0x0a, 0x18, 0x54, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x32, 0x80, 0x05, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x4e, 0x0a, 0x05,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x1e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24,
+	0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x64, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x70, 0x68, 0x65, 0x72, 0x61,
+	0x6c, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72,
+	0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x70, 0x68, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x63,
+	0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64,
+	0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x15, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e,
+	0x63, 0x6c, 0x69, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -344,23 +1488,54 @@ func file_debug_debug_proto_rawDescGZIP() []byte {
 	return file_debug_debug_proto_rawDescData
 }
 
-var file_debug_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_debug_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_debug_debug_proto_goTypes = []interface{}{
-	(*DebugReq)(nil),          // 0: cc.arduino.cli.debug.DebugReq
-	(*DebugConfigReq)(nil),    // 1: cc.arduino.cli.debug.DebugConfigReq
-	(*DebugResp)(nil),         // 2: cc.arduino.cli.debug.DebugResp
-	(*commands.Instance)(nil), // 3: cc.arduino.cli.commands.Instance
+	(*DebugReq)(nil),                  // 0: cc.arduino.cli.debug.DebugReq
+	(*SendCommand)(nil),               // 1: cc.arduino.cli.debug.SendCommand
+	(*DebugConfigReq)(nil),            // 2: cc.arduino.cli.debug.DebugConfigReq
+	(*DebugResp)(nil),                 // 3: cc.arduino.cli.debug.DebugResp
+	(*CommandResult)(nil),             // 4: cc.arduino.cli.debug.CommandResult
+	(*GetDebugConfigResp)(nil),        // 5: cc.arduino.cli.debug.GetDebugConfigResp
+	(*GetPeripheralsResp)(nil),        // 6: cc.arduino.cli.debug.GetPeripheralsResp
+	(*Peripheral)(nil),                // 7: cc.arduino.cli.debug.Peripheral
+	(*Register)(nil),                  // 8: cc.arduino.cli.debug.Register
+	(*RegisterField)(nil),             // 9: cc.arduino.cli.debug.RegisterField
+	(*ListDebugSessionsReq)(nil),      // 10: cc.arduino.cli.debug.ListDebugSessionsReq
+	(*ListDebugSessionsResp)(nil),     // 11: cc.arduino.cli.debug.ListDebugSessionsResp
+	(*DebugSession)(nil),              // 12: cc.arduino.cli.debug.DebugSession
+	(*SignalDebugSessionReq)(nil),     // 13: cc.arduino.cli.debug.SignalDebugSessionReq
+	(*SignalDebugSessionResp)(nil),    // 14: cc.arduino.cli.debug.SignalDebugSessionResp
+	(*TerminateDebugSessionReq)(nil),  // 15: cc.arduino.cli.debug.TerminateDebugSessionReq
+	(*TerminateDebugSessionResp)(nil), // 16: cc.arduino.cli.debug.TerminateDebugSessionResp
+	(*commands.Instance)(nil),         // 17: cc.arduino.cli.commands.Instance
 }
 var file_debug_debug_proto_depIdxs = []int32{
-	1, // 0: cc.arduino.cli.debug.DebugReq.debugReq:type_name -> cc.arduino.cli.debug.DebugConfigReq
-	3, // 1: cc.arduino.cli.debug.DebugConfigReq.instance:type_name -> cc.arduino.cli.commands.Instance
-	0, // 2: cc.arduino.cli.debug.Debug.Debug:input_type -> cc.arduino.cli.debug.DebugReq
-	2, // 3: cc.arduino.cli.debug.Debug.Debug:output_type -> cc.arduino.cli.debug.DebugResp
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: cc.arduino.cli.debug.DebugReq.debugReq:type_name -> cc.arduino.cli.debug.DebugConfigReq
+	1,  // 1: cc.arduino.cli.debug.DebugReq.send_command:type_name -> cc.arduino.cli.debug.SendCommand
+	17, // 2: cc.arduino.cli.debug.DebugConfigReq.instance:type_name -> cc.arduino.cli.commands.Instance
+	5,  // 3: cc.arduino.cli.debug.DebugResp.debug_config:type_name -> cc.arduino.cli.debug.GetDebugConfigResp
+	4,  // 4: cc.arduino.cli.debug.DebugResp.command_result:type_name -> cc.arduino.cli.debug.CommandResult
+	7,  // 5: cc.arduino.cli.debug.GetPeripheralsResp.peripheral:type_name -> cc.arduino.cli.debug.Peripheral
+	8,  // 6: cc.arduino.cli.debug.Peripheral.registers:type_name -> cc.arduino.cli.debug.Register
+	9,  // 7: cc.arduino.cli.debug.Register.fields:type_name -> cc.arduino.cli.debug.RegisterField
+	12, // 8: cc.arduino.cli.debug.ListDebugSessionsResp.sessions:type_name -> cc.arduino.cli.debug.DebugSession
+	0,  // 9: cc.arduino.cli.debug.Debug.Debug:input_type -> cc.arduino.cli.debug.DebugReq
+	2,  // 10: cc.arduino.cli.debug.Debug.GetDebugConfig:input_type -> cc.arduino.cli.debug.DebugConfigReq
+	2,  // 11: cc.arduino.cli.debug.Debug.GetPeripherals:input_type -> cc.arduino.cli.debug.DebugConfigReq
+	10, // 12: cc.arduino.cli.debug.Debug.ListDebugSessions:input_type -> cc.arduino.cli.debug.ListDebugSessionsReq
+	13, // 13: cc.arduino.cli.debug.Debug.SignalDebugSession:input_type -> cc.arduino.cli.debug.SignalDebugSessionReq
+	15, // 14: cc.arduino.cli.debug.Debug.TerminateDebugSession:input_type -> cc.arduino.cli.debug.TerminateDebugSessionReq
+	3,  // 15: cc.arduino.cli.debug.Debug.Debug:output_type -> cc.arduino.cli.debug.DebugResp
+	5,  // 16: cc.arduino.cli.debug.Debug.GetDebugConfig:output_type -> cc.arduino.cli.debug.GetDebugConfigResp
+	6,  // 17: cc.arduino.cli.debug.Debug.GetPeripherals:output_type -> cc.arduino.cli.debug.GetPeripheralsResp
+	11, // 18: cc.arduino.cli.debug.Debug.ListDebugSessions:output_type -> cc.arduino.cli.debug.ListDebugSessionsResp
+	14, // 19: cc.arduino.cli.debug.Debug.SignalDebugSession:output_type -> cc.arduino.cli.debug.SignalDebugSessionResp
+	16, // 20: cc.arduino.cli.debug.Debug.TerminateDebugSession:output_type -> cc.arduino.cli.debug.TerminateDebugSessionResp
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_debug_debug_proto_init() }
@@ -382,7 +1557,7 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugConfigReq); i {
+			switch v := v.(*SendCommand); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -394,6 +1569,18 @@ func file_debug_debug_proto_init() {
 			}
 		}
 		file_debug_debug_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugResp); i {
 			case 0:
 				return &v.state
@@ -405,6 +1592,162 @@ func file_debug_debug_proto_init() {
 				return nil
 			}
 		}
+		file_debug_debug_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDebugConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeripheralsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peripheral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Register); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDebugSessionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDebugSessionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDebugSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignalDebugSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateDebugSessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_debug_debug_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TerminateDebugSessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -412,7 +1755,7 @@ func file_debug_debug_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_debug_debug_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -440,6 +1783,19 @@ const _ = grpc.SupportPackageIsVersion6
 type DebugClient interface {
 	// Start a debug session and communicate with the debugger tool.
 	Debug(ctx context.Context, opts ...grpc.CallOption) (Debug_DebugClient, error)
+	// Resolve the debugger configuration for the given request without
+	// starting a debug session.
+	GetDebugConfig(ctx context.Context, in *DebugConfigReq, opts ...grpc.CallOption) (*GetDebugConfigResp, error)
+	// Stream the peripherals and registers described by the SVD file of the
+	// board, to be used to show register views while debugging.
+	GetPeripherals(ctx context.Context, in *DebugConfigReq, opts ...grpc.CallOption) (Debug_GetPeripheralsClient, error)
+	// List the debug sessions running in the daemon.
+	ListDebugSessions(ctx context.Context, in *ListDebugSessionsReq, opts ...grpc.CallOption) (*ListDebugSessionsResp, error)
+	// Send an interrupt signal to the debugger of a running session.
+	SignalDebugSession(ctx context.Context, in *SignalDebugSessionReq, opts ...grpc.CallOption) (*SignalDebugSessionResp, error)
+	// Terminate a running debug session. The debugger is asked to quit and it's
+	// killed if it doesn't within the `debug.shutdown_timeout`.
+	TerminateDebugSession(ctx context.Context, in *TerminateDebugSessionReq, opts ...grpc.CallOption) (*TerminateDebugSessionResp, error)
 }
 
 type debugClient struct {
@@ -481,10 +1837,91 @@ func (x *debugDebugClient) Recv() (*DebugResp, error) {
 	return m, nil
 }
 
+func (c *debugClient) GetDebugConfig(ctx context.Context, in *DebugConfigReq, opts ...grpc.CallOption) (*GetDebugConfigResp, error) {
+	out := new(GetDebugConfigResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.debug.Debug/GetDebugConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetPeripherals(ctx context.Context, in *DebugConfigReq, opts ...grpc.CallOption) (Debug_GetPeripheralsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[1], "/cc.arduino.cli.debug.Debug/GetPeripherals", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugGetPeripheralsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_GetPeripheralsClient interface {
+	Recv() (*GetPeripheralsResp, error)
+	grpc.ClientStream
+}
+
+type debugGetPeripheralsClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugGetPeripheralsClient) Recv() (*GetPeripheralsResp, error) {
+	m := new(GetPeripheralsResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) ListDebugSessions(ctx context.Context, in *ListDebugSessionsReq, opts ...grpc.CallOption) (*ListDebugSessionsResp, error) {
+	out := new(ListDebugSessionsResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.debug.Debug/ListDebugSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SignalDebugSession(ctx context.Context, in *SignalDebugSessionReq, opts ...grpc.CallOption) (*SignalDebugSessionResp, error) {
+	out := new(SignalDebugSessionResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.debug.Debug/SignalDebugSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) TerminateDebugSession(ctx context.Context, in *TerminateDebugSessionReq, opts ...grpc.CallOption) (*TerminateDebugSessionResp, error) {
+	out := new(TerminateDebugSessionResp)
+	err := c.cc.Invoke(ctx, "/cc.arduino.cli.debug.Debug/TerminateDebugSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	// Start a debug session and communicate with the debugger tool.
 	Debug(Debug_DebugServer) error
+	// Resolve the debugger configuration for the given request without
+	// starting a debug session.
+	GetDebugConfig(context.Context, *DebugConfigReq) (*GetDebugConfigResp, error)
+	// Stream the peripherals and registers described by the SVD file of the
+	// board, to be used to show register views while debugging.
+	GetPeripherals(*DebugConfigReq, Debug_GetPeripheralsServer) error
+	// List the debug sessions running in the daemon.
+	ListDebugSessions(context.Context, *ListDebugSessionsReq) (*ListDebugSessionsResp, error)
+	// Send an interrupt signal to the debugger of a running session.
+	SignalDebugSession(context.Context, *SignalDebugSessionReq) (*SignalDebugSessionResp, error)
+	// Terminate a running debug session. The debugger is asked to quit and it's
+	// killed if it doesn't within the `debug.shutdown_timeout`.
+	TerminateDebugSession(context.Context, *TerminateDebugSessionReq) (*TerminateDebugSessionResp, error)
 }
 
 // UnimplementedDebugServer can be embedded to have forward compatible implementations.
@@ -494,6 +1931,21 @@ type UnimplementedDebugServer struct {
 func (*UnimplementedDebugServer) Debug(Debug_DebugServer) error {
 	return status.Errorf(codes.Unimplemented, "method Debug not implemented")
 }
+func (*UnimplementedDebugServer) GetDebugConfig(context.Context, *DebugConfigReq) (*GetDebugConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebugConfig not implemented")
+}
+func (*UnimplementedDebugServer) GetPeripherals(*DebugConfigReq, Debug_GetPeripheralsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetPeripherals not implemented")
+}
+func (*UnimplementedDebugServer) ListDebugSessions(context.Context, *ListDebugSessionsReq) (*ListDebugSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDebugSessions not implemented")
+}
+func (*UnimplementedDebugServer) SignalDebugSession(context.Context, *SignalDebugSessionReq) (*SignalDebugSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalDebugSession not implemented")
+}
+func (*UnimplementedDebugServer) TerminateDebugSession(context.Context, *TerminateDebugSessionReq) (*TerminateDebugSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateDebugSession not implemented")
+}
 
 func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
 	s.RegisterService(&_Debug_serviceDesc, srv)
@@ -525,10 +1977,120 @@ func (x *debugDebugServer) Recv() (*DebugReq, error) {
 	return m, nil
 }
 
+func _Debug_GetDebugConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetDebugConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.debug.Debug/GetDebugConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetDebugConfig(ctx, req.(*DebugConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetPeripherals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DebugConfigReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).GetPeripherals(m, &debugGetPeripheralsServer{stream})
+}
+
+type Debug_GetPeripheralsServer interface {
+	Send(*GetPeripheralsResp) error
+	grpc.ServerStream
+}
+
+type debugGetPeripheralsServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugGetPeripheralsServer) Send(m *GetPeripheralsResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_ListDebugSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDebugSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListDebugSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.debug.Debug/ListDebugSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListDebugSessions(ctx, req.(*ListDebugSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SignalDebugSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalDebugSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SignalDebugSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.debug.Debug/SignalDebugSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SignalDebugSession(ctx, req.(*SignalDebugSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_TerminateDebugSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateDebugSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).TerminateDebugSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cc.arduino.cli.debug.Debug/TerminateDebugSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).TerminateDebugSession(ctx, req.(*TerminateDebugSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cc.arduino.cli.debug.Debug",
 	HandlerType: (*DebugServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDebugConfig",
+			Handler:    _Debug_GetDebugConfig_Handler,
+		},
+		{
+			MethodName: "ListDebugSessions",
+			Handler:    _Debug_ListDebugSessions_Handler,
+		},
+		{
+			MethodName: "SignalDebugSession",
+			Handler:    _Debug_SignalDebugSession_Handler,
+		},
+		{
+			MethodName: "TerminateDebugSession",
+			Handler:    _Debug_TerminateDebugSession_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Debug",
@@ -536,6 +2098,11 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GetPeripherals",
+			Handler:       _Debug_GetPeripherals_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "debug/debug.proto",
 }
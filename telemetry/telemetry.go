@@ -19,17 +19,36 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/arduino/arduino-cli/inventory"
 	"github.com/segmentio/stats/v4"
 	"github.com/segmentio/stats/v4/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
-	"net/http"
 )
 
 // serverPattern is the telemetry endpoint resource path for consume metrics
 var serverPattern = "/metrics"
 
+// The metrics exposed by the telemetry server, each name is prefixed with the
+// prefix passed to Activate and converted to the Prometheus format, e.g.
+// `daemon_compile_duration_seconds`.
+const (
+	// commandsMetric counts the invocations of the commands, by command and result
+	commandsMetric = "command.invocations"
+	// compileMetric is the histogram of the compilations durations, by result
+	compileMetric = "compile.duration_seconds"
+	// downloadMetric counts the bytes downloaded for indexes, platforms, tools
+	// and libraries
+	downloadMetric = "download.bytes"
+	// rpcMetric is the histogram of the latencies of the daemon RPCs, by method
+	// and status code
+	rpcMetric = "rpc.duration_seconds"
+)
+
 // Activate configures and starts the telemetry server exposing a Prometheus resource
 func Activate(metricPrefix string) {
 	// Create a Prometheus default handler
@@ -38,6 +57,9 @@ func Activate(metricPrefix string) {
 	// and includes the installationID as a tag, then replace the default stats engine
 	stats.DefaultEngine = stats.WithPrefix(metricPrefix, stats.T("installationID",
 		inventory.Store.GetString("installation.id")))
+	// The buckets of the histograms, in seconds
+	stats.Buckets.Set(bucketKey(metricPrefix, compileMetric), 1, 5, 10, 30, 60, 120, 300, 600)
+	stats.Buckets.Set(bucketKey(metricPrefix, rpcMetric), .005, .01, .05, .1, .5, 1, 5, 10, 30, 60)
 	// Register the handler so it receives metrics from the default engine.
 	stats.Register(ph)
 
@@ -51,6 +73,50 @@ func Activate(metricPrefix string) {
 
 }
 
+// bucketKey returns the key of the histogram buckets of a metric, in the
+// "measure:field" form, the field being the last part of the metric name
+func bucketKey(metricPrefix, metric string) string {
+	measure, field := metricPrefix, metric
+	if i := strings.LastIndex(metric, "."); i != -1 {
+		measure += "." + metric[:i]
+		field = metric[i+1:]
+	}
+	return measure + ":" + field
+}
+
+func successTag(err error) stats.Tag {
+	if err != nil {
+		return stats.T("success", "false")
+	}
+	return stats.T("success", "true")
+}
+
+// The following functions record the metrics, they do nothing unless the
+// telemetry has been activated.
+
+// CommandInvoked counts an invocation of the command, err is the error
+// returned by the command if any.
+func CommandInvoked(command string, err error) {
+	stats.Incr(commandsMetric, stats.T("command", command), successTag(err))
+}
+
+// CompileDone records the duration of a compilation, err is the error
+// returned by the compilation if any.
+func CompileDone(duration time.Duration, err error) {
+	stats.Observe(compileMetric, duration.Seconds(), successTag(err))
+}
+
+// Downloaded counts the bytes of a completed download.
+func Downloaded(bytes int64) {
+	stats.Add(downloadMetric, bytes)
+}
+
+// RPCDone records the latency of a call to a method of the daemon, code is
+// the gRPC status code of the result, e.g. "OK".
+func RPCDone(method string, code string, duration time.Duration) {
+	stats.Observe(rpcMetric, duration.Seconds(), stats.T("method", method), stats.T("code", code))
+}
+
 // Sanitize uses config generated UUID (installation.secret) as an HMAC secret to sanitize and anonymize
 // a string, maintaining it distinguishable from a different string from the same Installation
 func Sanitize(s string) string {
@@ -111,6 +111,7 @@ def run_command(pytestconfig, data_dir, downloads_dir, working_dir):
         "ARDUINO_DATA_DIR": data_dir,
         "ARDUINO_DOWNLOADS_DIR": downloads_dir,
         "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "ARDUINO_TELEMETRY_ENABLED": "true",
     }
     (Path(data_dir) / "packages").mkdir()
 
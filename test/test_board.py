@@ -12,6 +12,8 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import os
+
 import pytest
 import simplejson as json
 
@@ -505,3 +507,109 @@ def test_board_details_list_programmers_flag(run_command):
     assert "edbg      Atmel EDBG" in lines
     assert "atmel_ice Atmel-ICE" in lines
     assert "sam_ice   Atmel SAM-ICE" in lines
+
+
+def test_board_details_config_options(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    result = run_command("board details arduino:avr:nano:cpu=atmega168 --format json")
+    assert result.ok
+    details = json.loads(result.stdout)
+    assert not details.get("debugging_supported", False)
+
+    cpu = [o for o in details["config_options"] if o["option"] == "cpu"]
+    assert len(cpu) == 1
+    selected = [v["value"] for v in cpu[0]["values"] if v.get("selected", False)]
+    assert selected == ["atmega168"]
+    assert details["normalized_fqbn"] == "arduino:avr:nano:cpu=atmega168"
+
+    # The missing options are set to their default value
+    result = run_command("board details arduino:avr:nano --format json")
+    assert result.ok
+    assert json.loads(result.stdout)["normalized_fqbn"] == "arduino:avr:nano:cpu=atmega328"
+
+
+def test_board_details_invalid_config_options(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    result = run_command("board details arduino:avr:nano:cpu=atmega999")
+    assert result.failed
+    assert "invalid value 'atmega999' for option 'cpu', valid values are: atmega328," in result.stderr
+
+    result = run_command("board details arduino:avr:nano:speed=fast")
+    assert result.failed
+    assert "invalid option 'speed', valid options are: cpu" in result.stderr
+
+    result = run_command("board details arduino:avr:uno:cpu=atmega328")
+    assert result.failed
+    assert "invalid option 'cpu', the board has no options" in result.stderr
+
+
+def test_board_listall_fuzzy(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    # Typos and sequences of characters match, the best matches first
+    result = run_command("board listall --fuzzy naon --format json")
+    assert result.ok
+    boards = json.loads(result.stdout)["boards"]
+    assert boards[0]["name"] == "Arduino Nano"
+    result = run_command("board listall --fuzzy ardmga --format json")
+    assert result.ok
+    boards = json.loads(result.stdout)["boards"]
+    assert "arduino:avr:mega" in [b["FQBN"] for b in boards]
+
+    # The config options are included only if requested
+    result = run_command("board listall nano --format json")
+    assert result.ok
+    assert "config_options" not in json.loads(result.stdout)["boards"][0]
+    result = run_command("board listall nano --config-options --format json")
+    assert result.ok
+    nano = [b for b in json.loads(result.stdout)["boards"] if b["FQBN"] == "arduino:avr:nano"][0]
+    cpu = [o for o in nano["config_options"] if o["option"] == "cpu"]
+    assert "atmega168" in [v["value"] for v in cpu[0]["values"]]
+
+
+def test_board_attach_fqbn_and_port(run_command, data_dir):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    sketch_path = os.path.join(data_dir, "BoardAttach")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    result = run_command("board attach arduino:avr:uno {} -p /dev/ttyACM0".format(sketch_path))
+    assert result.ok
+    with open(os.path.join(sketch_path, "sketch.json")) as f:
+        metadata = json.load(f)
+    assert metadata["cpu"] == {"fqbn": "arduino:avr:uno", "name": "Arduino Uno", "port": "serial:///dev/ttyACM0"}
+
+    # Attaching another board keeps the port
+    result = run_command("board attach arduino:avr:nano {}".format(sketch_path))
+    assert result.ok
+    with open(os.path.join(sketch_path, "sketch.json")) as f:
+        metadata = json.load(f)
+    assert metadata["cpu"]["fqbn"] == "arduino:avr:nano"
+    assert metadata["cpu"]["port"] == "serial:///dev/ttyACM0"
+
+    # The attached board is used when compiling
+    result = run_command("compile {}".format(sketch_path))
+    assert result.ok
+
+
+def test_board_details_list_programmers(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    result = run_command("board details -b arduino:avr:uno --format json")
+    assert result.ok
+    programmers = json.loads(result.stdout)["programmers"]
+    ids = [p["id"] for p in programmers]
+    assert ids == sorted(ids)
+    usbasp = [p for p in programmers if p["id"] == "usbasp"][0]
+    assert usbasp["tool"] == "avrdude"
+
+    result = run_command("board details -b arduino:avr:uno --list-programmers")
+    assert result.ok
+    assert "avrdude" in result.stdout
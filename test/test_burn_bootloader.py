@@ -0,0 +1,39 @@
+# This file is part of arduino-cli.
+#
+# Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+#
+# This software is released under the GNU General Public License version 3,
+# which covers the main part of arduino-cli.
+# The terms of this license can be found at:
+# https://www.gnu.org/licenses/gpl-3.0.en.html
+#
+# You can be released from the requirements of the above licenses by purchasing
+# a commercial license. Buying such a license is mandatory if you want to modify or
+# otherwise use the software for commercial activities involving the Arduino
+# software without disclosing the source code of your own applications. To purchase
+# a commercial license, send an email to license@arduino.cc.
+import simplejson as json
+
+
+def test_burn_bootloader_list_programmers(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    result = run_command("burn-bootloader -b arduino:avr:uno -P list")
+    assert result.ok
+    assert "usbasp" in result.stdout
+    assert "arduinoasisp" in result.stdout
+
+    result = run_command("burn-bootloader -b arduino:avr:uno -P list --format json")
+    assert result.ok
+    programmers = json.loads(result.stdout)
+    assert "avrisp" in [p["id"] for p in programmers]
+
+
+def test_burn_bootloader_without_programmer(run_command):
+    run_command("core update-index")
+    run_command("core install arduino:avr@1.8.3")
+
+    result = run_command("burn-bootloader -b arduino:avr:uno")
+    assert result.failed
+    assert "no programmer specified for burning bootloader" in result.stderr
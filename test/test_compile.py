@@ -12,8 +12,10 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import json
 import os
 import platform
+from pathlib import Path
 
 import pytest
 
@@ -99,6 +101,109 @@ def test_output_flag_default_path(run_command, data_dir, working_dir):
     assert os.path.exists(target) and os.path.isdir(target)
 
 
+def test_compile_with_artifacts_name(run_command, data_dir, downloads_dir):
+    # Init the environment explicitly
+    run_command("core update-index")
+
+    # Install Arduino AVR Boards
+    run_command("core install arduino:avr@1.8.3")
+
+    # Create a test sketch
+    sketch_name = "CompileWithArtifactsName"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    fqbn = "arduino:avr:uno"
+    assert run_command(f"sketch new {sketch_path}")
+
+    # The artifacts are renamed using the template
+    target = os.path.join(data_dir, "artifacts")
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "SOURCE_DATE_EPOCH": "1615818301",
+    }
+    res = run_command(
+        f"compile -b {fqbn} {sketch_path} --output-dir {target} "
+        + '--artifacts-name "{sketch}-{board}-{timestamp}" --format json',
+        custom_env=env,
+    )
+    assert res.ok
+    name = f"{sketch_name}-uno-20210315-142501"
+    for ext in ["hex", "with_bootloader.hex", "elf"]:
+        assert os.path.exists(os.path.join(target, f"{name}.{ext}"))
+    compile_output = json.loads(res.stdout)
+    assert os.path.join(target, f"{name}.hex") in compile_output["artifacts"]
+
+    # Unknown placeholders are rejected before building
+    res = run_command(f"compile -b {fqbn} {sketch_path} --output-dir {target} --artifacts-name {{version}}")
+    assert res.failed
+    assert "unknown placeholder {version}" in res.stderr
+
+
+def test_compile_with_auto_install_deps(run_command, data_dir):
+    assert run_command("update")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_name = "CompileWithAutoInstallDeps"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    fqbn = "arduino:avr:uno"
+    assert run_command(f"sketch new {sketch_path}")
+    with open(os.path.join(sketch_path, sketch_name + ".ino"), "w") as f:
+        f.write("#include <Servo.h>\nServo servo;\nvoid setup() { servo.attach(9); }\nvoid loop() {}\n")
+
+    # Without the flag the missing library makes the build fail
+    result = run_command(f"compile -b {fqbn} {sketch_path}")
+    assert result.failed
+    assert "Servo.h" in result.stderr
+
+    result = run_command(f"compile -b {fqbn} {sketch_path} --auto-install-deps --format json")
+    assert result.ok
+    res = json.loads(result.stdout)
+    assert res["success"]
+    assert len(res["installed_libraries"]) == 1
+    assert res["installed_libraries"][0].startswith("Servo@")
+    assert "Servo" in [lib["library"]["name"] for lib in json.loads(run_command("lib list --format json").stdout)]
+
+    # Nothing is installed once the library is there
+    result = run_command(f"compile -b {fqbn} {sketch_path} --auto-install-deps --format json")
+    assert result.ok
+    assert "installed_libraries" not in json.loads(result.stdout)
+
+
+def test_compile_with_map_file_and_disassembly(run_command, data_dir):
+    # Init the environment explicitly
+    run_command("core update-index")
+
+    # Install Arduino AVR Boards
+    run_command("core install arduino:avr@1.8.3")
+
+    # Create a test sketch
+    sketch_name = "CompileWithMapFileAndDisassembly"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    fqbn = "arduino:avr:uno"
+    assert run_command(f"sketch new {sketch_path}")
+
+    target = os.path.join(data_dir, "artifacts")
+    res = run_command(f"compile -b {fqbn} {sketch_path} --output-dir {target} --map-file --disassemble --format json")
+    assert res.ok
+    compile_output = json.loads(res.stdout)
+    map_file = compile_output["map_file"]
+    assert map_file.endswith(f"{sketch_name}.ino.map")
+    assert "Memory Configuration" in open(map_file).read()
+    disassembly_file = compile_output["disassembly_file"]
+    assert disassembly_file.endswith(f"{sketch_name}.ino.lst")
+    assert "<setup>:" in open(disassembly_file).read()
+    assert os.path.exists(os.path.join(target, f"{sketch_name}.ino.map"))
+    assert os.path.exists(os.path.join(target, f"{sketch_name}.ino.lst"))
+
+    # The files of the previous build are removed when not requested
+    res = run_command(f"compile -b {fqbn} {sketch_path} --format json")
+    assert res.ok
+    compile_output = json.loads(res.stdout)
+    assert "map_file" not in compile_output
+    assert "disassembly_file" not in compile_output
+
+
 def test_compile_with_sketch_with_symlink_selfloop(run_command, data_dir):
     # Init the environment explicitly
     run_command("core update-index")
@@ -233,3 +338,567 @@ def test_compile_without_precompiled_libraries(run_command, data_dir):
         "compile -b arduino:mbed:nano33ble {}/libraries/BSEC_Software_Library/examples/basic/".format(data_dir)
     )
     assert result.ok
+
+
+def test_compile_only_compilation_database(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "CompileOnlyCompilationDatabase"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    build_path = os.path.join(data_dir, "build")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    result = run_command(
+        "compile -b arduino:avr:uno --only-compilation-database --build-path {} {}".format(build_path, sketch_path)
+    )
+    assert result.ok
+
+    # Nothing has been compiled or linked
+    assert not os.path.exists(os.path.join(build_path, "{}.ino.elf".format(sketch_name)))
+    assert not os.path.exists(os.path.join(build_path, "{}.ino.hex".format(sketch_name)))
+
+    compile_commands = os.path.join(build_path, "compile_commands.json")
+    assert os.path.exists(compile_commands)
+    with open(compile_commands) as f:
+        commands = json.load(f)
+    files = [c["file"] for c in commands]
+    assert os.path.join(build_path, "sketch", "{}.ino.cpp".format(sketch_name)) in files
+    assert any(f.endswith("main.cpp") for f in files)
+    for c in commands:
+        assert c["directory"]
+        assert c["arguments"]
+
+    # Uploading makes no sense without a binary
+    result = run_command("compile -b arduino:avr:uno --only-compilation-database -u {}".format(sketch_path))
+    assert result.failed
+
+
+def test_compile_diagnostics_json(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "CompileDiagnostics"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    sketch_file = os.path.join(sketch_path, "{}.ino".format(sketch_name))
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(sketch_file, "w") as f:
+        f.write("void setup() {\n  int unused;\n}\n\nvoid loop() {\n  undeclared();\n}\n")
+
+    result = run_command("compile -b arduino:avr:uno --warnings all --format json {}".format(sketch_path))
+    assert result.failed
+    res = json.loads(result.stdout)
+    assert not res["success"]
+    assert res["compiler_err"]
+
+    errors = [d for d in res["diagnostics"] if d["severity"] == "error"]
+    assert len(errors) == 1
+    assert errors[0]["file"] == sketch_file
+    assert errors[0]["line"] == 6
+    assert "undeclared" in errors[0]["message"]
+
+    warnings = [d for d in res["diagnostics"] if d["severity"] == "warning"]
+    assert len(warnings) == 1
+    assert warnings[0]["line"] == 2
+    assert warnings[0]["option"] == "-Wunused-variable"
+    assert "promoted" not in warnings[0]
+
+    # The warning treated as error is marked as promoted
+    result = run_command(
+        "compile -b arduino:avr:uno --warnings all --build-extra-flags -Werror=unused-variable --format json {}".format(
+            sketch_path
+        )
+    )
+    assert result.failed
+    res = json.loads(result.stdout)
+    promoted = [d for d in res["diagnostics"] if d.get("promoted")]
+    assert len(promoted) == 1
+    assert promoted[0]["severity"] == "error"
+    assert promoted[0]["option"] == "-Wunused-variable"
+    assert promoted[0]["line"] == 2
+
+    result = run_command("compile -b arduino:avr:uno --warnings some {}".format(sketch_path))
+    assert result.failed
+    assert "invalid warnings level 'some'" in result.stderr
+
+
+def test_compile_with_build_property(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_path = os.path.join(data_dir, "CompileBuildProperty")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    # Commas are kept in the value, unlike with --build-properties
+    result = run_command(
+        'compile -b arduino:avr:uno --show-properties --build-property "build.extra_flags=-DFOO=1,2 -DBAR" '
+        + '--build-extra-flags -DDEBUG_LEVEL=3 --build-extra-flags -DTRACE {}'.format(sketch_path)
+    )
+    assert result.ok
+    properties = result.stdout.splitlines()
+    assert "build.extra_flags=-DFOO=1,2 -DBAR" in properties
+    assert "compiler.c.extra_flags=-DDEBUG_LEVEL=3 -DTRACE" in properties
+    assert "compiler.cpp.extra_flags=-DDEBUG_LEVEL=3 -DTRACE" in properties
+    assert "compiler.S.extra_flags=-DDEBUG_LEVEL=3 -DTRACE" in properties
+
+    result = run_command("compile -b arduino:avr:uno --build-property invalid {}".format(sketch_path))
+    assert result.failed
+    assert "Invalid build property 'invalid'" in result.stderr
+
+
+def test_compile_with_profile(run_command, data_dir):
+    assert run_command("core update-index")
+
+    sketch_name = "CompileWithProfile"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, "sketch.yaml"), "w") as f:
+        f.write(
+            "profiles:\n"
+            + "  uno:\n"
+            + "    fqbn: arduino:avr:uno\n"
+            + "    platform: arduino:avr@1.8.3\n"
+            + "    extra_flags:\n"
+            + "      - -DPROFILE_FLAG\n"
+            + "default_profile: uno\n"
+        )
+
+    # The pinned platform is not installed
+    result = run_command("compile {}".format(sketch_path))
+    assert result.failed
+    assert "platform arduino:avr@1.8.3 required by the profile is not installed" in result.stderr
+
+    result = run_command("compile --install-profile-deps --show-properties {}".format(sketch_path))
+    assert result.ok
+    assert "compiler.cpp.extra_flags=-DPROFILE_FLAG" in result.stdout.splitlines()
+    assert "version=1.8.3" in result.stdout.splitlines()
+
+    result = run_command("compile --profile missing {}".format(sketch_path))
+    assert result.failed
+    assert "profile 'missing' not found, available profiles: uno" in result.stderr
+
+
+def test_compile_reports_shadowed_libraries(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    # A sketchbook library with the same name of the EEPROM library bundled with arduino:avr
+    user_eeprom = os.path.join(data_dir, "libraries", "EEPROM")
+    os.makedirs(os.path.join(user_eeprom, "src"))
+    with open(os.path.join(user_eeprom, "library.properties"), "w") as f:
+        f.write("name=EEPROM\nversion=9.9.9\nauthor=Me\nmaintainer=Me\narchitectures=avr\n")
+    with open(os.path.join(user_eeprom, "src", "EEPROM.h"), "w") as f:
+        f.write("#define USER_EEPROM\n")
+
+    sketch_name = "CompileShadowedLibrary"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, "{}.ino".format(sketch_name)), "w") as f:
+        f.write("#include <EEPROM.h>\nvoid setup() {}\nvoid loop() {}\n")
+
+    result = run_command("compile -b arduino:avr:uno --format json {}".format(sketch_path))
+    assert result.ok
+    res = json.loads(result.stdout)
+    resolutions = [r for r in res["library_resolutions"] if r["header"] == "EEPROM.h"]
+    assert len(resolutions) == 1
+    assert resolutions[0]["used"]["install_dir"] == user_eeprom
+    assert resolutions[0]["used"]["location"] == 1
+    assert len(resolutions[0]["not_used"]) == 1
+    assert "libraries in user location have priority over platform ones" == resolutions[0]["not_used"][0]["reason"]
+
+    result = run_command("compile -b arduino:avr:uno -v {}".format(sketch_path))
+    assert result.ok
+    assert 'Multiple libraries were found for "EEPROM.h"' in result.stdout
+    assert "(libraries in user location have priority over platform ones)" in result.stdout
+
+    # The built-in library is reported as shadowed
+    result = run_command("lib list --all --format json")
+    assert result.ok
+    libs = json.loads(result.stdout)
+    bundled = [lib for lib in libs if lib["library"]["name"] == "EEPROM" and lib["library"]["location"] == 2]
+    assert len(bundled) == 1
+    assert bundled[0]["shadowed_by"] == user_eeprom
+    user = [lib for lib in libs if lib["library"]["name"] == "EEPROM" and lib["library"]["location"] == 1]
+    assert "shadowed_by" not in user[0]
+
+
+def test_compile_with_library_and_libraries_flags(run_command, data_dir, working_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    def create_library(lib_dir, define):
+        os.makedirs(os.path.join(lib_dir, "src"))
+        with open(os.path.join(lib_dir, "library.properties"), "w") as f:
+            f.write("name=MyLib\nversion=1.0.0\nauthor=Me\nmaintainer=Me\n")
+        with open(os.path.join(lib_dir, "src", "MyLib.h"), "w") as f:
+            f.write("#define {}\n".format(define))
+
+    # The same library installed in the sketchbook and kept in-tree
+    sketchbook_lib = os.path.join(data_dir, "libraries", "MyLib")
+    create_library(sketchbook_lib, "SKETCHBOOK_LIB")
+    in_tree_libs = os.path.join(working_dir, "libs")
+    in_tree_lib = os.path.join(in_tree_libs, "MyLib")
+    create_library(in_tree_lib, "IN_TREE_LIB")
+
+    sketch_name = "CompileWithInTreeLibrary"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, "{}.ino".format(sketch_name)), "w") as f:
+        f.write(
+            "#include <MyLib.h>\n#ifndef IN_TREE_LIB\n#error wrong library\n#endif\nvoid setup() {}\nvoid loop() {}\n"
+        )
+
+    # Without flags the sketchbook library is used
+    result = run_command("compile -b arduino:avr:uno {}".format(sketch_path))
+    assert result.failed
+
+    for flag in ["--library {}".format(in_tree_lib), "--libraries {}".format(in_tree_libs)]:
+        result = run_command("compile -b arduino:avr:uno --format json {} {}".format(flag, sketch_path))
+        assert result.ok
+        res = json.loads(result.stdout)
+        resolutions = [r for r in res["library_resolutions"] if r["header"] == "MyLib.h"]
+        assert len(resolutions) == 1
+        assert resolutions[0]["used"]["install_dir"] == in_tree_lib
+        assert resolutions[0]["used"]["location"] == 4
+        assert resolutions[0]["not_used"][0]["install_dir"] == sketchbook_lib
+
+    missing_lib = os.path.join(working_dir, "nope")
+    result = run_command("compile -b arduino:avr:uno --library {} {}".format(missing_lib, sketch_path))
+    assert result.failed
+    assert "library folder not found" in result.stderr
+
+
+def test_compile_with_libraries_vendored_in_sketch(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "CompileWithVendoredLibrary"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, "{}.ino".format(sketch_name)), "w") as f:
+        f.write("#include <Vendored.h>\nvoid setup() {\n  vendored();\n}\nvoid loop() {}\n")
+
+    # The library is not installed anywhere
+    result = run_command("compile -b arduino:avr:uno {}".format(sketch_path))
+    assert result.failed
+
+    vendored_lib = os.path.join(sketch_path, "libraries", "Vendored")
+    os.makedirs(os.path.join(vendored_lib, "src"))
+    with open(os.path.join(vendored_lib, "library.properties"), "w") as f:
+        f.write("name=Vendored\nversion=1.0.0\nauthor=Me\nmaintainer=Me\n")
+    with open(os.path.join(vendored_lib, "src", "Vendored.h"), "w") as f:
+        f.write("void vendored();\n")
+    with open(os.path.join(vendored_lib, "src", "Vendored.cpp"), "w") as f:
+        f.write('#include "Vendored.h"\nvoid vendored() {}\n')
+
+    result = run_command("compile -b arduino:avr:uno -v {}".format(sketch_path))
+    assert result.ok
+    assert "Using library Vendored at version 1.0.0 in folder: {}".format(vendored_lib) in result.stdout
+
+    # Other sketches can't see it
+    other_sketch_path = os.path.join(data_dir, "OtherSketch")
+    assert run_command("sketch new {}".format(other_sketch_path))
+    with open(os.path.join(other_sketch_path, "OtherSketch.ino"), "w") as f:
+        f.write("#include <Vendored.h>\nvoid setup() {}\nvoid loop() {}\n")
+    result = run_command("compile -b arduino:avr:uno {}".format(other_sketch_path))
+    assert result.failed
+
+
+def test_compile_with_jobs(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_path = os.path.join(data_dir, "CompileJobs")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    assert run_command("compile -b arduino:avr:uno --clean --jobs 1 {}".format(sketch_path))
+    assert run_command("compile -b arduino:avr:uno --clean -j 4 {}".format(sketch_path))
+
+    result = run_command("compile -b arduino:avr:uno -j -1 {}".format(sketch_path))
+    assert result.failed
+    assert "invalid number of parallel jobs: -1" in result.stderr
+
+
+@pytest.mark.skipif(platform.system() == "Windows", reason="The env command is not available on Windows")
+def test_compile_with_compiler_launcher(run_command, data_dir, downloads_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_path = os.path.join(data_dir, "CompileCompilerLauncher")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        # env just runs the compiler command line it receives
+        "ARDUINO_BUILD_COMPILER_LAUNCHER": "env",
+    }
+    result = run_command("compile -b arduino:avr:uno --clean -v {}".format(sketch_path), custom_env=env)
+    assert result.ok
+    assert any(line.startswith("env ") for line in result.stdout.splitlines())
+
+    env["ARDUINO_BUILD_COMPILER_LAUNCHER"] = "non-existent-launcher"
+    result = run_command("compile -b arduino:avr:uno --clean {}".format(sketch_path), custom_env=env)
+    assert result.failed
+
+
+def test_compile_with_size_report(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_path = os.path.join(data_dir, "CompileSizeReport")
+    assert run_command("sketch new {}".format(sketch_path))
+
+    result = run_command("compile -b arduino:avr:uno --size-report --format json {}".format(sketch_path))
+    assert result.ok
+    report = json.loads(result.stdout)["size_report"]
+    memory = {m["name"]: m for m in report["memory"]}
+    assert memory["flash"]["max_size"] == 32256
+    assert 0 < memory["flash"]["size"] < 32256
+    assert memory["ram"]["max_size"] == 2048
+    assert 0 < len(report["symbols"]) <= 10
+    sizes = [s["size"] for s in report["symbols"]]
+    assert sizes == sorted(sizes, reverse=True)
+
+    result = run_command("compile -b arduino:avr:uno --size-report=full {}".format(sketch_path))
+    assert result.ok
+    assert "Section" in result.stdout
+    assert ".text" in result.stdout
+
+    result = run_command("compile -b arduino:avr:uno --size-report=long {}".format(sketch_path))
+    assert result.failed
+    assert "Invalid size report 'long'" in result.stderr
+
+    # Without the flag no report is added to the output
+    result = run_command("compile -b arduino:avr:uno --format json {}".format(sketch_path))
+    assert result.ok
+    assert "size_report" not in json.loads(result.stdout)
+
+
+def test_compile_sketch_with_src_folder(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "CompileSrcFolder"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    nested_path = Path(sketch_path, "src", "utils", "nested")
+    nested_path.mkdir(parents=True)
+    Path(sketch_path, "src", "utils", "helper.h").write_text("void helper();\n")
+    # helper.h is found through the sketch include dirs
+    helper_file = Path(nested_path, "helper.cpp")
+    helper_file.write_text('#include "helper.h"\nvoid helper() {}\n')
+
+    result = run_command("compile -b arduino:avr:uno {}".format(sketch_path))
+    assert result.failed
+
+    Path(sketch_path, "arduino-cli.yaml").write_text("build:\n  sketch_include_dirs:\n    - src/utils\n")
+    assert run_command("compile -b arduino:avr:uno {}".format(sketch_path))
+
+    # The errors in the src folder refer to the files in the sketch folder
+    helper_file.write_text('#include "helper.h"\nvoid helper() { undeclared(); }\n')
+    result = run_command("compile -b arduino:avr:uno --format json {}".format(sketch_path))
+    assert result.failed
+    errors = [d for d in json.loads(result.stdout)["diagnostics"] if d["severity"] == "error"]
+    assert len(errors) == 1
+    assert errors[0]["file"] == str(helper_file)
+
+
+def test_compile_batch(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+    assert run_command("lib install Servo")
+
+    result = run_command("compile -b arduino:avr:uno -b arduino:avr:nano --examples-of Servo --format json")
+    assert result.ok
+    builds = json.loads(result.stdout)["builds"]
+    assert len(builds) > 0
+    assert {b["fqbn"] for b in builds} == {"arduino:avr:uno", "arduino:avr:nano"}
+    assert all(b["success"] for b in builds)
+    assert all(b["sketch"].startswith("examples/") for b in builds)
+
+    sketches_path = os.path.join(data_dir, "BatchSketches")
+    assert run_command("sketch new {}".format(os.path.join(sketches_path, "Good")))
+    assert run_command("sketch new {}".format(os.path.join(sketches_path, "nested", "Bad")))
+    with open(os.path.join(sketches_path, "nested", "Bad", "Bad.ino"), "a") as f:
+        f.write("undeclared();\n")
+
+    result = run_command("compile -b arduino:avr:uno --sketches-in {}".format(sketches_path))
+    assert result.failed
+    assert "PASS Good (arduino:avr:uno)" in result.stdout
+    assert "FAIL nested/Bad (arduino:avr:uno)" in result.stdout
+    assert "2 builds, 1 failed" in result.stdout
+    assert "1 of 2 builds failed" in result.stderr
+
+    result = run_command("compile -b arduino:avr:uno -b arduino:avr:nano {}".format(sketches_path))
+    assert result.failed
+    assert "Multiple boards can be given only together with --examples-of or --sketches-in." in result.stderr
+
+
+def test_compile_with_secrets(run_command, data_dir, downloads_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_name = "CompileWithSecrets"
+    sketch_path = Path(data_dir, sketch_name)
+    build_path = Path(data_dir, "build")
+    assert run_command(f"sketch new {sketch_path}")
+    (sketch_path / f"{sketch_name}.ino").write_text(
+        '#include "arduino_secrets.h"\n'
+        + 'static_assert(sizeof(SECRET_SSID) == sizeof("my-network"), "wrong secret");\n'
+        + "void setup() {}\nvoid loop() {}\n"
+    )
+    # The header of the sketch only contains placeholders
+    (sketch_path / "arduino_secrets.h").write_text('#define SECRET_SSID ""\n')
+    (sketch_path / "sketch.yaml").write_text("secrets:\n  env:\n    SECRET_SSID: WIFI_SSID\n")
+
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+    }
+    result = run_command(f"compile -b arduino:avr:uno --build-path {build_path} {sketch_path}", custom_env=env)
+    assert result.failed
+    assert "WIFI_SSID" in result.stderr
+
+    env["WIFI_SSID"] = "my-network"
+    result = run_command(f"compile -b arduino:avr:uno --build-path {build_path} -v {sketch_path}", custom_env=env)
+    assert result.ok
+    assert "my-network" not in result.stdout
+    assert "my-network" not in result.stderr
+    assert '#define SECRET_SSID "my-network"' in (build_path / "sketch" / "arduino_secrets.h").read_text()
+    # The sketch is left untouched
+    assert (sketch_path / "arduino_secrets.h").read_text() == '#define SECRET_SSID ""\n'
+
+
+def test_compile_with_build_metadata(run_command, data_dir, downloads_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_name = "CompileWithBuildMetadata"
+    sketch_path = Path(data_dir, sketch_name)
+    assert run_command(f"sketch new {sketch_path}")
+    (sketch_path / f"{sketch_name}.ino").write_text(
+        'static_assert(sizeof(ARDUINO_CLI_BUILD_TIMESTAMP) == sizeof("20210315-142501"), "wrong timestamp");\n'
+        + 'static_assert(sizeof(ARDUINO_CLI_BUILD_PROFILE) == 1, "no profile");\n'
+        + "void setup() {}\nvoid loop() {}\n"
+    )
+
+    # The macros are not defined by default
+    result = run_command(f"compile -b arduino:avr:uno {sketch_path}")
+    assert result.failed
+
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "SOURCE_DATE_EPOCH": "1615818301",
+    }
+    result = run_command(f"compile -b arduino:avr:uno --build-metadata -v {sketch_path}", custom_env=env)
+    assert result.ok
+    assert '-DARDUINO_CLI_BUILD_TIMESTAMP=\\"20210315-142501\\"' in result.stdout
+
+    # The macros of the configuration replace the default ones
+    env["ARDUINO_BUILD_METADATA"] = "FIRMWARE_BOARD={board}"
+    (sketch_path / f"{sketch_name}.ino").write_text(
+        'static_assert(sizeof(FIRMWARE_BOARD) == sizeof("uno"), "wrong board");\n' + "void setup() {}\nvoid loop() {}\n"
+    )
+    result = run_command(f"compile -b arduino:avr:uno {sketch_path}", custom_env=env)
+    assert result.ok
+
+
+def test_compile_with_build_fs(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_name = "CompileWithBuildFs"
+    sketch_path = Path(data_dir, sketch_name)
+    assert run_command(f"sketch new {sketch_path}")
+
+    result = run_command(f"compile -b arduino:avr:uno --build-fs {sketch_path}")
+    assert result.failed
+    assert "the sketch has no data folder" in result.stderr
+
+    # The AVR platform has no filesystem
+    Path(sketch_path, "data").mkdir()
+    Path(sketch_path, "data", "index.html").write_text("<html></html>")
+    result = run_command(f"compile -b arduino:avr:uno --build-fs {sketch_path}")
+    assert result.failed
+    assert "recipe.fs.pattern is missing" in result.stderr
+
+    assert run_command(f"compile -b arduino:avr:uno {sketch_path}")
+    result = run_command(f"upload -b arduino:avr:uno -p /dev/ttyACM0 --fs {sketch_path}")
+    assert result.failed
+    assert "compile the sketch with --build-fs" in result.stderr
+
+
+def test_compile_with_partitions(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_path = Path(data_dir, "CompileWithPartitions")
+    assert run_command(f"sketch new {sketch_path}")
+
+    # The AVR platform doesn't declare partition tables nor linker scripts
+    result = run_command(f"compile -b arduino:avr:uno --partitions huge_app {sketch_path}")
+    assert result.failed
+    assert "doesn't provide any partition table" in result.stderr
+
+    result = run_command(f"compile -b arduino:avr:uno --linker-script no_ota {sketch_path}")
+    assert result.failed
+    assert "doesn't provide any linker script" in result.stderr
+
+
+def test_compile_with_sign_key(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_path = Path(data_dir, "CompileWithSignKey")
+    assert run_command(f"sketch new {sketch_path}")
+
+    result = run_command(f"compile -b arduino:avr:uno --sign-key {Path(data_dir, 'missing.pem')} {sketch_path}")
+    assert result.failed
+    assert "signing key" in result.stderr
+
+    # The AVR platform has no signing hook
+    key = Path(data_dir, "key.pem")
+    key.write_text("not a real key")
+    result = run_command(f"compile -b arduino:avr:uno --sign-key {key} {sketch_path}")
+    assert result.failed
+    assert "recipe.hooks.postbuild.signing.pattern is missing" in result.stderr
+
+
+def test_compile_with_log_build(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr@1.8.3")
+
+    sketch_path = Path(data_dir, "CompileWithLogBuild")
+    assert run_command(f"sketch new {sketch_path}")
+
+    log_file = Path(data_dir, "build.log")
+    assert run_command(f"compile -b arduino:avr:uno --log-build {log_file} {sketch_path}")
+    entries = [json.loads(line) for line in log_file.read_text().splitlines()]
+    recipes = [entry.get("recipe") for entry in entries]
+    assert "recipe.cpp.o.pattern" in recipes
+    assert "recipe.c.combine.pattern" in recipes
+    link = entries[recipes.index("recipe.c.combine.pattern")]
+    assert link["exit_code"] == 0
+    assert link["duration_ms"] >= 0
+    assert link["env"]
+    assert "avr-gcc" in link["arguments"][0]
+
+    # The recorded commands are listed and can be run again
+    result = run_command(f"build replay {log_file} --format json")
+    assert result.ok
+    assert len(json.loads(result.stdout)) == len(entries)
+
+    result = run_command(f"build replay {log_file} {link['id']} --format json")
+    assert result.ok
+    replay = json.loads(result.stdout)
+    assert replay["exit_code"] == 0
+    assert replay["recorded_exit_code"] == 0
+
+    result = run_command(f"build replay {log_file} 100000")
+    assert result.failed
+    assert "Command 100000 not found" in result.stderr
@@ -26,3 +26,30 @@ def test_init_dest(run_command, working_dir):
     result = run_command(f'config init --dest-dir "{dest}"')
     assert result.ok
     assert dest in result.stdout
+
+
+def test_set_get_add_remove(run_command, data_dir):
+    assert run_command("config init").ok
+
+    result = run_command("config set logging.level debug")
+    assert result.ok
+    result = run_command("config get logging.level")
+    assert result.ok
+    assert "debug" == result.stdout.strip()
+
+    url1 = "https://example.com/package_example_index.json"
+    url2 = "https://another-url.com/package_another_index.json"
+    assert run_command(f"config add board_manager.additional_urls {url1} {url2}").ok
+    assert run_command(f"config remove board_manager.additional_urls {url1}").ok
+    result = run_command("config get board_manager.additional_urls")
+    assert result.ok
+    assert [url2] == result.stdout.strip().splitlines()
+
+
+def test_set_invalid_key(run_command):
+    assert run_command("config init").ok
+    result = run_command("config set not.existent value")
+    assert result.failed
+    assert "unknown setting" in result.stderr
+    result = run_command("config add logging.level debug")
+    assert result.failed
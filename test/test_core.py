@@ -15,6 +15,7 @@
 import os
 import platform
 import pytest
+import tarfile
 import simplejson as json
 from pathlib import Path
 
@@ -49,7 +50,7 @@ def test_core_search(run_command, httpserver):
     result = run_command(f"core search retrokit --all --additional-urls={url}")
     assert result.ok
     assert 3 == len(result.stdout.strip().splitlines())
-    lines = [l.split(maxsplit=2) for l in result.stdout.strip().splitlines()]
+    lines = [l.split()[:3] for l in result.stdout.strip().splitlines()]
     assert ["Retrokits-RK002:arm", "1.0.5", "RK002"] in lines
     assert ["Retrokits-RK002:arm", "1.0.6", "RK002"] in lines
 
@@ -57,7 +58,7 @@ def test_core_search(run_command, httpserver):
     result = run_command(f"core search Retrokits-RK002 --all --additional-urls={url}")
     assert result.ok
     assert 3 == len(result.stdout.strip().splitlines())
-    lines = [l.split(maxsplit=2) for l in result.stdout.strip().splitlines()]
+    lines = [l.split()[:3] for l in result.stdout.strip().splitlines()]
     assert ["Retrokits-RK002:arm", "1.0.5", "RK002"] in lines
     assert ["Retrokits-RK002:arm", "1.0.6", "RK002"] in lines
 
@@ -65,7 +66,7 @@ def test_core_search(run_command, httpserver):
     result = run_command(f"core search rk002 --all --additional-urls={url}")
     assert result.ok
     assert 3 == len(result.stdout.strip().splitlines())
-    lines = [l.split(maxsplit=2) for l in result.stdout.strip().splitlines()]
+    lines = [l.split()[:3] for l in result.stdout.strip().splitlines()]
     assert ["Retrokits-RK002:arm", "1.0.5", "RK002"] in lines
     assert ["Retrokits-RK002:arm", "1.0.6", "RK002"] in lines
 
@@ -73,9 +74,19 @@ def test_core_search(run_command, httpserver):
     result = run_command(f"core search myboard --all --additional-urls={url}")
     assert result.ok
     assert 2 == len(result.stdout.strip().splitlines())
-    lines = [l.split(maxsplit=2) for l in result.stdout.strip().splitlines()]
+    lines = [l.split()[:3] for l in result.stdout.strip().splitlines()]
     assert ["Package:x86", "1.2.3", "Platform"] in lines
 
+    # Search using multiple keywords, all of them must match
+    result = run_command(f"core search rk002 arm --additional-urls={url} --format json")
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert 1 == len(data)
+    assert "Retrokits-RK002:arm" == data[0]["ID"]
+    result = run_command(f"core search rk002 avr --additional-urls={url} --format json")
+    assert result.ok
+    assert [] == json.loads(result.stdout)
+
 
 def test_core_search_no_args(run_command, httpserver):
     """
@@ -139,6 +150,18 @@ def test_core_updateindex_invalid_url(run_command):
     assert result.failed
 
 
+def test_core_updateindex_signatures(run_command):
+    url = "https://raw.githubusercontent.com/arduino/arduino-cli/master/test/testdata/test_index.json"
+    result = run_command("core update-index --additional-urls={} --format json".format(url))
+    assert result.ok
+    signatures = {s["url"]: s for s in json.loads(result.stdout)}
+    # The Arduino index is always signed
+    assert signatures["https://downloads.arduino.cc/packages/package_index.json"]["verified"]
+    assert "Arduino" in signatures["https://downloads.arduino.cc/packages/package_index.json"]["signer"]
+    # Third party indexes may not be signed
+    assert not signatures[url].get("verified", False)
+
+
 def test_core_install_without_updateindex(run_command):
     # Missing "core update-index"
     # Download samd core pinned to 1.8.6
@@ -182,6 +205,31 @@ def test_core_download(run_command, downloads_dir):
     assert result.failed
 
 
+def test_download_manifest(run_command, data_dir, downloads_dir):
+    assert run_command("update")
+
+    manifest = Path(data_dir, "manifest.yaml")
+    manifest.write_text("platforms:\n  - arduino:avr@1.6.16\nlibraries:\n  - Arduino_CRC32@1.0.0\n")
+    assert run_command('core download --manifest "{}"'.format(manifest))
+    assert Path(downloads_dir, "packages", "avr-1.6.16.tar.bz2").exists()
+    assert run_command('lib download --manifest "{}"'.format(manifest))
+    assert Path(downloads_dir, "libraries", "Arduino_CRC32-1.0.0.zip").exists()
+
+    # The downloaded archives can be installed offline
+    assert run_command("core install arduino:avr@1.6.16 --offline")
+    assert run_command("lib install Arduino_CRC32@1.0.0 --offline")
+
+    # The versions are required
+    manifest.write_text("platforms:\n  - arduino:avr\n")
+    result = run_command('core download --manifest "{}"'.format(manifest))
+    assert result.failed
+    assert "missing version of platform arduino:avr in manifest" in result.stderr
+
+    # Something to download is required
+    result = run_command("lib download")
+    assert result.failed
+
+
 def _in(jsondata, name, version=None):
     installed_cores = json.loads(jsondata)
     for c in installed_cores:
@@ -213,6 +261,13 @@ def test_core_install(run_command):
     assert result.ok
     assert _in(result.stdout, "arduino:avr", "1.6.17")
 
+    # A dry run shows the upgrade without performing it
+    result = run_command("core upgrade --dry-run --format json")
+    assert result.ok
+    assert _in(result.stdout, "arduino:avr", "1.6.17")
+    result = run_command("core list --format json")
+    assert _in(result.stdout, "arduino:avr", "1.6.17")
+
     # Upgrade the core to latest version
     assert run_command("core upgrade arduino:avr")
     result = run_command("core list --format json")
@@ -257,6 +312,100 @@ def test_core_uninstall_tool_dependency_removal(run_command, data_dir):
     ) is False
 
 
+def test_core_clean_tools(run_command, data_dir):
+    assert run_command("core install arduino:avr@1.8.2")
+    tools_store = Path(data_dir, "tools-store")
+    avr_gcc = Path(data_dir, "packages", "arduino", "tools", "avr-gcc", "7.3.0-atmel3.6.1-arduino5")
+    # The tool is extracted in the store and linked from the packages directory
+    assert avr_gcc.resolve().parent == tools_store.resolve()
+
+    # The stored tools are still referenced after the platform is removed, until they're cleaned
+    assert run_command("core uninstall arduino:avr")
+    assert len(list(tools_store.iterdir())) > 0
+
+    # Install another platform with some tools, the ones of arduino:avr@1.8.2 are
+    # removed from the store while the required ones are kept
+    assert run_command("core install arduino:megaavr@1.8.4")
+    result = run_command("core clean-tools")
+    assert result.ok
+    assert avr_gcc.exists()
+    avrdude = Path(data_dir, "packages", "arduino", "tools", "avrdude", "6.3.0-arduino17")
+    assert not avrdude.exists()
+    linked = {p.resolve() for p in Path(data_dir, "packages").glob("*/tools/*/*")}
+    assert {p.resolve() for p in tools_store.iterdir()} == linked
+
+
+def test_core_clean_cache(run_command, data_dir):
+    # Use a private downloads directory, the shared one is kept by the other tests
+    downloads_dir = Path(data_dir, "staging")
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": str(downloads_dir),
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+    }
+    assert run_command("core update-index", custom_env=env)
+    assert run_command("core install arduino:avr@1.8.2", custom_env=env)
+    archives = list(Path(downloads_dir, "packages").iterdir())
+    assert len(archives) > 0
+
+    # All the archives are listed in the package index
+    result = run_command("core clean-cache --orphaned --format json", custom_env=env)
+    assert result.ok
+    assert json.loads(result.stdout)["archives"] == []
+
+    result = run_command("core clean-cache --dry-run --format json", custom_env=env)
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert len(data["archives"]) == len(archives)
+    assert data["size"] == sum(a.stat().st_size for a in archives)
+    assert all(a.exists() for a in archives)
+
+    # Just downloaded archives aren't old enough
+    result = run_command("core clean-cache --older-than 1 --format json", custom_env=env)
+    assert result.ok
+    assert json.loads(result.stdout)["archives"] == []
+
+    assert run_command("core clean-cache", custom_env=env)
+    assert not any(a.exists() for a in archives)
+
+
+def test_core_downloads_max_size(run_command, data_dir):
+    downloads_dir = Path(data_dir, "staging")
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": str(downloads_dir),
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "ARDUINO_DIRECTORIES_DOWNLOADSMAXSIZE": "1KB",
+    }
+    assert run_command("core update-index", custom_env=env)
+    assert run_command("core install arduino:avr@1.8.2", custom_env=env)
+    assert sum(f.stat().st_size for f in downloads_dir.rglob("*") if f.is_file()) <= 1024
+
+
+def test_core_uninstall_referenced_platform(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    # A platform in the sketchbook using the core of arduino:avr
+    referencing_platform = Path(data_dir, "hardware", "myvendor", "avr")
+    referencing_platform.mkdir(parents=True)
+    referencing_platform.joinpath("boards.txt").write_text(
+        "myuno.name=My Uno\nmyuno.build.core=arduino:arduino\nmyuno.build.variant=arduino:standard\n"
+    )
+
+    result = run_command("core uninstall arduino:avr")
+    assert result.failed
+    assert "myvendor:avr" in result.stderr
+    result = run_command("core list --format json")
+    assert result.ok
+    assert _in(result.stdout, "arduino:avr")
+
+    assert run_command("core uninstall --force arduino:avr")
+    result = run_command("core list --format json")
+    assert result.ok
+    assert not _in(result.stdout, "arduino:avr")
+
+
 def test_core_zipslip(run_command):
     url = "https://raw.githubusercontent.com/arduino/arduino-cli/master/test/testdata/test_index.json"
     assert run_command("core update-index --additional-urls={}".format(url))
@@ -270,3 +419,58 @@ def test_core_broken_install(run_command):
     url = "https://raw.githubusercontent.com/arduino/arduino-cli/master/test/testdata/test_index.json"
     assert run_command("core update-index --additional-urls={}".format(url))
     assert not run_command("core install brokenchecksum:x86 --additional-urls={}".format(url))
+
+
+def test_core_deprecated_and_incompatible_platform(run_command, httpserver):
+    # Set up the server to serve our custom index file
+    test_index = Path(__file__).parent / "testdata" / "test_index.json"
+    httpserver.expect_request("/test_index.json").respond_with_data(test_index.read_text())
+
+    url = httpserver.url_for("/test_index.json")
+    assert run_command(f"core update-index --additional-urls={url}")
+
+    # Deprecation and host compatibility are reported by search
+    result = run_command(f"core search legacy --format json --additional-urls={url}")
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert 1 == len(data)
+    assert data[0]["Deprecated"]
+    assert data[0]["Incompatible"]
+    result = run_command(f"core search legacy --additional-urls={url}")
+    assert result.ok
+    assert "1.0.0 (incompatible)" in result.stdout
+    assert "[DEPRECATED] Legacy Platform" in result.stdout
+
+    # A platform whose tools are not available for the host can't be installed
+    result = run_command(f"core install legacy:x86 --additional-urls={url}")
+    assert result.failed
+    assert "legacy:legacy-tool@1.0.0" in result.stderr
+    result = run_command("core list --format json")
+    assert result.ok
+    assert "legacy:x86" not in result.stdout
+
+
+def test_core_install_from_archive(run_command, data_dir, working_dir):
+    # Create a platform archive that is not listed in any package index
+    platform_dir = Path(working_dir, "myarch")
+    platform_dir.mkdir()
+    (platform_dir / "boards.txt").write_text("myboard.name=My Board\n")
+    (platform_dir / "platform.txt").write_text("name=My Architecture\nversion=1.0.0\n")
+    archive = Path(working_dir, "myarch-1.0.0.tar.bz2")
+    with tarfile.open(archive, "w:bz2") as tar:
+        tar.add(platform_dir, arcname="myarch")
+
+    assert run_command("core update-index")
+    assert run_command("core install mypackage:myarch --archive {}".format(archive))
+    assert Path(data_dir, "packages", "mypackage", "hardware", "myarch", "1.0.0", "installed.json").exists()
+    result = run_command("core list --format json")
+    assert result.ok
+    assert _in(result.stdout, "mypackage:myarch", "1.0.0")
+
+    # Only one platform at a time can be installed from an archive
+    assert run_command("core install mypackage:myarch otherpackage:otherarch --archive {}".format(archive)).failed
+
+    assert run_command("core uninstall mypackage:myarch")
+    result = run_command("core list --format json")
+    assert result.ok
+    assert not _in(result.stdout, "mypackage:myarch")
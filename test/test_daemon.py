@@ -35,7 +35,7 @@ def test_telemetry_prometheus_endpoint(daemon_runner, data_dir):
         inventory = yaml.safe_load(stream)
 
         # Check if :9090/metrics endpoint is alive,
-        # telemetry is enabled by default in daemon mode
+        # telemetry is enabled by the daemon_runner fixture
         s = requests.Session()
         retries = Retry(total=3, backoff_factor=1, status_forcelist=[500, 502, 503, 504])
         s.mount("http://", HTTPAdapter(max_retries=retries))
@@ -43,3 +43,21 @@ def test_telemetry_prometheus_endpoint(daemon_runner, data_dir):
         family = next(text_string_to_metric_families(metrics))
         sample = family.samples[0]
         assert inventory["installation"]["id"] == sample.labels["installationID"]
+
+
+@pytest.mark.timeout(60)
+def test_daemon_health_and_shutdown(daemon_runner, run_command):
+    # Wait for the daemon to start listening
+    result = run_command("daemon health")
+    while not result.ok:
+        time.sleep(1)
+        result = run_command("daemon health")
+    assert "SERVING" in result.stdout
+
+    result = run_command("daemon shutdown")
+    assert result.ok
+
+    # The daemon is not reachable anymore
+    time.sleep(1)
+    result = run_command("daemon health --timeout 1s")
+    assert not result.ok
@@ -0,0 +1,48 @@
+# This file is part of arduino-cli.
+#
+# Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+#
+# This software is released under the GNU General Public License version 3,
+# which covers the main part of arduino-cli.
+# The terms of this license can be found at:
+# https://www.gnu.org/licenses/gpl-3.0.en.html
+#
+# You can be released from the requirements of the above licenses by purchasing
+# a commercial license. Buying such a license is mandatory if you want to modify or
+# otherwise use the software for commercial activities involving the Arduino
+# software without disclosing the source code of your own applications. To purchase
+# a commercial license, send an email to license@arduino.cc.
+import json
+from pathlib import Path
+
+
+def test_env_export_apply(run_command, data_dir):
+    assert run_command("update")
+    assert run_command("core install arduino:avr@1.6.16")
+    assert run_command("lib install Arduino_CRC32@1.0.0")
+
+    result = run_command("env export --format json")
+    assert result.ok
+    lockfile = json.loads(result.stdout)
+    assert "arduino:avr@1.6.16" in lockfile["platforms"]
+    assert "arduino:avr-gcc@4.9.2-atmel3.5.4-arduino2" in lockfile["tools"]
+    assert "Arduino_CRC32@1.0.0" in lockfile["libraries"]
+
+    result = run_command("env export")
+    assert result.ok
+    lock = Path(data_dir, "arduino-lock.yaml")
+    lock.write_text(result.stdout)
+
+    # Apply restores the exact versions
+    assert run_command("lib uninstall Arduino_CRC32")
+    assert run_command("core uninstall arduino:avr")
+    assert run_command('env apply "{}"'.format(lock))
+    result = run_command("env export --format json")
+    assert result.ok
+    assert json.loads(result.stdout) == lockfile
+
+    # Versions are required
+    lock.write_text("libraries:\n  - Arduino_CRC32\n")
+    result = run_command('env apply "{}"'.format(lock))
+    assert result.failed
+    assert "missing version in Arduino_CRC32" in result.stderr
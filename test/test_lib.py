@@ -12,7 +12,12 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import os
+import platform
+
+import pytest
 import simplejson as json
+from pathlib import Path
 
 
 def test_list(run_command):
@@ -70,6 +75,42 @@ def test_list(run_command):
     assert "Arduino_APDS9960.h" == data[0]["library"]["provides_includes"][0]
 
 
+def test_list_manually_installed_library(run_command, data_dir):
+    assert run_command("lib update-index")
+
+    # A library copied by hand in the sketchbook, not available in the index
+    lib_dir = Path(data_dir, "libraries", "MyLibrary-master")
+    lib_dir.mkdir(parents=True)
+    lib_dir.joinpath("library.properties").write_text(
+        "name=My Library\nversion=1.0-beta\nauthor=Me\nmaintainer=Me\nsentence=A library installed manually\n"
+    )
+    lib_dir.joinpath("MyLibrary.h").write_text("")
+
+    result = run_command("lib list --format json")
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert 1 == len(data)
+    assert "MyLibrary-master" == data[0]["library"]["name"]
+    assert "My Library" == data[0]["library"]["real_name"]
+    assert "1.0-beta" == data[0]["library"]["version"]
+    assert 1 == data[0]["library"]["location"]
+    assert data[0]["unknown_origin"]
+
+    result = run_command("lib list")
+    assert result.ok
+    assert "unknown origin" in result.stdout
+
+    # Libraries from the index are not marked
+    assert run_command("lib install ArduinoJson")
+    result = run_command("lib list ArduinoJson --format json")
+    assert result.ok
+    assert "unknown_origin" not in json.loads(result.stdout)[0]
+
+    # It can be uninstalled using the name from library.properties
+    assert run_command('lib uninstall "My Library"')
+    assert not lib_dir.exists()
+
+
 def test_list_exit_code(run_command):
     # Init the environment explicitly
     assert run_command("core update-index")
@@ -150,6 +191,121 @@ def test_install(run_command):
     # (https://github.com/arduino/arduino-cli/issues/534)
     result = run_command("lib install MD_Parola@3.2.0")
     assert "Error resolving dependencies for MD_Parola@3.2.0: dependency 'MD_MAX72xx' is not available" in result.stderr
+    assert result.exited == 9
+
+    # Unknown libraries are reported with their own exit code
+    result = run_command("lib install NonExistentLibrary")
+    assert result.failed
+    assert result.exited == 8
+
+
+def test_install_no_deps(run_command):
+    assert run_command("lib update-index")
+
+    # Dependencies are installed by default...
+    assert run_command("lib install MD_Parola")
+    result = run_command("lib list --format json")
+    assert result.ok
+    installed = [lib["library"]["name"] for lib in json.loads(result.stdout)]
+    assert "MD_MAX72XX" in installed
+    assert run_command("lib uninstall MD_Parola MD_MAX72XX")
+
+    # ...unless --no-deps is used
+    assert run_command("lib install MD_Parola --no-deps")
+    result = run_command("lib list --format json")
+    assert result.ok
+    installed = [lib["library"]["name"] for lib in json.loads(result.stdout)]
+    assert "MD_Parola" in installed
+    assert "MD_MAX72XX" not in installed
+
+
+def test_install_version_range(run_command):
+    assert run_command("lib update-index")
+
+    # The newest version in the range is installed
+    assert run_command('lib install "ArduinoJson@>=6.0 <6.12"')
+    result = run_command("lib list --format json")
+    assert result.ok
+    installed = {lib["library"]["name"]: lib["library"]["version"] for lib in json.loads(result.stdout)}
+    assert installed["ArduinoJson"] == "6.11.5"
+
+    # Ranges not satisfied by any version are reported
+    result = run_command('lib install "ArduinoJson@>=100.0"')
+    assert result.failed
+    assert "no version of library ArduinoJson satisfies >=100.0" in result.stderr
+
+    result = run_command('lib install "ArduinoJson@>=abc"')
+    assert result.failed
+
+
+@pytest.mark.skipif(platform.system() == "Windows", reason="The lock is held with flock, not available on Windows")
+def test_install_locked_libraries_dir(run_command, data_dir, downloads_dir):
+    import fcntl
+
+    assert run_command("lib update-index")
+
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "ARDUINO_DIRECTORIES_LOCKTIMEOUT": "1s",
+    }
+    libraries_dir = Path(data_dir, "libraries")
+    libraries_dir.mkdir(parents=True, exist_ok=True)
+    with open(libraries_dir / ".arduino-cli.lock", "w") as lock:
+        fcntl.flock(lock, fcntl.LOCK_EX)
+        lock.write("other-tool (pid 42)")
+        lock.flush()
+
+        # The installation waits for the lock and reports its holder
+        result = run_command("lib install AudioZero", custom_env=env)
+        assert result.failed
+        assert "is locked by other-tool (pid 42)" in result.stderr
+
+    assert run_command("lib install AudioZero", custom_env=env)
+
+
+def test_deps(run_command):
+    assert run_command("lib update-index")
+
+    result = run_command("lib deps MD_Parola --format json")
+    assert result.ok
+    deps = {dep["name"]: dep for dep in json.loads(result.stdout)["dependencies"]}
+    assert "MD_MAX72XX" in deps["MD_Parola"]["dependencies"]
+    # Nothing installed yet
+    assert deps["MD_Parola"]["status"] == "missing"
+    assert deps["MD_MAX72XX"]["status"] == "missing"
+
+    assert run_command("lib install MD_MAX72XX")
+    result = run_command("lib deps MD_Parola --format json")
+    assert result.ok
+    deps = {dep["name"]: dep for dep in json.loads(result.stdout)["dependencies"]}
+    # The latest version is the required one
+    assert deps["MD_MAX72XX"]["status"] == "installed"
+    assert deps["MD_MAX72XX"]["versionInstalled"] == deps["MD_MAX72XX"]["versionRequired"]
+    assert deps["MD_Parola"]["status"] == "missing"
+
+    result = run_command("lib deps MD_Parola")
+    assert result.ok
+    lines = result.stdout.splitlines()
+    assert "MD_Parola" in lines[0]
+    assert any(line.startswith("  ") and "MD_MAX72XX" in line for line in lines[1:])
+
+
+def test_install_offline(run_command):
+    assert run_command("lib update-index")
+
+    # Indexes can't be updated without network access
+    result = run_command("lib update-index --offline")
+    assert result.failed
+    assert "offline mode" in result.stderr
+
+    # Libraries can be installed offline only if already downloaded
+    result = run_command("lib install Arduino_CRC32@1.0.0 --offline")
+    assert result.failed
+    assert "missing from the downloads cache: Arduino_CRC32@1.0.0" in result.stderr
+    assert run_command("lib download Arduino_CRC32@1.0.0")
+    assert run_command("lib install Arduino_CRC32@1.0.0 --offline")
 
 
 def test_update_index(run_command):
@@ -158,6 +314,22 @@ def test_update_index(run_command):
     assert "Updating index: library_index.json downloaded" == result.stdout.splitlines()[-1].strip()
 
 
+def test_update_index_if_older_than(run_command, data_dir):
+    assert run_command("lib update-index")
+    index = Path(data_dir, "library_index.json")
+    assert Path(data_dir, "library_index.json.cache").exists()
+    modified = index.stat().st_mtime
+
+    # The index has just been checked, it's not downloaded again
+    result = run_command("lib update-index --if-older-than 1h")
+    assert result.ok
+    assert "Updating index: library_index.json downloaded" == result.stdout.splitlines()[-1].strip()
+    assert modified == index.stat().st_mtime
+
+    result = run_command("lib update-index --if-older-than soon")
+    assert result.failed
+
+
 def test_uninstall(run_command):
     libs = ['"AzureIoTProtocol_MQTT"', '"WiFiNINA"']
     assert run_command("lib install {}".format(" ".join(libs)))
@@ -229,6 +401,94 @@ def test_search(run_command):
     assert len(libs_json.get("libraries")) >= 1
 
 
+def test_clean_cache(run_command, data_dir):
+    # Use a private downloads directory, the shared one is kept by the other tests
+    downloads_dir = Path(data_dir, "staging")
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": str(downloads_dir),
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+    }
+    assert run_command("lib update-index", custom_env=env)
+    assert run_command("lib install ArduinoJson@6.11.0 WiFi101", custom_env=env)
+    archives = list(Path(downloads_dir, "libraries").iterdir())
+    assert len(archives) == 2
+
+    result = run_command("lib clean-cache --dry-run", custom_env=env)
+    assert result.ok
+    assert "2 archives" in result.stdout
+    assert all(a.exists() for a in archives)
+
+    result = run_command("lib clean-cache --orphaned --format json", custom_env=env)
+    assert result.ok
+    assert json.loads(result.stdout)["archives"] == []
+
+    result = run_command("lib clean-cache --format json", custom_env=env)
+    assert result.ok
+    assert len(json.loads(result.stdout)["archives"]) == 2
+    assert not any(a.exists() for a in archives)
+
+
+def test_search_additional_index(run_command, working_dir):
+    index = {
+        "libraries": [
+            {
+                "name": "MyPrivateLib",
+                "version": "1.0.0",
+                "author": "ACME",
+                "maintainer": "ACME",
+                "sentence": "A private library.",
+                "paragraph": "",
+                "website": "https://example.com",
+                "category": "Other",
+                "architectures": ["*"],
+                "types": ["Contributed"],
+                "url": "https://example.com/libraries/MyPrivateLib-1.0.0.zip",
+                "archiveFileName": "MyPrivateLib-1.0.0.zip",
+                "size": 1024,
+                "checksum": "SHA-256:0000000000000000000000000000000000000000000000000000000000000000",
+            }
+        ]
+    }
+    index_file = Path(working_dir, "private_library_index.json")
+    index_file.write_text(json.dumps(index))
+    index_url = index_file.as_uri()
+
+    assert run_command("config init").ok
+    assert run_command(f"config add library_manager.additional_urls {index_url}").ok
+    assert run_command("lib update-index")
+
+    result = run_command("lib search MyPrivateLib --format json")
+    assert result.ok
+    libs = json.loads(result.stdout)["libraries"]
+    assert ["MyPrivateLib"] == [lib["name"] for lib in libs]
+    assert index_url == libs[0]["latest"]["index_url"]
+
+    result = run_command("lib search MyPrivateLib")
+    assert result.ok
+    assert f"Index: {index_url}" in result.stdout
+
+
+def test_search_qualifiers(run_command):
+    assert run_command("lib update-index")
+
+    result = run_command("lib search header:ArduinoJson.h --names --format json")
+    assert result.ok
+    names = [lib["name"] for lib in json.loads(result.stdout)["libraries"]]
+    assert "ArduinoJson" in names
+
+    # The exact name match is the most relevant result
+    result = run_command("lib search servo --names --format json")
+    assert result.ok
+    names = [lib["name"] for lib in json.loads(result.stdout)["libraries"]]
+    assert names[0] == "Servo"
+
+    result = run_command("lib search servo author:nobody-has-this-name --format json")
+    assert result.ok
+    # No library matches, at most similar names are suggested
+    libs = json.loads(result.stdout).get("libraries") or []
+    assert not any(lib.get("latest") for lib in libs)
+
 def test_search_paragraph(run_command):
     """
     Search for a string that's only present in the `paragraph` field
@@ -239,3 +499,53 @@ def test_search_paragraph(run_command):
     assert result.ok
     libs_json = json.loads(result.stdout)
     assert 1 == len(libs_json.get("libraries"))
+
+
+def test_lib_upgrade_dry_run(run_command):
+    assert run_command("lib update-index")
+    assert run_command("lib install ArduinoJson@6.11.0")
+
+    result = run_command("lib upgrade --dry-run --format json")
+    assert result.ok
+    plan = json.loads(result.stdout)
+    assert [lib["library"]["version"] for lib in plan if lib["library"]["name"] == "ArduinoJson"] == ["6.11.0"]
+
+    # Nothing has been upgraded
+    result = run_command("lib list --format json")
+    assert result.ok
+    libs = json.loads(result.stdout)
+    assert [lib["library"]["version"] for lib in libs if lib["library"]["name"] == "ArduinoJson"] == ["6.11.0"]
+
+    result = run_command("lib upgrade --dry-run ArduinoJson")
+    assert result.ok
+    assert "ArduinoJson" in result.stdout
+    assert "6.11.0" in result.stdout
+
+
+def test_lib_examples(run_command):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+    assert run_command("lib install ArduinoJson")
+    assert run_command("lib install wm8978-esp32")
+
+    result = run_command("lib examples ArduinoJson --format json")
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert len(data) == 1
+    assert data[0]["library"]["name"] == "ArduinoJson"
+    assert len(data[0]["examples"]) > 0
+    for example in data[0]["examples"]:
+        assert os.path.isfile(os.path.join(example, os.path.basename(example) + ".ino"))
+
+    # The libraries bundled with the platform are listed too
+    result = run_command("lib examples -b arduino:avr:uno --compatible-only --format json")
+    assert result.ok
+    data = json.loads(result.stdout)
+    names = [lib["library"]["name"] for lib in data]
+    assert "Wire" in names
+    assert "ArduinoJson" in names
+    assert all(lib["compatible"] for lib in data)
+
+    result = run_command("lib examples --compatible-only")
+    assert result.failed
+    assert "The --compatible-only flag requires --fqbn." in result.stderr
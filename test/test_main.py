@@ -41,6 +41,19 @@ def test_version(run_command):
     assert isinstance(parsed_out.get("Commit", False), str)
 
 
+def test_version_check_disabled(run_command, data_dir, downloads_dir):
+    env = {
+        "ARDUINO_DATA_DIR": data_dir,
+        "ARDUINO_DOWNLOADS_DIR": downloads_dir,
+        "ARDUINO_SKETCHBOOK_DIR": data_dir,
+        "ARDUINO_UPDATER_ENABLE_CHECK": "false",
+    }
+    result = run_command("version --check", custom_env=env)
+    assert result.failed
+    assert "Version:" in result.stdout
+    assert "updater.enable_check" in result.stderr
+
+
 def test_log_options(run_command, data_dir):
     """
     using `version` as a test command
@@ -74,6 +87,13 @@ def test_log_options(run_command, data_dir):
         for line in f.readlines():
             json.loads(line)
 
+    # a log file for each command, the entries are tagged with the command
+    log_file = os.path.join(data_dir, "{command}.json")
+    run_command('config dump --log-format json --log-file "{}"'.format(log_file))
+    with open(os.path.join(data_dir, "config_dump.json")) as f:
+        for line in f.readlines():
+            assert json.loads(line)["command"] == "config dump"
+
 
 def test_inventory_creation(run_command, data_dir):
     """
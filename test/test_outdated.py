@@ -12,6 +12,7 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import json
 
 
 def test_outdated(run_command):
@@ -33,3 +34,19 @@ def test_outdated(run_command):
     lines = [l.strip() for l in result.stdout.splitlines()]
     assert lines[1].startswith("Arduino AVR Boards")
     assert lines[4].startswith("USBHost")
+
+
+def test_outdated_json_output(run_command):
+    run_command("core update-index")
+    run_command("lib update-index")
+
+    run_command("core install arduino:avr@1.6.3")
+    assert run_command("lib install USBHost@1.0.0")
+
+    result = run_command("outdated --format json")
+    assert result.ok
+    data = json.loads(result.stdout)
+    assert [p["id"] for p in data["platforms"]] == ["arduino:avr"]
+    assert data["platforms"][0]["installed"] == "1.6.3"
+    assert [l["name"] for l in data["libraries"]] == ["USBHost"]
+    assert data["libraries"][0]["installed"] == "1.0.0"
@@ -12,6 +12,7 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import json
 import os
 import platform
 import zipfile
@@ -62,6 +63,34 @@ def test_sketch_new(run_command, working_dir):
     assert os.path.isfile(os.path.join(current_sketch_path, sketch_name + ".ino"))
 
 
+
+def test_sketch_new_from_template(run_command, data_dir, working_dir):
+    template_dir = Path(data_dir, "templates", "blink")
+    template_dir.mkdir(parents=True)
+    Path(template_dir, "main.ino").write_text("// {{name}} by {{author}}\nvoid setup() {}\nvoid loop() {}\n")
+    Path(template_dir, "{{name}}.h").write_text("// {{name}}\n")
+
+    sketch_name = "SketchNewFromTemplate"
+    sketch_path = Path(working_dir, sketch_name)
+    result = run_command("sketch new {} --template blink --author Tester".format(sketch_name))
+    assert result.ok
+    assert "Sketch created in: {}".format(sketch_path) in result.stdout
+    main_file = Path(sketch_path, sketch_name + ".ino")
+    assert main_file.read_text().startswith("// SketchNewFromTemplate by Tester\n")
+    assert Path(sketch_path, sketch_name + ".h").read_text() == "// SketchNewFromTemplate\n"
+    assert not Path(sketch_path, "main.ino").exists()
+
+    # The main file is never overwritten
+    result = run_command("sketch new {}".format(sketch_name))
+    assert result.failed
+    assert main_file.read_text().startswith("// SketchNewFromTemplate by Tester\n")
+
+    # Unknown templates list the available ones
+    result = run_command("sketch new OtherSketch --template unknown")
+    assert result.failed
+    assert "available templates: blink" in result.stderr
+
+
 def verify_zip_contains_sketch_excluding_build_dir(files):
     assert "sketch_simple/doc.txt" in files
     assert "sketch_simple/header.h" in files
@@ -795,3 +824,106 @@ def test_sketch_archive_absolute_sketch_path_with_absolute_zip_path_and_name_wit
     verify_zip_contains_sketch_including_build_dir(archive_files)
 
     archive.close()
+
+
+def test_sketch_archive_with_exclusions(run_command, copy_sketch, working_dir):
+    Path(copy_sketch, ".gitignore").write_text("# ignored files\n*.txt\n!doc.txt\n")
+
+    result = run_command("sketch archive . --exclude src/ --exclude old.pde", copy_sketch)
+    assert result.ok
+
+    archive = zipfile.ZipFile(f"{working_dir}/sketch_simple.zip")
+    archive_files = archive.namelist()
+    assert "sketch_simple/sketch_simple.ino" in archive_files
+    assert "sketch_simple/doc.txt" in archive_files
+    assert "sketch_simple/merged_sketch.txt" not in archive_files
+    assert "sketch_simple/old.pde" not in archive_files
+    assert "sketch_simple/src/helper.h" not in archive_files
+    archive.close()
+
+
+def test_sketch_archive_with_manifest(run_command, copy_sketch, working_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    result = run_command("sketch archive . --include-manifest -b arduino:avr:uno", copy_sketch)
+    assert result.ok
+
+    archive = zipfile.ZipFile(f"{working_dir}/sketch_simple.zip")
+    manifest = json.loads(archive.read("sketch_simple/manifest.json"))
+    archive.close()
+    assert manifest["sketch"] == "sketch_simple"
+    assert manifest["fqbn"] == "arduino:avr:uno"
+    assert manifest["platform"]["name"] == "arduino:avr"
+    assert manifest["libraries"] == []
+
+
+def test_sketch_new_json_output(run_command, working_dir):
+    sketch_name = "SketchNewJsonOutput"
+    result = run_command("sketch new {} --format json".format(sketch_name))
+    assert result.ok
+    assert json.loads(result.stdout) == {"sketch_path": os.path.join(working_dir, sketch_name)}
+
+
+def test_sketch_preprocess(run_command, data_dir):
+    assert run_command("core update-index")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "SketchPreprocess"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, "other.ino"), "w") as f:
+        f.write("void other() { setup(); }\n")
+
+    result = run_command("sketch preprocess -b arduino:avr:uno {}".format(sketch_path))
+    assert result.ok
+    assert "#include <Arduino.h>" in result.stdout
+    assert "void other();" in result.stdout
+    assert "#line 1 " in result.stdout
+
+    result = run_command("sketch preprocess -b arduino:avr:uno {} --format json".format(sketch_path))
+    assert result.ok
+    res = json.loads(result.stdout)
+    assert res["file_name"] == sketch_name + ".ino.cpp"
+    assert "void other();" in res["source"]
+
+    output_dir = os.path.join(data_dir, "preprocessed")
+    result = run_command("sketch preprocess -b arduino:avr:uno {} --output-dir {}".format(sketch_path, output_dir))
+    assert result.ok
+    output_file = Path(output_dir, sketch_name + ".ino.cpp")
+    assert output_file.exists()
+    assert "void other();" in output_file.read_text()
+
+
+def test_sketch_includes(run_command, data_dir):
+    assert run_command("update")
+    assert run_command("core install arduino:avr")
+
+    sketch_name = "SketchIncludes"
+    sketch_path = os.path.join(data_dir, sketch_name)
+    assert run_command("sketch new {}".format(sketch_path))
+    with open(os.path.join(sketch_path, sketch_name + ".ino"), "w") as f:
+        f.write("#include <SPI.h>\n#include <Servo.h>\n#include <NotExistingHeader.h>\nvoid setup() {}\nvoid loop() {}\n")
+
+    result = run_command("sketch includes -b arduino:avr:uno {} --format json".format(sketch_path))
+    assert result.ok
+    res = json.loads(result.stdout)
+    libraries = {r["header"]: r["used"]["name"] for r in res["libraries"]}
+    assert libraries["SPI.h"] == "SPI"
+    unresolved = {u["header"]: u.get("suggested_libraries", []) for u in res["unresolved_includes"]}
+    assert "Servo" in unresolved["Servo.h"]
+    assert unresolved["NotExistingHeader.h"] == []
+
+    # Once the library is installed the header is resolved
+    assert run_command("lib install Servo")
+    result = run_command("sketch includes -b arduino:avr:uno {} --format json".format(sketch_path))
+    assert result.ok
+    res = json.loads(result.stdout)
+    libraries = {r["header"]: r["used"]["name"] for r in res["libraries"]}
+    assert libraries["Servo.h"] == "Servo"
+    assert [u["header"] for u in res["unresolved_includes"]] == ["NotExistingHeader.h"]
+
+    # The text output lists the missing headers
+    result = run_command("sketch includes -b arduino:avr:uno {}".format(sketch_path))
+    assert result.ok
+    assert "NotExistingHeader.h" in result.stdout
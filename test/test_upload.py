@@ -12,6 +12,7 @@
 # otherwise use the software for commercial activities involving the Arduino
 # software without disclosing the source code of your own applications. To purchase
 # a commercial license, send an email to license@arduino.cc.
+import json
 import os
 import time
 
@@ -79,6 +80,43 @@ def test_upload_after_attach(run_command, data_dir, detected_boards):
         assert run_command("upload  {sketch_path}".format(sketch_path=sketch_path))
 
 
+def test_upload_multiple(run_command, data_dir, detected_boards):
+    # Init the environment explicitly
+    run_command("core update-index")
+
+    boards = {}
+    for board in detected_boards:
+        boards.setdefault(board.fqbn, []).append(board)
+
+    for fqbn, same_boards in boards.items():
+        # Download core
+        run_command(f"core install {same_boards[0].core}")
+        # Create a sketch and build it
+        sketch_path = os.path.join(data_dir, "foo")
+        assert run_command(f"sketch new {sketch_path}")
+        assert run_command(f"compile -b {fqbn} {sketch_path}")
+
+        addresses = sorted(b.address for b in same_boards)
+        ports = " ".join(f"-p {address}" for address in addresses)
+        result = run_command(f"upload -b {fqbn} {ports} {sketch_path} --format json")
+        print(result.stderr)
+        assert result.ok
+        uploads = json.loads(result.stdout)["uploads"]
+        assert sorted(u["port"] for u in uploads) == addresses
+        assert all(u["success"] for u in uploads)
+
+        # multiple uploads requires some pauses
+        time.sleep(2)
+        result = run_command(f"upload --all-matching {fqbn} {sketch_path} --format json")
+        print(result.stderr)
+        assert result.ok
+        uploads = json.loads(result.stdout)["uploads"]
+        assert sorted(u["port"] for u in uploads) == addresses
+
+        # multiple uploads requires some pauses
+        time.sleep(2)
+
+
 def test_compile_and_upload_combo(run_command, data_dir, detected_boards, wait_for_board):
     # Init the environment explicitly
     run_command("core update-index")
@@ -0,0 +1,69 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package tracing carries the ID of the request being served through the
+// context passed to the commands, so that the log entries of the processes,
+// the downloads and the RPCs started by a request can be correlated.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/sirupsen/logrus"
+)
+
+type requestIDKey struct{}
+
+// NewRequestID returns a new random request ID
+func NewRequestID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, if any
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Detach returns a context carrying the same request ID of ctx that is never
+// canceled, it's used to run the operations that must survive the request,
+// or that must be terminated gracefully, while keeping them traced.
+func Detach(ctx context.Context) context.Context {
+	if id := RequestID(ctx); id != "" {
+		return WithRequestID(context.Background(), id)
+	}
+	return context.Background()
+}
+
+// Logger returns the logger to use while serving the request carried by ctx,
+// its entries are tagged with the request ID.
+func Logger(ctx context.Context) *logrus.Entry {
+	if id := RequestID(ctx); id != "" {
+		return logrus.WithField("request_id", id)
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
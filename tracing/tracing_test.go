@@ -0,0 +1,44 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2020 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestID(t *testing.T) {
+	require.Empty(t, RequestID(context.Background()))
+	require.NotContains(t, Logger(context.Background()).Data, "request_id")
+
+	id := NewRequestID()
+	require.Len(t, id, 16)
+	require.NotEqual(t, id, NewRequestID())
+
+	ctx, cancel := context.WithTimeout(WithRequestID(context.Background(), id), time.Hour)
+	require.Equal(t, id, RequestID(ctx))
+	require.Equal(t, id, Logger(ctx).Data["request_id"])
+
+	// A detached context keeps the ID but it's not canceled
+	detached := Detach(ctx)
+	cancel()
+	require.Error(t, ctx.Err())
+	require.NoError(t, detached.Err())
+	require.Equal(t, id, RequestID(detached))
+}